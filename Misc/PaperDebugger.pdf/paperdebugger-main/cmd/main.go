@@ -1,14 +1,22 @@
 package main
 
 import (
+	"os"
+	"os/signal"
 	"paperdebugger/internal"
 	"paperdebugger/internal/api"
 	"paperdebugger/internal/libs/logger"
+	"syscall"
 )
 
 func main() {
 	app := initializeAppOnly()
-	app.Run(":6060")
+	go app.Run(":6060")
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	app.Shutdown()
 }
 
 // initializeAppOnly initializes the app without starting the server (for testing)
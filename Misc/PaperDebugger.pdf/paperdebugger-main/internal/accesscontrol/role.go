@@ -0,0 +1,46 @@
+package accesscontrol
+
+// Role is the role of a user in a project, the users who are not members of a project have the
+// role RoleNone.
+type Role string
+
+const (
+	RoleNone   Role = ""
+	RoleOwner  Role = "owner"  // manages the members of the project
+	RoleEditor Role = "editor" // shares conversations and accepts the comments of shared conversations
+	RoleViewer Role = "viewer" // reads the shared conversations
+)
+
+// Permission is an action on the shared data of a project.
+type Permission int
+
+const (
+	// PermissionRead reads the members and the shared conversations of the project.
+	PermissionRead Permission = iota
+	// PermissionWrite shares conversations in the project and acts on the shared ones.
+	PermissionWrite
+	// PermissionManageMembers adds and removes members and changes their roles.
+	PermissionManageMembers
+)
+
+// ParseRole returns the role named s, it returns false for RoleNone and unknown names.
+func ParseRole(s string) (Role, bool) {
+	switch role := Role(s); role {
+	case RoleOwner, RoleEditor, RoleViewer:
+		return role, true
+	}
+	return RoleNone, false
+}
+
+// Can reports whether the role grants the permission.
+func (r Role) Can(permission Permission) bool {
+	switch r {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return permission == PermissionRead || permission == PermissionWrite
+	case RoleViewer:
+		return permission == PermissionRead
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package accesscontrol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	role, ok := ParseRole("editor")
+	assert.True(t, ok)
+	assert.Equal(t, RoleEditor, role)
+
+	_, ok = ParseRole("")
+	assert.False(t, ok)
+	_, ok = ParseRole("admin")
+	assert.False(t, ok)
+}
+
+func TestRoleCan(t *testing.T) {
+	assert.True(t, RoleOwner.Can(PermissionManageMembers))
+	assert.True(t, RoleEditor.Can(PermissionWrite))
+	assert.False(t, RoleEditor.Can(PermissionManageMembers))
+	assert.True(t, RoleViewer.Can(PermissionRead))
+	assert.False(t, RoleViewer.Can(PermissionWrite))
+	assert.False(t, RoleNone.Can(PermissionRead))
+}
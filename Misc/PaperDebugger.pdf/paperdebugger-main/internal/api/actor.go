@@ -29,10 +29,13 @@ func parseUserActor(ctx context.Context, token string, userService *services.Use
 		return nil, shared.ErrInvalidActor()
 	}
 
-	_, err = userService.GetUserByID(ctx, actorID)
+	user, err := userService.GetUserByID(ctx, actorID)
 	if err != nil {
 		return nil, shared.ErrInvalidUser(err)
 	}
+	if user.DeletionRequestedAt != nil {
+		return nil, shared.ErrInvalidUser("the account is being deleted")
+	}
 
 	return &accesscontrol.Actor{ID: actorID}, nil
 }
@@ -0,0 +1,141 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *AdminServer) ListDefaultPrompts(
+	ctx context.Context,
+	req *adminv1.ListDefaultPromptsRequest,
+) (*adminv1.ListDefaultPromptsResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	prompts, err := s.defaultPromptService.ListDefaultPrompts(ctx, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.ListDefaultPromptsResponse{
+		Prompts: lo.Map(prompts, func(prompt *models.DefaultPrompt, _ int) *adminv1.DefaultPrompt {
+			return mapper.MapDefaultPromptToProto(prompt)
+		}),
+	}, nil
+}
+
+func (s *AdminServer) CreateDefaultPrompt(
+	ctx context.Context,
+	req *adminv1.CreateDefaultPromptRequest,
+) (*adminv1.CreateDefaultPromptResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	prompt, err := s.defaultPromptService.CreateDefaultPrompt(ctx, &models.DefaultPrompt{
+		Key:             req.GetKey(),
+		Category:        req.GetCategory(),
+		Tags:            req.GetTags(),
+		Order:           int(req.GetOrder()),
+		Title:           req.GetTitle(),
+		LocalizedTitles: req.GetLocalizedTitles(),
+		Content:         req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.CreateDefaultPromptResponse{
+		Prompt: mapper.MapDefaultPromptToProto(prompt),
+	}, nil
+}
+
+func (s *AdminServer) UpdateDefaultPrompt(
+	ctx context.Context,
+	req *adminv1.UpdateDefaultPromptRequest,
+) (*adminv1.UpdateDefaultPromptResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	promptID, err := bson.ObjectIDFromHex(req.GetPromptId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid prompt id")
+	}
+
+	prompt, err := s.defaultPromptService.GetDefaultPrompt(ctx, promptID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("prompt not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if prompt.Version != int(req.GetVersion()) {
+		return nil, shared.ErrBadRequest("the prompt was changed since, reload it and try again")
+	}
+
+	if req.Category != nil {
+		prompt.Category = req.GetCategory()
+	}
+	if req.Tags != nil {
+		prompt.Tags = req.GetTags().GetTags()
+	}
+	if req.Order != nil {
+		prompt.Order = int(req.GetOrder())
+	}
+	if req.Title != nil {
+		prompt.Title = req.GetTitle()
+	}
+	if req.LocalizedTitles != nil {
+		prompt.LocalizedTitles = req.GetLocalizedTitles().GetTitles()
+	}
+	if req.Content != nil {
+		prompt.Content = req.GetContent()
+	}
+
+	prompt, err = s.defaultPromptService.UpdateDefaultPrompt(ctx, prompt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrBadRequest("the prompt was changed since, reload it and try again")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.UpdateDefaultPromptResponse{
+		Prompt: mapper.MapDefaultPromptToProto(prompt),
+	}, nil
+}
+
+func (s *AdminServer) DeleteDefaultPrompt(
+	ctx context.Context,
+	req *adminv1.DeleteDefaultPromptRequest,
+) (*adminv1.DeleteDefaultPromptResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	promptID, err := bson.ObjectIDFromHex(req.GetPromptId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid prompt id")
+	}
+
+	err = s.defaultPromptService.DeleteDefaultPrompt(ctx, promptID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("prompt not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.DeleteDefaultPromptResponse{}, nil
+}
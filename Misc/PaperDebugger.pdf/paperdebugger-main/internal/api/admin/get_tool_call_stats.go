@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const defaultToolCallStatsWindow = 7 * 24 * time.Hour
+
+func (s *AdminServer) GetToolCallStats(
+	ctx context.Context,
+	req *adminv1.GetToolCallStatsRequest,
+) (*adminv1.GetToolCallStatsResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := toolCallFilter("", req.GetProjectId(), req.GetToolName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-defaultToolCallStatsWindow)
+	if req.Since != nil {
+		since = req.GetSince().AsTime()
+	}
+
+	stats, err := s.toolCallService.GetToolCallStats(ctx, filter, since)
+	if err != nil {
+		s.logger.Error("Failed to get tool call stats", "error", err)
+		return nil, err
+	}
+
+	return &adminv1.GetToolCallStatsResponse{
+		Tools: lo.Map(stats, func(stats services.ToolCallStats, _ int) *adminv1.ToolCallStats {
+			return mapper.MapToolCallStatsToProto(stats)
+		}),
+	}, nil
+}
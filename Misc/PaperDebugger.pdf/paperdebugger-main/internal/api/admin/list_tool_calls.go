@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	defaultToolCallsPageSize = 50
+	maxToolCallsPageSize     = 200
+)
+
+func (s *AdminServer) ListToolCalls(
+	ctx context.Context,
+	req *adminv1.ListToolCallsRequest,
+) (*adminv1.ListToolCallsResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := toolCallFilter(req.GetConversationId(), req.GetProjectId(), req.GetToolName(), req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	var before bson.ObjectID
+	if req.GetPageToken() != "" {
+		before, err = bson.ObjectIDFromHex(req.GetPageToken())
+		if err != nil {
+			return nil, shared.ErrBadRequest("invalid page_token")
+		}
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultToolCallsPageSize
+	}
+	limit = min(limit, maxToolCallsPageSize)
+
+	calls, err := s.toolCallService.ListToolCalls(ctx, filter, before, limit)
+	if err != nil {
+		s.logger.Error("Failed to list tool calls", "error", err)
+		return nil, err
+	}
+
+	resp := &adminv1.ListToolCallsResponse{
+		ToolCalls: lo.Map(calls, func(c *models.FunctionCall, _ int) *adminv1.ToolCall {
+			return mapper.MapModelToolCallToProto(c)
+		}),
+	}
+	if len(calls) == limit {
+		resp.NextPageToken = lo.ToPtr(calls[len(calls)-1].ID.Hex())
+	}
+	return resp, nil
+}
+
+func toolCallFilter(conversationID, projectID, toolName, status string) (services.ToolCallFilter, error) {
+	switch models.FunctionCallStatus(status) {
+	case "", models.FunctionCallStatusPending, models.FunctionCallStatusSuccess, models.FunctionCallStatusError, models.FunctionCallStatusTimeout:
+	default:
+		return services.ToolCallFilter{}, shared.ErrBadRequest("invalid status: " + status)
+	}
+	return services.ToolCallFilter{
+		ConversationID: conversationID,
+		ProjectID:      projectID,
+		ToolName:       toolName,
+		Status:         models.FunctionCallStatus(status),
+	}, nil
+}
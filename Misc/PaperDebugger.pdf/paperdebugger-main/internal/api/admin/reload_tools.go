@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"context"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+)
+
+func (s *AdminServer) ReloadTools(
+	ctx context.Context,
+	req *adminv1.ReloadToolsRequest,
+) (*adminv1.ReloadToolsResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tools, errs := s.aiClient.ReloadTools(ctx)
+	errors := make([]string, 0, len(errs))
+	for _, err := range errs {
+		s.logger.Error("Failed to reload tools", "error", err)
+		errors = append(errors, err.Error())
+	}
+
+	return &adminv1.ReloadToolsResponse{
+		Tools:  tools,
+		Errors: errors,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	aiclient "paperdebugger/internal/services/toolkit/client"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+)
+
+// AdminServer implements adminv1.AdminServiceServer
+type AdminServer struct {
+	adminv1.UnimplementedAdminServiceServer
+	aiClient             *aiclient.AIClient
+	userService          *services.UserService
+	toolCallService      *services.ToolCallService
+	defaultPromptService *services.DefaultPromptService
+	systemPromptService  *services.SystemPromptService
+	logger               *logger.Logger
+	cfg                  *cfg.Cfg
+}
+
+func NewAdminServer(
+	aiClient *aiclient.AIClient,
+	userService *services.UserService,
+	toolCallService *services.ToolCallService,
+	defaultPromptService *services.DefaultPromptService,
+	systemPromptService *services.SystemPromptService,
+	logger *logger.Logger,
+	cfg *cfg.Cfg,
+) adminv1.AdminServiceServer {
+	return &AdminServer{
+		aiClient:             aiClient,
+		userService:          userService,
+		toolCallService:      toolCallService,
+		defaultPromptService: defaultPromptService,
+		systemPromptService:  systemPromptService,
+		logger:               logger,
+		cfg:                  cfg,
+	}
+}
+
+// checkAdmin makes sure the actor is one of the configured admins.
+func (s *AdminServer) checkAdmin(ctx context.Context) error {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userService.GetUserByID(ctx, actor.ID)
+	if err != nil {
+		return err
+	}
+	if !s.cfg.IsAdmin(user.Email) {
+		return shared.ErrPermissionDenied("admin only")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *AdminServer) ListSystemPrompts(
+	ctx context.Context,
+	req *adminv1.ListSystemPromptsRequest,
+) (*adminv1.ListSystemPromptsResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	systemPrompts, err := s.systemPromptService.ListSystemPrompts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.ListSystemPromptsResponse{
+		SystemPrompts: lo.Map(systemPrompts, func(t *services.SystemPromptTemplate, _ int) *adminv1.SystemPrompt {
+			return mapper.MapSystemPromptToProto(t)
+		}),
+	}, nil
+}
+
+// UpdateSystemPrompt overrides the template of a persona, it takes effect for the next new
+// conversations. With validate_only, the template is only checked and previewed.
+func (s *AdminServer) UpdateSystemPrompt(
+	ctx context.Context,
+	req *adminv1.UpdateSystemPromptRequest,
+) (*adminv1.UpdateSystemPromptResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	systemPrompt, preview, err := s.systemPromptService.UpdateSystemPrompt(ctx, actor.ID, models.Persona(req.GetPersona()), req.GetContent(), req.GetValidateOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.UpdateSystemPromptResponse{
+		SystemPrompt: mapper.MapSystemPromptToProto(systemPrompt),
+		Preview:      preview,
+	}, nil
+}
+
+// ResetSystemPrompt deletes the override of a persona, the template of the configuration or the
+// built-in one is used again.
+func (s *AdminServer) ResetSystemPrompt(
+	ctx context.Context,
+	req *adminv1.ResetSystemPromptRequest,
+) (*adminv1.ResetSystemPromptResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	systemPrompt, err := s.systemPromptService.ResetSystemPrompt(ctx, models.Persona(req.GetPersona()))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("the system prompt is not overridden")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.ResetSystemPromptResponse{
+		SystemPrompt: mapper.MapSystemPromptToProto(systemPrompt),
+	}, nil
+}
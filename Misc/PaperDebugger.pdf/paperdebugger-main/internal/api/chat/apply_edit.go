@@ -0,0 +1,46 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services/toolkit/handler"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *ChatServer) ApplyEdit(
+	ctx context.Context,
+	req *chatv1.ApplyEditRequest,
+) (*chatv1.ApplyEditResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+	editID, err := bson.ObjectIDFromHex(req.GetEditId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid edit_id")
+	}
+
+	edit, err := s.textEditService.ResolveTextEdit(ctx, actor.ID, conversationID, editID, req.GetAccepted())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("edit not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to resolve text edit", "error", err, "editID", req.GetEditId())
+		return nil, shared.ErrInternal("failed to resolve text edit")
+	}
+
+	return &chatv1.ApplyEditResponse{
+		Edit: handler.TextEditToProto(*edit),
+	}, nil
+}
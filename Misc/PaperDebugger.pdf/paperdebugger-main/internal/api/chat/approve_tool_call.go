@@ -0,0 +1,12 @@
+package chat
+
+import (
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+)
+
+func (s *ChatServer) ApproveToolCall(
+	req *chatv1.ApproveToolCallRequest,
+	stream chatv1.ChatService_ApproveToolCallServer,
+) error {
+	return s.resolveToolCall(stream, req.GetConversationId(), req.GetToolCallId(), true, "")
+}
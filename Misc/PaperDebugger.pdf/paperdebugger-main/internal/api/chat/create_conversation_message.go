@@ -2,6 +2,8 @@ package chat
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"paperdebugger/internal/api/mapper"
 	"paperdebugger/internal/libs/contextutil"
@@ -21,7 +23,8 @@ import (
 // 我们发送给 GPT 的就是从数据库里拿到的 Conversation 对象里面的内容（InputItemList）
 
 // buildUserMessage constructs both the user-facing message and the OpenAI input message
-func (s *ChatServer) buildUserMessage(ctx context.Context, userMessage, userSelectedText string, conversationType chatv1.ConversationType) (*chatv1.Message, *responses.ResponseInputItemUnionParam, error) {
+// promptID is the prompt of the library the message was written from, "" if none.
+func (s *ChatServer) buildUserMessage(ctx context.Context, userMessage, userSelectedText, promptID string, conversationType chatv1.ConversationType) (*chatv1.Message, *responses.ResponseInputItemUnionParam, error) {
 	userPrompt, err := s.chatService.GetPrompt(ctx, userMessage, userSelectedText, conversationType)
 	if err != nil {
 		return nil, nil, err
@@ -31,7 +34,7 @@ func (s *ChatServer) buildUserMessage(ctx context.Context, userMessage, userSele
 	switch conversationType {
 	case chatv1.ConversationType_CONVERSATION_TYPE_DEBUG:
 		inappMessage = &chatv1.Message{
-			MessageId: "pd_msg_user_" + uuid.New().String(),
+			MessageId: models.UserMessageIDPrefix + uuid.New().String(),
 			Payload: &chatv1.MessagePayload{
 				MessageType: &chatv1.MessagePayload_User{
 					User: &chatv1.MessageTypeUser{
@@ -42,7 +45,7 @@ func (s *ChatServer) buildUserMessage(ctx context.Context, userMessage, userSele
 		}
 	default:
 		inappMessage = &chatv1.Message{
-			MessageId: "pd_msg_user_" + uuid.New().String(),
+			MessageId: models.UserMessageIDPrefix + uuid.New().String(),
 			Payload: &chatv1.MessagePayload{
 				MessageType: &chatv1.MessagePayload_User{
 					User: &chatv1.MessageTypeUser{
@@ -54,6 +57,10 @@ func (s *ChatServer) buildUserMessage(ctx context.Context, userMessage, userSele
 		}
 	}
 
+	if promptID != "" {
+		inappMessage.GetPayload().GetUser().PromptId = &promptID
+	}
+
 	openaiMessage := &responses.ResponseInputItemUnionParam{
 		OfInputMessage: &responses.ResponseInputItemMessageParam{
 			Role: "user",
@@ -104,6 +111,26 @@ func convertToBSON(msg *chatv1.Message) (bson.M, error) {
 	return bsonMsg, nil
 }
 
+// mergeInappMessages appends messages to the in-app chat history of the conversation.
+// A message replaces the one with the same id, e.g. a resolved tool call replaces its approval request.
+func mergeInappMessages(conversation *models.Conversation, messages []chatv1.Message) error {
+	for i := range messages {
+		bsonMsg, err := convertToBSON(&messages[i])
+		if err != nil {
+			return err
+		}
+		index := slices.IndexFunc(conversation.InappChatHistory, func(msg bson.M) bool {
+			return msg["messageId"] == messages[i].GetMessageId()
+		})
+		if index >= 0 {
+			conversation.InappChatHistory[index] = bsonMsg
+		} else {
+			conversation.InappChatHistory = append(conversation.InappChatHistory, bsonMsg)
+		}
+	}
+	return nil
+}
+
 // 创建对话并写入数据库
 // 返回 Conversation 对象
 func (s *ChatServer) createConversation(
@@ -111,20 +138,23 @@ func (s *ChatServer) createConversation(
 	userId bson.ObjectID,
 	projectId string,
 	latexFullSource string,
+	teamInstructions string,
 	projectInstructions string,
 	userInstructions string,
 	userMessage string,
 	userSelectedText string,
+	promptID string,
 	languageModel models.LanguageModel,
 	conversationType chatv1.ConversationType,
 ) (*models.Conversation, error) {
-	systemPrompt, err := s.chatService.GetSystemPrompt(ctx, latexFullSource, projectInstructions, userInstructions, conversationType)
+	persona := models.PersonaFromProto(conversationType)
+	systemPrompt, err := s.chatService.GetSystemPrompt(ctx, latexFullSource, teamInstructions, projectInstructions, userInstructions, persona)
 	if err != nil {
 		return nil, err
 	}
 
 	_, openaiSystemMsg := s.buildSystemMessage(systemPrompt)
-	inappUserMsg, openaiUserMsg, err := s.buildUserMessage(ctx, userMessage, userSelectedText, conversationType)
+	inappUserMsg, openaiUserMsg, err := s.buildUserMessage(ctx, userMessage, userSelectedText, promptID, conversationType)
 	if err != nil {
 		return nil, err
 	}
@@ -135,18 +165,21 @@ func (s *ChatServer) createConversation(
 	}
 
 	return s.chatService.InsertConversationToDB(
-		ctx, userId, projectId, languageModel, messages, oaiHistory.OfInputItemList,
+		ctx, userId, projectId, languageModel, persona, messages, oaiHistory.OfInputItemList,
 	)
 }
 
 // 追加消息到对话并写入数据库
 // 返回 Conversation 对象
+// If branchAt is set, the message is a new version of that user message, on a new branch.
 func (s *ChatServer) appendConversationMessage(
 	ctx context.Context,
 	userId bson.ObjectID,
 	conversationId string,
+	branchAt string,
 	userMessage string,
 	userSelectedText string,
+	promptID string,
 	conversationType chatv1.ConversationType,
 ) (*models.Conversation, error) {
 	objectID, err := bson.ObjectIDFromHex(conversationId)
@@ -159,7 +192,26 @@ func (s *ChatServer) appendConversationMessage(
 		return nil, err
 	}
 
-	userMsg, userOaiMsg, err := s.buildUserMessage(ctx, userMessage, userSelectedText, conversationType)
+	// results of background tool calls which could not be delivered yet, e.g. because of a restart
+	deliveredJobs, err := s.deliverToolJobs(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+
+	if branchAt != "" {
+		if err := conversation.BranchAt(branchAt); err != nil {
+			return nil, branchError(err)
+		}
+	}
+
+	// the model must get an output for every tool call, the new message denies the ones awaiting approval
+	openaiChatHistory, deniedToolCalls := s.aiClient.DenyPendingToolCalls(ctx, conversation.OpenaiChatHistory, "the user sent a new message instead")
+	conversation.OpenaiChatHistory = openaiChatHistory
+	if err := mergeInappMessages(conversation, deniedToolCalls); err != nil {
+		return nil, err
+	}
+
+	userMsg, userOaiMsg, err := s.buildUserMessage(ctx, userMessage, userSelectedText, promptID, conversationType)
 	if err != nil {
 		return nil, err
 	}
@@ -170,30 +222,61 @@ func (s *ChatServer) appendConversationMessage(
 	}
 	conversation.InappChatHistory = append(conversation.InappChatHistory, bsonMsg)
 	conversation.OpenaiChatHistory = append(conversation.OpenaiChatHistory, *userOaiMsg)
+	conversation.SyncMessageTree()
 
 	if err := s.chatService.UpdateConversation(conversation); err != nil {
 		return nil, err
 	}
+	if err := s.aiClient.ToolJobs().MarkDelivered(ctx, deliveredJobs); err != nil {
+		s.logger.Error("Failed to mark tool jobs delivered", "error", err, "conversationID", conversationId)
+	}
 
 	return conversation, nil
 }
 
 // 如果 conversationId 是 ""， 就创建新对话，否则就追加消息到对话
 // conversationType 可以在一次 conversation 中多次切换
-func (s *ChatServer) prepare(ctx context.Context, projectId string, conversationId string, userMessage string, userSelectedText string, languageModel models.LanguageModel, conversationType chatv1.ConversationType) (context.Context, *models.Conversation, error) {
+// The persona of a new conversation is the one of conversationType, or the default persona of the
+// project if it is unspecified.
+// responseMode is kept in the conversation until the next user message, it is saved with the turn.
+// The conversation is locked until unlock is called, after the turn was saved (see ChatService.LockConversation).
+// branchAt is passed to appendConversationMessage. promptID, if set, must be a prompt the user can
+// use (see PromptService.GetPrompt), it is recorded with the user message and counted in the
+// usage of the prompt. The selection, userSelectedText at userSelectionOffset, is kept in the
+// context, the revisions are turned into edits of it.
+func (s *ChatServer) prepare(ctx context.Context, projectId string, conversationId string, branchAt string, userMessage string, userSelectedText string, userSelectionOffset *int32, promptID string, languageModel models.LanguageModel, conversationType chatv1.ConversationType, responseMode models.ResponseMode) (_ context.Context, _ *models.Conversation, unlock func(), err error) {
 	actor, err := contextutil.GetActor(ctx)
 	if err != nil {
-		return ctx, nil, err
+		return ctx, nil, nil, err
 	}
 
 	project, err := s.projectService.GetProject(ctx, actor.ID, projectId)
 	if err != nil && err != mongo.ErrNoDocuments {
-		return ctx, nil, err
+		return ctx, nil, nil, err
 	}
 
+	var prompt *models.Prompt
+	if promptID != "" {
+		prompt, err = s.promptService.GetPrompt(ctx, actor.ID, promptID)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ctx, nil, nil, shared.ErrBadRequest("prompt not found")
+		}
+		if err != nil {
+			return ctx, nil, nil, err
+		}
+	}
+
+	teamInstructions, err := s.organizationService.GetTeamInstructions(ctx, actor.ID)
+	if err != nil {
+		return ctx, nil, nil, err
+	}
 	userInstructions, err := s.userService.GetUserInstructions(ctx, actor.ID)
 	if err != nil {
-		return ctx, nil, err
+		return ctx, nil, nil, err
+	}
+
+	if conversationId == "" && conversationType == chatv1.ConversationType_CONVERSATION_TYPE_UNSPECIFIED && project != nil {
+		conversationType = project.DefaultPersona.ConversationType()
 	}
 
 	var latexFullSource string
@@ -202,12 +285,12 @@ func (s *ChatServer) prepare(ctx context.Context, projectId string, conversation
 		latexFullSource = "latex_full_source is not available in debug mode"
 	default:
 		if project == nil || project.IsOutOfDate() {
-			return ctx, nil, shared.ErrProjectOutOfDate("project is out of date")
+			return ctx, nil, nil, shared.ErrProjectOutOfDate("project is out of date")
 		}
 
 		latexFullSource, err = project.GetFullContent()
 		if err != nil {
-			return ctx, nil, err
+			return ctx, nil, nil, err
 		}
 	}
 
@@ -219,32 +302,61 @@ func (s *ChatServer) prepare(ctx context.Context, projectId string, conversation
 			actor.ID,
 			projectId,
 			latexFullSource,
+			teamInstructions,
 			project.Instructions,
 			userInstructions,
 			userMessage,
 			userSelectedText,
+			promptID,
 			languageModel,
 			conversationType,
 		)
+		if err == nil {
+			unlock = s.chatService.LockConversation(conversation.ID)
+		}
 	} else {
+		objectID, parseErr := bson.ObjectIDFromHex(conversationId)
+		if parseErr != nil {
+			return ctx, nil, nil, shared.ErrBadRequest("invalid conversation_id")
+		}
+		unlock = s.chatService.LockConversation(objectID)
 		conversation, err = s.appendConversationMessage(
 			ctx,
 			actor.ID,
 			conversationId,
+			branchAt,
 			userMessage,
 			userSelectedText,
+			promptID,
 			conversationType,
 		)
 	}
 
 	if err != nil {
-		return ctx, nil, err
+		if unlock != nil {
+			unlock()
+		}
+		return ctx, nil, nil, err
 	}
 
+	if prompt != nil {
+		if err := s.promptService.RecordPromptUsage(ctx, actor.ID, prompt.ID); err != nil {
+			s.logger.Error("Failed to record the prompt usage", err)
+		}
+	}
+
+	conversation.ResponseMode = responseMode
 	ctx = contextutil.SetProjectID(ctx, conversation.ProjectID)
 	ctx = contextutil.SetConversationID(ctx, conversation.ID.Hex())
+	ctx = contextutil.SetResponseMode(ctx, responseMode)
+	selection := contextutil.Selection{Text: userSelectedText}
+	if userSelectionOffset != nil {
+		offset := int(*userSelectionOffset)
+		selection.Offset = &offset
+	}
+	ctx = contextutil.SetSelection(ctx, selection)
 
-	return ctx, conversation, nil
+	return ctx, conversation, unlock, nil
 }
 
 // Deprecated: Use CreateConversationMessageStream instead.
@@ -252,18 +364,23 @@ func (s *ChatServer) CreateConversationMessage(
 	ctx context.Context,
 	req *chatv1.CreateConversationMessageRequest,
 ) (*chatv1.CreateConversationMessageResponse, error) {
-	ctx, conversation, err := s.prepare(
+	ctx, conversation, unlock, err := s.prepare(
 		ctx,
 		req.GetProjectId(),
 		req.GetConversationId(),
+		"",
 		req.GetUserMessage(),
 		req.GetUserSelectedText(),
+		req.UserSelectionOffset,
+		req.GetPromptId(),
 		models.LanguageModel(req.GetLanguageModel()),
 		req.GetConversationType(),
+		models.ResponseModeFromProto(req.GetResponseMode()),
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer unlock()
 
 	openaiChatHistory, inappChatHistory, err := s.aiClient.ChatCompletion(ctx, conversation.LanguageModel, conversation.OpenaiChatHistory)
 	if err != nil {
@@ -295,8 +412,7 @@ func (s *ChatServer) CreateConversationMessage(
 			s.logger.Error("Failed to get conversation title", "error", err, "conversationID", conversation.ID.Hex())
 			return
 		}
-		conversation.Title = title
-		if err := s.chatService.UpdateConversation(conversation); err != nil {
+		if err := s.chatService.UpdateConversationTitle(context.WithoutCancel(ctx), conversation.ID, title); err != nil {
 			s.logger.Error("Failed to update conversation with new title", "error", err, "conversationID", conversation.ID.Hex())
 			return
 		}
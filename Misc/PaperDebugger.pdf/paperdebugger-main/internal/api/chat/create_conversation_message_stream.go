@@ -1,6 +1,8 @@
 package chat
 
 import (
+	"context"
+
 	"paperdebugger/internal/api/mapper"
 	"paperdebugger/internal/models"
 	"paperdebugger/internal/services"
@@ -24,23 +26,39 @@ func (s *ChatServer) CreateConversationMessageStream(
 	stream chatv1.ChatService_CreateConversationMessageStreamServer,
 ) error {
 	ctx := stream.Context()
-	ctx, conversation, err := s.prepare(
+	ctx, conversation, unlock, err := s.prepare(
 		ctx,
 		req.GetProjectId(),
 		req.GetConversationId(),
+		"",
 		req.GetUserMessage(),
 		req.GetUserSelectedText(),
+		req.UserSelectionOffset,
+		req.GetPromptId(),
 		models.LanguageModel(req.GetLanguageModel()),
 		req.GetConversationType(),
+		models.ResponseModeFromProto(req.GetResponseMode()),
 	)
 	if err != nil {
 		return s.sendStreamError(stream, err)
 	}
+	defer unlock()
+
+	if err := s.streamCompletion(ctx, stream, conversation); err != nil {
+		return s.sendStreamError(stream, err)
+	}
 
+	// The final conversation object is NOT returned
+	return nil
+}
+
+// streamCompletion streams the answer to the last user message of the locked conversation and saves
+// the turn. The conversation gets a title if it still has the default one.
+func (s *ChatServer) streamCompletion(ctx context.Context, stream chatv1.ChatService_CreateConversationMessageStreamServer, conversation *models.Conversation) error {
 	// 用法跟 ChatCompletion 一样，只是传递了 stream 参数
 	openaiChatHistory, inappChatHistory, err := s.aiClient.ChatCompletionStream(ctx, stream, conversation.ID.Hex(), conversation.LanguageModel, conversation.OpenaiChatHistory)
 	if err != nil {
-		return s.sendStreamError(stream, err)
+		return err
 	}
 
 	// 附加消息到对话
@@ -48,14 +66,14 @@ func (s *ChatServer) CreateConversationMessageStream(
 	for i := range inappChatHistory {
 		bsonMsg, err := convertToBSON(&inappChatHistory[i])
 		if err != nil {
-			return s.sendStreamError(stream, err)
+			return err
 		}
 		bsonMessages[i] = bsonMsg
 	}
 	conversation.InappChatHistory = append(conversation.InappChatHistory, bsonMessages...)
 	conversation.OpenaiChatHistory = openaiChatHistory
 	if err := s.chatService.UpdateConversation(conversation); err != nil {
-		return s.sendStreamError(stream, err)
+		return err
 	}
 
 	if conversation.Title == services.DefaultConversationTitle {
@@ -69,14 +87,12 @@ func (s *ChatServer) CreateConversationMessageStream(
 				s.logger.Error("Failed to get conversation title", "error", err, "conversationID", conversation.ID.Hex())
 				return
 			}
-			conversation.Title = title
-			if err := s.chatService.UpdateConversation(conversation); err != nil {
+			if err := s.chatService.UpdateConversationTitle(context.WithoutCancel(ctx), conversation.ID, title); err != nil {
 				s.logger.Error("Failed to update conversation with new title", "error", err, "conversationID", conversation.ID.Hex())
 				return
 			}
 		}()
 	}
 
-	// The final conversation object is NOT returned
 	return nil
 }
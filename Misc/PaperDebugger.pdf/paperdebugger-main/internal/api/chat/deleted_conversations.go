@@ -0,0 +1,75 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChatServer) ListDeletedConversations(
+	ctx context.Context,
+	req *chatv1.ListDeletedConversationsRequest,
+) (*chatv1.ListDeletedConversationsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, err := s.chatService.ListDeletedConversations(ctx, actor.ID, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ListDeletedConversationsResponse{
+		Conversations: lo.Map(conversations, func(conversation *models.Conversation, _ int) *chatv1.DeletedConversation {
+			deleted := &chatv1.DeletedConversation{
+				Conversation: mapper.MapModelConversationToProto(conversation),
+				ProjectId:    conversation.ProjectID,
+			}
+			if conversation.DeletedAt != nil {
+				deleted.DeletedAt = timestamppb.New(conversation.DeletedAt.Time())
+				if purgeAt, ok := s.cfg.TrashPurgeAt(conversation.DeletedAt.Time()); ok {
+					deleted.PurgeAt = timestamppb.New(purgeAt)
+				}
+			}
+			return deleted
+		}),
+	}, nil
+}
+
+func (s *ChatServer) RestoreConversation(
+	ctx context.Context,
+	req *chatv1.RestoreConversationRequest,
+) (*chatv1.RestoreConversationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	conversation, err := s.chatService.RestoreConversation(ctx, actor.ID, conversationID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("deleted conversation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.RestoreConversationResponse{
+		Conversation: mapper.MapModelConversationToProto(conversation),
+	}, nil
+}
@@ -0,0 +1,12 @@
+package chat
+
+import (
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+)
+
+func (s *ChatServer) DenyToolCall(
+	req *chatv1.DenyToolCallRequest,
+	stream chatv1.ChatService_DenyToolCallServer,
+) error {
+	return s.resolveToolCall(stream, req.GetConversationId(), req.GetToolCallId(), false, req.GetReason())
+}
@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+)
+
+// EditMessage answers a new version of a past user message, like CreateConversationMessageStream.
+// The messages that followed the old version stay in the conversation tree.
+func (s *ChatServer) EditMessage(
+	req *chatv1.EditMessageRequest,
+	stream chatv1.ChatService_EditMessageServer,
+) error {
+	if req.GetConversationId() == "" {
+		return s.sendStreamError(stream, shared.ErrBadRequest("invalid conversation_id"))
+	}
+
+	ctx, conversation, unlock, err := s.prepare(
+		stream.Context(),
+		req.GetProjectId(),
+		req.GetConversationId(),
+		req.GetMessageId(),
+		req.GetUserMessage(),
+		req.GetUserSelectedText(),
+		req.UserSelectionOffset,
+		"",
+		models.LanguageModel(0), // only used by new conversations
+		req.GetConversationType(),
+		models.ResponseModeFromProto(req.GetResponseMode()),
+	)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	defer unlock()
+
+	if err := s.streamCompletion(ctx, stream, conversation); err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	return nil
+}
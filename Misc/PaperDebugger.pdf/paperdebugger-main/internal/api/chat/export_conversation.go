@@ -0,0 +1,78 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^\p{L}\p{N}_-]+`)
+
+func (s *ChatServer) ExportConversation(
+	ctx context.Context,
+	req *chatv1.ExportConversationRequest,
+) (*chatv1.ExportConversationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	conversation, err := s.chatService.GetAccessibleConversation(ctx, actor.ID, conversationID, accesscontrol.PermissionRead)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("conversation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var content, extension, mimeType string
+	switch req.GetFormat() {
+	case chatv1.ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_UNSPECIFIED,
+		chatv1.ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_JSON:
+		content, err = services.ExportConversationJSON(conversation)
+		extension, mimeType = ".json", "application/json"
+	case chatv1.ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_MARKDOWN:
+		content, err = services.ExportConversationMarkdown(conversation)
+		extension, mimeType = ".md", "text/markdown"
+	case chatv1.ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_OPENAI:
+		content, err = services.ExportConversationOpenAI(conversation)
+		extension, mimeType = ".openai.json", "application/json"
+	default:
+		return nil, shared.ErrBadRequest("unknown export format")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ExportConversationResponse{
+		Filename: exportFilename(conversation) + extension,
+		MimeType: mimeType,
+		Content:  content,
+	}, nil
+}
+
+// exportFilename is the title of the conversation without the characters that are not safe in a
+// filename.
+func exportFilename(conversation *models.Conversation) string {
+	name := strings.Trim(unsafeFilenameChars.ReplaceAllString(conversation.Title, "-"), "-")
+	if name == "" {
+		return "conversation-" + conversation.ID.Hex()
+	}
+	return name
+}
@@ -0,0 +1,102 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *ChatServer) ForkConversation(
+	ctx context.Context,
+	req *chatv1.ForkConversationRequest,
+) (*chatv1.ForkConversationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	// the conversations shared in the project can be forked to continue them
+	conversation, err := s.chatService.GetAccessibleConversation(ctx, actor.ID, conversationID, accesscontrol.PermissionRead)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("conversation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	inappChatHistory, openaiChatHistory, err := conversation.HistoryUpTo(req.GetUpToMessageId())
+	if err != nil {
+		return nil, branchError(err)
+	}
+
+	projectID := conversation.ProjectID
+	if req.TargetProjectId != nil {
+		projectID = req.GetTargetProjectId()
+		systemPrompt, err := s.projectSystemPrompt(ctx, actor.ID, projectID, conversation.Persona)
+		if err != nil {
+			return nil, err
+		}
+		// the items before the first user message are the system prompt of the source project
+		_, openaiSystemMsg := s.buildSystemMessage(systemPrompt)
+		head := slices.IndexFunc(openaiChatHistory, func(item responses.ResponseInputItemUnionParam) bool {
+			return item.OfInputMessage != nil && item.OfInputMessage.Role == "user"
+		})
+		if head < 0 {
+			head = len(openaiChatHistory)
+		}
+		openaiChatHistory = append(responses.ResponseInputParam{*openaiSystemMsg}, openaiChatHistory[head:]...)
+	}
+
+	fork, err := s.chatService.ForkConversation(ctx, actor.ID, conversation, projectID, inappChatHistory, openaiChatHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ForkConversationResponse{
+		Conversation: mapper.MapModelConversationToProto(fork),
+	}, nil
+}
+
+// projectSystemPrompt builds the system prompt of a new conversation grounded on the current
+// content of the project, with the persona of the forked conversation.
+func (s *ChatServer) projectSystemPrompt(ctx context.Context, userID bson.ObjectID, projectID string, persona models.Persona) (string, error) {
+	project, err := s.projectService.GetProject(ctx, userID, projectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", shared.ErrRecordNotFound("project not found")
+	}
+	if err != nil {
+		return "", err
+	}
+	if project.IsOutOfDate() {
+		return "", shared.ErrProjectOutOfDate("project is out of date")
+	}
+	latexFullSource, err := project.GetFullContent()
+	if err != nil {
+		return "", err
+	}
+	teamInstructions, err := s.organizationService.GetTeamInstructions(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	userInstructions, err := s.userService.GetUserInstructions(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return s.chatService.GetSystemPrompt(ctx, latexFullSource, teamInstructions, project.Instructions, userInstructions, persona)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/api/mapper"
 	"paperdebugger/internal/libs/contextutil"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
@@ -23,7 +24,7 @@ func (s *ChatServer) GetConversation(
 		return nil, err
 	}
 
-	conversation, err := s.chatService.GetConversation(ctx, actor.ID, conversationID)
+	conversation, err := s.chatService.GetAccessibleConversation(ctx, actor.ID, conversationID, accesscontrol.PermissionRead)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *ChatServer) ImportConversation(
+	ctx context.Context,
+	req *chatv1.ImportConversationRequest,
+) (*chatv1.ImportConversationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+	_, err = s.projectService.GetProject(ctx, actor.ID, req.GetProjectId())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("project not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := services.ParseConversationExport(req.GetContent())
+	if err != nil {
+		return nil, shared.ErrBadRequest(err.Error())
+	}
+
+	conversation, err = s.chatService.ImportConversation(ctx, actor.ID, req.GetProjectId(), conversation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatv1.ImportConversationResponse{
+		Conversation: mapper.MapModelConversationToProto(conversation),
+	}, nil
+}
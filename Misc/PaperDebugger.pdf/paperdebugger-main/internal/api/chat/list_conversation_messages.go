@@ -0,0 +1,64 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	defaultMessagesPageSize = 50
+	maxMessagesPageSize     = 200
+)
+
+func (s *ChatServer) ListConversationMessages(
+	ctx context.Context,
+	req *chatv1.ListConversationMessagesRequest,
+) (*chatv1.ListConversationMessagesResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultMessagesPageSize
+	}
+	pageSize = min(pageSize, maxMessagesPageSize)
+
+	// the page token is the id of the first message of the previous page
+	page, err := s.chatService.ListConversationMessages(ctx, actor.ID, conversationID, req.GetPageToken(), pageSize)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("conversation not found")
+	}
+	if errors.Is(err, models.ErrMessageNotInTree) {
+		return nil, shared.ErrBadRequest("invalid page_token")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the tree gives the versions of the user messages
+	conversation := &models.Conversation{InappChatHistory: page.Messages, MessageTree: page.MessageTree}
+	resp := &chatv1.ListConversationMessagesResponse{
+		Messages: mapper.MapModelConversationToProto(conversation).GetMessages(),
+	}
+	if page.Start > 0 && len(page.Messages) > 0 {
+		resp.NextPageToken = lo.ToPtr(mapper.BSONToChatMessage(page.Messages[0]).GetMessageId())
+	}
+	return resp, nil
+}
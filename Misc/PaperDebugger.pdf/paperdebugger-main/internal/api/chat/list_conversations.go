@@ -2,14 +2,46 @@ package chat
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"paperdebugger/internal/api/mapper"
 	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
 )
 
+const (
+	defaultConversationsPageSize = 50
+	maxConversationsPageSize     = 200
+)
+
+// conversationPageToken encodes the position of the last conversation of a page.
+func conversationPageToken(conversation *models.Conversation) string {
+	return fmt.Sprintf("%d_%s", int64(conversation.UpdatedAt), conversation.ID.Hex())
+}
+
+func parseConversationPageToken(token string) (*services.ConversationCursor, error) {
+	updatedAt, id, ok := strings.Cut(token, "_")
+	if !ok {
+		return nil, shared.ErrBadRequest("invalid page_token")
+	}
+	millis, err := strconv.ParseInt(updatedAt, 10, 64)
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid page_token")
+	}
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid page_token")
+	}
+	return &services.ConversationCursor{UpdatedAt: bson.DateTime(millis), ID: objectID}, nil
+}
+
 func (s *ChatServer) ListConversations(
 	ctx context.Context,
 	req *chatv1.ListConversationsRequest,
@@ -19,14 +51,32 @@ func (s *ChatServer) ListConversations(
 		return nil, err
 	}
 
-	conversations, err := s.chatService.ListConversations(ctx, actor.ID, req.GetProjectId())
+	var after *services.ConversationCursor
+	if req.GetPageToken() != "" {
+		after, err = parseConversationPageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultConversationsPageSize
+	}
+	pageSize = min(pageSize, maxConversationsPageSize)
+
+	conversations, err := s.chatService.ListConversations(ctx, actor.ID, req.GetProjectId(), after, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
-	return &chatv1.ListConversationsResponse{
+	resp := &chatv1.ListConversationsResponse{
 		Conversations: lo.Map(conversations, func(conversation *models.Conversation, _ int) *chatv1.Conversation {
 			return mapper.MapModelConversationToProto(conversation)
 		}),
-	}, nil
+	}
+	if len(conversations) == pageSize {
+		resp.NextPageToken = lo.ToPtr(conversationPageToken(conversations[len(conversations)-1]))
+	}
+	return resp, nil
 }
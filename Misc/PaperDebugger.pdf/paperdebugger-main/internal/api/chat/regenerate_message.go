@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// RegenerateMessage answers a past user message again: a copy of the message is added on a new
+// branch of the conversation, then the answer is streamed like in CreateConversationMessageStream.
+func (s *ChatServer) RegenerateMessage(
+	req *chatv1.RegenerateMessageRequest,
+	stream chatv1.ChatService_RegenerateMessageServer,
+) error {
+	ctx := stream.Context()
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	objectID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return s.sendStreamError(stream, shared.ErrBadRequest("invalid conversation_id"))
+	}
+
+	unlock := s.chatService.LockConversation(objectID)
+	defer unlock()
+
+	conversation, err := s.chatService.GetConversation(ctx, actor.ID, objectID)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	deliveredJobs, err := s.deliverToolJobs(ctx, conversation)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	if err := conversation.RepeatAt(req.GetMessageId(), models.UserMessageIDPrefix+uuid.New().String()); err != nil {
+		return s.sendStreamError(stream, branchError(err))
+	}
+	conversation.ResponseMode = models.ResponseModeFromProto(req.GetResponseMode())
+
+	if err := s.chatService.UpdateConversation(conversation); err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	if err := s.aiClient.ToolJobs().MarkDelivered(ctx, deliveredJobs); err != nil {
+		s.logger.Error("Failed to mark tool jobs delivered", "error", err, "conversationID", conversation.ID.Hex())
+	}
+
+	ctx = contextutil.SetProjectID(ctx, conversation.ProjectID)
+	ctx = contextutil.SetConversationID(ctx, conversation.ID.Hex())
+	ctx = contextutil.SetResponseMode(ctx, conversation.ResponseMode)
+
+	if err := s.streamCompletion(ctx, stream, conversation); err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"errors"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	aiclient "paperdebugger/internal/services/toolkit/client"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// resolveToolCall approves or denies a tool call awaiting approval, then streams the rest of the turn
+// like CreateConversationMessageStream.
+func (s *ChatServer) resolveToolCall(
+	stream chatv1.ChatService_CreateConversationMessageStreamServer,
+	conversationId string,
+	toolCallId string,
+	approved bool,
+	reason string,
+) error {
+	ctx := stream.Context()
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	objectID, err := bson.ObjectIDFromHex(conversationId)
+	if err != nil {
+		return s.sendStreamError(stream, shared.ErrBadRequest("invalid conversation_id"))
+	}
+
+	unlock := s.chatService.LockConversation(objectID)
+	defer unlock()
+
+	conversation, err := s.chatService.GetConversation(ctx, actor.ID, objectID)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	deliveredJobs, err := s.deliverToolJobs(ctx, conversation)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	ctx = contextutil.SetProjectID(ctx, conversation.ProjectID)
+	ctx = contextutil.SetConversationID(ctx, conversation.ID.Hex())
+	ctx = contextutil.SetResponseMode(ctx, conversation.ResponseMode)
+
+	openaiChatHistory, inappChatHistory, err := s.aiClient.ResolveToolCallStream(
+		ctx, stream, conversation.ID.Hex(), conversation.LanguageModel, conversation.OpenaiChatHistory, toolCallId, approved, reason,
+	)
+	if errors.Is(err, aiclient.ErrToolCallNotPending) {
+		return s.sendStreamError(stream, shared.ErrBadRequest(err.Error()))
+	}
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	if err := mergeInappMessages(conversation, inappChatHistory); err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	conversation.OpenaiChatHistory = openaiChatHistory
+	if err := s.chatService.UpdateConversation(conversation); err != nil {
+		return s.sendStreamError(stream, err)
+	}
+	if err := s.aiClient.ToolJobs().MarkDelivered(ctx, deliveredJobs); err != nil {
+		s.logger.Error("Failed to mark tool jobs delivered", "error", err, "conversationID", conversationId)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package chat
+
+import (
+	"context"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/stringutil"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+
+	maxSearchSnippets  = 3 // messages per conversation, besides the title
+	searchSnippetWidth = 160
+)
+
+func (s *ChatServer) SearchConversations(
+	ctx context.Context,
+	req *chatv1.SearchConversationsRequest,
+) (*chatv1.SearchConversationsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search := services.ConversationSearch{
+		Query:     req.GetQuery(),
+		ProjectID: req.GetProjectId(),
+	}
+	if req.LanguageModel != nil {
+		search.LanguageModel = lo.ToPtr(models.LanguageModel(req.GetLanguageModel()))
+	}
+	if req.UpdatedAfter != nil {
+		search.UpdatedAfter = req.GetUpdatedAfter().AsTime()
+	}
+	if req.UpdatedBefore != nil {
+		search.UpdatedBefore = req.GetUpdatedBefore().AsTime()
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	results, err := s.chatService.SearchConversations(ctx, actor.ID, search, limit)
+	if err != nil {
+		s.logger.Error("Failed to search conversations", "error", err)
+		return nil, err
+	}
+
+	terms := stringutil.SearchTerms(req.GetQuery())
+	return &chatv1.SearchConversationsResponse{
+		Results: lo.Map(results, func(result *services.ConversationSearchResult, _ int) *chatv1.ConversationSearchResult {
+			conversation := result.Conversation
+			conversation.InappChatHistory = nil
+			return &chatv1.ConversationSearchResult{
+				Conversation: mapper.MapModelConversationToProto(&conversation),
+				ProjectId:    result.ProjectID,
+				UpdatedAt:    timestamppb.New(result.UpdatedAt.Time()),
+				Score:        result.Score,
+				Snippets:     searchSnippets(result, terms),
+			}
+		}),
+	}, nil
+}
+
+// searchSnippets returns the matching parts of the title and of the first matching messages.
+func searchSnippets(result *services.ConversationSearchResult, terms []string) []*chatv1.SearchSnippet {
+	var snippets []*chatv1.SearchSnippet
+	add := func(messageID, text string) {
+		snippet, highlights, ok := stringutil.Snippet(text, terms, searchSnippetWidth)
+		if !ok {
+			return
+		}
+		snippets = append(snippets, &chatv1.SearchSnippet{
+			MessageId: messageID,
+			Text:      snippet,
+			Highlights: lo.Map(highlights, func(r stringutil.Range, _ int) *chatv1.TextRange {
+				return &chatv1.TextRange{Start: int32(r.Start), End: int32(r.End)}
+			}),
+		})
+	}
+
+	add("", result.Title)
+	titleSnippets := len(snippets)
+	for _, msg := range result.InappChatHistory {
+		if len(snippets)-titleSnippets >= maxSearchSnippets {
+			break
+		}
+		message := mapper.BSONToChatMessage(msg)
+		if content := message.GetPayload().GetUser().GetContent(); content != "" {
+			add(message.GetMessageId(), content)
+		} else if content := message.GetPayload().GetAssistant().GetContent(); content != "" {
+			add(message.GetMessageId(), content)
+		}
+	}
+	return snippets
+}
@@ -11,12 +11,15 @@ import (
 type ChatServer struct {
 	chatv1.UnimplementedChatServiceServer
 
-	aiClient       *aiclient.AIClient
-	chatService    *services.ChatService
-	projectService *services.ProjectService
-	userService    *services.UserService
-	logger         *logger.Logger
-	cfg            *cfg.Cfg
+	aiClient            *aiclient.AIClient
+	chatService         *services.ChatService
+	projectService      *services.ProjectService
+	userService         *services.UserService
+	textEditService     *services.TextEditService
+	organizationService *services.OrganizationService
+	promptService       *services.PromptService
+	logger              *logger.Logger
+	cfg                 *cfg.Cfg
 }
 
 func NewChatServer(
@@ -24,15 +27,23 @@ func NewChatServer(
 	chatService *services.ChatService,
 	projectService *services.ProjectService,
 	userService *services.UserService,
+	textEditService *services.TextEditService,
+	organizationService *services.OrganizationService,
+	promptService *services.PromptService,
 	logger *logger.Logger,
 	cfg *cfg.Cfg,
 ) chatv1.ChatServiceServer {
-	return &ChatServer{
-		aiClient:       aiClient,
-		chatService:    chatService,
-		projectService: projectService,
-		userService:    userService,
-		logger:         logger,
-		cfg:            cfg,
+	server := &ChatServer{
+		aiClient:            aiClient,
+		chatService:         chatService,
+		projectService:      projectService,
+		userService:         userService,
+		textEditService:     textEditService,
+		organizationService: organizationService,
+		promptService:       promptService,
+		logger:              logger,
+		cfg:                 cfg,
 	}
+	aiClient.ToolJobs().OnFinished(server.onToolJobFinished)
+	return server
 }
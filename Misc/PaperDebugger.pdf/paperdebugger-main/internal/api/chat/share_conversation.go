@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSharedConversationsPageSize = 50
+	maxSharedConversationsPageSize     = 200
+)
+
+func (s *ChatServer) ShareConversation(
+	ctx context.Context,
+	req *chatv1.ShareConversationRequest,
+) (*chatv1.ShareConversationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	conversation, err := s.chatService.SetConversationShared(ctx, actor.ID, conversationID, req.GetShared())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("conversation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conversation.InappChatHistory = nil
+	return &chatv1.ShareConversationResponse{
+		Conversation: mapper.MapModelConversationToProto(conversation),
+	}, nil
+}
+
+func (s *ChatServer) ListSharedConversations(
+	ctx context.Context,
+	req *chatv1.ListSharedConversationsRequest,
+) (*chatv1.ListSharedConversationsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultSharedConversationsPageSize
+	}
+	pageSize = min(pageSize, maxSharedConversationsPageSize)
+
+	conversations, err := s.chatService.ListSharedConversations(ctx, actor.ID, req.GetProjectId(), pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[bson.ObjectID]*models.User)
+	resp := &chatv1.ListSharedConversationsResponse{}
+	for _, conversation := range conversations {
+		owner, ok := owners[conversation.UserID]
+		if !ok {
+			owner, err = s.userService.GetUserByID(ctx, conversation.UserID)
+			if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, err
+			}
+			owners[conversation.UserID] = owner
+		}
+
+		sharedConversation := &chatv1.SharedConversation{
+			Conversation: mapper.MapModelConversationToProto(conversation),
+			OwnerId:      conversation.UserID.Hex(),
+			UpdatedAt:    timestamppb.New(conversation.UpdatedAt.Time()),
+		}
+		if owner != nil {
+			sharedConversation.OwnerName = owner.Name
+			sharedConversation.OwnerEmail = owner.Email
+		}
+		resp.Conversations = append(resp.Conversations, sharedConversation)
+	}
+	return resp, nil
+}
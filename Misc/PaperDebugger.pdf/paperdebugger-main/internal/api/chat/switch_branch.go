@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// branchError maps the errors of the conversation tree to API errors.
+func branchError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrMessageNotInTree):
+		return shared.ErrBadRequest("message_id is not a user message of the conversation")
+	case errors.Is(err, models.ErrHistoryNotBranchable):
+		return shared.ErrBadRequest(err.Error())
+	}
+	return err
+}
+
+func (s *ChatServer) SwitchBranch(
+	ctx context.Context,
+	req *chatv1.SwitchBranchRequest,
+) (*chatv1.SwitchBranchResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid conversation_id")
+	}
+
+	unlock := s.chatService.LockConversation(conversationID)
+	defer unlock()
+
+	conversation, err := s.chatService.GetConversation(ctx, actor.ID, conversationID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("conversation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conversation.SwitchBranch(req.GetMessageId()); err != nil {
+		return nil, branchError(err)
+	}
+	if err := s.chatService.UpdateConversation(conversation); err != nil {
+		return nil, err
+	}
+
+	return &chatv1.SwitchBranchResponse{
+		Conversation: mapper.MapModelConversationToProto(conversation),
+	}, nil
+}
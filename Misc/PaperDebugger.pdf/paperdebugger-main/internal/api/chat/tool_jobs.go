@@ -0,0 +1,92 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// deliverToolJobs adds the results of the finished background tool jobs to the conversation, which
+// must be locked (see ChatService.LockConversation). The tool call messages get the final result, and
+// the model is told about it. The returned jobs must be marked delivered once the conversation is saved.
+func (s *ChatServer) deliverToolJobs(ctx context.Context, conversation *models.Conversation) ([]bson.ObjectID, error) {
+	finished, err := s.aiClient.ToolJobs().Undelivered(ctx, conversation.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	delivered := make([]bson.ObjectID, 0, len(finished))
+	for _, job := range finished {
+		var report string
+		if job.Status == models.ToolJobStatusSucceeded {
+			report = fmt.Sprintf("The tool call %s (%s), which was running in the background, is done.\n<RESULT>%s</RESULT>", job.ToolCallID, job.ToolName, job.Result)
+		} else {
+			report = fmt.Sprintf("The tool call %s (%s), which was running in the background, failed: %s", job.ToolCallID, job.ToolName, job.Error)
+		}
+		conversation.OpenaiChatHistory = append(conversation.OpenaiChatHistory, responses.ResponseInputItemUnionParam{
+			OfInputMessage: &responses.ResponseInputItemMessageParam{
+				Role: "system",
+				Content: responses.ResponseInputMessageContentListParam{
+					responses.ResponseInputContentParamOfInputText(report),
+				},
+			},
+		})
+
+		if err := mergeInappMessages(conversation, []chatv1.Message{{
+			MessageId: "openai_" + job.ToolCallID,
+			Payload: &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_ToolCall{
+					ToolCall: &chatv1.MessageTypeToolCall{
+						Name:   job.ToolName,
+						Args:   job.Arguments,
+						Result: job.Result,
+						Error:  job.Error,
+					},
+				},
+			},
+		}}); err != nil {
+			return nil, err
+		}
+		delivered = append(delivered, job.ID)
+	}
+	return delivered, nil
+}
+
+// onToolJobFinished adds the result of a job that outlived its turn to the conversation, as soon as
+// no turn of the conversation is running.
+func (s *ChatServer) onToolJobFinished(job models.ToolJob) {
+	ctx := context.Background()
+	conversationID, err := bson.ObjectIDFromHex(job.ConversationID)
+	if err != nil {
+		s.logger.Error("Failed to deliver tool job", "error", err, "jobID", job.ID.Hex())
+		return
+	}
+
+	unlock := s.chatService.LockConversation(conversationID)
+	defer unlock()
+
+	conversation, err := s.chatService.GetConversation(ctx, job.UserID, conversationID)
+	if err != nil {
+		s.logger.Error("Failed to deliver tool job", "error", err, "jobID", job.ID.Hex(), "conversationID", job.ConversationID)
+		return
+	}
+	delivered, err := s.deliverToolJobs(ctx, conversation)
+	if err != nil || len(delivered) == 0 {
+		if err != nil {
+			s.logger.Error("Failed to deliver tool job", "error", err, "jobID", job.ID.Hex(), "conversationID", job.ConversationID)
+		}
+		return
+	}
+	if err := s.chatService.UpdateConversation(conversation); err != nil {
+		s.logger.Error("Failed to save delivered tool job", "error", err, "jobID", job.ID.Hex(), "conversationID", job.ConversationID)
+		return
+	}
+	if err := s.aiClient.ToolJobs().MarkDelivered(ctx, delivered); err != nil {
+		s.logger.Error("Failed to mark tool job delivered", "error", err, "jobID", job.ID.Hex())
+	}
+}
@@ -35,7 +35,7 @@ func (s *ChatServer) UpdateConversation(
 	}
 
 	conversation.Title = req.GetTitle()
-	err = s.chatService.UpdateConversation(conversation)
+	err = s.chatService.UpdateConversationTitle(ctx, conversation.ID, conversation.Title)
 	if err != nil {
 		return nil, err
 	}
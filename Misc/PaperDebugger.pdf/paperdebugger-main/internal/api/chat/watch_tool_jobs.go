@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// WatchToolJobs lets a client that reconnected follow the tool calls still running in the background.
+// Once the stream is finalized, GetConversation returns their results.
+func (s *ChatServer) WatchToolJobs(
+	req *chatv1.WatchToolJobsRequest,
+	stream chatv1.ChatService_WatchToolJobsServer,
+) error {
+	ctx := stream.Context()
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	conversationID, err := bson.ObjectIDFromHex(req.GetConversationId())
+	if err != nil {
+		return s.sendStreamError(stream, shared.ErrBadRequest("invalid conversation_id"))
+	}
+
+	conversation, err := s.chatService.GetConversation(ctx, actor.ID, conversationID)
+	if err != nil {
+		return s.sendStreamError(stream, err)
+	}
+
+	s.aiClient.WatchToolJobs(ctx, stream, conversation.ID.Hex(), conversation.LanguageModel)
+	return nil
+}
@@ -2,13 +2,16 @@ package comment
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/libs/contextutil"
 	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/models"
 	commentv1 "paperdebugger/pkg/gen/api/comment/v1"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
 func validateCommentsAcceptedRequest(req *commentv1.CommentsAcceptedRequest) error {
@@ -50,10 +53,17 @@ func (s *CommentServer) CommentsAccepted(
 		return nil, shared.ErrBadRequest("failed to get project")
 	}
 
-	conversation, err := s.conversationService.GetConversation(ctx, actor.ID, conversationObjectId)
-	if err != nil {
+	// the editors of the project accept the comments of the conversations shared in it
+	conversation, err := s.conversationService.GetAccessibleConversation(ctx, actor.ID, conversationObjectId, accesscontrol.PermissionWrite)
+	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, shared.ErrBadRequest("failed to get conversation")
 	}
+	if err != nil {
+		return nil, err
+	}
+	if conversation.ProjectID != req.GetProjectId() {
+		return nil, shared.ErrBadRequest("the conversation is not in this project")
+	}
 
 	messageID := req.GetMessageId()
 	messageExists := false
@@ -74,13 +84,13 @@ func (s *CommentServer) CommentsAccepted(
 			return nil, shared.ErrBadRequest(fmt.Sprintf("invalid comment_id %s", commentID))
 		}
 
-		comment, err := s.reverseCommentService.GetComment(ctx, actor.ID, req.GetProjectId(), commentObjectId)
+		comment, err := s.reverseCommentService.GetComment(ctx, conversation.UserID, req.GetProjectId(), commentObjectId)
 		if err != nil {
 			return nil, shared.ErrBadRequest(fmt.Sprintf("failed to get comment %s", commentID))
 		}
 
 		comment.IsAddedToOverleaf = models.CommentStatusAccepted
-		if err := s.reverseCommentService.UpdateComment(ctx, actor.ID, req.GetProjectId(), commentObjectId, comment); err != nil {
+		if err := s.reverseCommentService.UpdateComment(ctx, conversation.UserID, req.GetProjectId(), commentObjectId, comment); err != nil {
 			return nil, shared.ErrBadRequest(fmt.Sprintf("failed to update comment %s", commentID))
 		}
 	}
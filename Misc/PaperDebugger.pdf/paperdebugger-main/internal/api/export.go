@@ -0,0 +1,59 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// exportMyDataPath serves the zip of all data of the user: profile, settings, instructions,
+// prompts, projects, conversations, comments and tool call records. It is an HTTP handler on the
+// gateway mux rather than an RPC, the archive has no size limit and is streamed as it is written.
+const exportMyDataPath = "/_pd/api/v1/users/@self/export"
+
+func (s *Server) exportMyData(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		actor, err := parseUserActor(ctx, requestToken(r), s.userService)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		out := &attachmentWriter{
+			w:        w,
+			filename: "paperdebugger-data-" + time.Now().UTC().Format("2006-01-02") + ".zip",
+			mimeType: "application/zip",
+		}
+		err = s.accountService.ExportUserData(ctx, actor.ID, out)
+		if err == nil {
+			return
+		}
+		s.logger.Error("Failed to export user data", "error", err)
+		if !out.started {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+		}
+	}
+}
+
+// attachmentWriter sends the headers of a file download with the first write, so that an error
+// before any content can still be answered with an error response.
+type attachmentWriter struct {
+	w        http.ResponseWriter
+	filename string
+	mimeType string
+	started  bool
+}
+
+func (a *attachmentWriter) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.mimeType)
+		a.w.Header().Set("Content-Disposition", `attachment; filename="`+a.filename+`"`)
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/jwt"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestExportMyData_LargeExport(t *testing.T) {
+	os.Setenv("PD_MONGO_URI", "mongodb://localhost:27017")
+	if os.Getenv("JWT_SIGNING_KEY") == "" {
+		os.Setenv("JWT_SIGNING_KEY", "1234567890")
+	}
+	cfg := cfg.GetCfg()
+	logger := logger.GetLogger()
+	db, err := db.NewDB(cfg, logger)
+	if err != nil {
+		t.Fatalf("Failed to create db: %v", err)
+	}
+	ctx := context.Background()
+	tokenService := services.NewTokenService(db, cfg, logger)
+	s := &Server{
+		userService:    services.NewUserService(db, cfg, logger),
+		accountService: services.NewAccountService(db, cfg, logger, tokenService),
+		logger:         logger,
+	}
+
+	user, err := s.userService.UpsertUserByEmail(ctx, &models.User{Email: bson.NewObjectID().Hex() + "@example.com"})
+	require.NoError(t, err)
+
+	// 6 MB of prompts, more than the 4 MB a gRPC message can carry by default
+	database := db.Database("paperdebugger")
+	prompts := database.Collection(models.Prompt{}.CollectionName())
+	content := strings.Repeat("x", 1<<20)
+	for i := 0; i < 6; i++ {
+		now := bson.NewDateTimeFromTime(time.Now())
+		_, err := prompts.InsertOne(ctx, models.Prompt{
+			BaseModel: models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now},
+			UserID:    user.ID,
+			Title:     "Large",
+			Content:   content,
+		})
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		prompts.DeleteMany(ctx, bson.M{"user_id": user.ID})
+		database.Collection(models.User{}.CollectionName()).DeleteOne(ctx, bson.M{"_id": user.ID})
+	})
+
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(s.errorHandler()))
+	require.NoError(t, mux.HandlePath(http.MethodGet, exportMyDataPath, s.exportMyData(mux)))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		resp, err := http.Get(server.URL + exportMyDataPath)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	})
+
+	t.Run("large export", func(t *testing.T) {
+		token, err := jwt.SignJwtToken(user.ID.Hex())
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, server.URL+exportMyDataPath, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/zip", resp.Header.Get("Content-Type"))
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), "paperdebugger-data-")
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		require.NoError(t, err)
+
+		var exported []map[string]any
+		for _, file := range archive.File {
+			if file.Name != "prompts.json" {
+				continue
+			}
+			assert.Greater(t, file.UncompressedSize64, uint64(6<<20))
+			r, err := file.Open()
+			require.NoError(t, err)
+			require.NoError(t, json.NewDecoder(r).Decode(&exported))
+			r.Close()
+		}
+		require.Len(t, exported, 6)
+		assert.Equal(t, content, exported[0]["content"])
+	})
+}
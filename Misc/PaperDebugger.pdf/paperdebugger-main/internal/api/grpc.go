@@ -10,9 +10,11 @@ import (
 	"paperdebugger/internal/libs/metadatautil"
 	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
 	authv1 "paperdebugger/pkg/gen/api/auth/v1"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
 	commentv1 "paperdebugger/pkg/gen/api/comment/v1"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
 	projectv1 "paperdebugger/pkg/gen/api/project/v1"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
 
@@ -104,6 +106,8 @@ func NewGrpcServer(
 	userServer userv1.UserServiceServer,
 	projectServer projectv1.ProjectServiceServer,
 	commentServer commentv1.CommentServiceServer,
+	adminServer adminv1.AdminServiceServer,
+	organizationServer organizationv1.OrganizationServiceServer,
 ) *GrpcServer {
 	grpcServer := &GrpcServer{}
 	grpcServer.userService = userService
@@ -118,5 +122,7 @@ func NewGrpcServer(
 	userv1.RegisterUserServiceServer(grpcServer.Server, userServer)
 	projectv1.RegisterProjectServiceServer(grpcServer.Server, projectServer)
 	commentv1.RegisterCommentServiceServer(grpcServer.Server, commentServer)
+	adminv1.RegisterAdminServiceServer(grpcServer.Server, adminServer)
+	organizationv1.RegisterOrganizationServiceServer(grpcServer.Server, organizationServer)
 	return grpcServer
 }
@@ -27,15 +27,22 @@ func MapModelConversationToProto(conversation *models.Conversation) *chatv1.Conv
 	filteredMessages := lo.Map(conversation.InappChatHistory, func(msg bson.M, _ int) *chatv1.Message {
 		return BSONToChatMessage(msg)
 	})
+	for _, msg := range filteredMessages {
+		if msg.GetPayload().GetUser() != nil {
+			msg.SiblingIds = conversation.MessageSiblings(msg.GetMessageId())
+		}
+	}
 
 	filteredMessages = lo.Filter(filteredMessages, func(msg *chatv1.Message, _ int) bool {
 		return msg.GetPayload().GetMessageType() != &chatv1.MessagePayload_System{}
 	})
 
 	return &chatv1.Conversation{
-		Id:            conversation.ID.Hex(),
-		Title:         conversation.Title,
-		LanguageModel: chatv1.LanguageModel(conversation.LanguageModel),
-		Messages:      filteredMessages,
+		Id:               conversation.ID.Hex(),
+		Title:            conversation.Title,
+		LanguageModel:    chatv1.LanguageModel(conversation.LanguageModel),
+		Messages:         filteredMessages,
+		Shared:           conversation.Shared,
+		ConversationType: conversation.Persona.ConversationType(),
 	}
 }
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapDefaultPromptToProto(p *models.DefaultPrompt) *adminv1.DefaultPrompt {
+	return &adminv1.DefaultPrompt{
+		Id:              p.ID.Hex(),
+		Key:             p.Key,
+		Version:         int32(p.Version),
+		Category:        p.Category,
+		Tags:            p.Tags,
+		Order:           int32(p.Order),
+		Title:           p.Title,
+		LocalizedTitles: p.LocalizedTitles,
+		Content:         p.Content,
+		Deleted:         p.DeletedAt != nil,
+		CreatedAt:       timestamppb.New(p.CreatedAt.Time()),
+		UpdatedAt:       timestamppb.New(p.UpdatedAt.Time()),
+	}
+}
+
+// MapDefaultPromptToUserProto maps a built-in prompt as listed to the user, with the title in the
+// locale and the preferences of the user.
+func MapDefaultPromptToUserProto(p *models.DefaultPrompt, locale string, preferences models.PromptPreferences) *userv1.Prompt {
+	return &userv1.Prompt{
+		Id:           p.ID.Hex(),
+		CreatedAt:    timestamppb.New(p.CreatedAt.Time()),
+		UpdatedAt:    timestamppb.New(p.UpdatedAt.Time()),
+		Title:        services.LocalizedTitle(p, locale),
+		Content:      p.Content,
+		IsUserPrompt: false,
+		Key:          p.Key,
+		Category:     p.Category,
+		Tags:         p.Tags,
+		Pinned:       lo.Contains(preferences.PinnedPrompts, p.Key),
+		Hidden:       lo.Contains(preferences.HiddenPrompts, p.Key),
+	}
+}
@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/services"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var organizationRoles = map[accesscontrol.Role]organizationv1.OrganizationRole{
+	accesscontrol.RoleOwner:  organizationv1.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+	accesscontrol.RoleEditor: organizationv1.OrganizationRole_ORGANIZATION_ROLE_EDITOR,
+	accesscontrol.RoleViewer: organizationv1.OrganizationRole_ORGANIZATION_ROLE_VIEWER,
+}
+
+// MapProtoOrganizationRoleToModel returns false for ORGANIZATION_ROLE_UNSPECIFIED and unknown roles.
+func MapProtoOrganizationRoleToModel(role organizationv1.OrganizationRole) (accesscontrol.Role, bool) {
+	for modelRole, protoRole := range organizationRoles {
+		if protoRole == role {
+			return modelRole, true
+		}
+	}
+	return accesscontrol.RoleNone, false
+}
+
+func MapOrganizationToProto(organization *services.UserOrganization) *organizationv1.Organization {
+	return &organizationv1.Organization{
+		Id:           organization.ID.Hex(),
+		CreatedAt:    timestamppb.New(organization.CreatedAt.Time()),
+		UpdatedAt:    timestamppb.New(organization.UpdatedAt.Time()),
+		Name:         organization.Name,
+		Instructions: organization.Instructions,
+		MyRole:       organizationRoles[organization.Role],
+	}
+}
+
+func MapOrganizationMemberToProto(member *services.OrganizationMemberInfo) *organizationv1.OrganizationMember {
+	result := &organizationv1.OrganizationMember{
+		UserId:    member.UserID.Hex(),
+		Role:      organizationRoles[member.Role],
+		CreatedAt: timestamppb.New(member.CreatedAt.Time()),
+		Pending:   member.Pending,
+	}
+	if member.User != nil {
+		result.Email = member.User.Email
+		result.Name = member.User.Name
+		result.Picture = member.User.Picture
+	}
+	return result
+}
@@ -1,7 +1,9 @@
 package mapper
 
 import (
+	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
 	projectv1 "paperdebugger/pkg/gen/api/project/v1"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -27,11 +29,57 @@ func MapProtoProjectDocToModel(doc *projectv1.ProjectDoc) models.ProjectDoc {
 
 func MapModelProjectToProto(project *models.Project) *projectv1.Project {
 	return &projectv1.Project{
-		Id:        project.ProjectID,
-		CreatedAt: timestamppb.New(project.CreatedAt.Time()),
-		UpdatedAt: timestamppb.New(project.UpdatedAt.Time()),
-		Name:      project.Name,
-		RootDocId: project.RootDocID,
+		Id:             project.ProjectID,
+		CreatedAt:      timestamppb.New(project.CreatedAt.Time()),
+		UpdatedAt:      timestamppb.New(project.UpdatedAt.Time()),
+		Name:           project.Name,
+		RootDocId:      project.RootDocID,
+		DefaultPersona: string(project.DefaultPersona),
 		// Do not map docs here, user should get docs from the "websocket sync"
 	}
 }
+
+func MapModelPaperScoreToProto(score *models.PaperScore) *projectv1.PaperScoreRecord {
+	return &projectv1.PaperScoreRecord{
+		Id:             score.ID.Hex(),
+		CreatedAt:      timestamppb.New(score.CreatedAt.Time()),
+		PaperScore:     score.Result(),
+		Category:       score.Category,
+		ContentHash:    score.ContentHash,
+		ConversationId: score.ConversationID,
+	}
+}
+
+var projectRoles = map[accesscontrol.Role]projectv1.ProjectRole{
+	accesscontrol.RoleOwner:  projectv1.ProjectRole_PROJECT_ROLE_OWNER,
+	accesscontrol.RoleEditor: projectv1.ProjectRole_PROJECT_ROLE_EDITOR,
+	accesscontrol.RoleViewer: projectv1.ProjectRole_PROJECT_ROLE_VIEWER,
+}
+
+func MapProjectRoleToProto(role accesscontrol.Role) projectv1.ProjectRole {
+	return projectRoles[role] // PROJECT_ROLE_UNSPECIFIED for the users who are not members
+}
+
+// MapProtoProjectRoleToModel returns false for PROJECT_ROLE_UNSPECIFIED and unknown roles.
+func MapProtoProjectRoleToModel(role projectv1.ProjectRole) (accesscontrol.Role, bool) {
+	for modelRole, protoRole := range projectRoles {
+		if protoRole == role {
+			return modelRole, true
+		}
+	}
+	return accesscontrol.RoleNone, false
+}
+
+func MapProjectMemberToProto(member *services.ProjectMemberInfo) *projectv1.ProjectMember {
+	result := &projectv1.ProjectMember{
+		UserId:    member.UserID.Hex(),
+		Role:      MapProjectRoleToProto(member.Role),
+		CreatedAt: timestamppb.New(member.CreatedAt.Time()),
+	}
+	if member.User != nil {
+		result.Email = member.User.Email
+		result.Name = member.User.Name
+		result.Picture = member.User.Picture
+	}
+	return result
+}
@@ -1,24 +1,72 @@
 package mapper
 
 import (
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
 )
 
+var promptVariableTypes = map[models.PromptVariableType]userv1.PromptVariableType{
+	models.PromptVariableText:         userv1.PromptVariableType_PROMPT_VARIABLE_TYPE_TEXT,
+	models.PromptVariableNumber:       userv1.PromptVariableType_PROMPT_VARIABLE_TYPE_NUMBER,
+	models.PromptVariableChoice:       userv1.PromptVariableType_PROMPT_VARIABLE_TYPE_CHOICE,
+	models.PromptVariableSelectedText: userv1.PromptVariableType_PROMPT_VARIABLE_TYPE_SELECTED_TEXT,
+}
+
+var protoPromptVariableTypes = lo.Invert(promptVariableTypes)
+
+func MapPromptVariablesToProto(variables []models.PromptVariable) []*userv1.PromptVariable {
+	result := make([]*userv1.PromptVariable, len(variables))
+	for i, v := range variables {
+		result[i] = &userv1.PromptVariable{
+			Name:         v.Name,
+			Type:         promptVariableTypes[v.Type],
+			Description:  v.Description,
+			DefaultValue: v.Default,
+			Options:      v.Options,
+			Required:     v.Required,
+		}
+	}
+	return result
+}
+
+// MapProtoPromptVariablesToModel maps the variables of a request, an unspecified type is left
+// empty for services.ValidatePromptTemplate to reject.
+func MapProtoPromptVariablesToModel(variables []*userv1.PromptVariable) []models.PromptVariable {
+	result := make([]models.PromptVariable, len(variables))
+	for i, v := range variables {
+		result[i] = models.PromptVariable{
+			Name:        v.GetName(),
+			Description: v.GetDescription(),
+			Default:     v.GetDefaultValue(),
+			Options:     v.GetOptions(),
+			Required:    v.GetRequired(),
+		}
+		result[i].Type = protoPromptVariableTypes[v.GetType()]
+	}
+	return result
+}
+
 func MapModelPromptToProto(p *models.Prompt) *userv1.Prompt {
 	if p == nil {
 		return nil
 	}
 
-	return &userv1.Prompt{
+	prompt := &userv1.Prompt{
 		Id:           p.ID.Hex(),
 		CreatedAt:    timestamppb.New(p.CreatedAt.Time()),
 		UpdatedAt:    timestamppb.New(p.UpdatedAt.Time()),
 		Title:        p.Title,
 		Content:      p.Content,
-		IsUserPrompt: true,
+		IsUserPrompt: p.OrganizationID.IsZero(),
+		Variables:    MapPromptVariablesToProto(p.Variables),
 	}
+	if !p.OrganizationID.IsZero() {
+		prompt.OrganizationId = p.OrganizationID.Hex()
+	}
+	return prompt
 }
 
 func MapModelPromptsToProto(prompts []*models.Prompt) []*userv1.Prompt {
@@ -28,3 +76,16 @@ func MapModelPromptsToProto(prompts []*models.Prompt) []*userv1.Prompt {
 	}
 	return result
 }
+
+func MapPromptStatsToProto(stats *services.PromptStats) *userv1.PromptStats {
+	result := &userv1.PromptStats{
+		PromptId:  stats.PromptID.Hex(),
+		Uses:      int32(stats.Uses),
+		TotalUses: int32(stats.TotalUses),
+		Users:     int32(stats.Users),
+	}
+	if stats.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(stats.LastUsedAt.Time())
+	}
+	return result
+}
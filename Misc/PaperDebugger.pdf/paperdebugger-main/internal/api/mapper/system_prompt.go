@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var systemPromptSources = map[services.SystemPromptSource]adminv1.SystemPromptSource{
+	services.SystemPromptSourceBuiltIn:  adminv1.SystemPromptSource_SYSTEM_PROMPT_SOURCE_BUILT_IN,
+	services.SystemPromptSourceConfig:   adminv1.SystemPromptSource_SYSTEM_PROMPT_SOURCE_CONFIG,
+	services.SystemPromptSourceDatabase: adminv1.SystemPromptSource_SYSTEM_PROMPT_SOURCE_DATABASE,
+}
+
+func MapSystemPromptToProto(t *services.SystemPromptTemplate) *adminv1.SystemPrompt {
+	systemPrompt := &adminv1.SystemPrompt{
+		Persona:        string(t.Persona),
+		Source:         systemPromptSources[t.Source],
+		Content:        t.Content,
+		BuiltInContent: t.BuiltInContent,
+	}
+	if t.UpdatedAt != nil {
+		systemPrompt.UpdatedAt = timestamppb.New(t.UpdatedAt.Time())
+	}
+	return systemPrompt
+}
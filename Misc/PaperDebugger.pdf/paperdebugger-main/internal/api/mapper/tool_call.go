@@ -0,0 +1,50 @@
+package mapper
+
+import (
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapModelToolCallToProto(c *models.FunctionCall) *adminv1.ToolCall {
+	toolCall := &adminv1.ToolCall{
+		Id:             c.ID.Hex(),
+		UserId:         c.UserID.Hex(),
+		ProjectId:      c.ProjectID,
+		ConversationId: c.ConversationID,
+		ToolCallId:     c.ToolCallID,
+		Name:           c.FunctionName,
+		Status:         string(c.FunctionStatus),
+		Arguments:      c.FunctionParams,
+		Result:         c.FunctionResult,
+		Error:          c.FunctionError,
+		Cached:         c.CachedFrom != nil,
+		CreatedAt:      timestamppb.New(c.CreatedAt.Time()),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt.Time()),
+	}
+	if latency, ok := c.Latency(); ok {
+		toolCall.LatencyMs = lo.ToPtr(latency.Milliseconds())
+	}
+	return toolCall
+}
+
+func MapToolCallStatsToProto(stats services.ToolCallStats) *adminv1.ToolCallStats {
+	return &adminv1.ToolCallStats{
+		Name:         stats.Name,
+		Total:        int32(stats.Total),
+		Succeeded:    int32(stats.Succeeded),
+		Failed:       int32(stats.Failed),
+		TimedOut:     int32(stats.TimedOut),
+		Pending:      int32(stats.Pending),
+		Cached:       int32(stats.Cached),
+		SuccessRate:  stats.SuccessRate,
+		P50LatencyMs: stats.P50Latency.Milliseconds(),
+		P95LatencyMs: stats.P95Latency.Milliseconds(),
+		TopErrors: lo.Map(stats.TopErrors, func(e services.ToolErrorCount, _ int) *adminv1.ToolErrorCount {
+			return &adminv1.ToolErrorCount{Error: e.Error, Count: int32(e.Count)}
+		}),
+	}
+}
@@ -0,0 +1,167 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *OrganizationServer) ListOrganizationMembers(
+	ctx context.Context,
+	req *organizationv1.ListOrganizationMembersRequest,
+) (*organizationv1.ListOrganizationMembersResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.organizationService.ListMembers(ctx, actor.ID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.ListOrganizationMembersResponse{
+		Members: lo.Map(members, func(member *services.OrganizationMemberInfo, _ int) *organizationv1.OrganizationMember {
+			return mapper.MapOrganizationMemberToProto(member)
+		}),
+	}, nil
+}
+
+func (s *OrganizationServer) AddOrganizationMember(
+	ctx context.Context,
+	req *organizationv1.AddOrganizationMemberRequest,
+) (*organizationv1.AddOrganizationMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetEmail() == "" {
+		return nil, shared.ErrBadRequest("email is required")
+	}
+	role, ok := mapper.MapProtoOrganizationRoleToModel(req.GetRole())
+	if !ok {
+		return nil, shared.ErrBadRequest("invalid role")
+	}
+
+	member, err := s.organizationService.AddMember(ctx, actor.ID, organizationID, req.GetEmail(), role)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("no PaperDebugger user with this email")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.AddOrganizationMemberResponse{
+		Member: mapper.MapOrganizationMemberToProto(member),
+	}, nil
+}
+
+func (s *OrganizationServer) UpdateOrganizationMember(
+	ctx context.Context,
+	req *organizationv1.UpdateOrganizationMemberRequest,
+) (*organizationv1.UpdateOrganizationMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := bson.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid user_id")
+	}
+	role, ok := mapper.MapProtoOrganizationRoleToModel(req.GetRole())
+	if !ok {
+		return nil, shared.ErrBadRequest("invalid role")
+	}
+
+	member, err := s.organizationService.UpdateMemberRole(ctx, actor.ID, organizationID, userID, role)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.UpdateOrganizationMemberResponse{
+		Member: mapper.MapOrganizationMemberToProto(member),
+	}, nil
+}
+
+func (s *OrganizationServer) RemoveOrganizationMember(
+	ctx context.Context,
+	req *organizationv1.RemoveOrganizationMemberRequest,
+) (*organizationv1.RemoveOrganizationMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	userID, err := bson.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid user_id")
+	}
+
+	err = s.organizationService.RemoveMember(ctx, actor.ID, organizationID, userID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.RemoveOrganizationMemberResponse{}, nil
+}
+
+func (s *OrganizationServer) AcceptOrganizationInvitation(
+	ctx context.Context,
+	req *organizationv1.AcceptOrganizationInvitationRequest,
+) (*organizationv1.AcceptOrganizationInvitationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := s.organizationService.AcceptInvitation(ctx, actor.ID, organizationID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("invitation not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.AcceptOrganizationInvitationResponse{
+		Organization: mapper.MapOrganizationToProto(organization),
+	}, nil
+}
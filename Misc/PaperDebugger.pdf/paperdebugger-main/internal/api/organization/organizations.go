@@ -0,0 +1,122 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *OrganizationServer) ListOrganizations(
+	ctx context.Context,
+	req *organizationv1.ListOrganizationsRequest,
+) (*organizationv1.ListOrganizationsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := s.organizationService.ListOrganizations(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := s.organizationService.ListInvitations(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.ListOrganizationsResponse{
+		Organizations: lo.Map(organizations, func(organization *services.UserOrganization, _ int) *organizationv1.Organization {
+			return mapper.MapOrganizationToProto(organization)
+		}),
+		Invitations: lo.Map(invitations, func(organization *services.UserOrganization, _ int) *organizationv1.Organization {
+			return mapper.MapOrganizationToProto(organization)
+		}),
+	}, nil
+}
+
+func (s *OrganizationServer) CreateOrganization(
+	ctx context.Context,
+	req *organizationv1.CreateOrganizationRequest,
+) (*organizationv1.CreateOrganizationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, shared.ErrBadRequest("name is required")
+	}
+
+	organization, err := s.organizationService.CreateOrganization(ctx, actor.ID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.CreateOrganizationResponse{
+		Organization: mapper.MapOrganizationToProto(organization),
+	}, nil
+}
+
+func (s *OrganizationServer) UpdateOrganization(
+	ctx context.Context,
+	req *organizationv1.UpdateOrganizationRequest,
+) (*organizationv1.UpdateOrganizationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil && strings.TrimSpace(req.GetName()) == "" {
+		return nil, shared.ErrBadRequest("name cannot be empty")
+	}
+	var name *string
+	if req.Name != nil {
+		name = lo.ToPtr(strings.TrimSpace(req.GetName()))
+	}
+
+	organization, err := s.organizationService.UpdateOrganization(ctx, actor.ID, organizationID, name, req.Instructions)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("organization not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationv1.UpdateOrganizationResponse{
+		Organization: mapper.MapOrganizationToProto(organization),
+	}, nil
+}
+
+func (s *OrganizationServer) DeleteOrganization(
+	ctx context.Context,
+	req *organizationv1.DeleteOrganizationRequest,
+) (*organizationv1.DeleteOrganizationResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationID, err := parseOrganizationID(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organizationService.DeleteOrganization(ctx, actor.ID, organizationID); err != nil {
+		return nil, err
+	}
+	return &organizationv1.DeleteOrganizationResponse{}, nil
+}
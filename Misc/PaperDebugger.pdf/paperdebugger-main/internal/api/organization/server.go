@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// OrganizationServer implements organizationv1.OrganizationServiceServer
+type OrganizationServer struct {
+	organizationv1.UnimplementedOrganizationServiceServer
+	organizationService *services.OrganizationService
+	logger              *logger.Logger
+	cfg                 *cfg.Cfg
+}
+
+func NewOrganizationServer(
+	organizationService *services.OrganizationService,
+	logger *logger.Logger,
+	cfg *cfg.Cfg,
+) organizationv1.OrganizationServiceServer {
+	return &OrganizationServer{
+		organizationService: organizationService,
+		logger:              logger,
+		cfg:                 cfg,
+	}
+}
+
+func parseOrganizationID(organizationID string) (bson.ObjectID, error) {
+	id, err := bson.ObjectIDFromHex(organizationID)
+	if err != nil {
+		return bson.NilObjectID, shared.ErrBadRequest("invalid organization_id")
+	}
+	return id, nil
+}
@@ -0,0 +1,49 @@
+package project
+
+import (
+	"context"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+
+	"github.com/samber/lo"
+)
+
+const (
+	defaultPaperScoresPageSize = 50
+	maxPaperScoresPageSize     = 200
+)
+
+func (s *ProjectServer) ListProjectPaperScores(
+	ctx context.Context,
+	req *projectv1.ListProjectPaperScoresRequest,
+) (*projectv1.ListProjectPaperScoresResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultPaperScoresPageSize
+	}
+	limit = min(limit, maxPaperScoresPageSize)
+
+	scores, err := s.paperScoreService.ListPaperScores(ctx, actor.ID, req.GetProjectId(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.ListProjectPaperScoresResponse{
+		PaperScores: lo.Map(scores, func(score *models.PaperScore, _ int) *projectv1.PaperScoreRecord {
+			return mapper.MapModelPaperScoreToProto(score)
+		}),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package project
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *ProjectServer) ListProjectMembers(
+	ctx context.Context,
+	req *projectv1.ListProjectMembersRequest,
+) (*projectv1.ListProjectMembersResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	members, err := s.memberService.ListMembers(ctx, actor.ID, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &projectv1.ListProjectMembersResponse{
+		Members: lo.Map(members, func(member *services.ProjectMemberInfo, _ int) *projectv1.ProjectMember {
+			return mapper.MapProjectMemberToProto(member)
+		}),
+	}
+	for _, member := range members {
+		if member.UserID == actor.ID {
+			resp.MyRole = mapper.MapProjectRoleToProto(member.Role)
+		}
+	}
+	return resp, nil
+}
+
+func (s *ProjectServer) AddProjectMember(
+	ctx context.Context,
+	req *projectv1.AddProjectMemberRequest,
+) (*projectv1.AddProjectMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+	if req.GetEmail() == "" {
+		return nil, shared.ErrBadRequest("email is required")
+	}
+	role, ok := mapper.MapProtoProjectRoleToModel(req.GetRole())
+	if !ok {
+		return nil, shared.ErrBadRequest("invalid role")
+	}
+
+	member, err := s.memberService.AddMember(ctx, actor.ID, req.GetProjectId(), req.GetEmail(), role)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("no PaperDebugger user with this email")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.AddProjectMemberResponse{
+		Member: mapper.MapProjectMemberToProto(member),
+	}, nil
+}
+
+func (s *ProjectServer) UpdateProjectMember(
+	ctx context.Context,
+	req *projectv1.UpdateProjectMemberRequest,
+) (*projectv1.UpdateProjectMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+	userID, err := bson.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid user_id")
+	}
+	role, ok := mapper.MapProtoProjectRoleToModel(req.GetRole())
+	if !ok {
+		return nil, shared.ErrBadRequest("invalid role")
+	}
+
+	member, err := s.memberService.UpdateMemberRole(ctx, actor.ID, req.GetProjectId(), userID, role)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.UpdateProjectMemberResponse{
+		Member: mapper.MapProjectMemberToProto(member),
+	}, nil
+}
+
+func (s *ProjectServer) RemoveProjectMember(
+	ctx context.Context,
+	req *projectv1.RemoveProjectMemberRequest,
+) (*projectv1.RemoveProjectMemberResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+	userID, err := bson.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return nil, shared.ErrBadRequest("invalid user_id")
+	}
+
+	err = s.memberService.RemoveMember(ctx, actor.ID, req.GetProjectId(), userID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.RemoveProjectMemberResponse{}, nil
+}
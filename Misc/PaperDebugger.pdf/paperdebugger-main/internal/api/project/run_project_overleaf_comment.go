@@ -0,0 +1,45 @@
+package project
+
+import (
+	"context"
+	"strings"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+)
+
+// RunProjectOverleafComment anchors a comment in the document of the project with the given section.
+func (s *ProjectServer) RunProjectOverleafComment(
+	ctx context.Context,
+	req *projectv1.RunProjectOverleafCommentRequest,
+) (*projectv1.RunProjectOverleafCommentResponse, error) {
+	if _, err := contextutil.GetActor(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+	if strings.TrimSpace(req.GetAnchorText()) == "" || strings.TrimSpace(req.GetComment()) == "" {
+		return nil, shared.ErrBadRequest("anchor_text and comment are required")
+	}
+
+	comments, err := s.reverseCommentService.ReverseComments(contextutil.SetProjectID(ctx, req.GetProjectId()), &projectv1.PaperScoreCommentResult{
+		Results: []*projectv1.PaperScoreCommentEntry{{
+			Section:    req.GetSection(),
+			AnchorText: req.GetAnchorText(),
+			Weakness:   req.GetComment(),
+			Importance: req.GetImportance(),
+		}},
+	})
+	if err != nil {
+		s.logger.Error("Failed to add overleaf comment", "error", err, "projectID", req.GetProjectId())
+		return nil, err
+	}
+
+	return &projectv1.RunProjectOverleafCommentResponse{
+		ProjectId: req.GetProjectId(),
+		Comments:  comments,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package project
+
+import (
+	"context"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+)
+
+func (s *ProjectServer) RunProjectPaperScore(
+	ctx context.Context,
+	req *projectv1.RunProjectPaperScoreRequest,
+) (*projectv1.RunProjectPaperScoreResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	score, reused, err := s.paperScoreService.ScorePaper(ctx, actor.ID, req.GetProjectId(), req.GetConversationId())
+	if err != nil {
+		s.logger.Error("Failed to score paper", "error", err, "projectID", req.GetProjectId())
+		return nil, paperScoreError(err)
+	}
+
+	return &projectv1.RunProjectPaperScoreResponse{
+		ProjectId:  req.GetProjectId(),
+		PaperScore: score.Result(),
+		Record:     mapper.MapModelPaperScoreToProto(score),
+		Reused:     reused,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package project
+
+import (
+	"context"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+)
+
+func (s *ProjectServer) RunProjectPaperScoreComment(
+	ctx context.Context,
+	req *projectv1.RunProjectPaperScoreCommentRequest,
+) (*projectv1.RunProjectPaperScoreCommentResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	result, overleafComments, err := s.paperScoreService.CommentPaperScore(ctx, actor.ID, req.GetProjectId())
+	if err != nil {
+		s.logger.Error("Failed to comment paper score", "error", err, "projectID", req.GetProjectId())
+		return nil, paperScoreError(err)
+	}
+
+	return &projectv1.RunProjectPaperScoreCommentResponse{
+		ProjectId:        req.GetProjectId(),
+		Comments:         []*projectv1.PaperScoreCommentResult{result},
+		OverleafComments: overleafComments,
+	}, nil
+}
@@ -1,27 +1,47 @@
 package project
 
 import (
+	"errors"
 	"paperdebugger/internal/libs/cfg"
 	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/scoring"
+	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/services"
 	projectv1 "paperdebugger/pkg/gen/api/project/v1"
 )
 
 type ProjectServer struct {
 	projectv1.UnimplementedProjectServiceServer
-	projectService *services.ProjectService
-	logger         *logger.Logger
-	cfg            *cfg.Cfg
+	projectService        *services.ProjectService
+	paperScoreService     *services.PaperScoreService
+	reverseCommentService *services.ReverseCommentService
+	memberService         *services.ProjectMemberService
+	logger                *logger.Logger
+	cfg                   *cfg.Cfg
 }
 
 func NewProjectServer(
 	projectService *services.ProjectService,
+	paperScoreService *services.PaperScoreService,
+	reverseCommentService *services.ReverseCommentService,
+	memberService *services.ProjectMemberService,
 	logger *logger.Logger,
 	cfg *cfg.Cfg,
 ) projectv1.ProjectServiceServer {
 	return &ProjectServer{
-		projectService: projectService,
-		logger:         logger,
-		cfg:            cfg,
+		projectService:        projectService,
+		paperScoreService:     paperScoreService,
+		reverseCommentService: reverseCommentService,
+		memberService:         memberService,
+		logger:                logger,
+		cfg:                   cfg,
 	}
 }
+
+// paperScoreError turns the errors of the paper score service the user can act on into bad requests.
+func paperScoreError(err error) error {
+	if errors.Is(err, services.ErrPaperNotScored) || errors.Is(err, services.ErrPaperChanged) || errors.Is(err, scoring.ErrNotConfigured) {
+		return shared.ErrBadRequest(err.Error())
+	}
+	return err
+}
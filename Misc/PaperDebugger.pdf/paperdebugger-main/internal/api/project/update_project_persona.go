@@ -0,0 +1,37 @@
+package project
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *ProjectServer) UpdateProjectPersona(ctx context.Context, req *projectv1.UpdateProjectPersonaRequest) (*projectv1.UpdateProjectPersonaResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, shared.ErrInvalidActor("user not authenticated")
+	}
+
+	if req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required")
+	}
+
+	persona, err := s.projectService.UpdateProjectPersona(ctx, actor.ID, req.GetProjectId(), models.Persona(req.GetDefaultPersona()))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("project not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectv1.UpdateProjectPersonaResponse{
+		ProjectId:      req.GetProjectId(),
+		DefaultPersona: string(persona),
+	}, nil
+}
@@ -41,6 +41,11 @@ func (s *ProjectServer) UpsertProject(
 		return nil, err
 	}
 
+	// the first user who syncs the project owns it, unless it has members already
+	if err := s.memberService.ClaimProject(ctx, actor.ID, req.GetProjectId()); err != nil {
+		return nil, err
+	}
+
 	return &projectv1.UpsertProjectResponse{
 		Project: mapper.MapModelProjectToProto(project),
 	}, nil
@@ -11,9 +11,13 @@ import (
 	"paperdebugger/internal/libs/logger"
 	"paperdebugger/internal/libs/metadatautil"
 	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	aiclient "paperdebugger/internal/services/toolkit/client"
+	adminv1 "paperdebugger/pkg/gen/api/admin/v1"
 	authv1 "paperdebugger/pkg/gen/api/auth/v1"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
 	commentv1 "paperdebugger/pkg/gen/api/comment/v1"
+	organizationv1 "paperdebugger/pkg/gen/api/organization/v1"
 	projectv1 "paperdebugger/pkg/gen/api/project/v1"
 	sharedv1 "paperdebugger/pkg/gen/api/shared/v1"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
@@ -29,8 +33,12 @@ import (
 )
 
 type Server struct {
-	grpcServer *GrpcServer
-	ginServer  *GinServer
+	grpcServer     *GrpcServer
+	ginServer      *GinServer
+	aiClient       *aiclient.AIClient
+	retention      *services.RetentionService
+	userService    *services.UserService
+	accountService *services.AccountService
 
 	logger *logger.Logger
 }
@@ -38,15 +46,32 @@ type Server struct {
 func NewServer(
 	grpcServer *GrpcServer,
 	ginServer *GinServer,
+	aiClient *aiclient.AIClient,
+	retention *services.RetentionService,
+	userService *services.UserService,
+	accountService *services.AccountService,
 	logger *logger.Logger,
 ) *Server {
 	return &Server{
-		grpcServer: grpcServer,
-		ginServer:  ginServer,
-		logger:     logger,
+		grpcServer:     grpcServer,
+		ginServer:      ginServer,
+		aiClient:       aiClient,
+		retention:      retention,
+		userService:    userService,
+		accountService: accountService,
+		logger:         logger,
 	}
 }
 
+// Shutdown releases resources that outlive a request, e.g. local MCP server processes, and stops
+// the background workers.
+func (s *Server) Shutdown() {
+	s.logger.Info("[PAPERDEBUGGER] shutting down")
+	s.grpcServer.GracefulStop()
+	s.aiClient.Close()
+	s.retention.Close()
+}
+
 func (s *Server) Run(addr string) {
 	listener, err := net.Listen("tcp", ":0")
 	if err != nil {
@@ -99,6 +124,21 @@ func (s *Server) Run(addr string) {
 		s.logger.Fatalf("failed to register comment service grpc gateway: %v", err)
 		return
 	}
+	err = adminv1.RegisterAdminServiceHandler(context.Background(), mux, client)
+	if err != nil {
+		s.logger.Fatalf("failed to register admin service grpc gateway: %v", err)
+		return
+	}
+	err = organizationv1.RegisterOrganizationServiceHandler(context.Background(), mux, client)
+	if err != nil {
+		s.logger.Fatalf("failed to register organization service grpc gateway: %v", err)
+		return
+	}
+	err = mux.HandlePath(http.MethodGet, exportMyDataPath, s.exportMyData(mux))
+	if err != nil {
+		s.logger.Fatalf("failed to register data export handler: %v", err)
+		return
+	}
 
 	s.logger.Infof("[PAPERDEBUGGER] http server listening on %s", addr)
 	s.ginServer.Any("/_pd/api/*path", func(c *gin.Context) { mux.ServeHTTP(c.Writer, c.Request) })
@@ -111,22 +151,26 @@ func (s *Server) Run(addr string) {
 func (s *Server) metadataAnnotator() func(ctx context.Context, req *http.Request) metadata.MD {
 	return func(ctx context.Context, req *http.Request) metadata.MD {
 		md := metadata.New(map[string]string{})
-
-		cookie, err := req.Cookie("token")
-		if err == nil {
-			metadatautil.SetAuthToken(md, cookie.Value)
-		}
-
-		authHeader := req.Header.Get("Authorization")
-		if strings.HasPrefix(authHeader, "Bearer ") {
-			token := strings.TrimPrefix(authHeader, "Bearer ")
+		if token := requestToken(req); token != "" {
 			metadatautil.SetAuthToken(md, token)
 		}
-
 		return md
 	}
 }
 
+// requestToken returns the access token of the request, from the Authorization header or else the
+// token cookie.
+func requestToken(req *http.Request) string {
+	authHeader := req.Header.Get("Authorization")
+	if strings.HasPrefix(authHeader, "Bearer ") {
+		return strings.TrimPrefix(authHeader, "Bearer ")
+	}
+	if cookie, err := req.Cookie("token"); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
 func (s *Server) forwardResponseOption() func(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
 	return func(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
 		md, ok := runtime.ServerMetadataFromContext(ctx)
@@ -8,6 +8,8 @@ import (
 	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/models"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 func (s *UserServer) CreatePrompt(
@@ -27,8 +29,15 @@ func (s *UserServer) CreatePrompt(
 	}
 
 	prompt := &models.Prompt{
-		Title:   req.GetTitle(),
-		Content: req.GetContent(),
+		Title:     req.GetTitle(),
+		Content:   req.GetContent(),
+		Variables: mapper.MapProtoPromptVariablesToModel(req.GetVariables()),
+	}
+	if req.OrganizationId != nil {
+		prompt.OrganizationID, err = bson.ObjectIDFromHex(req.GetOrganizationId())
+		if err != nil {
+			return nil, shared.ErrBadRequest("invalid organization_id")
+		}
 	}
 
 	createdPrompt, err := s.promptService.CreatePrompt(ctx, actor.ID, prompt)
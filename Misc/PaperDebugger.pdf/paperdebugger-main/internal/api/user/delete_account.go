@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserServer) DeleteAccount(
+	ctx context.Context,
+	req *userv1.DeleteAccountRequest,
+) (*userv1.DeleteAccountResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetUserByID(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(strings.TrimSpace(req.GetConfirmEmail()), user.Email) {
+		return nil, shared.ErrBadRequest("confirm_email does not match the email of the account")
+	}
+
+	purgeAt, err := s.accountService.RequestDeletion(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Account deletion requested", "user_id", actor.ID.Hex(), "purge_at", purgeAt)
+
+	return &userv1.DeleteAccountResponse{
+		PurgeAt: timestamppb.New(purgeAt),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserServer) ListDeletedPrompts(
+	ctx context.Context,
+	req *userv1.ListDeletedPromptsRequest,
+) (*userv1.ListDeletedPromptsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts, err := s.promptService.ListDeletedPrompts(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.ListDeletedPromptsResponse{
+		Prompts: lo.Map(prompts, func(prompt *models.Prompt, _ int) *userv1.DeletedPrompt {
+			deleted := &userv1.DeletedPrompt{Prompt: mapper.MapModelPromptToProto(prompt)}
+			if prompt.DeletedAt != nil {
+				deleted.DeletedAt = timestamppb.New(prompt.DeletedAt.Time())
+				if purgeAt, ok := s.cfg.TrashPurgeAt(prompt.DeletedAt.Time()); ok {
+					deleted.PurgeAt = timestamppb.New(purgeAt)
+				}
+			}
+			return deleted
+		}),
+	}, nil
+}
+
+func (s *UserServer) RestorePrompt(
+	ctx context.Context,
+	req *userv1.RestorePromptRequest,
+) (*userv1.RestorePromptResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPromptId() == "" {
+		return nil, shared.ErrBadRequest("prompt_id cannot be empty")
+	}
+
+	prompt, err := s.promptService.RestorePrompt(ctx, actor.ID, req.GetPromptId())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("deleted prompt not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.RestorePromptResponse{
+		Prompt: mapper.MapModelPromptToProto(prompt),
+	}, nil
+}
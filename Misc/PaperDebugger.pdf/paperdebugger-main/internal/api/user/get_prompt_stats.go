@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/services"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"github.com/samber/lo"
+)
+
+func (s *UserServer) GetPromptStats(
+	ctx context.Context,
+	req *userv1.GetPromptStatsRequest,
+) (*userv1.GetPromptStatsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.promptService.GetPromptStats(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.GetPromptStatsResponse{
+		Stats: lo.Map(stats, func(stats *services.PromptStats, _ int) *userv1.PromptStats {
+			return mapper.MapPromptStatsToProto(stats)
+		}),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services/toolkit"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *UserServer) ListAvailableTools(ctx context.Context, req *userv1.ListAvailableToolsRequest) (*userv1.ListAvailableToolsResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, shared.ErrInvalidActor("user not authenticated")
+	}
+
+	tools, err := s.availableTools(ctx, actor.ID, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.ListAvailableToolsResponse{
+		Tools: tools,
+	}, nil
+}
+
+// availableTools lists the registered tools along with the preferences of the user and project.
+func (s *UserServer) availableTools(ctx context.Context, userID bson.ObjectID, projectID string) ([]*userv1.Tool, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err, "userID", userID)
+		return nil, shared.ErrInternal("failed to get user")
+	}
+
+	filter := toolkit.ToolFilter{DisabledTools: user.ToolPreferences.DisabledTools}
+	if projectID != "" {
+		project, err := s.projectService.GetProject(ctx, userID, projectID)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, shared.ErrRecordNotFound("project not found")
+		}
+		if err != nil {
+			s.logger.Error("Failed to get project", "error", err, "userID", userID, "projectID", projectID)
+			return nil, shared.ErrInternal("failed to get project")
+		}
+		filter.PinnedTools = project.PinnedTools
+	}
+
+	registered := s.aiClient.ListTools()
+	tools := make([]*userv1.Tool, 0, len(registered))
+	for _, tool := range registered {
+		tools = append(tools, &userv1.Tool{
+			Name:            tool.Name,
+			Description:     tool.Description,
+			Enabled:         filter.Allows(tool.Name),
+			DisabledByUser:  slices.Contains(filter.DisabledTools, tool.Name),
+			PinnedByProject: slices.Contains(filter.PinnedTools, tool.Name),
+		})
+	}
+	return tools, nil
+}
@@ -3,50 +3,15 @@ package user
 import (
 	"context"
 	"sort"
-	"time"
 
 	"paperdebugger/internal/api/mapper"
 	"paperdebugger/internal/libs/contextutil"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
-var defaultPrompts = []*userv1.Prompt{
-	{
-		Id:           "1",
-		CreatedAt:    timestamppb.New(time.Time{}),
-		UpdatedAt:    timestamppb.New(time.Time{}),
-		Title:        "Enhance Academic Writing (Powered by XtraGPT)",
-		Content:      "Suggest context-aware academic paper writing enhancements for selected text.",
-		IsUserPrompt: false,
-	},
-	{
-		Id:           "2",
-		CreatedAt:    timestamppb.New(time.Time{}),
-		UpdatedAt:    timestamppb.New(time.Time{}),
-		Title:        "Review (Powered by XtraMCP)",
-		Content:      "Review my paper and identify issues",
-		IsUserPrompt: false,
-	},
-	{
-		Id:           "3",
-		CreatedAt:    timestamppb.New(time.Time{}),
-		UpdatedAt:    timestamppb.New(time.Time{}),
-		Title:        "Find Relevant Papers (Powered by XtraMCP)",
-		Content:      "Find me relevant papers to read",
-		IsUserPrompt: false,
-	},
-	{
-		Id:           "4",
-		CreatedAt:    timestamppb.New(time.Time{}),
-		UpdatedAt:    timestamppb.New(time.Time{}),
-		Title:        "Deep Research (Powered by XtraMCP)",
-		Content:      "Do deep research and compare my papers against others",
-		IsUserPrompt: false,
-	},
-}
-
 func (s *UserServer) ListPrompts(
 	ctx context.Context,
 	req *userv1.ListPromptsRequest,
@@ -61,6 +26,19 @@ func (s *UserServer) ListPrompts(
 		return nil, err
 	}
 
+	organizations, err := s.organizationService.ListOrganizations(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	organizationNames := make(map[bson.ObjectID]string, len(organizations))
+	for _, organization := range organizations {
+		organizationNames[organization.ID] = organization.Name
+	}
+	teamPrompts, err := s.promptService.ListOrganizationPrompts(ctx, lo.Keys(organizationNames))
+	if err != nil {
+		return nil, err
+	}
+
 	// Get user prompts
 	userPrompts := mapper.MapModelPromptsToProto(prompts)
 
@@ -69,8 +47,43 @@ func (s *UserServer) ListPrompts(
 		return userPrompts[i].UpdatedAt.AsTime().After(userPrompts[j].UpdatedAt.AsTime())
 	})
 
-	// Append default prompts after sorted user prompts
-	allPrompts := append(userPrompts, defaultPrompts...)
+	// Team prompts by organization, then by title
+	sort.Slice(teamPrompts, func(i, j int) bool {
+		if teamPrompts[i].OrganizationID != teamPrompts[j].OrganizationID {
+			return organizationNames[teamPrompts[i].OrganizationID] < organizationNames[teamPrompts[j].OrganizationID]
+		}
+		return teamPrompts[i].Title < teamPrompts[j].Title
+	})
+	for _, prompt := range mapper.MapModelPromptsToProto(teamPrompts) {
+		organizationID, _ := bson.ObjectIDFromHex(prompt.OrganizationId)
+		prompt.OrganizationName = organizationNames[organizationID]
+		userPrompts = append(userPrompts, prompt)
+	}
+
+	user, err := s.userService.GetUserByID(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	defaultPrompts, err := s.defaultPromptService.ListDefaultPrompts(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pinned built-in prompts first, the others after the user and team prompts, both in the
+	// order of the catalog
+	var pinnedPrompts, otherPrompts []*userv1.Prompt
+	for _, defaultPrompt := range defaultPrompts {
+		prompt := mapper.MapDefaultPromptToUserProto(defaultPrompt, req.GetLocale(), user.PromptPreferences)
+		switch {
+		case prompt.Hidden && !req.GetIncludeHidden():
+		case prompt.Pinned:
+			pinnedPrompts = append(pinnedPrompts, prompt)
+		default:
+			otherPrompts = append(otherPrompts, prompt)
+		}
+	}
+	allPrompts := append(pinnedPrompts, userPrompts...)
+	allPrompts = append(allPrompts, otherPrompts...)
 
 	return &userv1.ListPromptsResponse{
 		Prompts: allPrompts,
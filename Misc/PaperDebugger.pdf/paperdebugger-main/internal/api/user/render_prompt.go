@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/services"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *UserServer) RenderPrompt(
+	ctx context.Context,
+	req *userv1.RenderPromptRequest,
+) (*userv1.RenderPromptResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPromptId() == "" {
+		return nil, shared.ErrBadRequest("prompt_id cannot be empty")
+	}
+
+	prompt, err := s.promptService.GetPrompt(ctx, actor.ID, req.GetPromptId())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("prompt not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := services.RenderPromptTemplate(prompt.Content, prompt.Variables, req.GetValues())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.RenderPromptResponse{
+		Content: content,
+	}, nil
+}
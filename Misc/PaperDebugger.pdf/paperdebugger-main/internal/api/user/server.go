@@ -4,28 +4,44 @@ import (
 	"paperdebugger/internal/libs/cfg"
 	"paperdebugger/internal/libs/logger"
 	"paperdebugger/internal/services"
+	"paperdebugger/internal/services/toolkit/client"
 	userv1 "paperdebugger/pkg/gen/api/user/v1"
 )
 
 type UserServer struct {
 	userv1.UnimplementedUserServiceServer
 
-	userService   *services.UserService
-	promptService *services.PromptService
-	cfg           *cfg.Cfg
-	logger        *logger.Logger
+	userService          *services.UserService
+	promptService        *services.PromptService
+	projectService       *services.ProjectService
+	accountService       *services.AccountService
+	organizationService  *services.OrganizationService
+	defaultPromptService *services.DefaultPromptService
+	aiClient             *client.AIClient
+	cfg                  *cfg.Cfg
+	logger               *logger.Logger
 }
 
 func NewUserServer(
 	userService *services.UserService,
 	promptService *services.PromptService,
+	projectService *services.ProjectService,
+	accountService *services.AccountService,
+	organizationService *services.OrganizationService,
+	defaultPromptService *services.DefaultPromptService,
+	aiClient *client.AIClient,
 	cfg *cfg.Cfg,
 	logger *logger.Logger,
 ) userv1.UserServiceServer {
 	return &UserServer{
-		userService:   userService,
-		promptService: promptService,
-		cfg:           cfg,
-		logger:        logger,
+		userService:          userService,
+		promptService:        promptService,
+		projectService:       projectService,
+		accountService:       accountService,
+		organizationService:  organizationService,
+		defaultPromptService: defaultPromptService,
+		aiClient:             aiClient,
+		cfg:                  cfg,
+		logger:               logger,
 	}
 }
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"paperdebugger/internal/api/mapper"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (s *UserServer) SharePrompt(
+	ctx context.Context,
+	req *userv1.SharePromptRequest,
+) (*userv1.SharePromptResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPromptId() == "" {
+		return nil, shared.ErrBadRequest("prompt_id cannot be empty")
+	}
+
+	share, err := s.promptService.SharePrompt(ctx, actor.ID, req.GetPromptId())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("prompt not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.SharePromptResponse{
+		Token:   share.Token,
+		Imports: int32(share.Imports),
+	}, nil
+}
+
+func (s *UserServer) RevokePromptShare(
+	ctx context.Context,
+	req *userv1.RevokePromptShareRequest,
+) (*userv1.RevokePromptShareResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPromptId() == "" {
+		return nil, shared.ErrBadRequest("prompt_id cannot be empty")
+	}
+
+	err = s.promptService.RevokePromptShare(ctx, actor.ID, req.GetPromptId())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("prompt share not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.RevokePromptShareResponse{}, nil
+}
+
+func (s *UserServer) ImportSharedPrompt(
+	ctx context.Context,
+	req *userv1.ImportSharedPromptRequest,
+) (*userv1.ImportSharedPromptResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token := strings.TrimSpace(req.GetToken())
+	if token == "" {
+		return nil, shared.ErrBadRequest("token cannot be empty")
+	}
+
+	prompt, err := s.promptService.ImportSharedPrompt(ctx, actor.ID, token)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, shared.ErrRecordNotFound("the shared prompt does not exist or is no longer shared")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.ImportSharedPromptResponse{
+		Prompt: mapper.MapModelPromptToProto(prompt),
+	}, nil
+}
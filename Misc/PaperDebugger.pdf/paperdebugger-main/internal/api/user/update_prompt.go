@@ -30,8 +30,9 @@ func (s *UserServer) UpdatePrompt(
 	}
 
 	prompt := &models.Prompt{
-		Title:   req.GetTitle(),
-		Content: req.GetContent(),
+		Title:     req.GetTitle(),
+		Content:   req.GetContent(),
+		Variables: mapper.MapProtoPromptVariablesToModel(req.GetVariables()),
 	}
 
 	updatedPrompt, err := s.promptService.UpdatePrompt(ctx, actor.ID, req.GetPromptId(), prompt)
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"github.com/samber/lo"
+)
+
+// UpdatePromptPreferences replaces the built-in prompts hidden or pinned by the user. Omitted
+// lists are left untouched.
+func (s *UserServer) UpdatePromptPreferences(ctx context.Context, req *userv1.UpdatePromptPreferencesRequest) (*userv1.UpdatePromptPreferencesResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, shared.ErrInvalidActor("user not authenticated")
+	}
+
+	user, err := s.userService.GetUserByID(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := user.PromptPreferences
+	if req.HiddenPrompts != nil {
+		if preferences.HiddenPrompts, err = promptKeys(req.GetHiddenPrompts()); err != nil {
+			return nil, err
+		}
+	}
+	if req.PinnedPrompts != nil {
+		if preferences.PinnedPrompts, err = promptKeys(req.GetPinnedPrompts()); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.userService.UpdatePromptPreferences(ctx, actor.ID, preferences)
+	if err != nil {
+		s.logger.Error("Failed to update prompt preferences", "error", err, "userID", actor.ID)
+		return nil, shared.ErrInternal("failed to update prompt preferences")
+	}
+
+	return &userv1.UpdatePromptPreferencesResponse{
+		HiddenPrompts: updated.HiddenPrompts,
+		PinnedPrompts: updated.PinnedPrompts,
+	}, nil
+}
+
+func promptKeys(keys *userv1.PromptKeys) ([]string, error) {
+	if lo.Contains(keys.GetKeys(), "") {
+		return nil, shared.ErrBadRequest("prompt key must not be empty")
+	}
+	return lo.Uniq(keys.GetKeys()), nil
+}
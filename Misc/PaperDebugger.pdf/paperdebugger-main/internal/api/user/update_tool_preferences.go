@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+	userv1 "paperdebugger/pkg/gen/api/user/v1"
+
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// UpdateToolPreferences replaces the tools disabled by the user and, when project_id is
+// set, the tools pinned by the project. Omitted lists are left untouched.
+func (s *UserServer) UpdateToolPreferences(ctx context.Context, req *userv1.UpdateToolPreferencesRequest) (*userv1.UpdateToolPreferencesResponse, error) {
+	actor, err := contextutil.GetActor(ctx)
+	if err != nil {
+		return nil, shared.ErrInvalidActor("user not authenticated")
+	}
+
+	if req.ProjectPinnedTools != nil && req.GetProjectId() == "" {
+		return nil, shared.ErrBadRequest("project_id is required to pin tools")
+	}
+
+	if req.DisabledTools != nil {
+		disabledTools, err := toolNames(req.GetDisabledTools())
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.userService.UpdateToolPreferences(ctx, actor.ID, models.ToolPreferences{DisabledTools: disabledTools})
+		if err != nil {
+			s.logger.Error("Failed to update tool preferences", "error", err, "userID", actor.ID)
+			return nil, shared.ErrInternal("failed to update tool preferences")
+		}
+	}
+
+	if req.ProjectPinnedTools != nil {
+		pinnedTools, err := toolNames(req.GetProjectPinnedTools())
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.projectService.UpdateProjectPinnedTools(ctx, actor.ID, req.GetProjectId(), pinnedTools)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, shared.ErrRecordNotFound("project not found")
+		}
+		if err != nil {
+			s.logger.Error("Failed to update project pinned tools", "error", err, "userID", actor.ID, "projectID", req.GetProjectId())
+			return nil, shared.ErrInternal("failed to update project pinned tools")
+		}
+	}
+
+	tools, err := s.availableTools(ctx, actor.ID, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.UpdateToolPreferencesResponse{
+		Tools: tools,
+	}, nil
+}
+
+func toolNames(names *userv1.ToolNames) ([]string, error) {
+	if lo.Contains(names.GetNames(), "") {
+		return nil, shared.ErrBadRequest("tool name must not be empty")
+	}
+	return lo.Uniq(names.GetNames()), nil
+}
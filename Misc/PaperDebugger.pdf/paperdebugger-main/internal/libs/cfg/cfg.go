@@ -2,6 +2,10 @@ package cfg
 
 import (
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -12,6 +16,29 @@ type Cfg struct {
 
 	MongoURI   string
 	XtraMCPURI string
+
+	LocalMCPServers        []LocalMCPServer
+	AdminEmails            []string
+	ToolsRequiringApproval []string
+	ValidateToolResults    bool
+	ToolJobWorkers         int
+	ToolJobInlineWait      time.Duration
+
+	ScoringServiceURI     string // empty if there is no paper scoring service
+	ScoringServiceTimeout time.Duration
+	ScoringServiceRetries int
+
+	TrashRetention             time.Duration // 0 keeps the deleted conversations and prompts forever
+	AccountDeletionGracePeriod time.Duration
+
+	SystemPromptDir string // empty if the system prompts are not overridden by files
+}
+
+// LocalMCPServer is a MCP server executable spoken to over stdio.
+type LocalMCPServer struct {
+	Name    string
+	Command string
+	Args    []string
 }
 
 var cfg *Cfg
@@ -23,6 +50,22 @@ func GetCfg() *Cfg {
 		JwtSigningKey: os.Getenv("JWT_SIGNING_KEY"),
 		MongoURI:      mongoURI(),
 		XtraMCPURI:    xtraMCPURI(),
+
+		LocalMCPServers:        localMCPServers(),
+		AdminEmails:            adminEmails(),
+		ToolsRequiringApproval: toolsRequiringApproval(),
+		ValidateToolResults:    os.Getenv("PD_VALIDATE_TOOL_RESULTS") == "true",
+		ToolJobWorkers:         toolJobWorkers(),
+		ToolJobInlineWait:      toolJobInlineWait(),
+
+		ScoringServiceURI:     strings.TrimRight(os.Getenv("PD_SCORING_SERVICE_URI"), "/"),
+		ScoringServiceTimeout: scoringServiceTimeout(),
+		ScoringServiceRetries: scoringServiceRetries(),
+
+		TrashRetention:             trashRetention(),
+		AccountDeletionGracePeriod: accountDeletionGracePeriod(),
+
+		SystemPromptDir: os.Getenv("PD_SYSTEM_PROMPT_DIR"),
 	}
 
 	return cfg
@@ -36,6 +79,130 @@ func xtraMCPURI() string {
 	return "http://paperdebugger-xtramcp-server:8080/mcp"
 }
 
+// localMCPServers parses LOCAL_MCP_SERVERS, e.g.
+// "latex-lint=/usr/local/bin/latex-lint-mcp --strict;bib=bib-mcp"
+func localMCPServers() []LocalMCPServer {
+	var servers []LocalMCPServer
+	for _, entry := range strings.Split(os.Getenv("LOCAL_MCP_SERVERS"), ";") {
+		name, commandLine, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		fields := strings.Fields(commandLine)
+		if !ok || name == "" || len(fields) == 0 {
+			continue
+		}
+		servers = append(servers, LocalMCPServer{
+			Name:    strings.TrimSpace(name),
+			Command: fields[0],
+			Args:    fields[1:],
+		})
+	}
+	return servers
+}
+
+// adminEmails parses PD_ADMIN_EMAILS, a comma separated list of emails
+// of the users allowed to call the admin API.
+func adminEmails() []string {
+	var emails []string
+	for _, email := range strings.Split(os.Getenv("PD_ADMIN_EMAILS"), ",") {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email != "" {
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// toolsRequiringApproval parses PD_TOOLS_REQUIRING_APPROVAL, a comma separated list of
+// the tools that send the manuscript to external services. Calls to them must be
+// approved by the user. XtraMCP tools always require approval.
+func toolsRequiringApproval() []string {
+	val, ok := os.LookupEnv("PD_TOOLS_REQUIRING_APPROVAL")
+	if !ok {
+		return []string{"paper_score", "paper_score_comment"}
+	}
+	var names []string
+	for _, name := range strings.Split(val, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// toolJobWorkers parses PD_TOOL_JOB_WORKERS, the number of tool calls that may
+// run in the background at the same time.
+func toolJobWorkers() int {
+	val, err := strconv.Atoi(os.Getenv("PD_TOOL_JOB_WORKERS"))
+	if err != nil || val <= 0 {
+		return 4
+	}
+	return val
+}
+
+// toolJobInlineWait parses PD_TOOL_JOB_INLINE_WAIT, e.g. "20s": how long a turn waits for
+// a background tool call before the model is told that the result will come later.
+func toolJobInlineWait() time.Duration {
+	val, err := time.ParseDuration(os.Getenv("PD_TOOL_JOB_INLINE_WAIT"))
+	if err != nil || val < 0 {
+		return 20 * time.Second
+	}
+	return val
+}
+
+// scoringServiceTimeout parses PD_SCORING_SERVICE_TIMEOUT, e.g. "2m": how long a
+// request to the paper scoring service may take.
+func scoringServiceTimeout() time.Duration {
+	val, err := time.ParseDuration(os.Getenv("PD_SCORING_SERVICE_TIMEOUT"))
+	if err != nil || val <= 0 {
+		return 2 * time.Minute
+	}
+	return val
+}
+
+// scoringServiceRetries parses PD_SCORING_SERVICE_RETRIES, how many times a request to
+// the paper scoring service is retried after a network error or a 5xx/429 response.
+func scoringServiceRetries() int {
+	val, err := strconv.Atoi(os.Getenv("PD_SCORING_SERVICE_RETRIES"))
+	if err != nil || val < 0 {
+		return 2
+	}
+	return val
+}
+
+// trashRetention parses PD_TRASH_RETENTION, e.g. "720h": how long deleted conversations and
+// prompts can be restored before they are deleted for good. "0" disables the deletion.
+func trashRetention() time.Duration {
+	val, err := time.ParseDuration(os.Getenv("PD_TRASH_RETENTION"))
+	if err != nil || val < 0 {
+		return 30 * 24 * time.Hour
+	}
+	return val
+}
+
+// IsAdmin reports whether the user with the given email may call the admin API.
+func (c *Cfg) IsAdmin(email string) bool {
+	return slices.Contains(c.AdminEmails, strings.ToLower(email))
+}
+
+// accountDeletionGracePeriod parses PD_ACCOUNT_DELETION_GRACE_PERIOD, e.g. "168h": how long a
+// deleted account can be recovered by logging in again before all its data is deleted.
+func accountDeletionGracePeriod() time.Duration {
+	val, err := time.ParseDuration(os.Getenv("PD_ACCOUNT_DELETION_GRACE_PERIOD"))
+	if err != nil || val < 0 {
+		return 7 * 24 * time.Hour
+	}
+	return val
+}
+
+// TrashPurgeAt returns when a conversation or prompt deleted at deletedAt is deleted for good,
+// false if it is kept forever.
+func (c *Cfg) TrashPurgeAt(deletedAt time.Time) (time.Time, bool) {
+	if c.TrashRetention <= 0 {
+		return time.Time{}, false
+	}
+	return deletedAt.Add(c.TrashRetention), true
+}
+
 func mongoURI() string {
 	val := os.Getenv("PD_MONGO_URI")
 	if val != "" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -28,3 +29,89 @@ func TestCfg(t *testing.T) {
 	assert.NotEmpty(t, cfg.OpenAIAPIKey)
 	assert.NotEmpty(t, cfg.MongoURI)
 }
+
+func TestLocalMCPServers(t *testing.T) {
+	os.Setenv("LOCAL_MCP_SERVERS", "lint=/usr/bin/lint-mcp --strict; bib = bib-mcp ;broken=")
+	defer os.Unsetenv("LOCAL_MCP_SERVERS")
+
+	servers := localMCPServers()
+	assert.Equal(t, []LocalMCPServer{
+		{Name: "lint", Command: "/usr/bin/lint-mcp", Args: []string{"--strict"}},
+		{Name: "bib", Command: "bib-mcp", Args: []string{}},
+	}, servers)
+}
+
+func TestAdminEmails(t *testing.T) {
+	os.Setenv("PD_ADMIN_EMAILS", " Admin@Example.com,,ops@example.com ")
+	defer os.Unsetenv("PD_ADMIN_EMAILS")
+
+	cfg := &Cfg{AdminEmails: adminEmails()}
+	assert.Equal(t, []string{"admin@example.com", "ops@example.com"}, cfg.AdminEmails)
+	assert.True(t, cfg.IsAdmin("ADMIN@example.com"))
+	assert.False(t, cfg.IsAdmin("someone@example.com"))
+}
+
+func TestToolsRequiringApproval(t *testing.T) {
+	os.Unsetenv("PD_TOOLS_REQUIRING_APPROVAL")
+	assert.Equal(t, []string{"paper_score", "paper_score_comment"}, toolsRequiringApproval())
+
+	os.Setenv("PD_TOOLS_REQUIRING_APPROVAL", "")
+	defer os.Unsetenv("PD_TOOLS_REQUIRING_APPROVAL")
+	assert.Empty(t, toolsRequiringApproval())
+
+	os.Setenv("PD_TOOLS_REQUIRING_APPROVAL", " deep_research, ,paper_score")
+	assert.Equal(t, []string{"deep_research", "paper_score"}, toolsRequiringApproval())
+}
+
+func TestToolJobSettings(t *testing.T) {
+	os.Unsetenv("PD_TOOL_JOB_WORKERS")
+	os.Unsetenv("PD_TOOL_JOB_INLINE_WAIT")
+	assert.Equal(t, 4, toolJobWorkers())
+	assert.Equal(t, 20*time.Second, toolJobInlineWait())
+
+	os.Setenv("PD_TOOL_JOB_WORKERS", "8")
+	defer os.Unsetenv("PD_TOOL_JOB_WORKERS")
+	os.Setenv("PD_TOOL_JOB_INLINE_WAIT", "1m")
+	defer os.Unsetenv("PD_TOOL_JOB_INLINE_WAIT")
+	assert.Equal(t, 8, toolJobWorkers())
+	assert.Equal(t, time.Minute, toolJobInlineWait())
+
+	os.Setenv("PD_TOOL_JOB_WORKERS", "-1")
+	assert.Equal(t, 4, toolJobWorkers())
+}
+
+func TestScoringServiceSettings(t *testing.T) {
+	os.Unsetenv("PD_SCORING_SERVICE_TIMEOUT")
+	os.Unsetenv("PD_SCORING_SERVICE_RETRIES")
+	assert.Equal(t, 2*time.Minute, scoringServiceTimeout())
+	assert.Equal(t, 2, scoringServiceRetries())
+
+	os.Setenv("PD_SCORING_SERVICE_TIMEOUT", "30s")
+	defer os.Unsetenv("PD_SCORING_SERVICE_TIMEOUT")
+	os.Setenv("PD_SCORING_SERVICE_RETRIES", "0")
+	defer os.Unsetenv("PD_SCORING_SERVICE_RETRIES")
+	assert.Equal(t, 30*time.Second, scoringServiceTimeout())
+	assert.Equal(t, 0, scoringServiceRetries())
+
+	os.Setenv("PD_SCORING_SERVICE_TIMEOUT", "soon")
+	assert.Equal(t, 2*time.Minute, scoringServiceTimeout())
+}
+
+func TestTrashRetention(t *testing.T) {
+	os.Unsetenv("PD_TRASH_RETENTION")
+	os.Unsetenv("PD_ACCOUNT_DELETION_GRACE_PERIOD")
+	assert.Equal(t, 30*24*time.Hour, trashRetention())
+	assert.Equal(t, 7*24*time.Hour, accountDeletionGracePeriod())
+
+	os.Setenv("PD_TRASH_RETENTION", "0")
+	defer os.Unsetenv("PD_TRASH_RETENTION")
+	assert.Equal(t, time.Duration(0), trashRetention())
+	_, ok := (&Cfg{TrashRetention: trashRetention()}).TrashPurgeAt(time.Now())
+	assert.False(t, ok)
+
+	os.Setenv("PD_TRASH_RETENTION", "48h")
+	deletedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	purgeAt, ok := (&Cfg{TrashRetention: trashRetention()}).TrashPurgeAt(deletedAt)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), purgeAt)
+}
@@ -5,6 +5,7 @@ import (
 
 	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,6 +17,8 @@ const (
 	userIdKey         = "userId"
 	projectIdKey      = "projectId"
 	conversationIDKey = "conversationID"
+	responseModeKey   = "responseMode"
+	selectionKey      = "selection"
 )
 
 func Get[T any](ctx context.Context, k string) (T, bool) {
@@ -67,3 +70,29 @@ func GetConversationID(ctx context.Context) (string, error) {
 	}
 	return v, nil
 }
+
+func SetResponseMode(ctx context.Context, mode models.ResponseMode) context.Context {
+	return Set(ctx, responseModeKey, mode)
+}
+
+// GetResponseMode returns how the assistant should reply, free text if the context does not tell.
+func GetResponseMode(ctx context.Context) models.ResponseMode {
+	v, _ := Get[models.ResponseMode](ctx, responseModeKey)
+	return v
+}
+
+// Selection is the text the user selected in the editor for a message.
+type Selection struct {
+	Text   string
+	Offset *int // the UTF-16 offset of Text in the document open in the editor, if the webapp sent it
+}
+
+func SetSelection(ctx context.Context, selection Selection) context.Context {
+	return Set(ctx, selectionKey, selection)
+}
+
+// GetSelection returns the selection of the message being answered, empty if there is none.
+func GetSelection(ctx context.Context) Selection {
+	v, _ := Get[Selection](ctx, selectionKey)
+	return v
+}
@@ -0,0 +1,100 @@
+// Package scoring talks to the paper scoring service, which classifies, scores and comments papers.
+package scoring
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"paperdebugger/internal/libs/cfg"
+	"time"
+)
+
+var ErrNotConfigured = errors.New("the paper scoring service is not configured (PD_SCORING_SERVICE_URI)")
+
+const maxErrorBodySize = 512
+
+type Client struct {
+	baseURL string
+	client  *http.Client
+	retries int
+	backoff time.Duration // before the first retry, doubled for each next one
+}
+
+func NewClient(cfg *cfg.Cfg) *Client {
+	return &Client{
+		baseURL: cfg.ScoringServiceURI,
+		client:  &http.Client{Timeout: cfg.ScoringServiceTimeout},
+		retries: cfg.ScoringServiceRetries,
+		backoff: time.Second,
+	}
+}
+
+// Configured reports whether there is a scoring service to talk to.
+func (c *Client) Configured() bool {
+	return c.baseURL != ""
+}
+
+// Post sends body as JSON to the path of the scoring service, e.g. "/paper-score", and decodes the
+// JSON response into out. Network errors and 5xx or 429 responses are retried.
+func (c *Client) Post(ctx context.Context, path string, body any, out any) error {
+	if !c.Configured() {
+		return ErrNotConfigured
+	}
+
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := c.post(ctx, c.baseURL+path, jsonData, out)
+		if err == nil || !retry || attempt >= c.retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (gave up retrying: %v)", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends one request, retry tells whether a failure may be transient.
+func (c *Client) post(ctx context.Context, url string, jsonData []byte, out any) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonData))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if len(respBody) > maxErrorBodySize {
+			respBody = respBody[:maxErrorBodySize]
+		}
+		retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return false, fmt.Errorf("failed to unmarshal response body: %w, body: %s", err, string(respBody))
+	}
+	return false, nil
+}
@@ -0,0 +1,95 @@
+package scoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"paperdebugger/internal/libs/cfg"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(uri string, retries int) *Client {
+	c := NewClient(&cfg.Cfg{
+		ScoringServiceURI:     uri,
+		ScoringServiceTimeout: time.Second,
+		ScoringServiceRetries: retries,
+	})
+	c.backoff = time.Millisecond
+	return c
+}
+
+func TestClient_NotConfigured(t *testing.T) {
+	c := newTestClient("", 2)
+	assert.False(t, c.Configured())
+	assert.ErrorIs(t, c.Post(context.Background(), "/classify-paper", nil, nil), ErrNotConfigured)
+}
+
+func TestClient_RetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/paper-score", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "\\section{Intro}", body["latexSource"])
+
+		if calls.Add(1) < 3 {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"score": 7}`))
+	}))
+	defer server.Close()
+
+	var out struct {
+		Score int `json:"score"`
+	}
+	err := newTestClient(server.URL, 2).Post(context.Background(), "/paper-score", map[string]string{"latexSource": "\\section{Intro}"}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, 7, out.Score)
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+func TestClient_GivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/bad" {
+			http.Error(w, "invalid latexSource", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, 1)
+	err := c.Post(context.Background(), "/broken", nil, &struct{}{})
+	assert.ErrorContains(t, err, "status code 500: boom")
+	assert.EqualValues(t, 2, calls.Load())
+
+	calls.Store(0)
+	err = c.Post(context.Background(), "/bad", nil, &struct{}{})
+	assert.ErrorContains(t, err, "status code 400: invalid latexSource")
+	assert.EqualValues(t, 1, calls.Load(), "client errors are not retried")
+}
+
+func TestClient_ScorePaper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/paper-score", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"latexSource": "\\section{Intro}", "category": "cs.CL"}, body)
+		w.Write([]byte(`{"score": 7, "percentile": 80}`))
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL, 0).ScorePaper(context.Background(), "\\section{Intro}", "cs.CL")
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, result.Score)
+	assert.EqualValues(t, 80, result.Percentile)
+}
@@ -0,0 +1,35 @@
+package scoring
+
+import (
+	"context"
+
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+)
+
+type paperScoreRequest struct {
+	LatexSource string `json:"latexSource"`
+	Category    string `json:"category"`
+}
+
+type paperScoreCommentRequest struct {
+	LatexSource      string                      `json:"latexSource"`
+	PaperScoreResult *projectv1.PaperScoreResult `json:"paperScoreResult"`
+}
+
+// ScorePaper scores the LaTeX source of a paper of the category (see the project category).
+func (c *Client) ScorePaper(ctx context.Context, latexSource string, category string) (*projectv1.PaperScoreResult, error) {
+	var result projectv1.PaperScoreResult
+	if err := c.Post(ctx, "/paper-score", paperScoreRequest{LatexSource: latexSource, Category: category}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CommentPaperScore returns comments on the weaknesses behind the score of the paper.
+func (c *Client) CommentPaperScore(ctx context.Context, latexSource string, score *projectv1.PaperScoreResult) (*projectv1.PaperScoreCommentResult, error) {
+	var result projectv1.PaperScoreCommentResult
+	if err := c.Post(ctx, "/paper-score-comments", paperScoreCommentRequest{LatexSource: latexSource, PaperScoreResult: score}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
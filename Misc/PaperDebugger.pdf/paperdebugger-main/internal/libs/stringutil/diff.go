@@ -0,0 +1,138 @@
+package stringutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Edit replaces the runes [Start, End) of a text with Replacement.
+type Edit struct {
+	Start       int
+	End         int
+	Replacement string
+}
+
+// maxDiffCells bounds the size of the LCS table, the changed part of longer texts is replaced as a whole.
+const maxDiffCells = 4_000_000
+
+// DiffEdits returns the edits turning original into revised, in increasing order of position.
+// The texts are compared word by word: runs of letters and digits, runs of spaces and single
+// other characters (e.g. "\", "{") are the tokens. Consecutive changed tokens form one edit.
+func DiffEdits(original, revised string) []Edit {
+	a, b := tokenize(original), tokenize(revised)
+
+	// the common prefix and suffix do not need the quadratic table
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	start := runeCount(a[:prefix])
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	if len(a)*len(b) > maxDiffCells {
+		return []Edit{{Start: start, End: start + runeCount(a), Replacement: strings.Join(b, "")}}
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var edits []Edit
+	var current *Edit
+	var replacement strings.Builder
+	flush := func() {
+		if current != nil {
+			current.Replacement = replacement.String()
+			edits = append(edits, *current)
+			current = nil
+			replacement.Reset()
+		}
+	}
+	position := start
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if i < len(a) && j < len(b) && a[i] == b[j] {
+			flush()
+			position += utf8.RuneCountInString(a[i])
+			i++
+			j++
+			continue
+		}
+		if current == nil {
+			current = &Edit{Start: position, End: position}
+		}
+		if j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]) {
+			position += utf8.RuneCountInString(a[i])
+			current.End = position
+			i++
+		} else {
+			replacement.WriteString(b[j])
+			j++
+		}
+	}
+	flush()
+	return edits
+}
+
+func tokenize(s string) []string {
+	var tokens []string
+	class := func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		}
+		return 0
+	}
+	begin := 0
+	for i, r := range s {
+		if i > begin {
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			if c := class(r); c == 0 || c != class(prev) {
+				tokens = append(tokens, s[begin:i])
+				begin = i
+			}
+		}
+	}
+	if begin < len(s) {
+		tokens = append(tokens, s[begin:])
+	}
+	return tokens
+}
+
+func runeCount(tokens []string) int {
+	n := 0
+	for _, token := range tokens {
+		n += utf8.RuneCountInString(token)
+	}
+	return n
+}
+
+// UTF16Len returns the length of s in UTF-16 code units, the unit of the JavaScript string indices.
+func UTF16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r) // the invalid bytes are decoded as U+FFFD, one unit
+	}
+	return n
+}
@@ -0,0 +1,49 @@
+package stringutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// apply applies edits given in increasing order of position to s.
+func apply(s string, edits []Edit) string {
+	runes := []rune(s)
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		runes = append(runes[:e.Start], append([]rune(e.Replacement), runes[e.End:]...)...)
+	}
+	return string(runes)
+}
+
+func TestDiffEdits(t *testing.T) {
+	original := `We propose an new method, which outperform the baselines.`
+	revised := `We propose a new method that outperforms the baselines.`
+	edits := DiffEdits(original, revised)
+	assert.Equal(t, []Edit{
+		{Start: 11, End: 13, Replacement: "a"},
+		{Start: 24, End: 25, Replacement: ""},
+		{Start: 26, End: 31, Replacement: "that"},
+		{Start: 32, End: 42, Replacement: "outperforms"},
+	}, edits)
+	assert.Equal(t, revised, apply(original, edits))
+}
+
+func TestDiffEdits_Unicode(t *testing.T) {
+	original := `Les résultats sont \textbf{très} bons.`
+	revised := `Les résultats sont \emph{très} bons !`
+	edits := DiffEdits(original, revised)
+	assert.Equal(t, Edit{Start: 20, End: 26, Replacement: "emph"}, edits[0])
+	assert.Equal(t, revised, apply(original, edits))
+}
+
+func TestDiffEdits_NoChange(t *testing.T) {
+	assert.Empty(t, DiffEdits("same text", "same text"))
+	assert.Equal(t, []Edit{{Start: 0, End: 0, Replacement: "new"}}, DiffEdits("", "new"))
+}
+
+func TestUTF16Len(t *testing.T) {
+	assert.Equal(t, 0, UTF16Len(""))
+	assert.Equal(t, 5, UTF16Len("très "))
+	assert.Equal(t, 4, UTF16Len("a𝔸b")) // 𝔸 is a surrogate pair
+}
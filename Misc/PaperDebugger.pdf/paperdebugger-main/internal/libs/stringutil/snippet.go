@@ -0,0 +1,93 @@
+package stringutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Range is the runes [Start, End) of a text.
+type Range struct {
+	Start int
+	End   int
+}
+
+// SearchTerms returns the lowercase words of a full-text search query, the words of "phrases"
+// included and -excluded words left out.
+func SearchTerms(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(query) {
+		if strings.HasPrefix(field, "-") {
+			continue
+		}
+		for _, word := range strings.FieldsFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			terms = append(terms, strings.ToLower(word))
+		}
+	}
+	return terms
+}
+
+// Snippet returns the part of text around its first word starting with one of the terms, about
+// width runes long, with the ranges of such words in it. Cut ends are marked with "…". ok is false
+// if no word matches.
+func Snippet(text string, terms []string, width int) (snippet string, highlights []Range, ok bool) {
+	runes := []rune(text)
+	var matches []Range
+	for start := 0; start < len(runes); {
+		if !unicode.IsLetter(runes[start]) && !unicode.IsDigit(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end])) {
+			end++
+		}
+		word := strings.ToLower(string(runes[start:end]))
+		for _, term := range terms {
+			if term != "" && strings.HasPrefix(word, term) {
+				matches = append(matches, Range{Start: start, End: end})
+				break
+			}
+		}
+		start = end
+	}
+	if len(matches) == 0 {
+		return "", nil, false
+	}
+
+	// the first match is shown after a third of the snippet, the cuts are moved to spaces
+	from := max(0, matches[0].Start-width/3)
+	for from > 0 && from < matches[0].Start && !unicode.IsSpace(runes[from-1]) {
+		from++
+	}
+	to := min(len(runes), from+width)
+	for to < len(runes) && to > matches[0].End && !unicode.IsSpace(runes[to]) {
+		to--
+	}
+
+	cutStart, cutEnd := from > 0, to < len(runes)
+	for from < to && unicode.IsSpace(runes[from]) {
+		from++
+	}
+	for to > from && unicode.IsSpace(runes[to-1]) {
+		to--
+	}
+
+	var b strings.Builder
+	offset := -from
+	if cutStart {
+		b.WriteString("…")
+		offset++
+	}
+	b.WriteString(string(runes[from:to]))
+	if cutEnd {
+		b.WriteString("…")
+	}
+	for _, m := range matches {
+		if m.Start >= from && m.End <= to {
+			highlights = append(highlights, Range{Start: m.Start + offset, End: m.End + offset})
+		}
+	}
+	return b.String(), highlights, true
+}
@@ -0,0 +1,29 @@
+package stringutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"related", "work", "latex"}, SearchTerms(`"Related work" -abstract LaTeX`))
+}
+
+func TestSnippet(t *testing.T) {
+	text := "The introduction is fine. Please rewrite the related work section, it cites outdated papers."
+	snippet, highlights, ok := Snippet(text, SearchTerms("related cite"), 40)
+	assert.True(t, ok)
+	assert.Equal(t, "…rewrite the related work section, it…", snippet)
+	assert.Equal(t, []Range{{Start: 13, End: 20}}, highlights)
+	runes := []rune(snippet)
+	assert.Equal(t, "related", string(runes[13:20]))
+
+	snippet, highlights, ok = Snippet("Über die Methode", []string{"über"}, 40)
+	assert.True(t, ok)
+	assert.Equal(t, "Über die Methode", snippet)
+	assert.Equal(t, []Range{{Start: 0, End: 4}}, highlights)
+
+	_, _, ok = Snippet(text, []string{"figure"}, 40)
+	assert.False(t, ok)
+}
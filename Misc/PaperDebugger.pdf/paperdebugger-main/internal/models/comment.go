@@ -28,6 +28,7 @@ type Comment struct {
 	BaseModel         `bson:",inline"`
 	UserID            bson.ObjectID   `bson:"user_id"`
 	ProjectID         string          `bson:"project_id"`
+	ConversationID    string          `bson:"conversation_id,omitempty"` // the conversation whose tool call made the comment
 	DocID             string          `bson:"doc_id"`
 	DocVersion        int             `bson:"doc_version"`
 	DocSHA1           string          `bson:"doc_sha1"`
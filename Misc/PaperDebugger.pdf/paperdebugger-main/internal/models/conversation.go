@@ -15,6 +15,23 @@ type Conversation struct {
 
 	OpenaiChatHistory responses.ResponseInputParam `bson:"openai_chat_history"` // 实际上发给 GPT 的聊天历史
 	OpenaiChatParams  responses.ResponseNewParams  `bson:"openai_chat_params"`  // 对话的参数，比如 temperature, etc.
+
+	ForkedFromID bson.ObjectID `bson:"forked_from_id,omitempty"` // the conversation this one was copied from
+
+	// Shared conversations can be read by the members of the project (see ProjectMember), only the
+	// user writes in them.
+	Shared bool `bson:"shared,omitempty"`
+
+	// MessageTree links the user messages of all branches, the chat histories above hold the branch
+	// that is shown (see MessageNode).
+	MessageTree []MessageNode `bson:"message_tree"`
+
+	// ResponseMode is the one of the last user message, the turn resumes with it after a tool approval.
+	ResponseMode ResponseMode `bson:"response_mode"`
+
+	// Persona is the one of the system prompt, chosen when the conversation was created. It is
+	// empty for the conversations created before the personas, which have the default one.
+	Persona Persona `bson:"persona,omitempty"`
 }
 
 func (c Conversation) CollectionName() string {
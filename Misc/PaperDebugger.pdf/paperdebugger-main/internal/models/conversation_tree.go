@@ -0,0 +1,277 @@
+package models
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/openai/openai-go/v2/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// UserMessageIDPrefix starts the id of every user message of the in-app chat history.
+const UserMessageIDPrefix = "pd_msg_user_"
+
+var (
+	ErrMessageNotInTree = errors.New("message not found in the conversation")
+	// ErrHistoryNotBranchable is returned when the user messages of the two chat histories do not
+	// pair up, the histories cannot be split into turns then.
+	ErrHistoryNotBranchable = errors.New("conversation history cannot be branched")
+)
+
+// MessageNode is a user message of the conversation tree. Its turn is the message with what
+// follows it up to the next user message, in both chat histories. Editing or regenerating a user
+// message adds a sibling node, i.e. a new branch.
+//
+// The turns of the shown branch are the InappChatHistory and OpenaiChatHistory of the conversation,
+// the nodes of the other branches keep their turn until they are switched to.
+type MessageNode struct {
+	MessageID     string `bson:"message_id"`
+	ParentID      string `bson:"parent_id"`       // the previous user message, "" for the first one
+	ActiveChildID string `bson:"active_child_id"` // the next user message on the branch last shown
+
+	InappMessages []bson.M                     `bson:"inapp_messages,omitempty"`
+	OpenaiItems   responses.ResponseInputParam `bson:"openai_items,omitempty"`
+}
+
+// turn is a user message of the shown branch with what follows it.
+type turn struct {
+	messageID string
+	inapp     []bson.M
+	openai    responses.ResponseInputParam
+}
+
+func isInappUserMessage(msg bson.M) bool {
+	id, _ := msg["messageId"].(string)
+	return strings.HasPrefix(id, UserMessageIDPrefix)
+}
+
+func isOpenaiUserItem(item responses.ResponseInputItemUnionParam) bool {
+	return item.OfInputMessage != nil && item.OfInputMessage.Role == "user"
+}
+
+// splitTurns splits the chat histories at the user messages, what precedes the first one (e.g.
+// the system prompt) is returned apart.
+func (c *Conversation) splitTurns() (inappHead []bson.M, openaiHead responses.ResponseInputParam, turns []turn, err error) {
+	inappHead = c.InappChatHistory
+	for i, msg := range c.InappChatHistory {
+		if !isInappUserMessage(msg) {
+			if len(turns) > 0 {
+				turns[len(turns)-1].inapp = append(turns[len(turns)-1].inapp, msg)
+			}
+			continue
+		}
+		if len(turns) == 0 {
+			inappHead = c.InappChatHistory[:i]
+		}
+		id, _ := msg["messageId"].(string)
+		turns = append(turns, turn{messageID: id, inapp: []bson.M{msg}})
+	}
+
+	openaiHead = c.OpenaiChatHistory
+	index := -1
+	for i, item := range c.OpenaiChatHistory {
+		if isOpenaiUserItem(item) {
+			index++
+			if index >= len(turns) {
+				return nil, nil, nil, ErrHistoryNotBranchable
+			}
+			if index == 0 {
+				openaiHead = c.OpenaiChatHistory[:i]
+			}
+		}
+		if index >= 0 {
+			turns[index].openai = append(turns[index].openai, item)
+		}
+	}
+	if index != len(turns)-1 {
+		return nil, nil, nil, ErrHistoryNotBranchable
+	}
+	return inappHead, openaiHead, turns, nil
+}
+
+// setTurns replaces the chat histories with the given turns.
+func (c *Conversation) setTurns(inappHead []bson.M, openaiHead responses.ResponseInputParam, turns []turn) {
+	inapp := slices.Clone(inappHead)
+	openai := slices.Clone(openaiHead)
+	for _, t := range turns {
+		inapp = append(inapp, t.inapp...)
+		openai = append(openai, t.openai...)
+	}
+	c.InappChatHistory = inapp
+	c.OpenaiChatHistory = openai
+}
+
+func (c *Conversation) node(messageID string) *MessageNode {
+	for i := range c.MessageTree {
+		if c.MessageTree[i].MessageID == messageID {
+			return &c.MessageTree[i]
+		}
+	}
+	return nil
+}
+
+// SyncMessageTree adds the user messages of the shown branch that are missing from the tree, e.g.
+// a new message or the messages of a conversation from before branching, and marks the branch as
+// the one last shown.
+func (c *Conversation) SyncMessageTree() {
+	parentID := ""
+	for _, msg := range c.InappChatHistory {
+		if !isInappUserMessage(msg) {
+			continue
+		}
+		id, _ := msg["messageId"].(string)
+		if c.node(id) == nil {
+			c.MessageTree = append(c.MessageTree, MessageNode{MessageID: id, ParentID: parentID})
+		}
+		if parentID != "" {
+			c.node(parentID).ActiveChildID = id
+		}
+		parentID = id
+	}
+}
+
+// BranchAt moves the turns of the shown branch from the user message messageID on into the tree,
+// the next user message appended to the histories is a new version of it.
+func (c *Conversation) BranchAt(messageID string) error {
+	c.SyncMessageTree()
+	inappHead, openaiHead, turns, err := c.splitTurns()
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(turns, func(t turn) bool { return t.messageID == messageID })
+	if index < 0 {
+		return ErrMessageNotInTree
+	}
+	c.stashTurns(turns[index:])
+	c.setTurns(inappHead, openaiHead, turns[:index])
+	return nil
+}
+
+// RepeatAt appends a copy of the user message messageID of the shown branch, with the id newID, as
+// a new version of it (see BranchAt), e.g. to answer it again.
+func (c *Conversation) RepeatAt(messageID string, newID string) error {
+	if err := c.BranchAt(messageID); err != nil {
+		return err
+	}
+	n := c.node(messageID)
+	msg := maps.Clone(n.InappMessages[0])
+	msg["messageId"] = newID
+	c.InappChatHistory = append(c.InappChatHistory, msg)
+	c.OpenaiChatHistory = append(c.OpenaiChatHistory, n.OpenaiItems[0])
+	c.SyncMessageTree()
+	return nil
+}
+
+// SwitchBranch shows the branch of the user message messageID: the path from the first user
+// message to it, followed by the messages last shown after it.
+func (c *Conversation) SwitchBranch(messageID string) error {
+	c.SyncMessageTree()
+	target := c.node(messageID)
+	if target == nil {
+		return ErrMessageNotInTree
+	}
+
+	// the lengths are bounded in case of a cycle in a corrupted tree
+	path := []string{messageID}
+	for n := target; n.ParentID != ""; {
+		n = c.node(n.ParentID)
+		if n == nil || len(path) > len(c.MessageTree) {
+			return ErrMessageNotInTree
+		}
+		path = append([]string{n.MessageID}, path...)
+	}
+	for n := c.node(target.ActiveChildID); n != nil && len(path) <= len(c.MessageTree); n = c.node(n.ActiveChildID) {
+		path = append(path, n.MessageID)
+	}
+
+	inappHead, openaiHead, turns, err := c.splitTurns()
+	if err != nil {
+		return err
+	}
+	common := 0
+	for common < len(turns) && common < len(path) && turns[common].messageID == path[common] {
+		common++
+	}
+	c.stashTurns(turns[common:])
+	turns = turns[:common]
+	for _, id := range path[common:] {
+		n := c.node(id)
+		turns = append(turns, turn{messageID: id, inapp: n.InappMessages, openai: n.OpenaiItems})
+		n.InappMessages, n.OpenaiItems = nil, nil
+	}
+	c.setTurns(inappHead, openaiHead, turns)
+
+	for i := 1; i < len(path); i++ {
+		c.node(path[i-1]).ActiveChildID = path[i]
+	}
+	return nil
+}
+
+// stashTurns keeps the turns in their nodes, they are no longer shown.
+func (c *Conversation) stashTurns(turns []turn) {
+	for _, t := range turns {
+		n := c.node(t.messageID)
+		n.InappMessages = t.inapp
+		n.OpenaiItems = t.openai
+	}
+}
+
+// MessageSiblings returns the versions of the user message messageID, the user messages with the
+// same parent, in the order they were added. It is nil if the message is not in the tree.
+func (c *Conversation) MessageSiblings(messageID string) []string {
+	n := c.node(messageID)
+	if n == nil {
+		return nil
+	}
+	var siblings []string
+	for _, node := range c.MessageTree {
+		if node.ParentID == n.ParentID {
+			siblings = append(siblings, node.MessageID)
+		}
+	}
+	return siblings
+}
+
+// HistoryUpTo returns the chat histories of the shown branch up to the end of the turn of the
+// message messageID, e.g. with the answer to a user message. An empty messageID returns all.
+func (c *Conversation) HistoryUpTo(messageID string) ([]bson.M, responses.ResponseInputParam, error) {
+	if messageID == "" {
+		return slices.Clone(c.InappChatHistory), slices.Clone(c.OpenaiChatHistory), nil
+	}
+	inappHead, openaiHead, turns, err := c.splitTurns()
+	if err != nil {
+		return nil, nil, err
+	}
+	index := slices.IndexFunc(turns, func(t turn) bool {
+		return slices.ContainsFunc(t.inapp, func(msg bson.M) bool { return msg["messageId"] == messageID })
+	})
+	if index < 0 {
+		return nil, nil, ErrMessageNotInTree
+	}
+	history := &Conversation{}
+	history.setTurns(inappHead, openaiHead, turns[:index+1])
+	return history.InappChatHistory, history.OpenaiChatHistory, nil
+}
+
+// ValidateMessageTree checks that the chat histories split into turns and that the tree is
+// consistent with them, e.g. for an imported conversation.
+func (c *Conversation) ValidateMessageTree() error {
+	if _, _, _, err := c.splitTurns(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(c.MessageTree))
+	for _, n := range c.MessageTree {
+		if n.MessageID == "" || seen[n.MessageID] {
+			return errors.New("duplicate or empty message id in the conversation tree")
+		}
+		seen[n.MessageID] = true
+	}
+	for _, n := range c.MessageTree {
+		if n.ParentID != "" && !seen[n.ParentID] {
+			return ErrMessageNotInTree
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/openai/openai-go/v2/responses"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func oaiMessage(role responses.EasyInputMessageRole, text string) responses.ResponseInputItemUnionParam {
+	return responses.ResponseInputItemUnionParam{
+		OfInputMessage: &responses.ResponseInputItemMessageParam{
+			Role: string(role),
+			Content: responses.ResponseInputMessageContentListParam{
+				responses.ResponseInputContentParamOfInputText(text),
+			},
+		},
+	}
+}
+
+// addTurn appends a user message and an answer to both histories.
+func addTurn(c *Conversation, id string) {
+	c.InappChatHistory = append(c.InappChatHistory,
+		bson.M{"messageId": UserMessageIDPrefix + id},
+		bson.M{"messageId": "pd_msg_assistant_" + id},
+	)
+	c.OpenaiChatHistory = append(c.OpenaiChatHistory,
+		oaiMessage("user", id),
+		responses.ResponseInputItemParamOfOutputMessage(nil, "answer_"+id, "completed"),
+	)
+	c.SyncMessageTree()
+}
+
+func shownTurns(t *testing.T, c *Conversation) []string {
+	_, _, turns, err := c.splitTurns()
+	assert.NoError(t, err)
+	var ids []string
+	for _, turn := range turns {
+		ids = append(ids, turn.messageID[len(UserMessageIDPrefix):])
+		assert.Len(t, turn.inapp, 2)
+		assert.Len(t, turn.openai, 2)
+		assert.Equal(t, turn.messageID[len(UserMessageIDPrefix):], turn.openai[0].OfInputMessage.Content[0].OfInputText.Text)
+	}
+	return ids
+}
+
+func TestConversationBranches(t *testing.T) {
+	c := &Conversation{OpenaiChatHistory: responses.ResponseInputParam{oaiMessage("system", "prompt")}}
+	addTurn(c, "a")
+	addTurn(c, "b")
+	addTurn(c, "c")
+
+	// edit b
+	assert.NoError(t, c.BranchAt(UserMessageIDPrefix+"b"))
+	assert.Equal(t, []string{"a"}, shownTurns(t, c))
+	addTurn(c, "b2")
+	assert.Equal(t, []string{"a", "b2"}, shownTurns(t, c))
+	assert.Equal(t, []string{UserMessageIDPrefix + "b", UserMessageIDPrefix + "b2"}, c.MessageSiblings(UserMessageIDPrefix+"b2"))
+	assert.Equal(t, "system", c.OpenaiChatHistory[0].OfInputMessage.Role)
+
+	// back to the first version, with the messages that followed it
+	assert.NoError(t, c.SwitchBranch(UserMessageIDPrefix+"b"))
+	assert.Equal(t, []string{"a", "b", "c"}, shownTurns(t, c))
+	assert.Equal(t, "system", c.OpenaiChatHistory[0].OfInputMessage.Role)
+
+	assert.NoError(t, c.SwitchBranch(UserMessageIDPrefix+"b2"))
+	assert.Equal(t, []string{"a", "b2"}, shownTurns(t, c))
+
+	// a branch of the first message
+	assert.NoError(t, c.BranchAt(UserMessageIDPrefix+"a"))
+	addTurn(c, "a2")
+	assert.Equal(t, []string{"a2"}, shownTurns(t, c))
+	assert.NoError(t, c.SwitchBranch(UserMessageIDPrefix+"a"))
+	assert.Equal(t, []string{"a", "b2"}, shownTurns(t, c))
+
+	assert.ErrorIs(t, c.SwitchBranch(UserMessageIDPrefix+"x"), ErrMessageNotInTree)
+	assert.ErrorIs(t, c.BranchAt(UserMessageIDPrefix+"c"), ErrMessageNotInTree)
+}
+
+func TestConversationRepeatAt(t *testing.T) {
+	c := &Conversation{OpenaiChatHistory: responses.ResponseInputParam{oaiMessage("system", "prompt")}}
+	addTurn(c, "a")
+	addTurn(c, "b")
+
+	assert.NoError(t, c.RepeatAt(UserMessageIDPrefix+"a", UserMessageIDPrefix+"a2"))
+	assert.Len(t, c.InappChatHistory, 1)
+	assert.Equal(t, UserMessageIDPrefix+"a2", c.InappChatHistory[0]["messageId"])
+	assert.Len(t, c.OpenaiChatHistory, 2)
+	assert.Equal(t, "a", c.OpenaiChatHistory[1].OfInputMessage.Content[0].OfInputText.Text)
+	assert.Equal(t, []string{UserMessageIDPrefix + "a", UserMessageIDPrefix + "a2"}, c.MessageSiblings(UserMessageIDPrefix+"a"))
+
+	assert.NoError(t, c.SwitchBranch(UserMessageIDPrefix+"a"))
+	assert.Equal(t, []string{"a", "b"}, shownTurns(t, c))
+}
+
+func TestConversationHistoryUpTo(t *testing.T) {
+	c := &Conversation{OpenaiChatHistory: responses.ResponseInputParam{oaiMessage("system", "prompt")}}
+	addTurn(c, "a")
+	addTurn(c, "b")
+
+	inapp, openai, err := c.HistoryUpTo("pd_msg_assistant_a")
+	assert.NoError(t, err)
+	assert.Len(t, inapp, 2)
+	assert.Len(t, openai, 3)
+	assert.Equal(t, "system", openai[0].OfInputMessage.Role)
+
+	inapp, openai, err = c.HistoryUpTo("")
+	assert.NoError(t, err)
+	assert.Len(t, inapp, 4)
+	assert.Len(t, openai, 5)
+
+	_, _, err = c.HistoryUpTo("pd_msg_assistant_x")
+	assert.ErrorIs(t, err, ErrMessageNotInTree)
+}
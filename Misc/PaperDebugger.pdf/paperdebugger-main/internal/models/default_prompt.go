@@ -0,0 +1,24 @@
+package models
+
+// DefaultPrompt is a built-in prompt of the catalog shown to every user. The catalog ships with
+// the server (see services/default_prompts.yaml) and the admins edit it at runtime.
+type DefaultPrompt struct {
+	BaseModel `bson:",inline"`
+	Key       string `bson:"key"`     // stable identifier, the user preferences refer to it
+	Version   int    `bson:"version"` // incremented on every change
+	// CatalogVersion is the version of the catalog entry the prompt was seeded from, 0 for the
+	// prompts created by the admins and the ones seeded before it was stored.
+	CatalogVersion int      `bson:"catalog_version,omitempty"`
+	Edited         bool     `bson:"edited,omitempty"` // changed by the admins, the catalog no longer overwrites it
+	Category       string   `bson:"category"`
+	Tags           []string `bson:"tags"`
+	Order          int      `bson:"order"` // lower first
+	Title          string   `bson:"title"`
+	// LocalizedTitles are the titles by language tag, e.g. "zh" or "zh-TW".
+	LocalizedTitles map[string]string `bson:"localized_titles,omitempty"`
+	Content         string            `bson:"content"`
+}
+
+func (p DefaultPrompt) CollectionName() string {
+	return "default_prompts"
+}
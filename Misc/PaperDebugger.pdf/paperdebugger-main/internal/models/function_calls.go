@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
@@ -24,8 +26,25 @@ type FunctionCall struct {
 	FunctionResult string             `bson:"function_result"` // json string
 	FunctionError  string             `bson:"function_error"`  // json string
 	FunctionStatus FunctionCallStatus `bson:"function_status"`
+
+	// The result of a call with the same cache key is reused, see ToolCallRecordDB.GetCached.
+	CacheKey    string         `bson:"cache_key,omitempty"`
+	ContentHash string         `bson:"content_hash,omitempty"` // sha256 of the paper content the tool ran on
+	CachedFrom  *bson.ObjectID `bson:"cached_from,omitempty"`  // the call whose result was reused
 }
 
 func (c FunctionCall) CollectionName() string {
 	return "function_calls"
 }
+
+// Latency returns how long the tool ran. It is unknown while the call is pending, after a timeout
+// (the record is only updated once the timeout is noticed), and for a reused result.
+func (c FunctionCall) Latency() (time.Duration, bool) {
+	switch {
+	case c.CachedFrom != nil:
+		return 0, false
+	case c.FunctionStatus != FunctionCallStatusSuccess && c.FunctionStatus != FunctionCallStatusError:
+		return 0, false
+	}
+	return c.UpdatedAt.Time().Sub(c.CreatedAt.Time()), true
+}
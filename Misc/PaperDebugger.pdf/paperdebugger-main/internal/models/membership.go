@@ -0,0 +1,24 @@
+package models
+
+import (
+	"paperdebugger/internal/accesscontrol"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Membership is the role of a user in a project or an organization, the fields shared by
+// ProjectMember and OrganizationMember.
+type Membership struct {
+	UserID  bson.ObjectID      `bson:"user_id"`
+	Role    accesscontrol.Role `bson:"role"`
+	AddedBy bson.ObjectID      `bson:"added_by,omitempty"` // unset for the owner
+
+	// Pending is set for the users invited to an organization until they accept, they have no
+	// role in it before.
+	Pending bool `bson:"pending,omitempty"`
+}
+
+// Member returns the membership of a member model.
+func (m Membership) Member() Membership {
+	return m
+}
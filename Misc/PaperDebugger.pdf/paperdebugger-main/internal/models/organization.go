@@ -0,0 +1,29 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Organization is a team, e.g. a lab, whose members share a prompt library (prompts with the
+// OrganizationID) and default instructions.
+type Organization struct {
+	BaseModel    `bson:",inline"`
+	Name         string `bson:"name"`
+	Instructions string `bson:"instructions"` // applied before the project and user instructions
+}
+
+func (o Organization) CollectionName() string {
+	return "organizations"
+}
+
+// OrganizationMember gives a user a role in an organization: the owner manages the members, the
+// editors manage the prompts and instructions of the team and the viewers use them.
+type OrganizationMember struct {
+	BaseModel      `bson:",inline"`
+	Membership     `bson:",inline"`
+	OrganizationID bson.ObjectID `bson:"organization_id"`
+}
+
+func (m OrganizationMember) CollectionName() string {
+	return "organization_members"
+}
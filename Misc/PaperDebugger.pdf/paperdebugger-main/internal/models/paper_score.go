@@ -0,0 +1,40 @@
+package models
+
+import (
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// PaperScore is a score of a project by the paper scoring service. The scores of a project are
+// kept, to follow the score across revisions.
+type PaperScore struct {
+	BaseModel      `bson:",inline"`
+	UserID         bson.ObjectID       `bson:"user_id"`
+	ProjectID      string              `bson:"project_id"`
+	ConversationID string              `bson:"conversation_id,omitempty"`
+	ContentHash    string              `bson:"content_hash"` // sha256 of the scored content
+	Category       string              `bson:"category"`
+	Score          float32             `bson:"score"`
+	Percentile     float32             `bson:"percentile"`
+	Details        map[string]int32    `bson:"details"`
+	Suggestions    map[string][]string `bson:"suggestions"`
+}
+
+func (s PaperScore) CollectionName() string {
+	return "paper_scores"
+}
+
+// Result returns the score as returned by the paper scoring service.
+func (s PaperScore) Result() *projectv1.PaperScoreResult {
+	suggestions := make(map[string]*projectv1.SuggestionList, len(s.Suggestions))
+	for key, list := range s.Suggestions {
+		suggestions[key] = &projectv1.SuggestionList{Suggestions: list}
+	}
+	return &projectv1.PaperScoreResult{
+		Score:       s.Score,
+		Percentile:  s.Percentile,
+		Details:     s.Details,
+		Suggestions: suggestions,
+	}
+}
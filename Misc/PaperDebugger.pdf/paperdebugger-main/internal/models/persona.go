@@ -0,0 +1,57 @@
+package models
+
+import chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+// Persona is the system prompt of a conversation, chosen by its conversation type.
+type Persona string
+
+const (
+	PersonaDefault        Persona = "default"
+	PersonaDebug          Persona = "debug" // for the developers, without the paper content
+	PersonaReviewer       Persona = "reviewer"
+	PersonaTranslator     Persona = "translator"
+	PersonaProofreader    Persona = "proofreader"
+	PersonaRebuttalWriter Persona = "rebuttal_writer"
+)
+
+var personaConversationTypes = map[Persona]chatv1.ConversationType{
+	PersonaDefault:        chatv1.ConversationType_CONVERSATION_TYPE_UNSPECIFIED,
+	PersonaDebug:          chatv1.ConversationType_CONVERSATION_TYPE_DEBUG,
+	PersonaReviewer:       chatv1.ConversationType_CONVERSATION_TYPE_REVIEWER,
+	PersonaTranslator:     chatv1.ConversationType_CONVERSATION_TYPE_TRANSLATOR,
+	PersonaProofreader:    chatv1.ConversationType_CONVERSATION_TYPE_PROOFREADER,
+	PersonaRebuttalWriter: chatv1.ConversationType_CONVERSATION_TYPE_REBUTTAL_WRITER,
+}
+
+// Personas are all the personas, in the order they are listed.
+var Personas = []Persona{
+	PersonaDefault,
+	PersonaDebug,
+	PersonaReviewer,
+	PersonaTranslator,
+	PersonaProofreader,
+	PersonaRebuttalWriter,
+}
+
+// PersonaFromProto returns the persona of a conversation type, the default one for an unknown type.
+func PersonaFromProto(conversationType chatv1.ConversationType) Persona {
+	for persona, t := range personaConversationTypes {
+		if t == conversationType {
+			return persona
+		}
+	}
+	return PersonaDefault
+}
+
+// ConversationType returns the conversation type of the persona, the empty persona of the
+// conversations created before the personas is the default one.
+func (p Persona) ConversationType() chatv1.ConversationType {
+	return personaConversationTypes[p]
+}
+
+// IsProjectPersona tells whether a project can choose the persona as the default one of its
+// conversations, the empty persona stands for the default one.
+func (p Persona) IsProjectPersona() bool {
+	_, ok := personaConversationTypes[p]
+	return p == "" || ok && p != PersonaDebug
+}
@@ -33,6 +33,11 @@ type Project struct {
 	Docs         []ProjectDoc          `bson:"docs"`
 	Category     ClassifyPaperResponse `bson:"category,omitempty"`
 	Instructions string                `bson:"instructions"`
+	PinnedTools  []string              `bson:"pinned_tools,omitempty"` // if set, the assistant may only use these tools in the project
+
+	// DefaultPersona is the persona of the new conversations which do not choose one, empty for
+	// the default one.
+	DefaultPersona Persona `bson:"default_persona,omitempty"`
 }
 
 func (u Project) CollectionName() string {
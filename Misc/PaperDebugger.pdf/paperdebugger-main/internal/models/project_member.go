@@ -0,0 +1,13 @@
+package models
+
+// ProjectMember gives a user a role in an Overleaf project, the members see the conversations
+// shared in the project. Every user keeps their own copy of the project (see Project).
+type ProjectMember struct {
+	BaseModel  `bson:",inline"`
+	Membership `bson:",inline"`
+	ProjectID  string `bson:"project_id"`
+}
+
+func (m ProjectMember) CollectionName() string {
+	return "project_members"
+}
@@ -4,11 +4,37 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
+// PromptVariableType is the type of the value of a prompt variable.
+type PromptVariableType string
+
+const (
+	PromptVariableText         PromptVariableType = "text"
+	PromptVariableNumber       PromptVariableType = "number"
+	PromptVariableChoice       PromptVariableType = "choice"        // one of the options
+	PromptVariableSelectedText PromptVariableType = "selected_text" // filled with the text selected in the editor
+)
+
+// PromptVariable is a placeholder of a prompt template, written {{name}} in the content.
+type PromptVariable struct {
+	Name        string             `bson:"name"`
+	Type        PromptVariableType `bson:"type"`
+	Description string             `bson:"description,omitempty"`
+	Default     string             `bson:"default,omitempty"`
+	Options     []string           `bson:"options,omitempty"` // for PromptVariableChoice
+	Required    bool               `bson:"required"`
+}
+
 type Prompt struct {
 	BaseModel `bson:",inline"`
-	UserID    bson.ObjectID `bson:"user_id"`
+	UserID    bson.ObjectID `bson:"user_id"` // the author of a team prompt
 	Title     string        `bson:"title"`
 	Content   string        `bson:"content"`
+
+	// Variables make the prompt a template, they are filled by RenderPromptTemplate.
+	Variables []PromptVariable `bson:"variables,omitempty"`
+
+	// OrganizationID is set for the prompts of a team library, they belong to the organization.
+	OrganizationID bson.ObjectID `bson:"organization_id,omitempty"`
 }
 
 func (p Prompt) CollectionName() string {
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// PromptShare lets anyone with the token import a copy of a prompt. A user has at most one share
+// per prompt.
+type PromptShare struct {
+	BaseModel `bson:",inline"`
+	UserID    bson.ObjectID `bson:"user_id"` // who shared the prompt
+	PromptID  bson.ObjectID `bson:"prompt_id"`
+	Token     string        `bson:"token"`
+	Imports   int64         `bson:"imports"`
+}
+
+func (s PromptShare) CollectionName() string {
+	return "prompt_shares"
+}
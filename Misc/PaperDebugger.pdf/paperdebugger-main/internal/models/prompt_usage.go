@@ -0,0 +1,18 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// PromptUsage counts the messages a user sent from a prompt, one record per user and prompt.
+type PromptUsage struct {
+	BaseModel  `bson:",inline"`
+	UserID     bson.ObjectID `bson:"user_id"`
+	PromptID   bson.ObjectID `bson:"prompt_id"` // a prompt or a built-in prompt
+	Count      int64         `bson:"count"`
+	LastUsedAt bson.DateTime `bson:"last_used_at"`
+}
+
+func (u PromptUsage) CollectionName() string {
+	return "prompt_usages"
+}
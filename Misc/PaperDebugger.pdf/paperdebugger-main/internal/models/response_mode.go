@@ -0,0 +1,19 @@
+package models
+
+import chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+// ResponseMode is how the assistant replies to the last user message of a conversation.
+type ResponseMode string
+
+const (
+	ResponseModeText     ResponseMode = ""
+	ResponseModeRevision ResponseMode = "revision" // structured output, see chatv1.MessageTypeRevision
+)
+
+func ResponseModeFromProto(mode chatv1.ResponseMode) ResponseMode {
+	switch mode {
+	case chatv1.ResponseMode_RESPONSE_MODE_REVISION:
+		return ResponseModeRevision
+	}
+	return ResponseModeText
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// SystemPrompt overrides the built-in system prompt template of a persona, it is set by the
+// admins.
+type SystemPrompt struct {
+	BaseModel `bson:",inline"`
+	Persona   Persona       `bson:"persona"`
+	Content   string        `bson:"content"`
+	UpdatedBy bson.ObjectID `bson:"updated_by"` // the admin who set it
+}
+
+func (p SystemPrompt) CollectionName() string {
+	return "system_prompts"
+}
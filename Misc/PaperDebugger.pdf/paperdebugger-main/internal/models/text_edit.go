@@ -0,0 +1,25 @@
+package models
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// TextEdit is a change of a project document proposed by the assistant, see chatv1.TextEdit.
+// Like comments, it records whether the user accepted or rejected it.
+type TextEdit struct {
+	BaseModel      `bson:",inline"`
+	UserID         bson.ObjectID `bson:"user_id"`
+	ProjectID      string        `bson:"project_id"`
+	ConversationID bson.ObjectID `bson:"conversation_id"`
+	MessageID      string        `bson:"message_id"` // of the MessageTypeTextEdits message
+	DocID          string        `bson:"doc_id"`
+	DocVersion     int           `bson:"doc_version"`
+	DocPath        string        `bson:"doc_path"`
+	StartOffset    int           `bson:"start_offset"` // in UTF-16 code units
+	EndOffset      int           `bson:"end_offset"`
+	Original       string        `bson:"original"`
+	Replacement    string        `bson:"replacement"`
+	Status         CommentStatus `bson:"status"`
+}
+
+func (e TextEdit) CollectionName() string {
+	return "text_edits"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type ToolJobStatus string
+
+const (
+	ToolJobStatusQueued    ToolJobStatus = "queued"
+	ToolJobStatusRunning   ToolJobStatus = "running"
+	ToolJobStatusSucceeded ToolJobStatus = "succeeded"
+	ToolJobStatusFailed    ToolJobStatus = "failed"
+)
+
+// ToolJob is a tool call running in the background, detached from the request that started it.
+type ToolJob struct {
+	BaseModel       `bson:",inline"`
+	UserID          bson.ObjectID `bson:"user_id"`
+	ProjectID       string        `bson:"project_id"`
+	ConversationID  string        `bson:"conversation_id"`
+	ToolCallID      string        `bson:"tool_call_id"`
+	ToolName        string        `bson:"tool_name"`
+	Arguments       string        `bson:"arguments"` // json string
+	Status          ToolJobStatus `bson:"status"`
+	Progress        float64       `bson:"progress"` // between 0 and 1, negative if unknown
+	ProgressMessage string        `bson:"progress_message"`
+	Result          string        `bson:"result"`
+	Error           string        `bson:"error"`
+	FinishedAt      bson.DateTime `bson:"finished_at,omitempty"`
+	Delivered       bool          `bson:"delivered"`             // whether the result was added to the conversation
+	Instance        string        `bson:"instance"`              // the process running the job
+	LeaseUntil      bson.DateTime `bson:"lease_until,omitempty"` // renewed while the process runs the job
+}
+
+func (j ToolJob) CollectionName() string {
+	return "tool_jobs"
+}
+
+func (j ToolJob) Finished() bool {
+	return j.Status == ToolJobStatusSucceeded || j.Status == ToolJobStatusFailed
+}
@@ -10,6 +10,17 @@ type Settings struct {
 	ShowedOnboarding             bool `bson:"showed_onboarding"`
 }
 
+// ToolPreferences controls which tools the assistant may use for a user.
+type ToolPreferences struct {
+	DisabledTools []string `bson:"disabled_tools"`
+}
+
+// PromptPreferences are the built-in prompts the user hid or pinned, by key.
+type PromptPreferences struct {
+	HiddenPrompts []string `bson:"hidden_prompts"`
+	PinnedPrompts []string `bson:"pinned_prompts"`
+}
+
 type User struct {
 	BaseModel    `bson:",inline"`
 	Email        string        `bson:"email,unique"`
@@ -18,6 +29,13 @@ type User struct {
 	LastLogin    bson.DateTime `bson:"last_login"`
 	Settings     Settings      `bson:"settings"`
 	Instructions string        `bson:"instructions"`
+
+	ToolPreferences   ToolPreferences   `bson:"tool_preferences"`
+	PromptPreferences PromptPreferences `bson:"prompt_preferences"`
+
+	// DeletionRequestedAt is set when the user deleted the account, it is deleted for good after
+	// a grace period. Logging in again cancels the deletion.
+	DeletionRequestedAt *bson.DateTime `bson:"deletion_requested_at,omitempty"`
 }
 
 func (u User) CollectionName() string {
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"time"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// userDataCollections hold the records of a user, by their user_id. They are exported by
+// ExportUserData and deleted with the account.
+var userDataCollections = []models.Model{
+	models.Project{},
+	models.Conversation{},
+	models.Prompt{},
+	models.Comment{},
+	models.FunctionCall{},
+	models.ToolJob{},
+	models.TextEdit{},
+	models.PaperScore{},
+	models.ProjectMember{},
+	models.OrganizationMember{},
+	models.PromptUsage{},
+	models.PromptShare{},
+}
+
+// AccountService exports the data of a user and deletes accounts.
+type AccountService struct {
+	BaseService
+	userCollection               *mongo.Collection
+	organizationMemberCollection *mongo.Collection
+	tokenService                 *TokenService
+}
+
+func NewAccountService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger, tokenService *TokenService) *AccountService {
+	base := NewBaseService(db, cfg, logger)
+	return &AccountService{
+		BaseService:                  base,
+		userCollection:               base.db.Collection((models.User{}).CollectionName()),
+		organizationMemberCollection: base.db.Collection((models.OrganizationMember{}).CollectionName()),
+		tokenService:                 tokenService,
+	}
+}
+
+// ExportUserData writes a zip archive with the profile of the user (settings and instructions
+// included) in user.json and the records of every collection of userDataCollections, deleted ones
+// included, in <collection>.json. The records are written as relaxed MongoDB Extended JSON one by
+// one as they are read, the export is never held in memory. Nothing is written if the user is not
+// found.
+func (s *AccountService) ExportUserData(ctx context.Context, userID bson.ObjectID, w io.Writer) error {
+	var user bson.Raw
+	if err := s.userCollection.FindOne(ctx, bson.M{"_id": userID}).Decode(&user); err != nil {
+		return err
+	}
+	cursor, err := mongo.NewCursorFromDocuments([]any{user}, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	if err := writeExtJSON(ctx, archive, "user.json", cursor); err != nil {
+		return err
+	}
+	for _, model := range userDataCollections {
+		cursor, err := s.db.Collection(model.CollectionName()).Find(ctx, bson.M{"user_id": userID},
+			options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+		if err != nil {
+			return err
+		}
+		if err := writeExtJSON(ctx, archive, model.CollectionName()+".json", cursor); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// writeExtJSON adds a file with a JSON array of the documents of the cursor to the archive, and
+// closes the cursor.
+func writeExtJSON(ctx context.Context, archive *zip.Writer, name string, cursor *mongo.Cursor) error {
+	defer cursor.Close(ctx)
+
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(file, "["); err != nil {
+		return err
+	}
+	for i := 0; cursor.Next(ctx); i++ {
+		data, err := bson.MarshalExtJSONIndent(cursor.Current, false, false, "  ", "  ")
+		if err != nil {
+			return err
+		}
+		out := []byte("\n  ")
+		if i > 0 {
+			out = []byte(",\n  ")
+		}
+		if _, err := file.Write(append(out, data...)); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(file, "\n]\n")
+	return err
+}
+
+// RequestDeletion revokes the refresh tokens of the user and marks the account for deletion, all
+// its data is deleted for good after cfg.AccountDeletionGracePeriod. It returns when. The owner of
+// an organization has to delete it first, its members would be left without anyone to manage them.
+func (s *AccountService) RequestDeletion(ctx context.Context, userID bson.ObjectID) (time.Time, error) {
+	owner, err := ownsOrganization(ctx, s.organizationMemberCollection, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if owner {
+		return time.Time{}, shared.ErrBadRequest("delete the organizations you own before deleting your account")
+	}
+
+	now := time.Now()
+	_, err = s.userCollection.UpdateOne(ctx,
+		bson.M{"_id": userID},
+		bson.M{"$set": bson.M{"deletion_requested_at": bson.NewDateTimeFromTime(now), "updated_at": bson.NewDateTimeFromTime(now)}},
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := s.tokenService.DeleteUserTokens(ctx, userID); err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(s.cfg.AccountDeletionGracePeriod), nil
+}
+
+// ownsOrganization reports whether the user is the owner of an organization.
+func ownsOrganization(ctx context.Context, organizationMemberCollection *mongo.Collection, userID bson.ObjectID) (bool, error) {
+	count, err := organizationMemberCollection.CountDocuments(ctx,
+		bson.M{"user_id": userID, "role": accesscontrol.RoleOwner},
+		options.Count().SetLimit(1))
+	return count > 0, err
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	_ "embed"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
+	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/libs/cfg"
 	"paperdebugger/internal/libs/db"
 	"paperdebugger/internal/libs/logger"
@@ -21,12 +23,6 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-//go:embed system_prompt_default.tmpl
-var systemPromptDefaultTemplate string
-
-//go:embed system_prompt_debug.tmpl
-var systemPromptDebugTemplate string
-
 //go:embed user_prompt_default.tmpl
 var userPromptDefaultTemplate string
 
@@ -36,39 +32,86 @@ var userPromptDebugTemplate string
 type ChatService struct {
 	BaseService
 	conversationCollection *mongo.Collection
+	memberService          *ProjectMemberService
+	systemPromptService    *SystemPromptService
+
+	locksMu           sync.Mutex
+	conversationLocks map[bson.ObjectID]*conversationLock
+}
+
+type conversationLock struct {
+	sync.Mutex
+	holders int // holding or waiting for the lock
 }
 
 // define default conversation title
 const DefaultConversationTitle = "New Conversation ."
 
-func NewChatService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *ChatService {
+func NewChatService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger, memberService *ProjectMemberService, systemPromptService *SystemPromptService) *ChatService {
 	base := NewBaseService(db, cfg, logger)
+	collection := base.db.Collection((models.Conversation{}).CollectionName())
+
+	// the full-text index of SearchConversations, matches in the title weigh more
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "title", Value: "text"},
+			{Key: "inapp_chat_history.payload.user.content", Value: "text"},
+			{Key: "inapp_chat_history.payload.assistant.content", Value: "text"},
+		},
+		Options: options.Index().
+			SetName("conversation_text").
+			SetWeights(bson.D{{Key: "title", Value: 5}}),
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for conversations collection", err)
+	}
+
 	return &ChatService{
 		BaseService:            base,
-		conversationCollection: base.db.Collection((models.Conversation{}).CollectionName()),
+		conversationCollection: collection,
+		memberService:          memberService,
+		systemPromptService:    systemPromptService,
+		conversationLocks:      make(map[bson.ObjectID]*conversationLock),
 	}
 }
 
-func (s *ChatService) GetSystemPrompt(ctx context.Context, fullContent string, projectInstructions string, userInstructions string, conversationType chatv1.ConversationType) (string, error) {
-	var systemPromptString string
-	switch conversationType {
-	case chatv1.ConversationType_CONVERSATION_TYPE_DEBUG:
-		systemPromptString = systemPromptDebugTemplate
-	default:
-		systemPromptString = systemPromptDefaultTemplate
+// LockConversation serializes the writers of a conversation within this process: a turn holds the
+// lock from loading the conversation until saving it, so that the results of background tool jobs
+// are not added in between and overwritten by UpdateConversation.
+func (s *ChatService) LockConversation(conversationID bson.ObjectID) (unlock func()) {
+	s.locksMu.Lock()
+	lock, ok := s.conversationLocks[conversationID]
+	if !ok {
+		lock = &conversationLock{}
+		s.conversationLocks[conversationID] = lock
 	}
+	lock.holders++
+	s.locksMu.Unlock()
 
-	tmpl := template.Must(template.New("system_prompt").Parse(systemPromptString))
-
-	var systemPromptBuffer bytes.Buffer
-	if err := tmpl.Execute(&systemPromptBuffer, map[string]string{
-		"FullContent":         fullContent,
-		"ProjectInstructions": projectInstructions,
-		"UserInstructions":    userInstructions,
-	}); err != nil {
-		return "", err
+	lock.Lock()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			lock.Unlock()
+			s.locksMu.Lock()
+			lock.holders--
+			if lock.holders == 0 {
+				delete(s.conversationLocks, conversationID)
+			}
+			s.locksMu.Unlock()
+		})
 	}
-	return strings.TrimSpace(systemPromptBuffer.String()), nil
+}
+
+// GetSystemPrompt renders the system prompt of a conversation with the persona. The instructions
+// are layered from the most general to the most specific: team, project and user.
+func (s *ChatService) GetSystemPrompt(ctx context.Context, fullContent string, teamInstructions string, projectInstructions string, userInstructions string, persona models.Persona) (string, error) {
+	return s.systemPromptService.RenderSystemPrompt(ctx, persona, SystemPromptData{
+		FullContent:         fullContent,
+		TeamInstructions:    teamInstructions,
+		ProjectInstructions: projectInstructions,
+		UserInstructions:    userInstructions,
+	})
 }
 
 func (s *ChatService) GetPrompt(ctx context.Context, content string, selectedText string, conversationType chatv1.ConversationType) (string, error) {
@@ -80,7 +123,10 @@ func (s *ChatService) GetPrompt(ctx context.Context, content string, selectedTex
 		userPromptString = userPromptDefaultTemplate
 	}
 
-	tmpl := template.Must(template.New("user_prompt").Parse(userPromptString))
+	tmpl, err := template.New("user_prompt").Parse(userPromptString)
+	if err != nil {
+		return "", err
+	}
 
 	var userPromptBuffer bytes.Buffer
 	if err := tmpl.Execute(&userPromptBuffer, map[string]string{
@@ -92,7 +138,7 @@ func (s *ChatService) GetPrompt(ctx context.Context, content string, selectedTex
 	return strings.TrimSpace(userPromptBuffer.String()), nil
 }
 
-func (s *ChatService) InsertConversationToDB(ctx context.Context, userID bson.ObjectID, projectID string, languageModel models.LanguageModel, inappChatHistory []*chatv1.Message, openaiChatHistory responses.ResponseInputParam) (*models.Conversation, error) {
+func (s *ChatService) InsertConversationToDB(ctx context.Context, userID bson.ObjectID, projectID string, languageModel models.LanguageModel, persona models.Persona, inappChatHistory []*chatv1.Message, openaiChatHistory responses.ResponseInputParam) (*models.Conversation, error) {
 	// Convert protobuf messages to BSON
 	bsonMessages := make([]bson.M, len(inappChatHistory))
 	for i := range inappChatHistory {
@@ -117,9 +163,56 @@ func (s *ChatService) InsertConversationToDB(ctx context.Context, userID bson.Ob
 		ProjectID:         projectID,
 		Title:             DefaultConversationTitle,
 		LanguageModel:     languageModel,
+		Persona:           persona,
 		InappChatHistory:  bsonMessages,
 		OpenaiChatHistory: openaiChatHistory,
 	}
+	conversation.SyncMessageTree()
+	_, err := s.conversationCollection.InsertOne(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+	return conversation, nil
+}
+
+// ForkConversation inserts a copy of the conversation for the user in the project projectID, with
+// the given chat histories. The copy is not shared.
+func (s *ChatService) ForkConversation(ctx context.Context, userID bson.ObjectID, source *models.Conversation, projectID string, inappChatHistory []bson.M, openaiChatHistory responses.ResponseInputParam) (*models.Conversation, error) {
+	conversation := &models.Conversation{
+		BaseModel: models.BaseModel{
+			ID:        bson.NewObjectID(),
+			CreatedAt: bson.NewDateTimeFromTime(time.Now()),
+			UpdatedAt: bson.NewDateTimeFromTime(time.Now()),
+		},
+		UserID:            userID,
+		ProjectID:         projectID,
+		Title:             source.Title,
+		LanguageModel:     source.LanguageModel,
+		InappChatHistory:  inappChatHistory,
+		OpenaiChatHistory: openaiChatHistory,
+		OpenaiChatParams:  source.OpenaiChatParams,
+		ResponseMode:      source.ResponseMode,
+		Persona:           source.Persona,
+		ForkedFromID:      source.ID,
+	}
+	conversation.SyncMessageTree()
+	_, err := s.conversationCollection.InsertOne(ctx, conversation)
+	if err != nil {
+		return nil, err
+	}
+	return conversation, nil
+}
+
+// ImportConversation inserts a conversation parsed with ParseConversationExport as a new
+// conversation of the user in the project.
+func (s *ChatService) ImportConversation(ctx context.Context, userID bson.ObjectID, projectID string, conversation *models.Conversation) (*models.Conversation, error) {
+	conversation.BaseModel = models.BaseModel{
+		ID:        bson.NewObjectID(),
+		CreatedAt: bson.NewDateTimeFromTime(time.Now()),
+		UpdatedAt: bson.NewDateTimeFromTime(time.Now()),
+	}
+	conversation.UserID = userID
+	conversation.ProjectID = projectID
 	_, err := s.conversationCollection.InsertOne(ctx, conversation)
 	if err != nil {
 		return nil, err
@@ -127,7 +220,15 @@ func (s *ChatService) InsertConversationToDB(ctx context.Context, userID bson.Ob
 	return conversation, nil
 }
 
-func (s *ChatService) ListConversations(ctx context.Context, userID bson.ObjectID, projectID string) ([]*models.Conversation, error) {
+// ConversationCursor is the position of a conversation in ListConversations.
+type ConversationCursor struct {
+	UpdatedAt bson.DateTime
+	ID        bson.ObjectID
+}
+
+// ListConversations returns at most limit conversations of the project without their messages,
+// most recently updated first, from the one after the cursor after (if not nil).
+func (s *ChatService) ListConversations(ctx context.Context, userID bson.ObjectID, projectID string, after *ConversationCursor, limit int) ([]*models.Conversation, error) {
 	filter := bson.M{
 		"user_id":    userID,
 		"project_id": projectID,
@@ -136,13 +237,20 @@ func (s *ChatService) ListConversations(ctx context.Context, userID bson.ObjectI
 			{"deleted_at": bson.M{"$exists": false}},
 		},
 	}
+	if after != nil {
+		filter["$and"] = []bson.M{{"$or": []bson.M{
+			{"updated_at": bson.M{"$lt": after.UpdatedAt}},
+			{"updated_at": after.UpdatedAt, "_id": bson.M{"$lt": after.ID}},
+		}}}
+	}
 	opts := options.Find().
 		SetProjection(bson.M{
 			"inapp_chat_history":  0,
 			"openai_chat_history": 0,
+			"message_tree":        0,
 		}).
-		SetSort(bson.M{"updated_at": -1}).
-		SetLimit(50)
+		SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
 	cursor, err := s.conversationCollection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -156,6 +264,92 @@ func (s *ChatService) ListConversations(ctx context.Context, userID bson.ObjectI
 	return conversations, nil
 }
 
+// ConversationMessagesPage is a slice of the in-app chat history of a conversation.
+type ConversationMessagesPage struct {
+	Messages    []bson.M             `bson:"messages"`
+	Start       int                  `bson:"start"`        // the index of the first message in the history
+	MessageTree []models.MessageNode `bson:"message_tree"` // without the messages of the branches
+}
+
+// ListConversationMessages returns at most limit messages of the in-app chat history, the ones
+// before the message before, or the last ones if before is empty. It returns
+// models.ErrMessageNotInTree if the history has no message before.
+func (s *ChatService) ListConversationMessages(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID, before string, limit int) (*ConversationMessagesPage, error) {
+	// the conversations shared in the projects of the user are readable too
+	owner := &models.Conversation{}
+	err := s.conversationCollection.FindOne(ctx, bson.M{
+		"_id": conversationID,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}, options.FindOne().SetProjection(bson.M{"user_id": 1, "project_id": 1, "shared": 1})).Decode(owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeConversation(ctx, userID, owner, accesscontrol.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	history := bson.M{"$ifNull": bson.A{"$inapp_chat_history", bson.A{}}}
+	var end any = bson.M{"$size": history}
+	if before != "" {
+		end = bson.M{"$indexOfArray": bson.A{"$inapp_chat_history.messageId", before}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"_id": conversationID,
+			"$or": []bson.M{
+				{"deleted_at": nil},
+				{"deleted_at": bson.M{"$exists": false}},
+			},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"history":                 history,
+			"end":                     end,
+			"message_tree.message_id": 1,
+			"message_tree.parent_id":  1,
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"start": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{"$end", limit}}}},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"start":        1,
+			"end":          1,
+			"message_tree": 1,
+			"messages": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{"$end", "$start"}},
+				bson.M{"$slice": bson.A{"$history", "$start", bson.M{"$subtract": bson.A{"$end", "$start"}}}},
+				bson.A{},
+			}},
+		}}},
+	}
+	cursor, err := s.conversationCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return nil, err
+		}
+		return nil, mongo.ErrNoDocuments
+	}
+	var page struct {
+		ConversationMessagesPage `bson:",inline"`
+		End                      int `bson:"end"`
+	}
+	if err := cursor.Decode(&page); err != nil {
+		return nil, err
+	}
+	if page.End < 0 {
+		return nil, models.ErrMessageNotInTree
+	}
+	return &page.ConversationMessagesPage, nil
+}
+
 func (s *ChatService) GetConversation(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID) (*models.Conversation, error) {
 	conversation := &models.Conversation{}
 	err := s.conversationCollection.FindOne(ctx, bson.M{
@@ -172,6 +366,99 @@ func (s *ChatService) GetConversation(ctx context.Context, userID bson.ObjectID,
 	return conversation, nil
 }
 
+// GetAccessibleConversation returns a conversation of the user, or a conversation shared in a
+// project in which the role of the user grants the permission. It returns mongo.ErrNoDocuments for
+// the conversations of other users that are not shared, and shared.ErrPermissionDenied if the role
+// does not grant the permission.
+func (s *ChatService) GetAccessibleConversation(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID, permission accesscontrol.Permission) (*models.Conversation, error) {
+	conversation := &models.Conversation{}
+	err := s.conversationCollection.FindOne(ctx, bson.M{
+		"_id": conversationID,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}).Decode(conversation)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeConversation(ctx, userID, conversation, permission); err != nil {
+		return nil, err
+	}
+	return conversation, nil
+}
+
+// authorizeConversation checks that the user can access the conversation with the permission, see
+// GetAccessibleConversation.
+func (s *ChatService) authorizeConversation(ctx context.Context, userID bson.ObjectID, conversation *models.Conversation, permission accesscontrol.Permission) error {
+	if conversation.UserID == userID {
+		return nil
+	}
+	if !conversation.Shared {
+		return mongo.ErrNoDocuments
+	}
+	_, err := s.memberService.Authorize(ctx, userID, conversation.ProjectID, permission)
+	return err
+}
+
+// ListSharedConversations returns at most limit conversations shared by the other members of the
+// project without their messages, most recently updated first. The user must be a member.
+func (s *ChatService) ListSharedConversations(ctx context.Context, userID bson.ObjectID, projectID string, limit int) ([]*models.Conversation, error) {
+	if _, err := s.memberService.Authorize(ctx, userID, projectID, accesscontrol.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"project_id": projectID,
+		"shared":     true,
+		"user_id":    bson.M{"$ne": userID},
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{
+			"inapp_chat_history":  0,
+			"openai_chat_history": 0,
+			"message_tree":        0,
+		}).
+		SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := s.conversationCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var conversations []*models.Conversation
+	if err := cursor.All(ctx, &conversations); err != nil {
+		return nil, err
+	}
+	return conversations, nil
+}
+
+// SetConversationShared shares a conversation of the user with the members of its project, or
+// stops sharing it. Sharing requires a role that grants accesscontrol.PermissionWrite.
+func (s *ChatService) SetConversationShared(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID, shared bool) (*models.Conversation, error) {
+	conversation, err := s.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		if _, err := s.memberService.Authorize(ctx, userID, conversation.ProjectID, accesscontrol.PermissionWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	// updated_at is left as is, sharing does not move the conversation in the history
+	_, err = s.conversationCollection.UpdateOne(ctx, bson.M{"_id": conversation.ID}, bson.M{"$set": bson.M{"shared": shared}})
+	if err != nil {
+		return nil, err
+	}
+	conversation.Shared = shared
+	return conversation, nil
+}
+
 func (s *ChatService) UpdateConversation(conversation *models.Conversation) error {
 	conversation.UpdatedAt = bson.NewDateTimeFromTime(time.Now())
 	_, err := s.conversationCollection.UpdateOne(
@@ -188,6 +475,69 @@ func (s *ChatService) UpdateConversation(conversation *models.Conversation) erro
 	return err
 }
 
+// UpdateConversationTitle only sets the title, so it can run concurrently with a turn.
+func (s *ChatService) UpdateConversationTitle(ctx context.Context, conversationID bson.ObjectID, title string) error {
+	_, err := s.conversationCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": conversationID},
+		bson.M{"$set": bson.M{"title": title, "updated_at": bson.NewDateTimeFromTime(time.Now())}},
+	)
+	return err
+}
+
+// ListDeletedConversations returns the deleted conversations of the user without their messages,
+// in the project if projectID is not empty, most recently deleted first.
+func (s *ChatService) ListDeletedConversations(ctx context.Context, userID bson.ObjectID, projectID string) ([]*models.Conversation, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"deleted_at": bson.M{"$ne": nil},
+	}
+	if projectID != "" {
+		filter["project_id"] = projectID
+	}
+	opts := options.Find().
+		SetProjection(bson.M{
+			"inapp_chat_history":  0,
+			"openai_chat_history": 0,
+			"message_tree":        0,
+		}).
+		SetSort(bson.D{{Key: "deleted_at", Value: -1}})
+	cursor, err := s.conversationCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var conversations []*models.Conversation
+	if err := cursor.All(ctx, &conversations); err != nil {
+		return nil, err
+	}
+	return conversations, nil
+}
+
+// RestoreConversation undoes DeleteConversation, it returns mongo.ErrNoDocuments if the
+// conversation is not deleted (or was deleted for good).
+func (s *ChatService) RestoreConversation(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID) (*models.Conversation, error) {
+	conversation := &models.Conversation{}
+	err := s.conversationCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id":        conversationID,
+			"user_id":    userID,
+			"deleted_at": bson.M{"$ne": nil},
+		},
+		bson.M{
+			"$unset": bson.M{"deleted_at": ""},
+			"$set":   bson.M{"updated_at": bson.NewDateTimeFromTime(time.Now())},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(conversation)
+	if err != nil {
+		return nil, err
+	}
+	return conversation, nil
+}
+
 func (s *ChatService) DeleteConversation(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID) error {
 	now := bson.NewDateTimeFromTime(time.Now())
 	_, err := s.conversationCollection.UpdateOne(
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ConversationSearch selects the conversations of a user, empty fields match everything.
+type ConversationSearch struct {
+	Query         string // MongoDB $text syntax
+	ProjectID     string
+	LanguageModel *models.LanguageModel
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+}
+
+// ConversationSearchResult is a conversation found by SearchConversations. Only the title and the
+// in-app messages of the shown branch are loaded.
+type ConversationSearchResult struct {
+	models.Conversation `bson:",inline"`
+	Score               float64 `bson:"score"` // text search relevance
+}
+
+// SearchConversations returns at most limit conversations of the user matching the search, the
+// best text matches first, or the most recently updated ones without query.
+func (s *ChatService) SearchConversations(ctx context.Context, userID bson.ObjectID, search ConversationSearch, limit int) ([]*ConversationSearchResult, error) {
+	filter := bson.M{
+		"user_id": userID,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}
+	if search.Query != "" {
+		filter["$text"] = bson.M{"$search": search.Query}
+	}
+	if search.ProjectID != "" {
+		filter["project_id"] = search.ProjectID
+	}
+	if search.LanguageModel != nil {
+		filter["language_model"] = *search.LanguageModel
+	}
+	updatedAt := bson.M{}
+	if !search.UpdatedAfter.IsZero() {
+		updatedAt["$gte"] = bson.NewDateTimeFromTime(search.UpdatedAfter)
+	}
+	if !search.UpdatedBefore.IsZero() {
+		updatedAt["$lt"] = bson.NewDateTimeFromTime(search.UpdatedBefore)
+	}
+	if len(updatedAt) > 0 {
+		filter["updated_at"] = updatedAt
+	}
+
+	projection := bson.M{
+		"title":                        1,
+		"project_id":                   1,
+		"language_model":               1,
+		"created_at":                   1,
+		"updated_at":                   1,
+		"inapp_chat_history.messageId": 1,
+		"inapp_chat_history.payload.user.content":      1,
+		"inapp_chat_history.payload.assistant.content": 1,
+	}
+	sort := bson.D{{Key: "updated_at", Value: -1}}
+	if search.Query != "" {
+		projection["score"] = bson.M{"$meta": "textScore"}
+		sort = bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "updated_at", Value: -1}}
+	}
+
+	opts := options.Find().
+		SetProjection(projection).
+		SetSort(sort).
+		SetLimit(int64(limit))
+	cursor, err := s.conversationCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []*ConversationSearchResult{}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -277,6 +277,9 @@ func (s *ReverseCommentService) ReverseComments(ctx context.Context, comments *p
 		return nil, err
 	}
 
+	// comments made outside of a conversation have none
+	conversationID, _ := contextutil.GetConversationID(ctx)
+
 	// Get the project from the database
 	project, err := s.projectService.GetProject(ctx, actor.ID, projectId)
 	if err != nil {
@@ -306,7 +309,7 @@ func (s *ReverseCommentService) ReverseComments(ctx context.Context, comments *p
 			continue
 		}
 
-		commentRecord := s.createCommentRecord(actor.ID, projectId, targetDoc, docSHA1, quotePosition, matchedText, comment)
+		commentRecord := s.createCommentRecord(actor.ID, projectId, conversationID, targetDoc, docSHA1, quotePosition, matchedText, comment)
 		one, err := s.commentCollection.InsertOne(ctx, commentRecord)
 		if err != nil {
 			return nil, err
@@ -320,7 +323,7 @@ func (s *ReverseCommentService) ReverseComments(ctx context.Context, comments *p
 }
 
 // createCommentRecord creates a models.Comment from the provided data
-func (s *ReverseCommentService) createCommentRecord(userID bson.ObjectID, projectId string, targetDoc *models.ProjectDoc, docSHA1 string, quotePosition int, matchedText string, comment *projectv1.PaperScoreCommentEntry) *models.Comment {
+func (s *ReverseCommentService) createCommentRecord(userID bson.ObjectID, projectId string, conversationID string, targetDoc *models.ProjectDoc, docSHA1 string, quotePosition int, matchedText string, comment *projectv1.PaperScoreCommentEntry) *models.Comment {
 	return &models.Comment{
 		BaseModel: models.BaseModel{
 			ID:        bson.NewObjectID(),
@@ -329,6 +332,7 @@ func (s *ReverseCommentService) createCommentRecord(userID bson.ObjectID, projec
 		},
 		UserID:            userID,
 		ProjectID:         projectId,
+		ConversationID:    conversationID,
 		DocID:             targetDoc.ID,
 		DocVersion:        targetDoc.Version,
 		DocSHA1:           docSHA1,
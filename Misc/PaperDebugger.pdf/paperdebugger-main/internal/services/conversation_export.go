@@ -0,0 +1,218 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	conversationExportFormat  = "paperdebugger.conversation"
+	conversationExportVersion = 1
+)
+
+// conversationExport is the lossless JSON export of a conversation. It is written as relaxed
+// MongoDB Extended JSON, the chat histories are kept as they are stored in the database.
+type conversationExport struct {
+	Format       string             `bson:"format"`
+	Version      int                `bson:"version"`
+	ExportedAt   time.Time          `bson:"exported_at"`
+	Conversation exportConversation `bson:"conversation"`
+}
+
+type exportConversation struct {
+	Title             string                       `bson:"title"`
+	LanguageModel     models.LanguageModel         `bson:"language_model"`
+	ResponseMode      models.ResponseMode          `bson:"response_mode"`
+	InappChatHistory  []bson.M                     `bson:"inapp_chat_history"`
+	OpenaiChatHistory responses.ResponseInputParam `bson:"openai_chat_history"`
+	OpenaiChatParams  responses.ResponseNewParams  `bson:"openai_chat_params"`
+	MessageTree       []models.MessageNode         `bson:"message_tree"`
+}
+
+// ExportConversationJSON returns the lossless JSON export of the conversation, it can be restored
+// with ParseConversationExport.
+func ExportConversationJSON(conversation *models.Conversation) (string, error) {
+	export := conversationExport{
+		Format:     conversationExportFormat,
+		Version:    conversationExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Conversation: exportConversation{
+			Title:             conversation.Title,
+			LanguageModel:     conversation.LanguageModel,
+			ResponseMode:      conversation.ResponseMode,
+			InappChatHistory:  conversation.InappChatHistory,
+			OpenaiChatHistory: conversation.OpenaiChatHistory,
+			OpenaiChatParams:  conversation.OpenaiChatParams,
+			MessageTree:       conversation.MessageTree,
+		},
+	}
+	data, err := bson.MarshalExtJSONIndent(export, false, false, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ParseConversationExport validates a JSON export and returns the conversation it holds, without
+// id, user and project.
+func ParseConversationExport(content string) (*models.Conversation, error) {
+	var export conversationExport
+	if err := bson.UnmarshalExtJSON([]byte(content), false, &export); err != nil {
+		return nil, fmt.Errorf("invalid conversation export: %w", err)
+	}
+	if export.Format != conversationExportFormat {
+		return nil, errors.New("invalid conversation export: not a PaperDebugger conversation")
+	}
+	if export.Version != conversationExportVersion {
+		return nil, fmt.Errorf("unsupported conversation export version %d", export.Version)
+	}
+
+	exported := export.Conversation
+	messages := append([]bson.M{}, exported.InappChatHistory...)
+	for _, n := range exported.MessageTree {
+		messages = append(messages, n.InappMessages...)
+	}
+	for _, msg := range messages {
+		message, err := inappMessageToProto(msg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conversation export: %w", err)
+		}
+		if message.GetMessageId() == "" || message.GetPayload().GetMessageType() == nil {
+			return nil, errors.New("invalid conversation export: message without id or payload")
+		}
+	}
+
+	conversation := &models.Conversation{
+		Title:             exported.Title,
+		LanguageModel:     exported.LanguageModel,
+		ResponseMode:      exported.ResponseMode,
+		InappChatHistory:  exported.InappChatHistory,
+		OpenaiChatHistory: exported.OpenaiChatHistory,
+		OpenaiChatParams:  exported.OpenaiChatParams,
+		MessageTree:       exported.MessageTree,
+	}
+	if err := conversation.ValidateMessageTree(); err != nil {
+		return nil, fmt.Errorf("invalid conversation export: %w", err)
+	}
+	conversation.SyncMessageTree()
+	return conversation, nil
+}
+
+// ExportConversationOpenAI returns the input of the OpenAI Responses API for the conversation:
+// the model and the items the model gets, with the system prompt.
+func ExportConversationOpenAI(conversation *models.Conversation) (string, error) {
+	data, err := json.MarshalIndent(struct {
+		Model string                       `json:"model"`
+		Input responses.ResponseInputParam `json:"input"`
+	}{
+		Model: conversation.LanguageModel.Name(),
+		Input: conversation.OpenaiChatHistory,
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ExportConversationMarkdown returns the shown branch of the conversation as Markdown, tool calls
+// are collapsible blocks.
+func ExportConversationMarkdown(conversation *models.Conversation) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", conversation.Title)
+	fmt.Fprintf(&b, "_Exported from PaperDebugger on %s, model %s._\n", time.Now().UTC().Format("2006-01-02"), conversation.LanguageModel.Name())
+
+	for _, msg := range conversation.InappChatHistory {
+		message, err := inappMessageToProto(msg)
+		if err != nil {
+			return "", err
+		}
+		payload := message.GetPayload()
+		switch {
+		case payload.GetUser() != nil:
+			b.WriteString("\n## User\n\n")
+			if selected := payload.GetUser().GetSelectedText(); selected != "" {
+				b.WriteString(quote(selected) + "\n\n")
+			}
+			b.WriteString(payload.GetUser().GetContent() + "\n")
+		case payload.GetAssistant() != nil:
+			b.WriteString("\n## Assistant\n\n")
+			b.WriteString(payload.GetAssistant().GetContent() + "\n")
+		case payload.GetRevision() != nil:
+			revision := payload.GetRevision()
+			b.WriteString("\n## Assistant\n\n")
+			fmt.Fprintf(&b, "Original:\n\n%s\n\nRevised:\n\n%s\n", quote(revision.GetOriginal()), quote(revision.GetRevised()))
+			if revision.GetRationale() != "" {
+				fmt.Fprintf(&b, "\n%s\n", revision.GetRationale())
+			}
+		case payload.GetTextEdits() != nil:
+			textEdits := payload.GetTextEdits()
+			fmt.Fprintf(&b, "\n<details>\n<summary>%d edits in %s</summary>\n\n", len(textEdits.GetEdits()), textEdits.GetDocPath())
+			for _, edit := range textEdits.GetEdits() {
+				fmt.Fprintf(&b, "- ~~%s~~ %s (%s)\n", edit.GetOriginal(), edit.GetReplacement(), editStatus(edit.GetStatus()))
+			}
+			b.WriteString("\n</details>\n")
+		case payload.GetToolCall() != nil:
+			toolCall := payload.GetToolCall()
+			fmt.Fprintf(&b, "\n<details>\n<summary>Tool call: %s</summary>\n\n", toolCall.GetName())
+			b.WriteString(codeBlock("json", toolCall.GetArgs()))
+			if toolCall.GetError() != "" {
+				b.WriteString("\nError:\n\n" + codeBlock("", toolCall.GetError()))
+			} else if toolCall.GetResult() != "" {
+				b.WriteString("\nResult:\n\n" + codeBlock("", toolCall.GetResult()))
+			}
+			b.WriteString("\n</details>\n")
+		case payload.GetToolCallApprovalRequired() != nil:
+			toolCall := payload.GetToolCallApprovalRequired()
+			fmt.Fprintf(&b, "\n<details>\n<summary>Tool call awaiting approval: %s</summary>\n\n", toolCall.GetName())
+			b.WriteString(codeBlock("json", toolCall.GetArgs()))
+			b.WriteString("\n</details>\n")
+		}
+	}
+	return b.String(), nil
+}
+
+// inappMessageToProto converts a message of the in-app chat history, stored as BSON.
+func inappMessageToProto(msg bson.M) (*chatv1.Message, error) {
+	jsonBytes, err := bson.MarshalExtJSON(msg, true, false)
+	if err != nil {
+		return nil, err
+	}
+	message := &chatv1.Message{}
+	if err := protojson.Unmarshal(jsonBytes, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func quote(text string) string {
+	return "> " + strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n> ")
+}
+
+// codeBlock fences text with more backticks than it contains in a row.
+func codeBlock(language, text string) string {
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	return fence + language + "\n" + strings.TrimRight(text, "\n") + "\n" + fence + "\n"
+}
+
+func editStatus(status chatv1.TextEditStatus) string {
+	switch status {
+	case chatv1.TextEditStatus_TEXT_EDIT_STATUS_ACCEPTED:
+		return "applied"
+	case chatv1.TextEditStatus_TEXT_EDIT_STATUS_REJECTED:
+		return "rejected"
+	}
+	return "proposed"
+}
@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"testing"
+
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func inappMessage(t *testing.T, message *chatv1.Message) bson.M {
+	jsonBytes, err := protojson.Marshal(message)
+	require.NoError(t, err)
+	var msg bson.M
+	require.NoError(t, bson.UnmarshalExtJSON(jsonBytes, true, &msg))
+	return msg
+}
+
+func exportedConversation(t *testing.T) *models.Conversation {
+	inputMessage := func(role responses.EasyInputMessageRole, text string) responses.ResponseInputItemUnionParam {
+		return responses.ResponseInputItemUnionParam{
+			OfInputMessage: &responses.ResponseInputItemMessageParam{
+				Role:    string(role),
+				Content: responses.ResponseInputMessageContentListParam{responses.ResponseInputContentParamOfInputText(text)},
+			},
+		}
+	}
+	c := &models.Conversation{
+		Title:         "Related work",
+		LanguageModel: models.LanguageModel(chatv1.LanguageModel_LANGUAGE_MODEL_OPENAI_GPT41),
+		InappChatHistory: []bson.M{
+			inappMessage(t, &chatv1.Message{MessageId: models.UserMessageIDPrefix + "1", Payload: &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_User{User: &chatv1.MessageTypeUser{Content: "Check the citations", SelectedText: strPtr("See [3].")}},
+			}}),
+			inappMessage(t, &chatv1.Message{MessageId: "pd_msg_tool_1", Payload: &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_ToolCall{ToolCall: &chatv1.MessageTypeToolCall{Name: "search_papers", Args: `{"query":"transformers"}`, Result: "3 papers"}},
+			}}),
+			inappMessage(t, &chatv1.Message{MessageId: "pd_msg_assistant_1", Payload: &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_Assistant{Assistant: &chatv1.MessageTypeAssistant{Content: "The citations look fine."}},
+			}}),
+		},
+		OpenaiChatHistory: responses.ResponseInputParam{
+			inputMessage(responses.EasyInputMessageRoleSystem, "You are a helpful assistant."),
+			inputMessage(responses.EasyInputMessageRoleUser, "Check the citations"),
+			responses.ResponseInputItemParamOfFunctionCall(`{"query":"transformers"}`, "call_1", "search_papers"),
+			responses.ResponseInputItemParamOfFunctionCallOutput("call_1", "3 papers"),
+			responses.ResponseInputItemParamOfOutputMessage(nil, "msg_1", "completed"),
+		},
+	}
+	c.SyncMessageTree()
+	return c
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestConversationExportJSON(t *testing.T) {
+	conversation := exportedConversation(t)
+	content, err := services.ExportConversationJSON(conversation)
+	require.NoError(t, err)
+
+	imported, err := services.ParseConversationExport(content)
+	require.NoError(t, err)
+	assert.Equal(t, conversation.Title, imported.Title)
+	assert.Equal(t, conversation.LanguageModel, imported.LanguageModel)
+	assert.Len(t, imported.InappChatHistory, 3)
+	assert.Equal(t, conversation.InappChatHistory[0]["messageId"], imported.InappChatHistory[0]["messageId"])
+	require.Len(t, imported.OpenaiChatHistory, 5)
+	assert.Equal(t, "call_1", imported.OpenaiChatHistory[2].OfFunctionCall.CallID)
+	assert.Equal(t, "3 papers", imported.OpenaiChatHistory[3].OfFunctionCallOutput.Output)
+	assert.Equal(t, conversation.MessageTree, imported.MessageTree)
+}
+
+func TestConversationExportInvalid(t *testing.T) {
+	_, err := services.ParseConversationExport(`{"format": "other", "version": 1}`)
+	assert.Error(t, err)
+	_, err = services.ParseConversationExport(`not json`)
+	assert.Error(t, err)
+
+	// a user message missing from the model history
+	conversation := exportedConversation(t)
+	conversation.OpenaiChatHistory = conversation.OpenaiChatHistory[:1]
+	content, err := services.ExportConversationJSON(conversation)
+	require.NoError(t, err)
+	_, err = services.ParseConversationExport(content)
+	assert.ErrorIs(t, err, models.ErrHistoryNotBranchable)
+}
+
+func TestConversationExportMarkdown(t *testing.T) {
+	content, err := services.ExportConversationMarkdown(exportedConversation(t))
+	require.NoError(t, err)
+	assert.Contains(t, content, "# Related work\n")
+	assert.Contains(t, content, "## User\n\n> See [3].\n\nCheck the citations\n")
+	assert.Contains(t, content, "<details>\n<summary>Tool call: search_papers</summary>\n\n```json\n{\"query\":\"transformers\"}\n```\n")
+	assert.Contains(t, content, "Result:\n\n```\n3 papers\n```\n\n</details>\n")
+	assert.Contains(t, content, "## Assistant\n\nThe citations look fine.\n")
+}
+
+func TestConversationExportOpenAI(t *testing.T) {
+	content, err := services.ExportConversationOpenAI(exportedConversation(t))
+	require.NoError(t, err)
+	assert.Contains(t, content, `"model": "gpt-4.1"`)
+	assert.Contains(t, content, `"type": "function_call"`)
+	assert.Contains(t, content, `"role": "system"`)
+}
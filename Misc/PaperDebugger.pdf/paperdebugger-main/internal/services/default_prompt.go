@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed default_prompts.yaml
+var defaultPromptCatalog []byte
+
+var defaultPromptKeyPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// DefaultPromptService manages the catalog of built-in prompts. The catalog embedded in the
+// server is seeded when the service starts, the admins edit it at runtime.
+type DefaultPromptService struct {
+	BaseService
+	defaultPromptCollection *mongo.Collection
+}
+
+type defaultPromptCatalogEntry struct {
+	Key      string            `yaml:"key"`
+	Version  int               `yaml:"version"`
+	Category string            `yaml:"category"`
+	Tags     []string          `yaml:"tags"`
+	Order    int               `yaml:"order"`
+	Title    string            `yaml:"title"`
+	Titles   map[string]string `yaml:"titles"`
+	Content  string            `yaml:"content"`
+}
+
+func NewDefaultPromptService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *DefaultPromptService {
+	base := NewBaseService(db, cfg, logger)
+	collection := base.db.Collection((models.DefaultPrompt{}).CollectionName())
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for default_prompts collection", err)
+	}
+
+	s := &DefaultPromptService{
+		BaseService:             base,
+		defaultPromptCollection: collection,
+	}
+
+	catalog, err := ParseDefaultPromptCatalog(defaultPromptCatalog)
+	if err != nil {
+		logger.Error("Failed to parse the default prompt catalog", err)
+		return s
+	}
+	if err := s.seed(context.Background(), catalog); err != nil {
+		logger.Error("Failed to seed the default prompts", err)
+	}
+	return s
+}
+
+// ParseDefaultPromptCatalog parses and validates a YAML catalog of built-in prompts.
+func ParseDefaultPromptCatalog(data []byte) ([]*models.DefaultPrompt, error) {
+	var entries []defaultPromptCatalogEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(entries))
+	prompts := make([]*models.DefaultPrompt, 0, len(entries))
+	for _, entry := range entries {
+		prompt := &models.DefaultPrompt{
+			Key:             entry.Key,
+			CatalogVersion:  entry.Version,
+			Category:        entry.Category,
+			Tags:            entry.Tags,
+			Order:           entry.Order,
+			Title:           entry.Title,
+			LocalizedTitles: entry.Titles,
+			Content:         entry.Content,
+		}
+		if err := ValidateDefaultPrompt(prompt); err != nil {
+			return nil, fmt.Errorf("default prompt %q: %w", entry.Key, err)
+		}
+		if prompt.CatalogVersion < 1 {
+			return nil, fmt.Errorf("default prompt %q: version must be at least 1", entry.Key)
+		}
+		if keys[prompt.Key] {
+			return nil, fmt.Errorf("default prompt %q: duplicate key", entry.Key)
+		}
+		keys[prompt.Key] = true
+		prompts = append(prompts, prompt)
+	}
+	return prompts, nil
+}
+
+// ValidateDefaultPrompt checks the key, the title and the content of a built-in prompt.
+func ValidateDefaultPrompt(prompt *models.DefaultPrompt) error {
+	if !defaultPromptKeyPattern.MatchString(prompt.Key) {
+		return shared.ErrBadRequest("key must be lowercase letters and digits separated by dashes")
+	}
+	if strings.TrimSpace(prompt.Title) == "" {
+		return shared.ErrBadRequest("title must not be empty")
+	}
+	if strings.TrimSpace(prompt.Content) == "" {
+		return shared.ErrBadRequest("content must not be empty")
+	}
+	for locale, title := range prompt.LocalizedTitles {
+		if locale == "" || strings.TrimSpace(title) == "" {
+			return shared.ErrBadRequest("localized titles need a language tag and a title")
+		}
+	}
+	return nil
+}
+
+// LocalizedTitle returns the title of the prompt in the locale, e.g. "zh-TW", falling back to
+// the language ("zh") and then to the default title.
+func LocalizedTitle(prompt *models.DefaultPrompt, locale string) string {
+	locale = strings.ReplaceAll(locale, "_", "-")
+	for locale != "" {
+		for tag, title := range prompt.LocalizedTitles {
+			if strings.EqualFold(tag, locale) {
+				return title
+			}
+		}
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return prompt.Title
+}
+
+// CatalogSeedAction is what seeding the catalog does with a stored prompt.
+type CatalogSeedAction int
+
+const (
+	CatalogSeedSkip       CatalogSeedAction = iota // the stored prompt is up to date
+	CatalogSeedUpdate                              // the catalog entry overwrites the stored prompt
+	CatalogSeedKeepEdited                          // the catalog entry is newer, but the admins edited the prompt
+)
+
+// SeedActionFor compares the stored prompt with the catalog entry of its key. An entry with a
+// higher version overwrites the prompt, unless the admins edited it: their edit is kept and the
+// admins merge the catalog change themselves.
+//
+// The prompts stored before the catalog version was kept apart have only the version, raised both
+// by the catalog and by the admins. Unless it is still 1, they may have been edited and are kept.
+func SeedActionFor(stored *models.DefaultPrompt, entry *models.DefaultPrompt) CatalogSeedAction {
+	catalogVersion, edited := stored.CatalogVersion, stored.Edited
+	if catalogVersion == 0 && !edited {
+		catalogVersion, edited = stored.Version, stored.Version > 1
+	}
+	if catalogVersion >= entry.CatalogVersion {
+		return CatalogSeedSkip
+	}
+	if edited {
+		return CatalogSeedKeepEdited
+	}
+	return CatalogSeedUpdate
+}
+
+// seed inserts the prompts of the catalog which are missing, and updates the stored ones as told
+// by SeedActionFor. The prompts deleted by the admins stay deleted.
+func (s *DefaultPromptService) seed(ctx context.Context, catalog []*models.DefaultPrompt) error {
+	now := bson.NewDateTimeFromTime(time.Now())
+	for _, prompt := range catalog {
+		var existing models.DefaultPrompt
+		err := s.defaultPromptCollection.FindOne(ctx, bson.M{"key": prompt.Key}).Decode(&existing)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			prompt.BaseModel = models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now}
+			prompt.Version = 1
+			_, err = s.defaultPromptCollection.InsertOne(ctx, prompt)
+			if mongo.IsDuplicateKeyError(err) {
+				// seeded by another instance
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		switch SeedActionFor(&existing, prompt) {
+		case CatalogSeedSkip:
+			continue
+		case CatalogSeedKeepEdited:
+			s.logger.Warn("Kept the admin edit of default prompt, the catalog has a newer version", "key", prompt.Key, "catalog_version", prompt.CatalogVersion)
+			continue
+		}
+
+		// an admin edit meanwhile changes the version, the catalog entry is applied at the next start
+		_, err = s.defaultPromptCollection.UpdateOne(ctx,
+			bson.M{"_id": existing.ID, "version": existing.Version},
+			bson.M{
+				"$set": bson.M{
+					"catalog_version":  prompt.CatalogVersion,
+					"category":         prompt.Category,
+					"tags":             prompt.Tags,
+					"order":            prompt.Order,
+					"title":            prompt.Title,
+					"localized_titles": prompt.LocalizedTitles,
+					"content":          prompt.Content,
+					"updated_at":       now,
+				},
+				"$inc": bson.M{"version": 1},
+			},
+		)
+		if err != nil {
+			return err
+		}
+		s.logger.Info("Updated default prompt from the catalog", "key", prompt.Key, "catalog_version", prompt.CatalogVersion)
+	}
+	return nil
+}
+
+// ListDefaultPrompts returns the built-in prompts by order then title, with the deleted ones if
+// includeDeleted is set.
+func (s *DefaultPromptService) ListDefaultPrompts(ctx context.Context, includeDeleted bool) ([]*models.DefaultPrompt, error) {
+	filter := bson.M{}
+	if !includeDeleted {
+		filter["$or"] = []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		}
+	}
+
+	cursor, err := s.defaultPromptCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "title", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var prompts []*models.DefaultPrompt
+	if err := cursor.All(ctx, &prompts); err != nil {
+		return nil, err
+	}
+	return prompts, nil
+}
+
+// GetDefaultPrompt returns a built-in prompt that is not deleted.
+func (s *DefaultPromptService) GetDefaultPrompt(ctx context.Context, id bson.ObjectID) (*models.DefaultPrompt, error) {
+	var prompt models.DefaultPrompt
+	err := s.defaultPromptCollection.FindOne(ctx, bson.M{
+		"_id": id,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}).Decode(&prompt)
+	if err != nil {
+		return nil, err
+	}
+	return &prompt, nil
+}
+
+// CreateDefaultPrompt adds a built-in prompt. Creating a prompt with the key of a deleted one
+// brings it back with the new content, so the preferences of the users apply again.
+func (s *DefaultPromptService) CreateDefaultPrompt(ctx context.Context, prompt *models.DefaultPrompt) (*models.DefaultPrompt, error) {
+	if err := ValidateDefaultPrompt(prompt); err != nil {
+		return nil, err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	var existing models.DefaultPrompt
+	err := s.defaultPromptCollection.FindOne(ctx, bson.M{"key": prompt.Key}).Decode(&existing)
+	if err == nil {
+		if existing.DeletedAt == nil {
+			return nil, shared.ErrBadRequest("a built-in prompt with this key already exists")
+		}
+		prompt.BaseModel = models.BaseModel{ID: existing.ID, CreatedAt: existing.CreatedAt, UpdatedAt: now}
+		prompt.Version = existing.Version + 1
+		prompt.CatalogVersion = existing.CatalogVersion
+		prompt.Edited = true
+		result, err := s.defaultPromptCollection.ReplaceOne(ctx, bson.M{"_id": existing.ID, "version": existing.Version}, prompt)
+		if err != nil {
+			return nil, err
+		}
+		if result.MatchedCount == 0 {
+			return nil, shared.ErrBadRequest("the built-in prompt was changed meanwhile")
+		}
+		return prompt, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	prompt.BaseModel = models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now}
+	prompt.Version = 1
+	prompt.CatalogVersion = 0
+	prompt.Edited = true
+	_, err = s.defaultPromptCollection.InsertOne(ctx, prompt)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, shared.ErrBadRequest("a built-in prompt with this key already exists")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prompt, nil
+}
+
+// UpdateDefaultPrompt saves the changes to a prompt read by GetDefaultPrompt and increments its
+// version. The key does not change, and the newer versions of the catalog no longer overwrite it.
+// It returns mongo.ErrNoDocuments if the prompt was deleted or changed meanwhile.
+func (s *DefaultPromptService) UpdateDefaultPrompt(ctx context.Context, prompt *models.DefaultPrompt) (*models.DefaultPrompt, error) {
+	if err := ValidateDefaultPrompt(prompt); err != nil {
+		return nil, err
+	}
+
+	var updated models.DefaultPrompt
+	err := s.defaultPromptCollection.FindOneAndUpdate(ctx,
+		bson.M{
+			"_id":     prompt.ID,
+			"key":     prompt.Key,
+			"version": prompt.Version,
+			"$or": []bson.M{
+				{"deleted_at": nil},
+				{"deleted_at": bson.M{"$exists": false}},
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"category":         prompt.Category,
+				"tags":             prompt.Tags,
+				"order":            prompt.Order,
+				"title":            prompt.Title,
+				"localized_titles": prompt.LocalizedTitles,
+				"content":          prompt.Content,
+				"edited":           true,
+				"updated_at":       bson.NewDateTimeFromTime(time.Now()),
+			},
+			"$inc": bson.M{"version": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteDefaultPrompt hides a built-in prompt from every user. It stays deleted when the server
+// seeds the catalog again.
+func (s *DefaultPromptService) DeleteDefaultPrompt(ctx context.Context, id bson.ObjectID) error {
+	now := bson.NewDateTimeFromTime(time.Now())
+	result, err := s.defaultPromptCollection.UpdateOne(ctx,
+		bson.M{
+			"_id": id,
+			"$or": []bson.M{
+				{"deleted_at": nil},
+				{"deleted_at": bson.M{"$exists": false}},
+			},
+		},
+		bson.M{
+			"$set": bson.M{"deleted_at": now, "updated_at": now},
+			"$inc": bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"os"
+	"testing"
+
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultPromptCatalog(t *testing.T) {
+	data, err := os.ReadFile("default_prompts.yaml")
+	require.NoError(t, err)
+
+	prompts, err := services.ParseDefaultPromptCatalog(data)
+	require.NoError(t, err)
+	assert.NotEmpty(t, prompts)
+	for _, prompt := range prompts {
+		assert.GreaterOrEqual(t, prompt.CatalogVersion, 1, prompt.Key)
+	}
+}
+
+func TestParseDefaultPromptCatalog_Invalid(t *testing.T) {
+	_, err := services.ParseDefaultPromptCatalog([]byte(`
+- {key: review, version: 1, title: Review, content: Review my paper}
+- {key: review, version: 1, title: Review again, content: Review my paper again}
+`))
+	assert.ErrorContains(t, err, "duplicate key")
+
+	_, err = services.ParseDefaultPromptCatalog([]byte(`- {key: Review Paper, version: 1, title: Review, content: Review my paper}`))
+	assert.Error(t, err)
+
+	_, err = services.ParseDefaultPromptCatalog([]byte(`- {key: review, title: Review, content: Review my paper}`))
+	assert.ErrorContains(t, err, "version")
+
+	_, err = services.ParseDefaultPromptCatalog([]byte(`- {key: review, version: 1, title: Review}`))
+	assert.Error(t, err)
+}
+
+func TestSeedActionFor(t *testing.T) {
+	entry := &models.DefaultPrompt{Key: "review", CatalogVersion: 3}
+
+	tests := []struct {
+		name   string
+		stored models.DefaultPrompt
+		want   services.CatalogSeedAction
+	}{
+		{"up to date", models.DefaultPrompt{Version: 2, CatalogVersion: 3}, services.CatalogSeedSkip},
+		{"newer catalog", models.DefaultPrompt{Version: 1, CatalogVersion: 2}, services.CatalogSeedUpdate},
+		// the version counts the changes, a prompt updated once by the catalog is at version 2
+		{"version ahead of the catalog", models.DefaultPrompt{Version: 4, CatalogVersion: 2}, services.CatalogSeedUpdate},
+		{"edited", models.DefaultPrompt{Version: 2, CatalogVersion: 2, Edited: true}, services.CatalogSeedKeepEdited},
+		{"edited and up to date", models.DefaultPrompt{Version: 5, CatalogVersion: 3, Edited: true}, services.CatalogSeedSkip},
+		{"created by the admins", models.DefaultPrompt{Version: 1, Edited: true}, services.CatalogSeedKeepEdited},
+		{"seeded before the catalog version", models.DefaultPrompt{Version: 1}, services.CatalogSeedUpdate},
+		{"seeded before the catalog version, up to date", models.DefaultPrompt{Version: 3}, services.CatalogSeedSkip},
+		// an admin edit or a catalog update, it cannot be told apart
+		{"changed before the catalog version", models.DefaultPrompt{Version: 2}, services.CatalogSeedKeepEdited},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, services.SeedActionFor(&tt.stored, entry))
+		})
+	}
+}
+
+func TestLocalizedTitle(t *testing.T) {
+	prompt := &models.DefaultPrompt{
+		Title:           "Review",
+		LocalizedTitles: map[string]string{"zh": "审阅", "zh-TW": "審閱"},
+	}
+
+	assert.Equal(t, "Review", services.LocalizedTitle(prompt, ""))
+	assert.Equal(t, "Review", services.LocalizedTitle(prompt, "fr-FR"))
+	assert.Equal(t, "审阅", services.LocalizedTitle(prompt, "zh-CN"))
+	assert.Equal(t, "審閱", services.LocalizedTitle(prompt, "zh_tw"))
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// memberModel is a member model embedding models.Membership.
+type memberModel interface {
+	Member() models.Membership
+}
+
+// memberships manages the members of the projects or of the organizations, scoped by the field
+// scopeKey of their collection. S is the type of the project or organization ids, M the member
+// model and I the member with their user returned to the callers.
+//
+// Every scope has a single owner, who manages the members: they add users as editors or viewers,
+// change their roles and remove them. The members list the others and can leave, the owner cannot.
+// With invite, the users are added pending and have no role until they accept.
+type memberships[S any, M memberModel, I any] struct {
+	collection     *mongo.Collection
+	userCollection *mongo.Collection
+	scopeKey       string // e.g. "project_id"
+	scopeName      string // e.g. "project", in the errors
+	invite         bool   // the added users accept before they become members
+
+	newMember func(scopeID S, base models.BaseModel, membership models.Membership) M
+	newInfo   func(member M, user *models.User) *I // user is nil if the account was deleted
+}
+
+// createIndexes makes a user a member once per scope, with a single owner.
+func (m *memberships[S, M, I]) createIndexes(logger *logger.Logger) {
+	_, err := m.collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: m.scopeKey, Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: m.scopeKey, Value: 1}},
+			Options: options.Index().
+				SetName(m.scopeName + "_owner").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"role": accesscontrol.RoleOwner}),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for "+m.collection.Name()+" collection", err)
+	}
+}
+
+// addOwner inserts the owner, it returns a duplicate key error if the scope has an owner or the
+// user is already a member.
+func (m *memberships[S, M, I]) addOwner(ctx context.Context, scopeID S, userID bson.ObjectID) error {
+	now := bson.NewDateTimeFromTime(time.Now())
+	_, err := m.collection.InsertOne(ctx, m.newMember(
+		scopeID,
+		models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now},
+		models.Membership{UserID: userID, Role: accesscontrol.RoleOwner},
+	))
+	return err
+}
+
+func (m *memberships[S, M, I]) getRole(ctx context.Context, userID bson.ObjectID, scopeID S) (accesscontrol.Role, error) {
+	var member models.Membership
+	err := m.collection.FindOne(ctx, bson.M{m.scopeKey: scopeID, "user_id": userID, "pending": bson.M{"$ne": true}}).Decode(&member)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return accesscontrol.RoleNone, nil
+	}
+	if err != nil {
+		return accesscontrol.RoleNone, err
+	}
+	return member.Role, nil
+}
+
+func (m *memberships[S, M, I]) authorize(ctx context.Context, userID bson.ObjectID, scopeID S, permission accesscontrol.Permission) (accesscontrol.Role, error) {
+	role, err := m.getRole(ctx, userID, scopeID)
+	if err != nil {
+		return accesscontrol.RoleNone, err
+	}
+	if !role.Can(permission) {
+		if role == accesscontrol.RoleNone {
+			return role, shared.ErrPermissionDenied("you are not a member of this " + m.scopeName)
+		}
+		return role, shared.ErrPermissionDenied("the " + string(role) + " role does not allow this")
+	}
+	return role, nil
+}
+
+// list returns the members and the invited users, the owner first.
+func (m *memberships[S, M, I]) list(ctx context.Context, userID bson.ObjectID, scopeID S) ([]*I, error) {
+	if _, err := m.authorize(ctx, userID, scopeID, accesscontrol.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	cursor, err := m.collection.Find(ctx, bson.M{m.scopeKey: scopeID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var members []M
+	if err := cursor.All(ctx, &members); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]bson.ObjectID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.Member().UserID
+	}
+	users, err := findUsers(ctx, m.userCollection, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*I, 0, len(members))
+	for _, member := range members {
+		info := m.newInfo(member, users[member.Member().UserID])
+		if member.Member().Role == accesscontrol.RoleOwner {
+			infos = append([]*I{info}, infos...)
+		} else {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+// add adds the user with the email, or invites them with invite. It returns mongo.ErrNoDocuments if
+// no user has the email.
+func (m *memberships[S, M, I]) add(ctx context.Context, userID bson.ObjectID, scopeID S, email string, role accesscontrol.Role) (*I, error) {
+	if _, err := m.authorize(ctx, userID, scopeID, accesscontrol.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	if role != accesscontrol.RoleEditor && role != accesscontrol.RoleViewer {
+		return nil, shared.ErrBadRequest("members are added as editors or viewers")
+	}
+
+	var user models.User
+	if err := m.userCollection.FindOne(ctx, bson.M{"email": email}).Decode(&user); err != nil {
+		return nil, err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	member := m.newMember(
+		scopeID,
+		models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now},
+		models.Membership{UserID: user.ID, Role: role, AddedBy: userID, Pending: m.invite},
+	)
+	_, err := m.collection.InsertOne(ctx, member)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, shared.ErrBadRequest("the user is already a member of this " + m.scopeName + " or invited to it")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.newInfo(member, &user), nil
+}
+
+// accept makes the invited user a member, it returns mongo.ErrNoDocuments if they are not invited.
+func (m *memberships[S, M, I]) accept(ctx context.Context, userID bson.ObjectID, scopeID S) (accesscontrol.Role, error) {
+	var member models.Membership
+	err := m.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{m.scopeKey: scopeID, "user_id": userID, "pending": true},
+		bson.M{"$unset": bson.M{"pending": ""}, "$set": bson.M{"updated_at": bson.NewDateTimeFromTime(time.Now())}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&member)
+	if err != nil {
+		return accesscontrol.RoleNone, err
+	}
+	return member.Role, nil
+}
+
+// updateRole makes a member other than the owner an editor or a viewer.
+func (m *memberships[S, M, I]) updateRole(ctx context.Context, userID bson.ObjectID, scopeID S, memberID bson.ObjectID, role accesscontrol.Role) (*I, error) {
+	if _, err := m.authorize(ctx, userID, scopeID, accesscontrol.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	if role != accesscontrol.RoleEditor && role != accesscontrol.RoleViewer {
+		return nil, shared.ErrBadRequest("members are editors or viewers")
+	}
+
+	var member M
+	err := m.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{m.scopeKey: scopeID, "user_id": memberID, "role": bson.M{"$ne": accesscontrol.RoleOwner}},
+		bson.M{"$set": bson.M{"role": role, "updated_at": bson.NewDateTimeFromTime(time.Now())}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&member)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := findUsers(ctx, m.userCollection, []bson.ObjectID{memberID})
+	if err != nil {
+		return nil, err
+	}
+	return m.newInfo(member, users[memberID]), nil
+}
+
+// remove removes a member other than the owner, the members can remove themselves.
+func (m *memberships[S, M, I]) remove(ctx context.Context, userID bson.ObjectID, scopeID S, memberID bson.ObjectID) error {
+	if memberID != userID {
+		if _, err := m.authorize(ctx, userID, scopeID, accesscontrol.PermissionManageMembers); err != nil {
+			return err
+		}
+	}
+
+	result, err := m.collection.DeleteOne(ctx, bson.M{
+		m.scopeKey: scopeID,
+		"user_id":  memberID,
+		"role":     bson.M{"$ne": accesscontrol.RoleOwner},
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// handOverOwnership makes the longest-standing editor, or else viewer, the owner of every scope of
+// the collection the user owns, before the account of the user is deleted. The scopes without
+// other members keep the membership of the user, it is deleted with their data.
+func handOverOwnership(ctx context.Context, collection *mongo.Collection, scopeKey string, userID bson.ObjectID) error {
+	cursor, err := collection.Find(ctx, bson.M{"user_id": userID, "role": accesscontrol.RoleOwner})
+	if err != nil {
+		return err
+	}
+	var owned []bson.M
+	if err := cursor.All(ctx, &owned); err != nil {
+		return err
+	}
+
+	for _, owner := range owned {
+		var successor struct {
+			models.BaseModel  `bson:",inline"`
+			models.Membership `bson:",inline"`
+		}
+		for _, role := range []accesscontrol.Role{accesscontrol.RoleEditor, accesscontrol.RoleViewer} {
+			err = collection.FindOne(ctx,
+				bson.M{scopeKey: owner[scopeKey], "role": role, "pending": bson.M{"$ne": true}},
+				options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+			).Decode(&successor)
+			if !errors.Is(err, mongo.ErrNoDocuments) {
+				break
+			}
+		}
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// a scope has a single owner and a user is a member once, the successor takes over the
+		// membership of the owner. If this fails in between, the next purge hands the scope over to
+		// the next member.
+		if _, err := collection.DeleteOne(ctx, bson.M{"_id": successor.ID}); err != nil {
+			return err
+		}
+		_, err = collection.UpdateOne(ctx,
+			bson.M{"_id": owner["_id"]},
+			bson.M{"$set": bson.M{"user_id": successor.UserID, "updated_at": bson.NewDateTimeFromTime(time.Now())}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findUsers returns the users with the ids by id, the deleted accounts are missing.
+func findUsers(ctx context.Context, userCollection *mongo.Collection, userIDs []bson.ObjectID) (map[bson.ObjectID]*models.User, error) {
+	cursor, err := userCollection.Find(ctx, bson.M{"_id": bson.M{"$in": userIDs}})
+	if err != nil {
+		return nil, err
+	}
+	var users []*models.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	byID := make(map[bson.ObjectID]*models.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+	return byID, nil
+}
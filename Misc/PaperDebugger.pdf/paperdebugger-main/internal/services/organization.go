@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// OrganizationService manages the organizations (teams), their members and instructions. The
+// prompts of the team libraries are managed by the PromptService.
+type OrganizationService struct {
+	BaseService
+	organizationCollection *mongo.Collection
+	memberCollection       *mongo.Collection
+	promptCollection       *mongo.Collection
+	members                *memberships[bson.ObjectID, models.OrganizationMember, OrganizationMemberInfo]
+}
+
+// UserOrganization is an organization of a user with their role in it.
+type UserOrganization struct {
+	models.Organization
+	Role accesscontrol.Role
+}
+
+// OrganizationMemberInfo is a member with their user, User is nil if the account was deleted.
+type OrganizationMemberInfo struct {
+	models.OrganizationMember
+	User *models.User
+}
+
+func NewOrganizationService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *OrganizationService {
+	base := NewBaseService(db, cfg, logger)
+	members := &memberships[bson.ObjectID, models.OrganizationMember, OrganizationMemberInfo]{
+		collection:     base.db.Collection((models.OrganizationMember{}).CollectionName()),
+		userCollection: base.db.Collection((models.User{}).CollectionName()),
+		scopeKey:       "organization_id",
+		scopeName:      "organization",
+		invite:         true,
+		newMember: func(organizationID bson.ObjectID, base models.BaseModel, membership models.Membership) models.OrganizationMember {
+			return models.OrganizationMember{BaseModel: base, Membership: membership, OrganizationID: organizationID}
+		},
+		newInfo: func(member models.OrganizationMember, user *models.User) *OrganizationMemberInfo {
+			return &OrganizationMemberInfo{OrganizationMember: member, User: user}
+		},
+	}
+	members.createIndexes(logger)
+
+	return &OrganizationService{
+		BaseService:            base,
+		organizationCollection: base.db.Collection((models.Organization{}).CollectionName()),
+		memberCollection:       members.collection,
+		promptCollection:       base.db.Collection((models.Prompt{}).CollectionName()),
+		members:                members,
+	}
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (s *OrganizationService) CreateOrganization(ctx context.Context, userID bson.ObjectID, name string) (*UserOrganization, error) {
+	now := bson.NewDateTimeFromTime(time.Now())
+	organization := models.Organization{
+		BaseModel: models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now},
+		Name:      name,
+	}
+	if _, err := s.organizationCollection.InsertOne(ctx, organization); err != nil {
+		return nil, err
+	}
+	if err := s.members.addOwner(ctx, organization.ID, userID); err != nil {
+		return nil, err
+	}
+	return &UserOrganization{Organization: organization, Role: accesscontrol.RoleOwner}, nil
+}
+
+// ListOrganizations returns the organizations of the user, in the order they joined them.
+func (s *OrganizationService) ListOrganizations(ctx context.Context, userID bson.ObjectID) ([]*UserOrganization, error) {
+	return s.listOrganizations(ctx, bson.M{"user_id": userID, "pending": bson.M{"$ne": true}})
+}
+
+// ListInvitations returns the organizations the user is invited to, with the role they are
+// invited as, in the order they were invited.
+func (s *OrganizationService) ListInvitations(ctx context.Context, userID bson.ObjectID) ([]*UserOrganization, error) {
+	return s.listOrganizations(ctx, bson.M{"user_id": userID, "pending": true})
+}
+
+// AcceptInvitation makes the user a member of the organization they are invited to, from then on
+// its instructions apply to their conversations. It returns mongo.ErrNoDocuments if they are not
+// invited.
+func (s *OrganizationService) AcceptInvitation(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID) (*UserOrganization, error) {
+	role, err := s.members.accept(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	var organization models.Organization
+	if err := s.organizationCollection.FindOne(ctx, bson.M{"_id": organizationID}).Decode(&organization); err != nil {
+		return nil, err
+	}
+	return &UserOrganization{Organization: organization, Role: role}, nil
+}
+
+func (s *OrganizationService) listOrganizations(ctx context.Context, filter bson.M) ([]*UserOrganization, error) {
+	cursor, err := s.memberCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var memberships []models.OrganizationMember
+	if err := cursor.All(ctx, &memberships); err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, nil
+	}
+
+	organizationIDs := make([]bson.ObjectID, len(memberships))
+	for i, membership := range memberships {
+		organizationIDs[i] = membership.OrganizationID
+	}
+	cursor, err = s.organizationCollection.Find(ctx, bson.M{"_id": bson.M{"$in": organizationIDs}})
+	if err != nil {
+		return nil, err
+	}
+	var organizations []models.Organization
+	if err := cursor.All(ctx, &organizations); err != nil {
+		return nil, err
+	}
+	byID := make(map[bson.ObjectID]models.Organization, len(organizations))
+	for _, organization := range organizations {
+		byID[organization.ID] = organization
+	}
+
+	result := make([]*UserOrganization, 0, len(memberships))
+	for _, membership := range memberships {
+		if organization, ok := byID[membership.OrganizationID]; ok {
+			result = append(result, &UserOrganization{Organization: organization, Role: membership.Role})
+		}
+	}
+	return result, nil
+}
+
+// UpdateOrganization sets the name and the instructions of an organization, the ones that are not
+// nil. It requires a role that grants accesscontrol.PermissionWrite.
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID, name *string, instructions *string) (*UserOrganization, error) {
+	role, err := s.Authorize(ctx, userID, organizationID, accesscontrol.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{"updated_at": bson.NewDateTimeFromTime(time.Now())}
+	if name != nil {
+		set["name"] = *name
+	}
+	if instructions != nil {
+		set["instructions"] = *instructions
+	}
+	var organization models.Organization
+	err = s.organizationCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": organizationID},
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&organization)
+	if err != nil {
+		return nil, err
+	}
+	return &UserOrganization{Organization: organization, Role: role}, nil
+}
+
+// DeleteOrganization deletes an organization with its members and its prompts, only the owner can
+// delete it.
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID) error {
+	if _, err := s.Authorize(ctx, userID, organizationID, accesscontrol.PermissionManageMembers); err != nil {
+		return err
+	}
+
+	byOrganization := bson.M{"organization_id": organizationID}
+	if _, err := s.promptCollection.DeleteMany(ctx, byOrganization); err != nil {
+		return err
+	}
+	if _, err := s.memberCollection.DeleteMany(ctx, byOrganization); err != nil {
+		return err
+	}
+	_, err := s.organizationCollection.DeleteOne(ctx, bson.M{"_id": organizationID})
+	return err
+}
+
+// GetRole returns the role of the user in the organization, accesscontrol.RoleNone if they are
+// not a member.
+func (s *OrganizationService) GetRole(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID) (accesscontrol.Role, error) {
+	return s.members.getRole(ctx, userID, organizationID)
+}
+
+// Authorize returns the role of the user in the organization, or shared.ErrPermissionDenied if the
+// role does not grant the permission.
+func (s *OrganizationService) Authorize(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID, permission accesscontrol.Permission) (accesscontrol.Role, error) {
+	return s.members.authorize(ctx, userID, organizationID, permission)
+}
+
+// GetTeamInstructions returns the instructions of the organizations of the user, each one under
+// the name of its organization if there are several. The organizations the user is only invited
+// to are left out, nobody can put instructions in the conversations of a user without their
+// consent.
+func (s *OrganizationService) GetTeamInstructions(ctx context.Context, userID bson.ObjectID) (string, error) {
+	organizations, err := s.ListOrganizations(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return FormatTeamInstructions(organizations), nil
+}
+
+// FormatTeamInstructions joins the instructions of the organizations, see GetTeamInstructions.
+func FormatTeamInstructions(organizations []*UserOrganization) string {
+	var withInstructions []*UserOrganization
+	for _, organization := range organizations {
+		if strings.TrimSpace(organization.Instructions) != "" {
+			withInstructions = append(withInstructions, organization)
+		}
+	}
+	if len(withInstructions) == 1 {
+		return withInstructions[0].Instructions
+	}
+	var sections []string
+	for _, organization := range withInstructions {
+		sections = append(sections, "### "+organization.Name+"\n"+organization.Instructions)
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// ListMembers returns the members of the team and the users invited to it, the owner first.
+func (s *OrganizationService) ListMembers(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID) ([]*OrganizationMemberInfo, error) {
+	return s.members.list(ctx, userID, organizationID)
+}
+
+// AddMember invites a user to the team by the email of their account, they become a member when
+// they accept with AcceptInvitation.
+func (s *OrganizationService) AddMember(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID, email string, role accesscontrol.Role) (*OrganizationMemberInfo, error) {
+	return s.members.add(ctx, userID, organizationID, email, role)
+}
+
+// UpdateMemberRole lets a member manage the team library (editor) or only use it (viewer).
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID, memberID bson.ObjectID, role accesscontrol.Role) (*OrganizationMemberInfo, error) {
+	return s.members.updateRole(ctx, userID, organizationID, memberID, role)
+}
+
+// RemoveMember removes a member from the team or withdraws an invitation, or lets the user leave
+// it or decline the invitation. The owner deletes the organization instead.
+func (s *OrganizationService) RemoveMember(ctx context.Context, userID bson.ObjectID, organizationID bson.ObjectID, memberID bson.ObjectID) error {
+	return s.members.remove(ctx, userID, organizationID, memberID)
+}
@@ -0,0 +1,78 @@
+package services_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestTeamInstructions(t *testing.T) {
+	lab := &services.UserOrganization{Organization: models.Organization{Name: "NLP Lab", Instructions: "Use British spelling."}}
+	venue := &services.UserOrganization{Organization: models.Organization{Name: "ACL Team", Instructions: "Follow the ACL style guide."}}
+	empty := &services.UserOrganization{Organization: models.Organization{Name: "Reading Group", Instructions: "  "}}
+
+	assert.Equal(t, "", services.FormatTeamInstructions(nil))
+	assert.Equal(t, "Use British spelling.", services.FormatTeamInstructions([]*services.UserOrganization{lab, empty}))
+	assert.Equal(t,
+		"### NLP Lab\nUse British spelling.\n\n### ACL Team\nFollow the ACL style guide.",
+		services.FormatTeamInstructions([]*services.UserOrganization{lab, empty, venue}))
+}
+
+func TestOrganizationService_Invitation(t *testing.T) {
+	os.Setenv("PD_MONGO_URI", "mongodb://localhost:27017") // 确保本地有 MongoDB
+	dbInstance, err := db.NewDB(cfg.GetCfg(), logger.GetLogger())
+	if err != nil {
+		t.Fatalf("failed to connect to test db: %v", err)
+	}
+	userService := services.NewUserService(dbInstance, cfg.GetCfg(), logger.GetLogger())
+	organizationService := services.NewOrganizationService(dbInstance, cfg.GetCfg(), logger.GetLogger())
+	ctx := context.Background()
+
+	owner := bson.NewObjectID()
+	invited, err := userService.UpsertUserByEmail(ctx, &models.User{Email: bson.NewObjectID().Hex() + "@example.com"})
+	require.NoError(t, err)
+
+	organization, err := organizationService.CreateOrganization(ctx, owner, "NLP Lab")
+	require.NoError(t, err)
+	t.Cleanup(func() { organizationService.DeleteOrganization(ctx, owner, organization.ID) })
+	instructions := "Use British spelling."
+	_, err = organizationService.UpdateOrganization(ctx, owner, organization.ID, nil, &instructions)
+	require.NoError(t, err)
+
+	member, err := organizationService.AddMember(ctx, owner, organization.ID, invited.Email, accesscontrol.RoleEditor)
+	require.NoError(t, err)
+	assert.True(t, member.Pending)
+
+	// the instructions and the library of the team do not apply before the user accepts
+	teamInstructions, err := organizationService.GetTeamInstructions(ctx, invited.ID)
+	require.NoError(t, err)
+	assert.Empty(t, teamInstructions)
+	role, err := organizationService.GetRole(ctx, invited.ID, organization.ID)
+	require.NoError(t, err)
+	assert.Equal(t, accesscontrol.RoleNone, role)
+	invitations, err := organizationService.ListInvitations(ctx, invited.ID)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	assert.Equal(t, accesscontrol.RoleEditor, invitations[0].Role)
+
+	accepted, err := organizationService.AcceptInvitation(ctx, invited.ID, organization.ID)
+	require.NoError(t, err)
+	assert.Equal(t, accesscontrol.RoleEditor, accepted.Role)
+	teamInstructions, err = organizationService.GetTeamInstructions(ctx, invited.ID)
+	require.NoError(t, err)
+	assert.Equal(t, instructions, teamInstructions)
+
+	_, err = organizationService.AcceptInvitation(ctx, invited.ID, organization.ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/scoring"
+	"paperdebugger/internal/models"
+	toolkitdb "paperdebugger/internal/services/toolkit/db"
+	projectv1 "paperdebugger/pkg/gen/api/project/v1"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	ErrPaperNotScored = errors.New("the paper has not been scored yet, run the paper score first")
+	ErrPaperChanged   = errors.New("the paper changed since it was last scored, run the paper score again")
+)
+
+// PaperScoreService scores projects with the paper scoring service, and keeps their score history.
+type PaperScoreService struct {
+	BaseService
+	paperScoreCollection  *mongo.Collection
+	scoringClient         *scoring.Client
+	projectService        *ProjectService
+	reverseCommentService *ReverseCommentService
+}
+
+func NewPaperScoreService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger, projectService *ProjectService, reverseCommentService *ReverseCommentService) *PaperScoreService {
+	base := NewBaseService(db, cfg, logger)
+	return &PaperScoreService{
+		BaseService:           base,
+		paperScoreCollection:  base.db.Collection((models.PaperScore{}).CollectionName()),
+		scoringClient:         scoring.NewClient(cfg),
+		projectService:        projectService,
+		reverseCommentService: reverseCommentService,
+	}
+}
+
+// ScorePaper scores the current content of the project and adds the score to its history. If the
+// content did not change since the last score, that score is returned and reused is true.
+func (s *PaperScoreService) ScorePaper(ctx context.Context, userID bson.ObjectID, projectID string, conversationID string) (score *models.PaperScore, reused bool, err error) {
+	fullContent, contentHash, err := s.projectContent(ctx, userID, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	latest, err := s.latestPaperScore(ctx, userID, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+	if latest != nil && latest.ContentHash == contentHash {
+		return latest, true, nil
+	}
+
+	if !s.scoringClient.Configured() {
+		return nil, false, scoring.ErrNotConfigured
+	}
+	category, err := s.projectService.GetProjectCategory(ctx, userID, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	result, err := s.scoringClient.ScorePaper(ctx, fullContent, category.Category)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to score paper: %w", err)
+	}
+
+	suggestions := make(map[string][]string, len(result.Suggestions))
+	for key, list := range result.Suggestions {
+		suggestions[key] = list.GetSuggestions()
+	}
+	now := bson.NewDateTimeFromTime(time.Now())
+	score = &models.PaperScore{
+		BaseModel: models.BaseModel{
+			ID:        bson.NewObjectID(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		UserID:         userID,
+		ProjectID:      projectID,
+		ConversationID: conversationID,
+		ContentHash:    contentHash,
+		Category:       category.Category,
+		Score:          result.Score,
+		Percentile:     result.Percentile,
+		Details:        result.Details,
+		Suggestions:    suggestions,
+	}
+	if _, err := s.paperScoreCollection.InsertOne(ctx, score); err != nil {
+		return nil, false, err
+	}
+	return score, false, nil
+}
+
+// CommentPaperScore asks the scoring service for comments on the weaknesses behind the last score of
+// the project, and anchors them in the project documents.
+func (s *PaperScoreService) CommentPaperScore(ctx context.Context, userID bson.ObjectID, projectID string) (*projectv1.PaperScoreCommentResult, []*projectv1.OverleafComment, error) {
+	fullContent, contentHash, err := s.projectContent(ctx, userID, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	latest, err := s.latestPaperScore(ctx, userID, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if latest == nil {
+		return nil, nil, ErrPaperNotScored
+	}
+	if latest.ContentHash != contentHash {
+		return nil, nil, ErrPaperChanged
+	}
+
+	result, err := s.scoringClient.CommentPaperScore(ctx, fullContent, latest.Result())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paper score comment: %w", err)
+	}
+
+	comments, err := s.reverseCommentService.ReverseComments(contextutil.SetProjectID(ctx, projectID), result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to reverse comments: %w", err)
+	}
+	return result, comments, nil
+}
+
+// ListPaperScores returns the last limit scores of the project, newest first.
+func (s *PaperScoreService) ListPaperScores(ctx context.Context, userID bson.ObjectID, projectID string, limit int) ([]*models.PaperScore, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := s.paperScoreCollection.Find(ctx, bson.M{"user_id": userID, "project_id": projectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	scores := []*models.PaperScore{}
+	if err := cursor.All(ctx, &scores); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+func (s *PaperScoreService) latestPaperScore(ctx context.Context, userID bson.ObjectID, projectID string) (*models.PaperScore, error) {
+	scores, err := s.ListPaperScores(ctx, userID, projectID, 1)
+	if err != nil || len(scores) == 0 {
+		return nil, err
+	}
+	return scores[0], nil
+}
+
+func (s *PaperScoreService) projectContent(ctx context.Context, userID bson.ObjectID, projectID string) (fullContent string, contentHash string, err error) {
+	project, err := s.projectService.GetProject(ctx, userID, projectID)
+	if err != nil {
+		return "", "", err
+	}
+	fullContent, err = project.GetFullContent()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get paper full content: %w", err)
+	}
+	return fullContent, toolkitdb.HashContent(fullContent), nil
+}
@@ -1,17 +1,16 @@
 package services
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"time"
 
 	"paperdebugger/internal/libs/cfg"
 	"paperdebugger/internal/libs/db"
 	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/scoring"
+	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/models"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -21,20 +20,34 @@ import (
 type ProjectService struct {
 	BaseService
 	projectCollection *mongo.Collection
+	scoringClient     *scoring.Client
+	classifier        PaperClassifier
 }
 
 type ClassifyPaperRequest struct {
 	LatexSource string `json:"latexSource"`
 }
 
+// PaperClassifier classifies the papers when the scoring service is not configured.
+type PaperClassifier interface {
+	ClassifyPaper(ctx context.Context, latexSource string) (*models.ClassifyPaperResponse, error)
+}
+
 func NewProjectService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *ProjectService {
 	base := NewBaseService(db, cfg, logger)
 	return &ProjectService{
 		BaseService:       base,
 		projectCollection: base.db.Collection((models.Project{}).CollectionName()),
+		scoringClient:     scoring.NewClient(cfg),
 	}
 }
 
+// SetPaperClassifier sets the classifier used when the scoring service is not configured. It must
+// be called before the service is used.
+func (s *ProjectService) SetPaperClassifier(classifier PaperClassifier) {
+	s.classifier = classifier
+}
+
 func (s *ProjectService) UpsertProject(ctx context.Context, userID bson.ObjectID, projectID string, project *models.Project) (*models.Project, error) {
 	existingProject, err := s.GetProject(ctx, userID, projectID)
 	if err != nil && err != mongo.ErrNoDocuments {
@@ -124,9 +137,9 @@ func (s *ProjectService) GetProjectCategory(ctx context.Context, userID bson.Obj
 		return models.ClassifyPaperResponse{}, fmt.Errorf("failed to get full content: %w", err)
 	}
 
-	category, err := s.fetchCategoryFromAPI(fullContent)
+	category, err := s.classifyPaper(ctx, fullContent)
 	if err != nil {
-		return models.ClassifyPaperResponse{}, fmt.Errorf("failed to fetch category from API: %w", err)
+		return models.ClassifyPaperResponse{}, err
 	}
 
 	err = s.UpdateProjectCategory(ctx, userID, projectID, *category)
@@ -138,68 +151,99 @@ func (s *ProjectService) GetProjectCategory(ctx context.Context, userID bson.Obj
 	return *category, nil
 }
 
-func (s *ProjectService) fetchCategoryFromAPI(latexSource string) (*models.ClassifyPaperResponse, error) {
-	reqBody := ClassifyPaperRequest{
-		LatexSource: latexSource,
+func (s *ProjectService) classifyPaper(ctx context.Context, latexSource string) (*models.ClassifyPaperResponse, error) {
+	if !s.scoringClient.Configured() && s.classifier != nil {
+		category, err := s.classifier.ClassifyPaper(ctx, latexSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to classify paper: %w", err)
+		}
+		return category, nil
 	}
 
-	jsonData, err := json.Marshal(reqBody)
+	var category models.ClassifyPaperResponse
+	err := s.scoringClient.Post(ctx, "/classify-paper", ClassifyPaperRequest{LatexSource: latexSource}, &category)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		return nil, fmt.Errorf("failed to fetch category from API: %w", err)
 	}
+	return &category, nil
+}
 
-	req, err := http.NewRequest("POST", "http://paperdebugger-mcp-server:8000/classify-paper", bytes.NewBuffer(jsonData))
+func (s *ProjectService) GetProjectInstructions(ctx context.Context, userID bson.ObjectID, projectID string) (string, error) {
+	project, err := s.GetProject(ctx, userID, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return "", err
 	}
+	return project.Instructions, nil
+}
 
-	req.Header.Set("Content-Type", "application/json")
+func (s *ProjectService) UpsertProjectInstructions(ctx context.Context, userID bson.ObjectID, projectID string, instructions string) (string, error) {
+	filter := bson.M{"user_id": userID, "project_id": projectID}
+	update := bson.M{
+		"$set": bson.M{
+			"instructions": instructions,
+			"updated_at":   bson.NewDateTimeFromTime(time.Now()),
+		},
+	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	result, err := s.projectCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
+		return "", err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
+	if result.MatchedCount == 0 {
+		return "", mongo.ErrNoDocuments
 	}
 
-	var response models.ClassifyPaperResponse
-	err = json.NewDecoder(resp.Body).Decode(&response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	return instructions, nil
+}
+
+// UpdateProjectPersona sets the persona of the new conversations of the project which do not
+// choose one, "" for the default one.
+func (s *ProjectService) UpdateProjectPersona(ctx context.Context, userID bson.ObjectID, projectID string, persona models.Persona) (models.Persona, error) {
+	if !persona.IsProjectPersona() {
+		return "", shared.ErrBadRequest("unknown persona " + string(persona))
+	}
+	if persona == models.PersonaDefault {
+		persona = ""
 	}
 
-	return &response, nil
-}
+	filter := bson.M{"user_id": userID, "project_id": projectID}
+	update := bson.M{
+		"$set": bson.M{
+			"default_persona": persona,
+			"updated_at":      bson.NewDateTimeFromTime(time.Now()),
+		},
+	}
 
-func (s *ProjectService) GetProjectInstructions(ctx context.Context, userID bson.ObjectID, projectID string) (string, error) {
-	project, err := s.GetProject(ctx, userID, projectID)
+	result, err := s.projectCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return "", err
 	}
-	return project.Instructions, nil
+
+	if result.MatchedCount == 0 {
+		return "", mongo.ErrNoDocuments
+	}
+
+	return persona, nil
 }
 
-func (s *ProjectService) UpsertProjectInstructions(ctx context.Context, userID bson.ObjectID, projectID string, instructions string) (string, error) {
+func (s *ProjectService) UpdateProjectPinnedTools(ctx context.Context, userID bson.ObjectID, projectID string, pinnedTools []string) ([]string, error) {
 	filter := bson.M{"user_id": userID, "project_id": projectID}
 	update := bson.M{
 		"$set": bson.M{
-			"instructions": instructions,
+			"pinned_tools": pinnedTools,
 			"updated_at":   bson.NewDateTimeFromTime(time.Now()),
 		},
 	}
 
 	result, err := s.projectCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if result.MatchedCount == 0 {
-		return "", mongo.ErrNoDocuments
+		return nil, mongo.ErrNoDocuments
 	}
 
-	return instructions, nil
+	return pinnedTools, nil
 }
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ProjectMemberService manages the members of the Overleaf projects and checks their permissions.
+// The first user who syncs a project becomes its owner, the owner adds the coauthors as editors or
+// viewers. When the account of the owner is deleted, a coauthor becomes the owner.
+type ProjectMemberService struct {
+	BaseService
+	members *memberships[string, models.ProjectMember, ProjectMemberInfo]
+}
+
+// ProjectMemberInfo is a member with their user, User is nil if the account was deleted.
+type ProjectMemberInfo struct {
+	models.ProjectMember
+	User *models.User
+}
+
+func NewProjectMemberService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *ProjectMemberService {
+	base := NewBaseService(db, cfg, logger)
+	members := &memberships[string, models.ProjectMember, ProjectMemberInfo]{
+		collection:     base.db.Collection((models.ProjectMember{}).CollectionName()),
+		userCollection: base.db.Collection((models.User{}).CollectionName()),
+		scopeKey:       "project_id",
+		scopeName:      "project",
+		newMember: func(projectID string, base models.BaseModel, membership models.Membership) models.ProjectMember {
+			return models.ProjectMember{BaseModel: base, Membership: membership, ProjectID: projectID}
+		},
+		newInfo: func(member models.ProjectMember, user *models.User) *ProjectMemberInfo {
+			return &ProjectMemberInfo{ProjectMember: member, User: user}
+		},
+	}
+	members.createIndexes(logger)
+
+	return &ProjectMemberService{
+		BaseService: base,
+		members:     members,
+	}
+}
+
+// ClaimProject makes the user the owner of the project if it has no members yet. The members of a
+// project are only added by its owner, an unknown user syncing it later does not join it.
+func (s *ProjectMemberService) ClaimProject(ctx context.Context, userID bson.ObjectID, projectID string) error {
+	count, err := s.members.collection.CountDocuments(ctx, bson.M{"project_id": projectID}, options.Count().SetLimit(1))
+	if err != nil || count > 0 {
+		return err
+	}
+	err = s.members.addOwner(ctx, projectID, userID)
+	if mongo.IsDuplicateKeyError(err) {
+		// the project has an owner, or the user is already a member
+		return nil
+	}
+	return err
+}
+
+// GetRole returns the role of the user in the project, accesscontrol.RoleNone if they are not a
+// member.
+func (s *ProjectMemberService) GetRole(ctx context.Context, userID bson.ObjectID, projectID string) (accesscontrol.Role, error) {
+	return s.members.getRole(ctx, userID, projectID)
+}
+
+// Authorize returns the role of the user in the project, or shared.ErrPermissionDenied if the role
+// does not grant the permission.
+func (s *ProjectMemberService) Authorize(ctx context.Context, userID bson.ObjectID, projectID string, permission accesscontrol.Permission) (accesscontrol.Role, error) {
+	return s.members.authorize(ctx, userID, projectID, permission)
+}
+
+// ListMembers returns the members of the project, the owner first.
+func (s *ProjectMemberService) ListMembers(ctx context.Context, userID bson.ObjectID, projectID string) ([]*ProjectMemberInfo, error) {
+	return s.members.list(ctx, userID, projectID)
+}
+
+// AddMember adds a coauthor to the project by the email of their account.
+func (s *ProjectMemberService) AddMember(ctx context.Context, userID bson.ObjectID, projectID string, email string, role accesscontrol.Role) (*ProjectMemberInfo, error) {
+	return s.members.add(ctx, userID, projectID, email, role)
+}
+
+// UpdateMemberRole changes the role of a coauthor.
+func (s *ProjectMemberService) UpdateMemberRole(ctx context.Context, userID bson.ObjectID, projectID string, memberID bson.ObjectID, role accesscontrol.Role) (*ProjectMemberInfo, error) {
+	return s.members.updateRole(ctx, userID, projectID, memberID, role)
+}
+
+// RemoveMember removes a coauthor from the project, or lets them leave it.
+func (s *ProjectMemberService) RemoveMember(ctx context.Context, userID bson.ObjectID, projectID string, memberID bson.ObjectID) error {
+	return s.members.remove(ctx, userID, projectID, memberID)
+}
@@ -5,31 +5,120 @@ import (
 	"errors"
 	"time"
 
+	"paperdebugger/internal/accesscontrol"
 	"paperdebugger/internal/libs/cfg"
 	"paperdebugger/internal/libs/db"
 	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
 	"paperdebugger/internal/models"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type PromptService struct {
 	BaseService
-	promptCollection *mongo.Collection
+	promptCollection      *mongo.Collection
+	promptUsageCollection *mongo.Collection
+	promptShareCollection *mongo.Collection
+	organizationService   *OrganizationService
+	defaultPromptService  *DefaultPromptService
 }
 
-func NewPromptService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *PromptService {
+func NewPromptService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger, organizationService *OrganizationService, defaultPromptService *DefaultPromptService) *PromptService {
 	base := NewBaseService(db, cfg, logger)
+	usageCollection := base.db.Collection((models.PromptUsage{}).CollectionName())
+	shareCollection := base.db.Collection((models.PromptShare{}).CollectionName())
+
+	_, err := usageCollection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "prompt_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "prompt_id", Value: 1}},
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for prompt_usages collection", err)
+	}
+
+	// a user shares a prompt once
+	_, err = shareCollection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "token", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "prompt_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for prompt_shares collection", err)
+	}
+
 	return &PromptService{
-		BaseService:      base,
-		promptCollection: base.db.Collection((models.Prompt{}).CollectionName()),
+		BaseService:           base,
+		promptCollection:      base.db.Collection((models.Prompt{}).CollectionName()),
+		promptUsageCollection: usageCollection,
+		promptShareCollection: shareCollection,
+		organizationService:   organizationService,
+		defaultPromptService:  defaultPromptService,
+	}
+}
+
+// GetPrompt returns a prompt the user can use: one of their prompts, a team prompt of one of
+// their organizations, or a built-in prompt. It returns mongo.ErrNoDocuments for the other ones.
+func (s *PromptService) GetPrompt(ctx context.Context, userID bson.ObjectID, promptID string) (*models.Prompt, error) {
+	objectID, err := bson.ObjectIDFromHex(promptID)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+
+	var prompt models.Prompt
+	err = s.promptCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}).Decode(&prompt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		defaultPrompt, err := s.defaultPromptService.GetDefaultPrompt(ctx, objectID)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Prompt{
+			BaseModel: defaultPrompt.BaseModel,
+			Title:     defaultPrompt.Title,
+			Content:   defaultPrompt.Content,
+		}, nil
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !prompt.OrganizationID.IsZero() {
+		role, err := s.organizationService.GetRole(ctx, userID, prompt.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		if !role.Can(accesscontrol.PermissionRead) {
+			return nil, mongo.ErrNoDocuments
+		}
+	} else if prompt.UserID != userID {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &prompt, nil
 }
 
+// ListPrompts returns the prompts of the user, without the team prompts they wrote.
 func (s *PromptService) ListPrompts(ctx context.Context, userID bson.ObjectID) ([]*models.Prompt, error) {
 	filter := bson.M{
-		"user_id": userID,
+		"user_id":         userID,
+		"organization_id": bson.M{"$exists": false},
 		"$or": []bson.M{
 			{"deleted_at": nil},
 			{"deleted_at": bson.M{"$exists": false}},
@@ -49,10 +138,46 @@ func (s *PromptService) ListPrompts(ctx context.Context, userID bson.ObjectID) (
 	return prompts, nil
 }
 
+// ListOrganizationPrompts returns the prompts of the team libraries of the organizations.
+func (s *PromptService) ListOrganizationPrompts(ctx context.Context, organizationIDs []bson.ObjectID) ([]*models.Prompt, error) {
+	if len(organizationIDs) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{
+		"organization_id": bson.M{"$in": organizationIDs},
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}
+
+	cursor, err := s.promptCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var prompts []*models.Prompt
+	if err := cursor.All(ctx, &prompts); err != nil {
+		return nil, err
+	}
+	return prompts, nil
+}
+
+// CreatePrompt creates a prompt of the user, or a team prompt if prompt.OrganizationID is set,
+// which requires a role that grants accesscontrol.PermissionWrite in the organization.
 func (s *PromptService) CreatePrompt(ctx context.Context, userID bson.ObjectID, prompt *models.Prompt) (*models.Prompt, error) {
 	if prompt == nil {
 		return nil, errors.New("prompt cannot be nil")
 	}
+	if err := ValidatePromptTemplate(prompt.Content, prompt.Variables); err != nil {
+		return nil, err
+	}
+	if !prompt.OrganizationID.IsZero() {
+		if _, err := s.organizationService.Authorize(ctx, userID, prompt.OrganizationID, accesscontrol.PermissionWrite); err != nil {
+			return nil, err
+		}
+	}
 
 	prompt.BaseModel = models.BaseModel{
 		ID:        bson.NewObjectID(),
@@ -73,6 +198,9 @@ func (s *PromptService) UpdatePrompt(ctx context.Context, userID bson.ObjectID,
 	if updates == nil {
 		return nil, errors.New("updates cannot be nil")
 	}
+	if err := ValidatePromptTemplate(updates.Content, updates.Variables); err != nil {
+		return nil, err
+	}
 
 	objectID, err := bson.ObjectIDFromHex(promptID)
 	if err != nil {
@@ -84,12 +212,13 @@ func (s *PromptService) UpdatePrompt(ctx context.Context, userID bson.ObjectID,
 		return nil, err
 	}
 
-	if existing.UserID != userID {
-		return nil, errors.New("cannot update other user's prompts")
+	if err := s.authorizePromptWrite(ctx, userID, existing); err != nil {
+		return nil, err
 	}
 
 	updates.ID = existing.ID
 	updates.UserID = existing.UserID
+	updates.OrganizationID = existing.OrganizationID
 	updates.CreatedAt = existing.CreatedAt
 	updates.UpdatedAt = bson.NewDateTimeFromTime(time.Now())
 
@@ -118,8 +247,8 @@ func (s *PromptService) DeletePrompt(ctx context.Context, userID bson.ObjectID,
 		return err
 	}
 
-	if existing.UserID != userID {
-		return errors.New("cannot delete other user's prompts")
+	if err := s.authorizePromptWrite(ctx, userID, existing); err != nil {
+		return err
 	}
 
 	now := bson.NewDateTimeFromTime(time.Now())
@@ -137,6 +266,70 @@ func (s *PromptService) DeletePrompt(ctx context.Context, userID bson.ObjectID,
 	return err
 }
 
+// ListDeletedPrompts returns the deleted prompts of the user, most recently deleted first. The
+// deleted team prompts are not listed.
+func (s *PromptService) ListDeletedPrompts(ctx context.Context, userID bson.ObjectID) ([]*models.Prompt, error) {
+	filter := bson.M{
+		"user_id":         userID,
+		"organization_id": bson.M{"$exists": false},
+		"deleted_at":      bson.M{"$ne": nil},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}})
+	cursor, err := s.promptCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var prompts []*models.Prompt
+	if err := cursor.All(ctx, &prompts); err != nil {
+		return nil, err
+	}
+	return prompts, nil
+}
+
+// RestorePrompt undoes DeletePrompt, it returns mongo.ErrNoDocuments if the prompt is not deleted
+// (or was deleted for good).
+func (s *PromptService) RestorePrompt(ctx context.Context, userID bson.ObjectID, promptID string) (*models.Prompt, error) {
+	objectID, err := bson.ObjectIDFromHex(promptID)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := &models.Prompt{}
+	err = s.promptCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id":             objectID,
+			"user_id":         userID,
+			"organization_id": bson.M{"$exists": false},
+			"deleted_at":      bson.M{"$ne": nil},
+		},
+		bson.M{
+			"$unset": bson.M{"deleted_at": ""},
+			"$set":   bson.M{"updated_at": bson.NewDateTimeFromTime(time.Now())},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(prompt)
+	if err != nil {
+		return nil, err
+	}
+	return prompt, nil
+}
+
+// authorizePromptWrite checks that the user can change the prompt: their own prompts, and the
+// team prompts of the organizations in which they are editors.
+func (s *PromptService) authorizePromptWrite(ctx context.Context, userID bson.ObjectID, prompt *models.Prompt) error {
+	if !prompt.OrganizationID.IsZero() {
+		_, err := s.organizationService.Authorize(ctx, userID, prompt.OrganizationID, accesscontrol.PermissionWrite)
+		return err
+	}
+	if prompt.UserID != userID {
+		return shared.ErrPermissionDenied("cannot change other user's prompts")
+	}
+	return nil
+}
+
 func (s *PromptService) getPromptByID(ctx context.Context, id bson.ObjectID) (*models.Prompt, error) {
 	result := s.promptCollection.FindOne(ctx, bson.M{
 		"_id": id,
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// SharePrompt returns the share of a prompt the user can edit, it is created on the first call.
+// Built-in prompts are not shared, every user has them.
+func (s *PromptService) SharePrompt(ctx context.Context, userID bson.ObjectID, promptID string) (*models.PromptShare, error) {
+	prompt, err := s.GetPrompt(ctx, userID, promptID)
+	if err != nil {
+		return nil, err
+	}
+	if prompt.UserID.IsZero() {
+		return nil, shared.ErrBadRequest("built-in prompts are available to every user")
+	}
+	if err := s.authorizePromptWrite(ctx, userID, prompt); err != nil {
+		return nil, err
+	}
+
+	share, err := s.getPromptShare(ctx, userID, prompt.ID)
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return share, err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	share = &models.PromptShare{
+		BaseModel: models.BaseModel{ID: bson.NewObjectID(), CreatedAt: now, UpdatedAt: now},
+		UserID:    userID,
+		PromptID:  prompt.ID,
+		Token:     rand.Text(),
+	}
+	_, err = s.promptShareCollection.InsertOne(ctx, share)
+	if mongo.IsDuplicateKeyError(err) {
+		// shared meanwhile by a concurrent request
+		return s.getPromptShare(ctx, userID, prompt.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// RevokePromptShare deletes the share of a prompt, its token stops working. It returns
+// mongo.ErrNoDocuments if the user did not share the prompt.
+func (s *PromptService) RevokePromptShare(ctx context.Context, userID bson.ObjectID, promptID string) error {
+	objectID, err := bson.ObjectIDFromHex(promptID)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	result, err := s.promptShareCollection.DeleteOne(ctx, bson.M{"prompt_id": objectID, "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ImportSharedPrompt copies the prompt of a share to the prompts of the user. It returns
+// mongo.ErrNoDocuments if the token is unknown, the prompt was deleted, or the user who shared it
+// can no longer edit it.
+func (s *PromptService) ImportSharedPrompt(ctx context.Context, userID bson.ObjectID, token string) (*models.Prompt, error) {
+	var share models.PromptShare
+	if err := s.promptShareCollection.FindOne(ctx, bson.M{"token": token}).Decode(&share); err != nil {
+		return nil, err
+	}
+
+	var prompt models.Prompt
+	err := s.promptCollection.FindOne(ctx, bson.M{
+		"_id": share.PromptID,
+		"$or": []bson.M{
+			{"deleted_at": nil},
+			{"deleted_at": bson.M{"$exists": false}},
+		},
+	}).Decode(&prompt)
+	if err != nil {
+		return nil, err
+	}
+	if !prompt.OrganizationID.IsZero() {
+		role, err := s.organizationService.GetRole(ctx, share.UserID, prompt.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		if !role.Can(accesscontrol.PermissionWrite) {
+			return nil, mongo.ErrNoDocuments
+		}
+	}
+
+	imported, err := s.CreatePrompt(ctx, userID, &models.Prompt{
+		Title:     prompt.Title,
+		Content:   prompt.Content,
+		Variables: prompt.Variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.promptShareCollection.UpdateOne(ctx, bson.M{"_id": share.ID}, bson.M{"$inc": bson.M{"imports": 1}})
+	if err != nil {
+		s.logger.Error("Failed to count the import of a shared prompt", err)
+	}
+	return imported, nil
+}
+
+func (s *PromptService) getPromptShare(ctx context.Context, userID bson.ObjectID, promptID bson.ObjectID) (*models.PromptShare, error) {
+	var share models.PromptShare
+	err := s.promptShareCollection.FindOne(ctx, bson.M{"prompt_id": promptID, "user_id": userID}).Decode(&share)
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+)
+
+var promptVariableNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// promptPlaceholderPattern matches {{name}} and {{ name }}. Only the declared variables are
+// placeholders, the other double braces of the content (e.g. LaTeX) are kept as they are.
+var promptPlaceholderPattern = regexp.MustCompile(`\{\{\s*([a-z][a-z0-9_]*)\s*\}\}`)
+
+// ValidatePromptTemplate checks the variables of a prompt: valid and unique names, known types,
+// options for the choices, defaults of the right type, and a placeholder in the content for
+// every variable.
+func ValidatePromptTemplate(content string, variables []models.PromptVariable) error {
+	placeholders := map[string]bool{}
+	for _, match := range promptPlaceholderPattern.FindAllStringSubmatch(content, -1) {
+		placeholders[match[1]] = true
+	}
+
+	names := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		if !promptVariableNamePattern.MatchString(variable.Name) {
+			return shared.ErrBadRequest(fmt.Sprintf("invalid variable name %q, use lowercase letters, digits and underscores", variable.Name))
+		}
+		if names[variable.Name] {
+			return shared.ErrBadRequest(fmt.Sprintf("variable %q is declared twice", variable.Name))
+		}
+		names[variable.Name] = true
+
+		if !placeholders[variable.Name] {
+			return shared.ErrBadRequest(fmt.Sprintf("variable %q has no {{%s}} placeholder in the content", variable.Name, variable.Name))
+		}
+
+		switch variable.Type {
+		case models.PromptVariableText, models.PromptVariableNumber, models.PromptVariableSelectedText:
+			if len(variable.Options) > 0 {
+				return shared.ErrBadRequest(fmt.Sprintf("variable %q: only choices have options", variable.Name))
+			}
+		case models.PromptVariableChoice:
+			if len(variable.Options) == 0 {
+				return shared.ErrBadRequest(fmt.Sprintf("variable %q: a choice needs options", variable.Name))
+			}
+		default:
+			return shared.ErrBadRequest(fmt.Sprintf("variable %q: unknown type %q", variable.Name, variable.Type))
+		}
+
+		if variable.Default != "" {
+			if err := checkPromptVariableValue(variable, variable.Default); err != nil {
+				return shared.ErrBadRequest(fmt.Sprintf("variable %q: invalid default: %s", variable.Name, err))
+			}
+		}
+	}
+	return nil
+}
+
+// RenderPromptTemplate fills the placeholders of the variables with the values, or with their
+// defaults. It fails on a missing required value, a value of the wrong type, or a value for an
+// unknown variable.
+func RenderPromptTemplate(content string, variables []models.PromptVariable, values map[string]string) (string, error) {
+	filled := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		value, ok := values[variable.Name]
+		if !ok || value == "" {
+			value = variable.Default
+		}
+		if value == "" {
+			if variable.Required {
+				return "", shared.ErrBadRequest(fmt.Sprintf("variable %q is required", variable.Name))
+			}
+		} else if err := checkPromptVariableValue(variable, value); err != nil {
+			return "", shared.ErrBadRequest(fmt.Sprintf("variable %q: %s", variable.Name, err))
+		}
+		filled[variable.Name] = value
+	}
+	for name := range values {
+		if _, ok := filled[name]; !ok {
+			return "", shared.ErrBadRequest(fmt.Sprintf("unknown variable %q", name))
+		}
+	}
+
+	return promptPlaceholderPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := promptPlaceholderPattern.FindStringSubmatch(placeholder)[1]
+		if value, ok := filled[name]; ok {
+			return value
+		}
+		return placeholder
+	}), nil
+}
+
+func checkPromptVariableValue(variable models.PromptVariable, value string) error {
+	switch variable.Type {
+	case models.PromptVariableNumber:
+		if _, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	case models.PromptVariableChoice:
+		if !slices.Contains(variable.Options, value) {
+			return fmt.Errorf("%q is not one of %s", value, strings.Join(variable.Options, ", "))
+		}
+	}
+	return nil
+}
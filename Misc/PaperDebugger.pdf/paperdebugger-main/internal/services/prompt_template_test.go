@@ -0,0 +1,67 @@
+package services_test
+
+import (
+	"testing"
+
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rebuttalVariables = []models.PromptVariable{
+	{Name: "venue", Type: models.PromptVariableText, Required: true},
+	{Name: "tone", Type: models.PromptVariableChoice, Options: []string{"polite", "firm"}, Default: "polite"},
+	{Name: "word_limit", Type: models.PromptVariableNumber},
+	{Name: "selected_text", Type: models.PromptVariableSelectedText, Required: true},
+}
+
+const rebuttalContent = `Write a {{tone}} rebuttal for {{ venue }} in at most {{word_limit}} words to: {{selected_text}}. Keep \textbf{{x}} as is.`
+
+func TestValidatePromptTemplate(t *testing.T) {
+	require.NoError(t, services.ValidatePromptTemplate(rebuttalContent, rebuttalVariables))
+	require.NoError(t, services.ValidatePromptTemplate("No variables {{here}}", nil))
+
+	cases := map[string][]models.PromptVariable{
+		"invalid name": {{Name: "Venue", Type: models.PromptVariableText}},
+		"declared twice": {
+			{Name: "venue", Type: models.PromptVariableText},
+			{Name: "venue", Type: models.PromptVariableText},
+		},
+		"no placeholder":     {{Name: "deadline", Type: models.PromptVariableText}},
+		"unknown type":       {{Name: "venue", Type: "date"}},
+		"choice without":     {{Name: "tone", Type: models.PromptVariableChoice}},
+		"options not choice": {{Name: "venue", Type: models.PromptVariableText, Options: []string{"ACL"}}},
+		"bad default":        {{Name: "word_limit", Type: models.PromptVariableNumber, Default: "many"}},
+	}
+	for name, variables := range cases {
+		assert.Error(t, services.ValidatePromptTemplate(rebuttalContent, variables), name)
+	}
+}
+
+func TestRenderPromptTemplate(t *testing.T) {
+	rendered, err := services.RenderPromptTemplate(rebuttalContent, rebuttalVariables, map[string]string{
+		"venue":         "ACL",
+		"word_limit":    "500",
+		"selected_text": "the baseline is weak",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `Write a polite rebuttal for ACL in at most 500 words to: the baseline is weak. Keep \textbf{{x}} as is.`, rendered)
+
+	_, err = services.RenderPromptTemplate(rebuttalContent, rebuttalVariables, map[string]string{"selected_text": "x"})
+	assert.ErrorContains(t, err, "venue")
+
+	_, err = services.RenderPromptTemplate(rebuttalContent, rebuttalVariables, map[string]string{"venue": "ACL", "selected_text": "x", "tone": "rude"})
+	assert.ErrorContains(t, err, "tone")
+
+	_, err = services.RenderPromptTemplate(rebuttalContent, rebuttalVariables, map[string]string{"venue": "ACL", "selected_text": "x", "word_limit": "five"})
+	assert.ErrorContains(t, err, "word_limit")
+
+	_, err = services.RenderPromptTemplate(rebuttalContent, rebuttalVariables, map[string]string{"venue": "ACL", "selected_text": "x", "deadline": "Friday"})
+	assert.ErrorContains(t, err, "deadline")
+
+	rendered, err = services.RenderPromptTemplate("Plain {{text}}", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Plain {{text}}", rendered)
+}
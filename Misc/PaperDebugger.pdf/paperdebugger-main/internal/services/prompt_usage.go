@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// PromptStats is the usage of a prompt, by the user and by all the users.
+type PromptStats struct {
+	PromptID   bson.ObjectID  `bson:"_id"`
+	Uses       int64          `bson:"uses"`
+	LastUsedAt *bson.DateTime `bson:"last_used_at"` // nil if the user never used the prompt
+	TotalUses  int64          `bson:"total_uses"`
+	Users      int64          `bson:"users"`
+}
+
+// RecordPromptUsage counts a message the user sent from a prompt, which must be one they can use
+// (see GetPrompt).
+func (s *PromptService) RecordPromptUsage(ctx context.Context, userID bson.ObjectID, promptID bson.ObjectID) error {
+	now := bson.NewDateTimeFromTime(time.Now())
+	_, err := s.promptUsageCollection.UpdateOne(ctx,
+		bson.M{"user_id": userID, "prompt_id": promptID},
+		bson.M{
+			"$inc":         bson.M{"count": 1},
+			"$set":         bson.M{"last_used_at": now, "updated_at": now},
+			"$setOnInsert": bson.M{"_id": bson.NewObjectID(), "created_at": now},
+		},
+		options.UpdateOne().SetUpsert(true),
+	)
+	return err
+}
+
+// GetPromptStats returns the usage of the prompts of the library of the user, most used first.
+// The team and built-in prompts count the uses of all the users, the prompts never used are left
+// out.
+func (s *PromptService) GetPromptStats(ctx context.Context, userID bson.ObjectID) ([]*PromptStats, error) {
+	promptIDs, err := s.libraryPromptIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(promptIDs) == 0 {
+		return nil, nil
+	}
+
+	byUser := bson.M{"$eq": bson.A{"$user_id", userID}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"prompt_id": bson.M{"$in": promptIDs}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$prompt_id",
+			"uses":         bson.M{"$sum": bson.M{"$cond": bson.A{byUser, "$count", 0}}},
+			"last_used_at": bson.M{"$max": bson.M{"$cond": bson.A{byUser, "$last_used_at", nil}}},
+			"total_uses":   bson.M{"$sum": "$count"},
+			"users":        bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total_uses", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	cursor, err := s.promptUsageCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []*PromptStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// libraryPromptIDs returns the ids of the prompts the user can use: their prompts, the team
+// prompts of their organizations and the built-in prompts.
+func (s *PromptService) libraryPromptIDs(ctx context.Context, userID bson.ObjectID) ([]bson.ObjectID, error) {
+	prompts, err := s.ListPrompts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := s.organizationService.ListOrganizations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organizationIDs := make([]bson.ObjectID, len(organizations))
+	for i, organization := range organizations {
+		organizationIDs[i] = organization.ID
+	}
+	teamPrompts, err := s.ListOrganizationPrompts(ctx, organizationIDs)
+	if err != nil {
+		return nil, err
+	}
+	defaultPrompts, err := s.defaultPromptService.ListDefaultPrompts(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]bson.ObjectID, 0, len(prompts)+len(teamPrompts)+len(defaultPrompts))
+	for _, prompt := range append(prompts, teamPrompts...) {
+		ids = append(ids, prompt.ID)
+	}
+	for _, prompt := range defaultPrompts {
+		ids = append(ids, prompt.ID)
+	}
+	return ids, nil
+}
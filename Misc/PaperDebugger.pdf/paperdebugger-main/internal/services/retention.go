@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// retentionInterval is how often the deleted records past the retention period are looked for.
+const retentionInterval = time.Hour
+
+// RetentionService deletes for good the conversations and prompts that were deleted longer than
+// cfg.TrashRetention ago, with the records of their conversations: tool calls, tool jobs, text
+// edits and comments, and the usage records and shares of the prompts. It also deletes the accounts whose deletion was requested longer than
+// cfg.AccountDeletionGracePeriod ago, with all their data.
+type RetentionService struct {
+	BaseService
+	conversationCollection *mongo.Collection
+	promptCollection       *mongo.Collection
+	userCollection         *mongo.Collection
+
+	stop chan struct{}
+	done sync.WaitGroup
+}
+
+// PurgeResult counts the records deleted by a purge.
+type PurgeResult struct {
+	Conversations int64
+	Prompts       int64
+	FunctionCalls int64
+	Comments      int64
+	Accounts      int64
+}
+
+// NewRetentionService starts purging every retentionInterval.
+func NewRetentionService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *RetentionService {
+	base := NewBaseService(db, cfg, logger)
+	s := &RetentionService{
+		BaseService:            base,
+		conversationCollection: base.db.Collection((models.Conversation{}).CollectionName()),
+		promptCollection:       base.db.Collection((models.Prompt{}).CollectionName()),
+		userCollection:         base.db.Collection((models.User{}).CollectionName()),
+		stop:                   make(chan struct{}),
+	}
+
+	for _, collection := range []*mongo.Collection{s.conversationCollection, s.promptCollection} {
+		_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		})
+		if err != nil {
+			logger.Error("Failed to create indexes for "+collection.Name()+" collection", err)
+		}
+	}
+	_, err := s.userCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "deletion_requested_at", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for users collection", err)
+	}
+
+	s.done.Add(1)
+	go s.run()
+	return s
+}
+
+func (s *RetentionService) run() {
+	defer s.done.Done()
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), retentionInterval)
+		var result PurgeResult
+		var err error
+		if s.cfg.TrashRetention > 0 {
+			result, err = s.Purge(ctx, time.Now().Add(-s.cfg.TrashRetention))
+		}
+		if err == nil {
+			result.Accounts, err = s.PurgeAccounts(ctx, time.Now().Add(-s.cfg.AccountDeletionGracePeriod))
+		}
+		cancel()
+		if err != nil {
+			s.logger.Error("[Retention] failed to purge the deleted records", "error", err)
+		} else if result.Conversations > 0 || result.Prompts > 0 || result.Accounts > 0 {
+			s.logger.Info("[Retention] purged the deleted records", "result", result)
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops purging, it waits for a running purge to finish.
+func (s *RetentionService) Close() {
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	s.done.Wait()
+}
+
+// Purge deletes for good the conversations and prompts deleted before the given time. A
+// conversation is deleted after its records, and a prompt after its usage records and shares, so
+// that a failed purge is resumed by the next one.
+func (s *RetentionService) Purge(ctx context.Context, deletedBefore time.Time) (PurgeResult, error) {
+	var result PurgeResult
+	expired := bson.M{"deleted_at": bson.M{"$lt": bson.NewDateTimeFromTime(deletedBefore)}}
+
+	cursor, err := s.conversationCollection.Find(ctx, expired, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return result, err
+	}
+	var conversations []models.BaseModel
+	if err := cursor.All(ctx, &conversations); err != nil {
+		return result, err
+	}
+
+	if len(conversations) > 0 {
+		ids := make([]bson.ObjectID, len(conversations))
+		hexIDs := make([]string, len(conversations))
+		for i, conversation := range conversations {
+			ids[i] = conversation.ID
+			hexIDs[i] = conversation.ID.Hex()
+		}
+		byHexID := bson.M{"conversation_id": bson.M{"$in": hexIDs}}
+
+		deleted, err := s.db.Collection((models.FunctionCall{}).CollectionName()).DeleteMany(ctx, byHexID)
+		if err != nil {
+			return result, err
+		}
+		result.FunctionCalls = deleted.DeletedCount
+		deleted, err = s.db.Collection((models.Comment{}).CollectionName()).DeleteMany(ctx, byHexID)
+		if err != nil {
+			return result, err
+		}
+		result.Comments = deleted.DeletedCount
+		if _, err := s.db.Collection((models.ToolJob{}).CollectionName()).DeleteMany(ctx, byHexID); err != nil {
+			return result, err
+		}
+		if _, err := s.db.Collection((models.TextEdit{}).CollectionName()).DeleteMany(ctx, bson.M{"conversation_id": bson.M{"$in": ids}}); err != nil {
+			return result, err
+		}
+
+		deleted, err = s.conversationCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err != nil {
+			return result, err
+		}
+		result.Conversations = deleted.DeletedCount
+	}
+
+	cursor, err = s.promptCollection.Find(ctx, expired, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return result, err
+	}
+	var prompts []models.BaseModel
+	if err := cursor.All(ctx, &prompts); err != nil {
+		return result, err
+	}
+
+	if len(prompts) > 0 {
+		ids := make([]bson.ObjectID, len(prompts))
+		for i, prompt := range prompts {
+			ids[i] = prompt.ID
+		}
+		byPromptID := bson.M{"prompt_id": bson.M{"$in": ids}}
+
+		if _, err := s.db.Collection((models.PromptUsage{}).CollectionName()).DeleteMany(ctx, byPromptID); err != nil {
+			return result, err
+		}
+		if _, err := s.db.Collection((models.PromptShare{}).CollectionName()).DeleteMany(ctx, byPromptID); err != nil {
+			return result, err
+		}
+
+		deleted, err := s.promptCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err != nil {
+			return result, err
+		}
+		result.Prompts = deleted.DeletedCount
+	}
+	return result, nil
+}
+
+// PurgeAccounts deletes for good the accounts whose deletion was requested before the given time,
+// with the records of userDataCollections and the refresh tokens. The user is deleted last, so
+// that a failed purge is resumed by the next one. The accounts of organization owners are kept
+// until they delete their organizations, the projects the user owns are handed over to their
+// coauthors. It returns the number of deleted accounts.
+func (s *RetentionService) PurgeAccounts(ctx context.Context, requestedBefore time.Time) (int64, error) {
+	cursor, err := s.userCollection.Find(ctx,
+		bson.M{"deletion_requested_at": bson.M{"$lt": bson.NewDateTimeFromTime(requestedBefore)}},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return 0, err
+	}
+	var users []models.BaseModel
+	if err := cursor.All(ctx, &users); err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for _, user := range users {
+		// the user may have logged in again, which cancels the deletion, since it was listed
+		requested := bson.M{
+			"_id":                   user.ID,
+			"deletion_requested_at": bson.M{"$lt": bson.NewDateTimeFromTime(requestedBefore)},
+		}
+		if count, err := s.userCollection.CountDocuments(ctx, requested); err != nil || count == 0 {
+			if err != nil {
+				return purged, err
+			}
+			continue
+		}
+
+		// the deletion was requested before the owners had to delete their organizations first
+		owner, err := ownsOrganization(ctx, s.db.Collection((models.OrganizationMember{}).CollectionName()), user.ID)
+		if err != nil {
+			return purged, err
+		}
+		if owner {
+			s.logger.Warn("Account deletion postponed, the user owns an organization", "user_id", user.ID.Hex())
+			continue
+		}
+
+		// the coauthors keep their projects
+		if err := handOverOwnership(ctx, s.db.Collection((models.ProjectMember{}).CollectionName()), "project_id", user.ID); err != nil {
+			return purged, err
+		}
+
+		byUserID := bson.M{"user_id": user.ID}
+		for _, model := range userDataCollections {
+			filter := byUserID
+			if _, ok := model.(models.Prompt); ok {
+				// the team prompts the user wrote stay in the library of their organization
+				filter = bson.M{"user_id": user.ID, "organization_id": bson.M{"$exists": false}}
+			}
+			if _, err := s.db.Collection(model.CollectionName()).DeleteMany(ctx, filter); err != nil {
+				return purged, err
+			}
+		}
+		if _, err := s.db.Collection("tokens").DeleteMany(ctx, byUserID); err != nil {
+			return purged, err
+		}
+		deleted, err := s.userCollection.DeleteOne(ctx, requested)
+		if err != nil {
+			return purged, err
+		}
+		purged += deleted.DeletedCount
+	}
+	return purged, nil
+}
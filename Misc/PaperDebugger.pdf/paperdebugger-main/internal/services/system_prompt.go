@@ -0,0 +1,336 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/shared"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// The built-in system prompts are system_prompt_<persona>.tmpl, they can use the blocks of
+// system_prompt_blocks.tmpl.
+//
+//go:embed system_prompt_*.tmpl
+var systemPromptFS embed.FS
+
+const (
+	maxSystemPromptTemplateSize = 64 << 10
+	// a template rendered with the sample data which is larger is rejected, e.g. a runaway range
+	maxSystemPromptPreviewSize = 1 << 20
+)
+
+// SystemPromptData are the fields of the system prompt templates.
+type SystemPromptData struct {
+	FullContent         string // the LaTeX source of the paper
+	TeamInstructions    string
+	ProjectInstructions string
+	UserInstructions    string
+}
+
+// SystemPromptSource is where the system prompt template of a persona comes from, by precedence:
+// the database, the configuration, and the built-in one.
+type SystemPromptSource string
+
+const (
+	SystemPromptSourceBuiltIn  SystemPromptSource = "built_in"
+	SystemPromptSourceConfig   SystemPromptSource = "config"
+	SystemPromptSourceDatabase SystemPromptSource = "database"
+)
+
+// SystemPromptTemplate is the system prompt template in use for a persona.
+type SystemPromptTemplate struct {
+	Persona        models.Persona
+	Source         SystemPromptSource
+	Content        string
+	BuiltInContent string
+	UpdatedAt      *bson.DateTime // of the override in the database
+}
+
+// SystemPromptService renders the system prompts of the personas. The admins override the
+// built-in templates with the files of cfg.SystemPromptDir, read at startup, or at runtime with
+// UpdateSystemPrompt.
+type SystemPromptService struct {
+	BaseService
+	systemPromptCollection *mongo.Collection
+	builtIn                map[models.Persona]string
+	configured             map[models.Persona]string
+}
+
+func NewSystemPromptService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *SystemPromptService {
+	base := NewBaseService(db, cfg, logger)
+	collection := base.db.Collection((models.SystemPrompt{}).CollectionName())
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "persona", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error("Failed to create indexes for system_prompts collection", err)
+	}
+
+	s := &SystemPromptService{
+		BaseService:            base,
+		systemPromptCollection: collection,
+		builtIn:                make(map[models.Persona]string, len(models.Personas)),
+		configured:             make(map[models.Persona]string),
+	}
+	for _, persona := range models.Personas {
+		content, err := BuiltInSystemPrompt(persona)
+		if err != nil {
+			logger.Error("Failed to load the built-in system prompt of "+string(persona), err)
+			continue
+		}
+		s.builtIn[persona] = content
+	}
+	if cfg.SystemPromptDir != "" {
+		s.loadConfigured(cfg.SystemPromptDir)
+	}
+	return s
+}
+
+// BuiltInSystemPrompt returns the built-in template of a persona.
+func BuiltInSystemPrompt(persona models.Persona) (string, error) {
+	content, err := systemPromptFS.ReadFile("system_prompt_" + string(persona) + ".tmpl")
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// loadConfigured reads the templates system_prompt_<persona>.tmpl of the directory, the invalid
+// ones are logged and left out.
+func (s *SystemPromptService) loadConfigured(dir string) {
+	for _, persona := range models.Personas {
+		path := filepath.Join(dir, "system_prompt_"+string(persona)+".tmpl")
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("Failed to read the system prompt "+path, err)
+			continue
+		}
+		if _, err := ValidateSystemPromptTemplate(string(content)); err != nil {
+			s.logger.Error("Invalid system prompt "+path+", the built-in one is used", err)
+			continue
+		}
+		s.configured[persona] = string(content)
+		s.logger.Info("Loaded system prompt from the configuration", "persona", persona, "path", path)
+	}
+}
+
+// ValidateSystemPromptTemplate checks that the template parses with the blocks and renders, with
+// and without instructions. It returns the template rendered with sample data.
+func ValidateSystemPromptTemplate(content string) (string, error) {
+	if strings.TrimSpace(content) == "" {
+		return "", shared.ErrBadRequest("the template must not be empty")
+	}
+	if len(content) > maxSystemPromptTemplateSize {
+		return "", shared.ErrBadRequest(fmt.Sprintf("the template must be at most %d bytes", maxSystemPromptTemplateSize))
+	}
+
+	tmpl, err := parseSystemPromptTemplate(content)
+	if err != nil {
+		return "", shared.ErrBadRequest("invalid template: " + err.Error())
+	}
+	if _, err := renderSystemPromptTemplate(tmpl, SystemPromptData{}); err != nil {
+		return "", shared.ErrBadRequest("the template fails to render: " + err.Error())
+	}
+	preview, err := renderSystemPromptTemplate(tmpl, SystemPromptData{
+		FullContent:         "\\documentclass{article}\n\\begin{document}\nThe paper.\n\\end{document}",
+		TeamInstructions:    "The team instructions.",
+		ProjectInstructions: "The project instructions.",
+		UserInstructions:    "The user instructions.",
+	})
+	if err != nil {
+		return "", shared.ErrBadRequest("the template fails to render: " + err.Error())
+	}
+	return preview, nil
+}
+
+func parseSystemPromptTemplate(content string) (*template.Template, error) {
+	blocks, err := systemPromptFS.ReadFile("system_prompt_blocks.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New("system_prompt").Parse(string(blocks))
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.Parse(content)
+}
+
+// renderSystemPromptTemplate executes the template, an unknown field fails.
+func renderSystemPromptTemplate(tmpl *template.Template, data SystemPromptData) (string, error) {
+	out := &limitedBuffer{max: maxSystemPromptPreviewSize + len(data.FullContent)}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errors.New("the rendered prompt is too large")
+	}
+	return b.Buffer.Write(p)
+}
+
+// RenderSystemPrompt renders the system prompt of the persona. If the template of the database or
+// of the configuration fails, it is logged and the built-in one is used.
+func (s *SystemPromptService) RenderSystemPrompt(ctx context.Context, persona models.Persona, data SystemPromptData) (string, error) {
+	if _, ok := s.builtIn[persona]; !ok {
+		persona = models.PersonaDefault
+	}
+	current, err := s.getSystemPrompt(ctx, persona)
+	if err != nil {
+		return "", err
+	}
+
+	if current.Source != SystemPromptSourceBuiltIn {
+		tmpl, err := parseSystemPromptTemplate(current.Content)
+		if err == nil {
+			var prompt string
+			if prompt, err = renderSystemPromptTemplate(tmpl, data); err == nil {
+				return prompt, nil
+			}
+		}
+		s.logger.Error("Failed to render the "+string(current.Source)+" system prompt of "+string(persona)+", the built-in one is used", err)
+	}
+
+	tmpl, err := parseSystemPromptTemplate(current.BuiltInContent)
+	if err != nil {
+		return "", err
+	}
+	return renderSystemPromptTemplate(tmpl, data)
+}
+
+// ListSystemPrompts returns the templates in use for all the personas.
+func (s *SystemPromptService) ListSystemPrompts(ctx context.Context) ([]*SystemPromptTemplate, error) {
+	cursor, err := s.systemPromptCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var overrides []*models.SystemPrompt
+	if err := cursor.All(ctx, &overrides); err != nil {
+		return nil, err
+	}
+	byPersona := make(map[models.Persona]*models.SystemPrompt, len(overrides))
+	for _, override := range overrides {
+		byPersona[override.Persona] = override
+	}
+
+	var templates []*SystemPromptTemplate
+	for _, persona := range models.Personas {
+		if _, ok := s.builtIn[persona]; ok {
+			templates = append(templates, s.systemPromptTemplate(persona, byPersona[persona]))
+		}
+	}
+	return templates, nil
+}
+
+// UpdateSystemPrompt validates the template and saves it as the override of the persona, unless
+// validateOnly is set. It returns the template of the persona and the preview of the new one.
+func (s *SystemPromptService) UpdateSystemPrompt(ctx context.Context, adminID bson.ObjectID, persona models.Persona, content string, validateOnly bool) (*SystemPromptTemplate, string, error) {
+	if _, ok := s.builtIn[persona]; !ok {
+		return nil, "", shared.ErrBadRequest("unknown persona " + string(persona))
+	}
+	preview, err := ValidateSystemPromptTemplate(content)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	if validateOnly {
+		return s.systemPromptTemplate(persona, &models.SystemPrompt{
+			BaseModel: models.BaseModel{UpdatedAt: now},
+			Persona:   persona,
+			Content:   content,
+		}), preview, nil
+	}
+
+	_, err = s.systemPromptCollection.UpdateOne(ctx,
+		bson.M{"persona": persona},
+		bson.M{
+			"$set":         bson.M{"content": content, "updated_by": adminID, "updated_at": now},
+			"$setOnInsert": bson.M{"_id": bson.NewObjectID(), "created_at": now},
+		},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	current, err := s.getSystemPrompt(ctx, persona)
+	if err != nil {
+		return nil, "", err
+	}
+	return current, preview, nil
+}
+
+// ResetSystemPrompt deletes the override of the persona, it returns mongo.ErrNoDocuments if there
+// is none.
+func (s *SystemPromptService) ResetSystemPrompt(ctx context.Context, persona models.Persona) (*SystemPromptTemplate, error) {
+	if _, ok := s.builtIn[persona]; !ok {
+		return nil, shared.ErrBadRequest("unknown persona " + string(persona))
+	}
+	result, err := s.systemPromptCollection.DeleteOne(ctx, bson.M{"persona": persona})
+	if err != nil {
+		return nil, err
+	}
+	if result.DeletedCount == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return s.getSystemPrompt(ctx, persona)
+}
+
+func (s *SystemPromptService) getSystemPrompt(ctx context.Context, persona models.Persona) (*SystemPromptTemplate, error) {
+	var override models.SystemPrompt
+	err := s.systemPromptCollection.FindOne(ctx, bson.M{"persona": persona}).Decode(&override)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return s.systemPromptTemplate(persona, nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.systemPromptTemplate(persona, &override), nil
+}
+
+func (s *SystemPromptService) systemPromptTemplate(persona models.Persona, override *models.SystemPrompt) *SystemPromptTemplate {
+	t := &SystemPromptTemplate{
+		Persona:        persona,
+		Source:         SystemPromptSourceBuiltIn,
+		Content:        s.builtIn[persona],
+		BuiltInContent: s.builtIn[persona],
+	}
+	if override != nil {
+		t.Source = SystemPromptSourceDatabase
+		t.Content = override.Content
+		t.UpdatedAt = &override.UpdatedAt
+	} else if content, ok := s.configured[persona]; ok {
+		t.Source = SystemPromptSourceConfig
+		t.Content = content
+	}
+	return t
+}
@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInSystemPrompts(t *testing.T) {
+	for _, persona := range models.Personas {
+		content, err := services.BuiltInSystemPrompt(persona)
+		require.NoError(t, err, persona)
+
+		preview, err := services.ValidateSystemPromptTemplate(content)
+		require.NoError(t, err, persona)
+		assert.Contains(t, preview, "The user instructions.", persona)
+		if persona != models.PersonaDebug {
+			assert.Contains(t, preview, "The paper.", persona)
+		}
+	}
+}
+
+func TestValidateSystemPromptTemplate(t *testing.T) {
+	preview, err := services.ValidateSystemPromptTemplate(`You review papers.
+{{ template "instructions" . }}
+{{ template "paper_content" . }}`)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(preview, "You review papers."))
+	assert.Contains(t, preview, "The team instructions.")
+
+	_, err = services.ValidateSystemPromptTemplate("   ")
+	assert.ErrorContains(t, err, "empty")
+
+	_, err = services.ValidateSystemPromptTemplate(strings.Repeat("a", 65<<10))
+	assert.ErrorContains(t, err, "at most")
+
+	_, err = services.ValidateSystemPromptTemplate("{{ if .FullContent }}")
+	assert.ErrorContains(t, err, "invalid template")
+
+	_, err = services.ValidateSystemPromptTemplate("{{ .Paper }}")
+	assert.ErrorContains(t, err, "fails to render")
+
+	_, err = services.ValidateSystemPromptTemplate(`{{ template "unknown" . }}`)
+	assert.ErrorContains(t, err, "fails to render")
+
+	_, err = services.ValidateSystemPromptTemplate(`{{ range 10000000 }}{{ $.FullContent }}{{ end }}`)
+	assert.ErrorContains(t, err, "too large")
+}
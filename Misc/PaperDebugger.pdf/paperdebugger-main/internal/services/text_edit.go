@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/libs/stringutil"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var ErrTextNotLocated = errors.New("the selected text was not found in the project documents, or several times")
+
+// TextEditService turns revisions of the selected text into edits of the project documents, and
+// records which of them the user accepted.
+type TextEditService struct {
+	BaseService
+	textEditCollection *mongo.Collection
+	projectService     *ProjectService
+}
+
+func NewTextEditService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger, projectService *ProjectService) *TextEditService {
+	base := NewBaseService(db, cfg, logger)
+	return &TextEditService{
+		BaseService:        base,
+		textEditCollection: base.db.Collection((models.TextEdit{}).CollectionName()),
+		projectService:     projectService,
+	}
+}
+
+// ProposeTextEdits records the edits of LocateTextEdits in the documents of the project, as the
+// message messageID of the conversation.
+func (s *TextEditService) ProposeTextEdits(ctx context.Context, userID bson.ObjectID, projectID string, conversationID bson.ObjectID, messageID string, selection contextutil.Selection, revised string) ([]models.TextEdit, error) {
+	project, err := s.projectService.GetProject(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	edits, err := LocateTextEdits(project.Docs, selection, revised)
+	if err != nil || len(edits) == 0 {
+		return nil, err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	documents := make([]any, len(edits))
+	for i := range edits {
+		edits[i].BaseModel = models.BaseModel{
+			ID:        bson.NewObjectID(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		edits[i].UserID = userID
+		edits[i].ProjectID = projectID
+		edits[i].ConversationID = conversationID
+		edits[i].MessageID = messageID
+		edits[i].Status = models.CommentStatusNoAction
+		documents[i] = edits[i]
+	}
+	if _, err := s.textEditCollection.InsertMany(ctx, documents); err != nil {
+		return nil, err
+	}
+	return edits, nil
+}
+
+// LocateTextEdits locates the selected text in the documents and returns the edits of its
+// document turning it into revised, with offsets in UTF-16 code units. The whitespace around the
+// selection is kept. It returns ErrTextNotLocated if the selection is not found, or found several
+// times and its offset does not tell which one, and no edits if revised does not change it.
+func LocateTextEdits(docs []models.ProjectDoc, selection contextutil.Selection, revised string) ([]models.TextEdit, error) {
+	doc, offset, ok := locateText(docs, selection)
+	if !ok {
+		return nil, ErrTextNotLocated
+	}
+
+	// the models drop the line breaks around the selection, they stay in the document
+	selected := strings.TrimLeftFunc(selection.Text, unicode.IsSpace)
+	offset += stringutil.UTF16Len(selection.Text[:len(selection.Text)-len(selected)])
+	selected = strings.TrimRightFunc(selected, unicode.IsSpace)
+
+	selectedRunes := []rune(selected)
+	diff := stringutil.DiffEdits(selected, strings.TrimSpace(revised))
+	edits := make([]models.TextEdit, len(diff))
+	for i, edit := range diff {
+		original := string(selectedRunes[edit.Start:edit.End])
+		start := offset + stringutil.UTF16Len(string(selectedRunes[:edit.Start]))
+		edits[i] = models.TextEdit{
+			DocID:       doc.ID,
+			DocVersion:  doc.Version,
+			DocPath:     doc.Filepath,
+			StartOffset: start,
+			EndOffset:   start + stringutil.UTF16Len(original),
+			Original:    original,
+			Replacement: edit.Replacement,
+		}
+	}
+	return edits, nil
+}
+
+// ResolveTextEdit records that the user accepted (applied) or rejected a proposed edit.
+func (s *TextEditService) ResolveTextEdit(ctx context.Context, userID bson.ObjectID, conversationID bson.ObjectID, editID bson.ObjectID, accepted bool) (*models.TextEdit, error) {
+	status := models.CommentStatusRejected
+	if accepted {
+		status = models.CommentStatusAccepted
+	}
+
+	var edit models.TextEdit
+	err := s.textEditCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": editID, "user_id": userID, "conversation_id": conversationID},
+		bson.M{"$set": bson.M{"status": status, "updated_at": bson.NewDateTimeFromTime(time.Now())}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&edit)
+	if err != nil {
+		return nil, err
+	}
+	return &edit, nil
+}
+
+// locateText finds the selected text in the documents, and its UTF-16 offset in its document. If
+// it occurs several times, the occurrence at the offset of the selection is the one.
+func locateText(docs []models.ProjectDoc, selection contextutil.Selection) (*models.ProjectDoc, int, bool) {
+	if strings.TrimSpace(selection.Text) == "" {
+		return nil, 0, false
+	}
+	type occurrence struct {
+		doc    *models.ProjectDoc
+		offset int
+	}
+	var occurrences, atOffset []occurrence
+	for i := range docs {
+		content := strings.Join(docs[i].Lines, "\n")
+		index, offset := 0, 0 // where the search resumes, in bytes and in UTF-16 code units
+		for {
+			found := strings.Index(content[index:], selection.Text)
+			if found < 0 {
+				break
+			}
+			offset += stringutil.UTF16Len(content[index : index+found])
+			index += found
+			occurrence := occurrence{doc: &docs[i], offset: offset}
+			occurrences = append(occurrences, occurrence)
+			if selection.Offset != nil && *selection.Offset == offset {
+				atOffset = append(atOffset, occurrence)
+			}
+
+			// the next occurrence may overlap this one
+			_, size := utf8.DecodeRuneInString(content[index:])
+			offset += stringutil.UTF16Len(content[index : index+size])
+			index += size
+		}
+	}
+
+	switch {
+	case len(occurrences) == 1:
+		return occurrences[0].doc, occurrences[0].offset, true
+	case len(atOffset) == 1:
+		return atOffset[0].doc, atOffset[0].offset, true
+	}
+	return nil, 0, false
+}
@@ -0,0 +1,94 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// applyTextEdits applies edits in increasing order of position like the webapp, on the UTF-16
+// code units of the content.
+func applyTextEdits(content string, edits []models.TextEdit) string {
+	units := utf16.Encode([]rune(content))
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		replacement := utf16.Encode([]rune(e.Replacement))
+		units = append(units[:e.StartOffset], append(replacement, units[e.EndOffset:]...)...)
+	}
+	return string(utf16.Decode(units))
+}
+
+func TestLocateTextEdits(t *testing.T) {
+	docs := []models.ProjectDoc{
+		{ID: "intro", Version: 3, Filepath: "intro.tex", Lines: []string{`\section{Intro}`, `We propose an new method.`}},
+		{ID: "main", Version: 7, Filepath: "main.tex", Lines: []string{`\input{intro}`}},
+	}
+	selection := contextutil.Selection{Text: "We propose an new method."}
+
+	edits, err := services.LocateTextEdits(docs, selection, "We propose a new method.")
+	require.NoError(t, err)
+	require.Len(t, edits, 1)
+	assert.Equal(t, models.TextEdit{
+		DocID: "intro", DocVersion: 3, DocPath: "intro.tex",
+		StartOffset: 27, EndOffset: 29, Original: "an", Replacement: "a",
+	}, edits[0])
+
+	edits, err = services.LocateTextEdits(docs, selection, selection.Text)
+	assert.NoError(t, err)
+	assert.Empty(t, edits)
+
+	_, err = services.LocateTextEdits(docs, contextutil.Selection{Text: "a method we do not have"}, "a method")
+	assert.ErrorIs(t, err, services.ErrTextNotLocated)
+}
+
+func TestLocateTextEdits_SurroundingWhitespace(t *testing.T) {
+	content := "First.\n\nThe results is good.\n\nLast."
+	docs := []models.ProjectDoc{{ID: "main", Filepath: "main.tex", Lines: strings.Split(content, "\n")}}
+
+	// the revision drops the line breaks of the selection, they are not edits
+	edits, err := services.LocateTextEdits(docs, contextutil.Selection{Text: "\nThe results is good.\n"}, "The results are good.")
+	require.NoError(t, err)
+	require.Len(t, edits, 1)
+	assert.Equal(t, "First.\n\nThe results are good.\n\nLast.", applyTextEdits(content, edits))
+}
+
+func TestLocateTextEdits_AstralCharacters(t *testing.T) {
+	// 𝔸 and 😀 take two UTF-16 code units each, the offsets are JavaScript string indices
+	content := "Let $\\mathbb{𝔸}$ be a set 😀.\nThe set is finit."
+	docs := []models.ProjectDoc{{ID: "main", Filepath: "main.tex", Lines: strings.Split(content, "\n")}}
+
+	edits, err := services.LocateTextEdits(docs, contextutil.Selection{Text: "The set is finit."}, "The set is finite.")
+	require.NoError(t, err)
+	require.Len(t, edits, 1)
+	assert.Equal(t, 42, edits[0].StartOffset) // 40 runes
+	assert.Equal(t, "finit", edits[0].Original)
+	assert.Equal(t, "Let $\\mathbb{𝔸}$ be a set 😀.\nThe set is finite.", applyTextEdits(content, edits))
+
+	edits, err = services.LocateTextEdits(docs, contextutil.Selection{Text: "a set 😀."}, "a set 😀 .")
+	require.NoError(t, err)
+	assert.Equal(t, "Let $\\mathbb{𝔸}$ be a set 😀 .\nThe set is finit.", applyTextEdits(content, edits))
+}
+
+func TestLocateTextEdits_RepeatedSelection(t *testing.T) {
+	content := "The model is fast.\nThe model is fast."
+	docs := []models.ProjectDoc{{ID: "main", Filepath: "main.tex", Lines: strings.Split(content, "\n")}}
+
+	_, err := services.LocateTextEdits(docs, contextutil.Selection{Text: "The model is fast."}, "The model is slow.")
+	assert.ErrorIs(t, err, services.ErrTextNotLocated)
+
+	second := 19
+	edits, err := services.LocateTextEdits(docs, contextutil.Selection{Text: "The model is fast.", Offset: &second}, "The model is slow.")
+	require.NoError(t, err)
+	assert.Equal(t, "The model is fast.\nThe model is slow.", applyTextEdits(content, edits))
+
+	stale := 3
+	_, err = services.LocateTextEdits(docs, contextutil.Selection{Text: "The model is fast.", Offset: &stale}, "The model is slow.")
+	assert.ErrorIs(t, err, services.ErrTextNotLocated)
+}
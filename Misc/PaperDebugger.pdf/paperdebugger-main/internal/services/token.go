@@ -62,6 +62,12 @@ func (s *TokenService) UpdateToken(ctx context.Context, token *Token) (*Token, e
 	return token, nil
 }
 
+// DeleteUserTokens revokes all refresh tokens of the user.
+func (s *TokenService) DeleteUserTokens(ctx context.Context, userID bson.ObjectID) error {
+	_, err := s.tokenCollection.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
+
 func (s *TokenService) DeleteToken(ctx context.Context, token *Token) error {
 	_, err := s.tokenCollection.DeleteOne(ctx, bson.M{"_id": token.ID})
 	return err
@@ -57,3 +57,25 @@ func TestTokenService_CRUD(t *testing.T) {
 	_, err = ts.GetTokenByToken(ctx, tk.Token)
 	assert.Error(t, err)
 }
+
+func TestTokenService_DeleteUserTokens(t *testing.T) {
+	ts := setupTestTokenService(t)
+	ctx := context.Background()
+
+	userID := bson.NewObjectID()
+	first, err := ts.CreateRefreshToken(ctx, userID)
+	assert.NoError(t, err)
+	second, err := ts.CreateRefreshToken(ctx, userID)
+	assert.NoError(t, err)
+	other, err := ts.CreateRefreshToken(ctx, bson.NewObjectID())
+	assert.NoError(t, err)
+
+	assert.NoError(t, ts.DeleteUserTokens(ctx, userID))
+	_, err = ts.GetTokenByToken(ctx, first.Token)
+	assert.Error(t, err)
+	_, err = ts.GetTokenByToken(ctx, second.Token)
+	assert.Error(t, err)
+	_, err = ts.GetTokenByToken(ctx, other.Token)
+	assert.NoError(t, err)
+	assert.NoError(t, ts.DeleteToken(ctx, other))
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"paperdebugger/internal/libs/cfg"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	maxTopErrors    = 5
+	maxErrorKeySize = 200 // errors often end with a response body, only their beginning is grouped
+)
+
+// ToolCallService reads the records the tools keep of their calls (models.FunctionCall).
+type ToolCallService struct {
+	BaseService
+	functionCallCollection *mongo.Collection
+}
+
+// ToolCallFilter selects tool calls, empty fields match everything.
+type ToolCallFilter struct {
+	ConversationID string
+	ProjectID      string
+	ToolName       string
+	Status         models.FunctionCallStatus
+}
+
+func (f ToolCallFilter) bson() bson.M {
+	filter := bson.M{}
+	if f.ConversationID != "" {
+		filter["conversation_id"] = f.ConversationID
+	}
+	if f.ProjectID != "" {
+		filter["project_id"] = f.ProjectID
+	}
+	if f.ToolName != "" {
+		filter["function_name"] = f.ToolName
+	}
+	if f.Status != "" {
+		filter["function_status"] = f.Status
+	}
+	return filter
+}
+
+// ToolErrorCount is how many times a tool failed with an error.
+type ToolErrorCount struct {
+	Error string
+	Count int
+}
+
+// ToolCallStats aggregates the calls of a tool.
+type ToolCallStats struct {
+	Name        string
+	Total       int
+	Succeeded   int // including the reused results
+	Failed      int
+	TimedOut    int
+	Pending     int
+	Cached      int
+	SuccessRate float64 // of the finished calls
+	P50Latency  time.Duration
+	P95Latency  time.Duration
+	TopErrors   []ToolErrorCount
+}
+
+func NewToolCallService(db *db.DB, cfg *cfg.Cfg, logger *logger.Logger) *ToolCallService {
+	base := NewBaseService(db, cfg, logger)
+	return &ToolCallService{
+		BaseService:            base,
+		functionCallCollection: base.db.Collection((models.FunctionCall{}).CollectionName()),
+	}
+}
+
+// ListToolCalls returns at most limit tool calls, newest first, created before the call with the
+// id before (if not zero).
+func (s *ToolCallService) ListToolCalls(ctx context.Context, filter ToolCallFilter, before bson.ObjectID, limit int) ([]*models.FunctionCall, error) {
+	query := filter.bson()
+	if !before.IsZero() {
+		query["_id"] = bson.M{"$lt": before}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := s.functionCallCollection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	calls := []*models.FunctionCall{}
+	if err := cursor.All(ctx, &calls); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+// GetToolCallStats aggregates the tool calls created since the given time, per tool.
+func (s *ToolCallService) GetToolCallStats(ctx context.Context, filter ToolCallFilter, since time.Time) ([]ToolCallStats, error) {
+	query := filter.bson()
+	query["created_at"] = bson.M{"$gte": bson.NewDateTimeFromTime(since)}
+
+	// the arguments and results can be whole papers
+	opts := options.Find().SetProjection(bson.M{"function_params": 0, "function_result": 0})
+	cursor, err := s.functionCallCollection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	calls := []models.FunctionCall{}
+	if err := cursor.All(ctx, &calls); err != nil {
+		return nil, err
+	}
+	return ComputeToolCallStats(calls), nil
+}
+
+// ComputeToolCallStats aggregates the calls per tool, the tools with the lowest success rate first.
+func ComputeToolCallStats(calls []models.FunctionCall) []ToolCallStats {
+	type accumulator struct {
+		stats     ToolCallStats
+		latencies []time.Duration
+		errors    map[string]int
+	}
+	byTool := map[string]*accumulator{}
+	for _, call := range calls {
+		acc, ok := byTool[call.FunctionName]
+		if !ok {
+			acc = &accumulator{stats: ToolCallStats{Name: call.FunctionName}, errors: map[string]int{}}
+			byTool[call.FunctionName] = acc
+		}
+
+		acc.stats.Total++
+		switch call.FunctionStatus {
+		case models.FunctionCallStatusSuccess:
+			acc.stats.Succeeded++
+			if call.CachedFrom != nil {
+				acc.stats.Cached++
+			}
+		case models.FunctionCallStatusError:
+			acc.stats.Failed++
+			acc.errors[errorKey(call.FunctionError)]++
+		case models.FunctionCallStatusTimeout:
+			acc.stats.TimedOut++
+		default:
+			acc.stats.Pending++
+		}
+		if latency, ok := call.Latency(); ok {
+			acc.latencies = append(acc.latencies, latency)
+		}
+	}
+
+	result := make([]ToolCallStats, 0, len(byTool))
+	for _, acc := range byTool {
+		stats := acc.stats
+		if finished := stats.Succeeded + stats.Failed + stats.TimedOut; finished > 0 {
+			stats.SuccessRate = float64(stats.Succeeded) / float64(finished)
+		}
+		sort.Slice(acc.latencies, func(i, j int) bool { return acc.latencies[i] < acc.latencies[j] })
+		stats.P50Latency = percentile(acc.latencies, 0.50)
+		stats.P95Latency = percentile(acc.latencies, 0.95)
+
+		for message, count := range acc.errors {
+			stats.TopErrors = append(stats.TopErrors, ToolErrorCount{Error: message, Count: count})
+		}
+		sort.Slice(stats.TopErrors, func(i, j int) bool {
+			if stats.TopErrors[i].Count != stats.TopErrors[j].Count {
+				return stats.TopErrors[i].Count > stats.TopErrors[j].Count
+			}
+			return stats.TopErrors[i].Error < stats.TopErrors[j].Error
+		})
+		if len(stats.TopErrors) > maxTopErrors {
+			stats.TopErrors = stats.TopErrors[:maxTopErrors]
+		}
+		result = append(result, stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].SuccessRate != result[j].SuccessRate {
+			return result[i].SuccessRate < result[j].SuccessRate
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// percentile returns the nearest-rank percentile p of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
+
+func errorKey(message string) string {
+	runes := []rune(message)
+	if len(runes) > maxErrorKeySize {
+		return string(runes[:maxErrorKeySize]) + "…"
+	}
+	return message
+}
@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func toolCall(name string, status models.FunctionCallStatus, latency time.Duration, errMessage string) models.FunctionCall {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return models.FunctionCall{
+		BaseModel: models.BaseModel{
+			ID:        bson.NewObjectID(),
+			CreatedAt: bson.NewDateTimeFromTime(start),
+			UpdatedAt: bson.NewDateTimeFromTime(start.Add(latency)),
+		},
+		FunctionName:   name,
+		FunctionStatus: status,
+		FunctionError:  errMessage,
+	}
+}
+
+func TestComputeToolCallStats(t *testing.T) {
+	origin := bson.NewObjectID()
+	cached := toolCall("paper_score", models.FunctionCallStatusSuccess, 0, "")
+	cached.CachedFrom = &origin
+
+	stats := services.ComputeToolCallStats([]models.FunctionCall{
+		toolCall("paper_score", models.FunctionCallStatusSuccess, 100*time.Millisecond, ""),
+		toolCall("paper_score", models.FunctionCallStatusSuccess, 300*time.Millisecond, ""),
+		cached,
+		toolCall("search", models.FunctionCallStatusSuccess, time.Second, ""),
+		toolCall("search", models.FunctionCallStatusError, 2*time.Second, "connection refused"),
+		toolCall("search", models.FunctionCallStatusError, 3*time.Second, "connection refused"),
+		toolCall("search", models.FunctionCallStatusError, 4*time.Second, "bad gateway"),
+		toolCall("search", models.FunctionCallStatusTimeout, time.Hour, ""),
+		toolCall("search", models.FunctionCallStatusPending, 0, ""),
+	})
+	require.Len(t, stats, 2)
+
+	search := stats[0]
+	assert.Equal(t, "search", search.Name, "the flakiest tool comes first")
+	assert.Equal(t, 6, search.Total)
+	assert.Equal(t, 1, search.Succeeded)
+	assert.Equal(t, 3, search.Failed)
+	assert.Equal(t, 1, search.TimedOut)
+	assert.Equal(t, 1, search.Pending)
+	assert.InDelta(t, 0.2, search.SuccessRate, 1e-9)
+	assert.Equal(t, 2*time.Second, search.P50Latency, "timeouts have no latency")
+	assert.Equal(t, 4*time.Second, search.P95Latency)
+	assert.Equal(t, []services.ToolErrorCount{
+		{Error: "connection refused", Count: 2},
+		{Error: "bad gateway", Count: 1},
+	}, search.TopErrors)
+
+	paperScore := stats[1]
+	assert.Equal(t, 3, paperScore.Succeeded)
+	assert.Equal(t, 1, paperScore.Cached)
+	assert.Equal(t, 1.0, paperScore.SuccessRate)
+	assert.Equal(t, 100*time.Millisecond, paperScore.P50Latency, "reused results have no latency")
+	assert.Equal(t, 300*time.Millisecond, paperScore.P95Latency)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/handler"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2/responses"
+)
+
+var ErrToolCallNotPending = errors.New("tool call is not awaiting approval")
+
+// ResolveToolCallStream approves or denies a tool call awaiting approval in the chat history (messages).
+// Once no tool call is pending anymore, the tool results are sent to the language model and the
+// conversation goes on as in ChatCompletionStream.
+//
+// Returns: (same as ChatCompletionStream)
+//  1. The full chat history sent to the language model (including the output of the resolved tool call).
+//  2. The incremental chat history visible to the user, starting with the resolved tool call. Its message id
+//     is the one of the ToolCallApprovalRequired message it replaces.
+//  3. ErrToolCallNotPending if toolCallId is not awaiting approval, or any error of the completion.
+func (a *AIClient) ResolveToolCallStream(ctx context.Context, callbackStream chatv1.ChatService_CreateConversationMessageStreamServer, conversationId string, languageModel models.LanguageModel, messages responses.ResponseInputParam, toolCallId string, approved bool, reason string) (responses.ResponseInputParam, []chatv1.Message, error) {
+	pending := handler.PendingToolCalls(messages)
+	index := -1
+	for i, toolCall := range pending {
+		if toolCall.CallID == toolCallId {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, nil, ErrToolCallNotPending
+	}
+
+	streamHandler := handler.NewStreamHandler(callbackStream, conversationId, languageModel)
+
+	streamHandler.SendInitialization()
+	defer func() {
+		streamHandler.SendFinalization()
+	}()
+	defer a.forwardToolJobProgress(conversationId, streamHandler)()
+
+	openaiChatHistory := responses.ResponseNewParamsInputUnion{OfInputItemList: messages}
+	inappChatHistory := []chatv1.Message{}
+	a.toolCallHandler.ResolveToolCall(ctx, &openaiChatHistory, &inappChatHistory, pending[index], approved, reason, streamHandler, a.toolFilter(ctx))
+
+	// wait for the other tool calls of the turn before going back to the model
+	if len(pending) > 1 {
+		return openaiChatHistory.OfInputItemList, inappChatHistory, nil
+	}
+	return a.completionLoop(ctx, streamHandler, languageModel, openaiChatHistory.OfInputItemList, inappChatHistory)
+}
+
+// DenyPendingToolCalls denies every tool call awaiting approval in messages, e.g. because the user
+// sent a new message instead. It returns the completed chat history and the in-app messages
+// replacing the ToolCallApprovalRequired ones.
+func (a *AIClient) DenyPendingToolCalls(ctx context.Context, messages responses.ResponseInputParam, reason string) (responses.ResponseInputParam, []chatv1.Message) {
+	openaiChatHistory := responses.ResponseNewParamsInputUnion{OfInputItemList: messages}
+	inappChatHistory := []chatv1.Message{}
+	for _, toolCall := range handler.PendingToolCalls(messages) {
+		a.toolCallHandler.ResolveToolCall(ctx, &openaiChatHistory, &inappChatHistory, toolCall, false, reason, nil, toolkit.ToolFilter{})
+	}
+	return openaiChatHistory.OfInputItemList, inappChatHistory
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"paperdebugger/internal/models"
+	"strings"
+
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/responses"
+)
+
+// classifyPaperMaxChars bounds the part of the paper sent to the classifier, the beginning of a
+// paper (title, abstract, introduction) is enough to classify it.
+const classifyPaperMaxChars = 60000
+
+var classifyPaperSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"category": map[string]any{
+			"type":        "string",
+			"description": "The research field of the paper, e.g. \"Computer Science - Machine Learning\".",
+		},
+		"confidence": map[string]any{
+			"type":        "integer",
+			"description": "How confident the classification is, from 0 to 100.",
+		},
+		"explanation": map[string]any{
+			"type":        "string",
+			"description": "One or two sentences explaining the classification.",
+		},
+	},
+	"required":             []string{"category", "confidence", "explanation"},
+	"additionalProperties": false,
+}
+
+// ClassifyPaper implements services.PaperClassifier with a language model, for deployments
+// without a paper scoring service.
+func (a *AIClient) ClassifyPaper(ctx context.Context, latexSource string) (*models.ClassifyPaperResponse, error) {
+	if runes := []rune(latexSource); len(runes) > classifyPaperMaxChars {
+		latexSource = string(runes[:classifyPaperMaxChars])
+	}
+
+	resp, err := a.openaiClient.Responses.New(ctx, responses.ResponseNewParams{
+		Model: models.LanguageModel(chatv1.LanguageModel_LANGUAGE_MODEL_OPENAI_GPT41_MINI).Name(),
+		Input: responses.ResponseNewParamsInputUnion{
+			OfInputItemList: responses.ResponseInputParam{
+				{
+					OfInputMessage: &responses.ResponseInputItemMessageParam{
+						Role: "system",
+						Content: responses.ResponseInputMessageContentListParam{
+							responses.ResponseInputContentParamOfInputText(`You are an expert academic editor. Classify the research field of the LaTeX paper given by the user.`),
+						},
+					},
+				},
+				{
+					OfInputMessage: &responses.ResponseInputItemMessageParam{
+						Role: "user",
+						Content: responses.ResponseInputMessageContentListParam{
+							responses.ResponseInputContentParamOfInputText(latexSource),
+						},
+					},
+				},
+			},
+		},
+		Text: responses.ResponseTextConfigParam{
+			Format: responses.ResponseFormatTextConfigUnionParam{
+				OfJSONSchema: &responses.ResponseFormatTextJSONSchemaConfigParam{
+					Name:   "paper_classification",
+					Schema: classifyPaperSchema,
+					Strict: openai.Bool(true),
+				},
+			},
+		},
+		Store: openai.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseClassification(resp.OutputText())
+}
+
+// parseClassification decodes the structured output of ClassifyPaper.
+func parseClassification(output string) (*models.ClassifyPaperResponse, error) {
+	var result struct {
+		Category    string `json:"category"`
+		Confidence  int    `json:"confidence"`
+		Explanation string `json:"explanation"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal paper classification: %w, output: %s", err, output)
+	}
+
+	category := strings.TrimSpace(result.Category)
+	if category == "" {
+		return nil, errors.New("the language model returned an empty category")
+	}
+	return &models.ClassifyPaperResponse{
+		Category:    category,
+		Confidence:  max(0, min(result.Confidence, 100)),
+		Explanation: strings.TrimSpace(result.Explanation),
+	}, nil
+}
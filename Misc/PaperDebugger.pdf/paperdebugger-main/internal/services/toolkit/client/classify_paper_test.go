@@ -0,0 +1,22 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassification(t *testing.T) {
+	category, err := parseClassification(`{"category": " Computer Science - Machine Learning ", "confidence": 120, "explanation": "It trains transformers. "}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Computer Science - Machine Learning", category.Category)
+	assert.Equal(t, 100, category.Confidence)
+	assert.Equal(t, "It trains transformers.", category.Explanation)
+
+	_, err = parseClassification(`{"category": "", "confidence": 50, "explanation": ""}`)
+	assert.Error(t, err)
+
+	_, err = parseClassification(`I think it is about physics.`)
+	assert.Error(t, err)
+}
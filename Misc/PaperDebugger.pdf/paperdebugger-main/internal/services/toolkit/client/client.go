@@ -8,8 +8,10 @@ import (
 	"paperdebugger/internal/models"
 	"paperdebugger/internal/services"
 	"paperdebugger/internal/services/toolkit/handler"
+	"paperdebugger/internal/services/toolkit/jobs"
 	"paperdebugger/internal/services/toolkit/registry"
 	"paperdebugger/internal/services/toolkit/tools/xtramcp"
+	"sync"
 
 	"github.com/openai/openai-go/v2"
 	"github.com/openai/openai-go/v2/option"
@@ -25,8 +27,17 @@ type AIClient struct {
 
 	reverseCommentService *services.ReverseCommentService
 	projectService        *services.ProjectService
+	userService           *services.UserService
+	textEditService       *services.TextEditService
 	cfg                   *cfg.Cfg
 	logger                *logger.Logger
+
+	toolRegistry    *registry.ToolRegistry
+	toolJobs        *jobs.Manager
+	xtraMCPLoader   *xtramcp.XtraMCPLoader
+	stdioMCPLoaders []*xtramcp.StdioMCPLoader
+	reloadMu        sync.Mutex
+	stopWatching    context.CancelFunc
 }
 
 func NewAIClient(
@@ -34,6 +45,8 @@ func NewAIClient(
 
 	reverseCommentService *services.ReverseCommentService,
 	projectService *services.ProjectService,
+	userService *services.UserService,
+	textEditService *services.TextEditService,
 	cfg *cfg.Cfg,
 	logger *logger.Logger,
 ) *AIClient {
@@ -42,10 +55,17 @@ func NewAIClient(
 		option.WithAPIKey(cfg.OpenAIAPIKey),
 	)
 	CheckOpenAIWorks(oaiClient, logger)
-	// toolPaperScore := tools.NewPaperScoreTool(db, projectService)
-	// toolPaperScoreComment := tools.NewPaperScoreCommentTool(db, projectService, reverseCommentService)
+	// scoringClient := scoring.NewClient(cfg)
+	// toolPaperScore := tools.NewPaperScoreTool(db, projectService, scoringClient)
+	// toolPaperScoreComment := tools.NewPaperScoreCommentTool(db, projectService, reverseCommentService, scoringClient)
 
 	toolRegistry := registry.NewToolRegistry()
+	toolRegistry.RequireApproval(cfg.ToolsRequiringApproval...)
+	if cfg.ValidateToolResults {
+		toolRegistry.EnableResultValidation()
+	}
+	toolJobs := jobs.NewManager(jobs.NewMongoStore(db), cfg.ToolJobWorkers, logger)
+	toolRegistry.SetJobRunner(toolJobs, cfg.ToolJobInlineWait)
 
 	// toolRegistry.Register("always_exception", tools.AlwaysExceptionToolDescription, tools.AlwaysExceptionTool)
 	// toolRegistry.Register("greeting", tools.GreetingToolDescription, tools.GreetingTool)
@@ -53,20 +73,21 @@ func NewAIClient(
 	// Load tools dynamically from backend
 	xtraMCPLoader := xtramcp.NewXtraMCPLoader(db, projectService, cfg.XtraMCPURI)
 
-	// initialize MCP session first and log session ID
-	sessionID, err := xtraMCPLoader.InitializeMCP()
-	if err != nil {
-		logger.Errorf("[AI Client] Failed to initialize XtraMCP session: %v", err)
-		// TODO: Fallback to static tools or exit?
-	} else {
-		logger.Info("[AI Client] XtraMCP session initialized", "sessionID", sessionID)
-
-		// dynamically load all tools from XtraMCP backend
-		err = xtraMCPLoader.LoadToolsFromBackend(toolRegistry)
-		if err != nil {
-			logger.Errorf("[AI Client] Failed to load XtraMCP tools: %v", err)
+	// start local MCP servers spoken to over stdio
+	var stdioMCPLoaders []*xtramcp.StdioMCPLoader
+	for _, server := range cfg.LocalMCPServers {
+		loader := xtramcp.NewStdioMCPLoader(db, server.Name, server.Command, server.Args, logger)
+		loader.Watch(toolRegistry)
+		if err := loader.Start(context.Background()); err != nil {
+			logger.Errorf("[AI Client] Failed to start local MCP server %s: %v", server.Name, err)
+			continue
+		}
+		stdioMCPLoaders = append(stdioMCPLoaders, loader)
+
+		if err := loader.LoadToolsFromBackend(toolRegistry); err != nil {
+			logger.Errorf("[AI Client] Failed to load tools from local MCP server %s: %v", server.Name, err)
 		} else {
-			logger.Info("[AI Client] Successfully loaded XtraMCP tools")
+			logger.Info("[AI Client] Successfully loaded local MCP tools", "server", server.Name)
 		}
 	}
 
@@ -80,13 +101,40 @@ func NewAIClient(
 
 		reverseCommentService: reverseCommentService,
 		projectService:        projectService,
+		userService:           userService,
+		textEditService:       textEditService,
 		cfg:                   cfg,
 		logger:                logger,
+
+		toolRegistry:    toolRegistry,
+		toolJobs:        toolJobs,
+		xtraMCPLoader:   xtraMCPLoader,
+		stdioMCPLoaders: stdioMCPLoaders,
 	}
 
+	// classify the papers with the language model when there is no paper scoring service
+	projectService.SetPaperClassifier(client)
+
+	// XtraMCP may not be up yet, keep trying in the background instead of running without tools
+	ctx, cancel := context.WithCancel(context.Background())
+	client.stopWatching = cancel
+	client.watchXtraMCP(ctx)
+
 	return client
 }
 
+// Close stops watching XtraMCP, stops the background tool jobs and shuts down the local MCP
+// servers started by the client.
+func (a *AIClient) Close() {
+	a.stopWatching()
+	a.toolJobs.Close()
+	for _, loader := range a.stdioMCPLoaders {
+		if err := loader.Close(); err != nil {
+			a.logger.Errorf("[AI Client] Failed to close local MCP server: %v", err)
+		}
+	}
+}
+
 func CheckOpenAIWorks(oaiClient openai.Client, logger *logger.Logger) {
 	logger.Info("[AI Client] checking if openai client works")
 	chatCompletion, err := oaiClient.Chat.Completions.New(context.TODO(), openai.ChatCompletionNewParams{
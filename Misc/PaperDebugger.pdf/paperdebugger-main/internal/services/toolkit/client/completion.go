@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"paperdebugger/internal/libs/contextutil"
 	"paperdebugger/internal/models"
 	"paperdebugger/internal/services/toolkit/handler"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
 
+	"github.com/openai/openai-go/v2"
 	"github.com/openai/openai-go/v2/responses"
 )
 
@@ -49,19 +51,36 @@ func (a *AIClient) ChatCompletion(ctx context.Context, languageModel models.Lang
 //   - It repeatedly sends the current chat history to the language model, receives streaming responses, and forwards them to the client as they arrive.
 //   - If tool calls are required, it handles them and appends the results to the chat history, then continues the loop.
 //   - If no tool calls are needed, it appends the assistant's response and exits the loop.
+//   - If some tool calls require the user's approval, it exits the loop as well; ResolveToolCallStream resumes it.
+//   - Tool calls that outlast the inline wait go on in the background, their progress is streamed until the turn ends.
 //   - Finally, it returns the updated chat histories and any error encountered.
 func (a *AIClient) ChatCompletionStream(ctx context.Context, callbackStream chatv1.ChatService_CreateConversationMessageStreamServer, conversationId string, languageModel models.LanguageModel, messages responses.ResponseInputParam) (responses.ResponseInputParam, []chatv1.Message, error) {
-	openaiChatHistory := responses.ResponseNewParamsInputUnion{OfInputItemList: messages}
-	inappChatHistory := []chatv1.Message{}
-
 	streamHandler := handler.NewStreamHandler(callbackStream, conversationId, languageModel)
 
 	streamHandler.SendInitialization()
 	defer func() {
 		streamHandler.SendFinalization()
 	}()
+	defer a.forwardToolJobProgress(conversationId, streamHandler)()
+
+	return a.completionLoop(ctx, streamHandler, languageModel, messages, []chatv1.Message{})
+}
+
+// completionLoop sends the chat history to the language model and runs the tool calls it asks for,
+// until the model answers without tool calls or some tool calls wait for the user's approval.
+// inappChatHistory is extended with the messages visible to the user.
+func (a *AIClient) completionLoop(ctx context.Context, streamHandler *handler.StreamHandler, languageModel models.LanguageModel, messages responses.ResponseInputParam, inappChatHistory []chatv1.Message) (responses.ResponseInputParam, []chatv1.Message, error) {
+	openaiChatHistory := responses.ResponseNewParamsInputUnion{OfInputItemList: messages}
 
-	params := getDefaultParams(languageModel, openaiChatHistory, a.toolCallHandler.Registry)
+	toolFilter := a.toolFilter(ctx)
+	params := getDefaultParams(languageModel, openaiChatHistory, a.toolCallHandler.Registry.GetAllowedTools(toolFilter))
+
+	responseMode := contextutil.GetResponseMode(ctx)
+	streamHandler.SetResponseMode(responseMode)
+	if responseMode == models.ResponseModeRevision {
+		params.Instructions = openai.String(revisionInstructions)
+		params.Text = revisionTextConfig()
+	}
 
 	for {
 		params.Input = openaiChatHistory
@@ -95,12 +114,15 @@ func (a *AIClient) ChatCompletionStream(ctx context.Context, callbackStream chat
 		// 把 openai 的 response 记录下来，然后执行调用（如果有）
 		for _, item := range openaiOutput {
 			if item.Type == "message" && item.Role == "assistant" {
-				appendAssistantTextResponse(&openaiChatHistory, &inappChatHistory, item)
+				appendAssistantTextResponse(&openaiChatHistory, &inappChatHistory, item, responseMode)
+				if responseMode == models.ResponseModeRevision {
+					a.proposeTextEdits(ctx, streamHandler, &inappChatHistory)
+				}
 			}
 		}
 
 		// 执行调用（如果有），返回增量数据
-		openaiToolHistory, inappToolHistory, err := a.toolCallHandler.HandleToolCalls(ctx, openaiOutput, streamHandler)
+		openaiToolHistory, inappToolHistory, awaitingApproval, err := a.toolCallHandler.HandleToolCalls(ctx, openaiOutput, streamHandler, toolFilter)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -113,11 +135,11 @@ func (a *AIClient) ChatCompletionStream(ctx context.Context, callbackStream chat
 			// response stream is finished, if there is no tool call, then break
 			break
 		}
-	}
 
-	ptrChatHistory := make([]*chatv1.Message, len(inappChatHistory))
-	for i := range inappChatHistory {
-		ptrChatHistory[i] = &inappChatHistory[i]
+		// the turn pauses until the user approved or denied the tool calls (see ResolveToolCallStream)
+		if awaitingApproval {
+			break
+		}
 	}
 
 	return openaiChatHistory.OfInputItemList, inappChatHistory, nil
@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"paperdebugger/internal/libs/contextutil"
 	"paperdebugger/internal/models"
 	"strings"
 
@@ -21,6 +22,9 @@ func (a *AIClient) GetConversationTitle(ctx context.Context, inappChatHistory []
 		if _, ok := message.Payload.MessageType.(*chatv1.MessagePayload_User); ok {
 			return fmt.Sprintf("User: %s", message.Payload.GetUser().GetContent())
 		}
+		if _, ok := message.Payload.MessageType.(*chatv1.MessagePayload_Revision); ok {
+			return fmt.Sprintf("Assistant: revised to %s", message.Payload.GetRevision().GetRevised())
+		}
 		if _, ok := message.Payload.MessageType.(*chatv1.MessagePayload_ToolCall); ok {
 			return fmt.Sprintf("Tool '%s' called", message.Payload.GetToolCall().GetName())
 		}
@@ -29,6 +33,8 @@ func (a *AIClient) GetConversationTitle(ctx context.Context, inappChatHistory []
 	message := strings.Join(messages, "\n")
 	message = fmt.Sprintf("%s\nBased on above conversation, generate a short, clear, and descriptive title that summarizes the main topic or purpose of the discussion. The title should be concise, specific, and use natural language. Avoid vague or generic titles. Use abbreviation and short words if possible. Use 3-5 words if possible. Give me the title only, no other text including any other words.", message)
 
+	// the title is free text, whatever the response mode of the conversation turn
+	ctx = contextutil.SetResponseMode(ctx, models.ResponseModeText)
 	_, resp, err := a.ChatCompletion(ctx, models.LanguageModel(chatv1.LanguageModel_LANGUAGE_MODEL_OPENAI_GPT41_MINI), responses.ResponseInputParam{
 		{
 			OfInputMessage: &responses.ResponseInputItemMessageParam{
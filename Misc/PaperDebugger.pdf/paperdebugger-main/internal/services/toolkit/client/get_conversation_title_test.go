@@ -23,6 +23,8 @@ func TestGetConversationTitle_Case1(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -75,6 +77,8 @@ func TestGetConversationTitle_Case2(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -150,6 +154,8 @@ func TestGetConversationTitle_Case3(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
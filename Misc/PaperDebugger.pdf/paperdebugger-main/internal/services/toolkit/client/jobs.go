@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit/handler"
+	"paperdebugger/internal/services/toolkit/jobs"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+)
+
+// ToolJobs returns the manager of the tool calls running in the background.
+func (a *AIClient) ToolJobs() *jobs.Manager {
+	return a.toolJobs
+}
+
+// forwardToolJobProgress streams the progress of the background tool jobs of the conversation
+// until the returned function is called.
+func (a *AIClient) forwardToolJobProgress(conversationId string, streamHandler *handler.StreamHandler) (stop func()) {
+	if conversationId == "" {
+		return func() {}
+	}
+	events, unsubscribe := a.toolJobs.Subscribe(conversationId)
+	done := make(chan struct{})
+	forwarded := make(chan struct{})
+	go func() {
+		defer close(forwarded)
+		for {
+			select {
+			case job := <-events:
+				streamHandler.SendToolCallProgress(job)
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-forwarded
+		unsubscribe()
+	}
+}
+
+// WatchToolJobs streams the progress of the background tool jobs of the conversation, until all of
+// them finished and their results were added to the conversation, or ctx is done.
+func (a *AIClient) WatchToolJobs(ctx context.Context, callbackStream chatv1.ChatService_CreateConversationMessageStreamServer, conversationId string, languageModel models.LanguageModel) {
+	streamHandler := handler.NewStreamHandler(callbackStream, conversationId, languageModel)
+	streamHandler.SendInitialization()
+	defer streamHandler.SendFinalization()
+
+	events, unsubscribe := a.toolJobs.Subscribe(conversationId)
+	defer unsubscribe()
+
+	// subscribed first, so that no final snapshot is missed
+	pending := map[string]bool{}
+	for _, job := range a.toolJobs.Active(conversationId) {
+		pending[job.ID.Hex()] = true
+		streamHandler.SendToolCallProgress(job)
+	}
+
+	for len(pending) > 0 {
+		select {
+		case job := <-events:
+			streamHandler.SendToolCallProgress(job)
+			if job.Finished() {
+				delete(pending, job.ID.Hex())
+			} else {
+				pending[job.ID.Hex()] = true
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
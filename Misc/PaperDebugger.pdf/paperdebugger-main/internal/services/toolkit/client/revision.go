@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/services"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/handler"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+
+	"github.com/google/uuid"
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// revisionInstructions replace the formatting rules of the system prompt (triple backticks) in
+// models.ResponseModeRevision.
+const revisionInstructions = `Reply with a revision of the text selected by the user, following the user's request. ` +
+	`"original" is the selected text, "revised" is the full replacement of it in LaTeX, without triple backticks, ` +
+	`and "rationale" briefly explains the changes.`
+
+var revisionSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"original": map[string]any{
+			"type":        "string",
+			"description": "The text selected by the user, unchanged.",
+		},
+		"revised": map[string]any{
+			"type":        "string",
+			"description": "The revised text, which replaces the original one in the paper.",
+		},
+		"rationale": map[string]any{
+			"type":        "string",
+			"description": "A short explanation of the changes.",
+		},
+	},
+	"required":             []string{"original", "revised", "rationale"},
+	"additionalProperties": false,
+}
+
+// revisionTextConfig constrains the assistant reply to a revision (see handler.ParseRevision).
+func revisionTextConfig() responses.ResponseTextConfigParam {
+	return responses.ResponseTextConfigParam{
+		Format: responses.ResponseFormatTextConfigUnionParam{
+			OfJSONSchema: &responses.ResponseFormatTextJSONSchemaConfigParam{
+				Name:   "revision",
+				Schema: revisionSchema,
+				Strict: openai.Bool(true),
+			},
+		},
+	}
+}
+
+// proposeTextEdits follows the revision of the last in-app message, if it is one, with the edits
+// turning the text the user selected (see contextutil.GetSelection) into the revised text. The revision is still usable on
+// its own when the selected text cannot be located in the project.
+func (a *AIClient) proposeTextEdits(ctx context.Context, streamHandler *handler.StreamHandler, inappChatHistory *[]chatv1.Message) {
+	if len(*inappChatHistory) == 0 {
+		return
+	}
+	revision := (*inappChatHistory)[len(*inappChatHistory)-1].GetPayload().GetRevision()
+	if revision == nil {
+		return
+	}
+	actor, projectID, conversationID := toolkit.GetActorProjectConversationID(ctx)
+	conversationObjectID, err := bson.ObjectIDFromHex(conversationID)
+	if actor == nil || projectID == "" || err != nil {
+		return
+	}
+
+	messageID := "pd_msg_edits_" + uuid.New().String()
+	// the original of the revision is the copy of the model, which may differ from the selection
+	selection := contextutil.GetSelection(ctx)
+	edits, err := a.textEditService.ProposeTextEdits(ctx, actor.ID, projectID, conversationObjectID, messageID, selection, revision.GetRevised())
+	if err != nil {
+		if !errors.Is(err, services.ErrTextNotLocated) {
+			a.logger.Error("Failed to propose text edits", "error", err, "conversationID", conversationID)
+		}
+		return
+	}
+	if len(edits) == 0 {
+		return
+	}
+
+	payload := handler.TextEditsPayload(edits)
+	streamHandler.SendTextEdits(messageID, payload)
+	*inappChatHistory = append(*inappChatHistory, chatv1.Message{
+		MessageId: messageID,
+		Payload:   payload,
+	})
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/registry"
+	"paperdebugger/internal/services/toolkit/tools/xtramcp"
+	"time"
+)
+
+const (
+	xtraMCPMinBackoff = 1 * time.Second
+	xtraMCPMaxBackoff = 5 * time.Minute
+)
+
+// watchXtraMCP loads the XtraMCP tools, synchronously on the first attempt so the
+// tools are available right after boot. If that fails, or once the backend reports
+// that its tool list changed, the tools are (re)loaded in the background.
+func (a *AIClient) watchXtraMCP(ctx context.Context) {
+	err := a.reloadXtraMCP()
+	if err != nil {
+		a.logger.Errorf("[AI Client] Failed to load XtraMCP tools, retrying in background: %v", err)
+	}
+	go a.keepXtraMCPInSync(ctx, err == nil)
+}
+
+func (a *AIClient) keepXtraMCPInSync(ctx context.Context, loaded bool) {
+	backoff := xtraMCPMinBackoff
+	wait := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, xtraMCPMaxBackoff)
+		return true
+	}
+
+	for {
+		if !loaded {
+			if !wait() {
+				return
+			}
+			if err := a.reloadXtraMCP(); err != nil {
+				a.logger.Errorf("[AI Client] Failed to load XtraMCP tools, retrying in %s: %v", backoff, err)
+				continue
+			}
+			backoff = xtraMCPMinBackoff
+		}
+
+		err := a.xtraMCPLoader.ListenNotifications(ctx, func(method string) {
+			if method != xtramcp.NotificationToolsListChanged {
+				return
+			}
+			if err := a.reloadXtraMCP(); err != nil {
+				a.logger.Errorf("[AI Client] Failed to reload XtraMCP tools: %v", err)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, xtramcp.ErrNotificationStreamUnsupported) {
+			a.logger.Info("[AI Client] XtraMCP does not push tool list changes, use the admin API to reload tools")
+			return
+		}
+		// the stream broke, the backend may have restarted: reload tools with a fresh session
+		a.logger.Warn("[AI Client] XtraMCP notification stream closed", "error", err)
+		loaded = false
+	}
+}
+
+func (a *AIClient) reloadXtraMCP() error {
+	a.reloadMu.Lock()
+	defer a.reloadMu.Unlock()
+
+	if err := a.xtraMCPLoader.Reload(a.toolRegistry); err != nil {
+		return err
+	}
+	a.logger.Info("[AI Client] Successfully loaded XtraMCP tools")
+	return nil
+}
+
+// ReloadTools forces a reload of the tools from all MCP backends and returns the
+// names of the registered tools along with the backends which failed to reload.
+func (a *AIClient) ReloadTools(ctx context.Context) ([]string, []error) {
+	var errs []error
+	if err := a.reloadXtraMCP(); err != nil {
+		errs = append(errs, fmt.Errorf("xtramcp: %w", err))
+	}
+	for _, loader := range a.stdioMCPLoaders {
+		if err := loader.LoadToolsFromBackend(a.toolRegistry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return a.toolRegistry.Names(), errs
+}
+
+// ListTools returns every registered tool, regardless of user and project preferences.
+func (a *AIClient) ListTools() []registry.ToolInfo {
+	return a.toolRegistry.List()
+}
+
+// toolFilter resolves the tool preferences of the actor and project in ctx.
+func (a *AIClient) toolFilter(ctx context.Context) toolkit.ToolFilter {
+	filter := toolkit.ToolFilter{}
+	actor, projectID, _ := toolkit.GetActorProjectConversationID(ctx)
+	if actor == nil {
+		return filter
+	}
+
+	user, err := a.userService.GetUserByID(ctx, actor.ID)
+	if err != nil {
+		a.logger.Error("Failed to get user tool preferences", "error", err, "userID", actor.ID)
+	} else {
+		filter.DisabledTools = user.ToolPreferences.DisabledTools
+	}
+
+	if projectID != "" {
+		project, err := a.projectService.GetProject(ctx, actor.ID, projectID)
+		if err != nil {
+			a.logger.Error("Failed to get project pinned tools", "error", err, "userID", actor.ID, "projectID", projectID)
+		} else {
+			filter.PinnedTools = project.PinnedTools
+		}
+	}
+	return filter
+}
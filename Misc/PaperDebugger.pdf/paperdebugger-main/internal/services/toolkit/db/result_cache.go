@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// ResultKey identifies the result of a tool call on a version of the paper.
+type ResultKey struct {
+	Key         string // hash of the tool name, the normalized arguments and ContentHash
+	ContentHash string // hash of the paper content
+}
+
+// HashContent returns the hash of the paper content, e.g. of project.GetFullContent().
+func HashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewResultKey returns the cache key of a call of the tool with args on the paper content. Arguments
+// differing only in key order or number formatting get the same key. The paper content should not be
+// part of args, it is already covered by its hash.
+func NewResultKey(toolName string, args map[string]any, content string) (ResultKey, error) {
+	normalized, err := normalizeArgs(args)
+	if err != nil {
+		return ResultKey{}, errors.New("failed to normalize tool arguments: " + err.Error())
+	}
+
+	contentHash := HashContent(content)
+	h := sha256.New()
+	h.Write([]byte(toolName))
+	h.Write([]byte{0})
+	h.Write(normalized)
+	h.Write([]byte{0})
+	h.Write([]byte(contentHash))
+	return ResultKey{
+		Key:         hex.EncodeToString(h.Sum(nil)),
+		ContentHash: contentHash,
+	}, nil
+}
+
+// normalizeArgs returns args as JSON, with sorted object keys and without the Go types of the values.
+func normalizeArgs(args map[string]any) ([]byte, error) {
+	if len(args) == 0 {
+		return []byte("{}"), nil
+	}
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+// CreateWithKey creates a pending record, whose result is reused by the next calls with the same key.
+func (r *ToolCallRecordDB) CreateWithKey(ctx context.Context, toolCallId string, functionName string, functionParams map[string]any, key ResultKey) (*models.FunctionCall, error) {
+	return r.create(ctx, toolCallId, functionName, functionParams, key)
+}
+
+// GetCached returns the result of the latest successful call with the same key in the project, or
+// nil if there is none. The reuse is recorded as a successful call of its own, so that GetLatest
+// returns the result matching the current content.
+func (r *ToolCallRecordDB) GetCached(ctx context.Context, toolCallId string, key ResultKey) (*models.FunctionCall, error) {
+	actor, projectId, conversationID := toolkit.GetActorProjectConversationID(ctx)
+	if actor == nil || projectId == "" || conversationID == "" {
+		return nil, errors.New("failed to get actor, project id, or conversation id")
+	}
+
+	cached, err := r.findLatest(ctx, bson.M{
+		"cache_key":       key.Key,
+		"user_id":         actor.ID,
+		"project_id":      projectId,
+		"function_status": models.FunctionCallStatusSuccess,
+	})
+	if err != nil || cached == nil {
+		return nil, err
+	}
+
+	origin := cached.ID
+	if cached.CachedFrom != nil {
+		origin = *cached.CachedFrom
+	}
+	now := bson.NewDateTimeFromTime(time.Now())
+	record := &models.FunctionCall{
+		BaseModel: models.BaseModel{
+			ID:        bson.NewObjectID(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		UserID:         actor.ID,
+		ProjectID:      projectId,
+		ConversationID: conversationID,
+		ToolCallID:     toolCallId,
+		FunctionName:   cached.FunctionName,
+		FunctionParams: cached.FunctionParams,
+		FunctionResult: cached.FunctionResult,
+		FunctionStatus: models.FunctionCallStatusSuccess,
+		CacheKey:       key.Key,
+		ContentHash:    key.ContentHash,
+		CachedFrom:     &origin,
+	}
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		return nil, errors.New("failed to insert function call record: " + err.Error())
+	}
+	return record, nil
+}
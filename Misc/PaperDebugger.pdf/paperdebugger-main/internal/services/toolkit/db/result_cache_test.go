@@ -0,0 +1,39 @@
+package db_test
+
+import (
+	"paperdebugger/internal/services/toolkit/db"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResultKey(t *testing.T) {
+	args := map[string]any{"category": "cs", "options": map[string]any{"a": 1, "b": true}}
+	key, err := db.NewResultKey("paper_score", args, "\\section{Intro}")
+	require.NoError(t, err)
+	assert.Equal(t, db.HashContent("\\section{Intro}"), key.ContentHash)
+
+	reordered, err := db.NewResultKey("paper_score", map[string]any{"options": map[string]any{"b": true, "a": 1.0}, "category": "cs"}, "\\section{Intro}")
+	require.NoError(t, err)
+	assert.Equal(t, key, reordered, "the key order and number types of the arguments do not matter")
+
+	otherTool, err := db.NewResultKey("paper_score_comment", args, "\\section{Intro}")
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Key, otherTool.Key)
+
+	otherArgs, err := db.NewResultKey("paper_score", map[string]any{"category": "math"}, "\\section{Intro}")
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Key, otherArgs.Key)
+
+	otherContent, err := db.NewResultKey("paper_score", args, "\\section{Introduction}")
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Key, otherContent.Key)
+	assert.NotEqual(t, key.ContentHash, otherContent.ContentHash)
+
+	empty, err := db.NewResultKey("paper_score", nil, "")
+	require.NoError(t, err)
+	emptyMap, err := db.NewResultKey("paper_score", map[string]any{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, empty, emptyMap)
+}
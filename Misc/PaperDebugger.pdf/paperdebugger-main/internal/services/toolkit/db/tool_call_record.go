@@ -28,6 +28,10 @@ func NewToolCallRecordDB(db *db.DB) *ToolCallRecordDB {
 }
 
 func (r *ToolCallRecordDB) Create(ctx context.Context, toolCallId string, functionName string, functionParams map[string]any) (*models.FunctionCall, error) {
+	return r.create(ctx, toolCallId, functionName, functionParams, ResultKey{})
+}
+
+func (r *ToolCallRecordDB) create(ctx context.Context, toolCallId string, functionName string, functionParams map[string]any, key ResultKey) (*models.FunctionCall, error) {
 	actor, projectId, conversationID := toolkit.GetActorProjectConversationID(ctx)
 	if actor == nil || projectId == "" || conversationID == "" {
 		return nil, errors.New("failed to get actor, project id, or conversation id")
@@ -51,6 +55,8 @@ func (r *ToolCallRecordDB) Create(ctx context.Context, toolCallId string, functi
 		FunctionName:   functionName,
 		FunctionParams: string(functionParamsJSON),
 		FunctionStatus: models.FunctionCallStatusPending,
+		CacheKey:       key.Key,
+		ContentHash:    key.ContentHash,
 	}
 
 	_, err = r.collection.InsertOne(ctx, record)
@@ -68,12 +74,14 @@ func (r *ToolCallRecordDB) GetLatest(ctx context.Context, toolName string, userI
 		return nil, errors.New("failed to get actor, project id, or conversation id")
 	}
 
-	filter := bson.M{
+	return r.findLatest(ctx, bson.M{
 		"function_name": toolName,
 		"user_id":       actor.ID,
 		"project_id":    projectId,
-	}
+	})
+}
 
+func (r *ToolCallRecordDB) findLatest(ctx context.Context, filter bson.M) (*models.FunctionCall, error) {
 	var record models.FunctionCall
 	err := r.collection.FindOne(ctx, filter, options.FindOne().SetSort(bson.M{"created_at": -1})).Decode(&record)
 	if err != nil {
@@ -86,8 +94,20 @@ func (r *ToolCallRecordDB) GetLatest(ctx context.Context, toolName string, userI
 	return &record, nil
 }
 
+// CheckCoolDown fails if the tool ran less than coolDownTime ago. Reused results do not count: a
+// tool returning cached results (see GetCached) is only cooled down when the content changed.
 func (r *ToolCallRecordDB) CheckCoolDown(ctx context.Context, toolName string, userID string, projectID string, coolDownTime time.Duration) error {
-	record, err := r.GetLatest(ctx, toolName, userID, projectID)
+	actor, projectId, conversationID := toolkit.GetActorProjectConversationID(ctx)
+	if actor == nil || projectId == "" || conversationID == "" {
+		return errors.New("failed to get actor, project id, or conversation id")
+	}
+
+	record, err := r.findLatest(ctx, bson.M{
+		"function_name": toolName,
+		"user_id":       actor.ID,
+		"project_id":    projectId,
+		"cached_from":   bson.M{"$exists": false},
+	})
 	if err != nil {
 		return err
 	}
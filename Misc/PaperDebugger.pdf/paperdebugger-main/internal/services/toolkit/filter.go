@@ -0,0 +1,17 @@
+package toolkit
+
+import "slices"
+
+// ToolFilter decides which registered tools the assistant may use in a conversation.
+type ToolFilter struct {
+	DisabledTools []string // disabled by the user
+	PinnedTools   []string // pinned by the project, empty means every tool
+}
+
+// Allows reports whether the tool called name may be used.
+func (f ToolFilter) Allows(name string) bool {
+	if slices.Contains(f.DisabledTools, name) {
+		return false
+	}
+	return len(f.PinnedTools) == 0 || slices.Contains(f.PinnedTools, name)
+}
@@ -0,0 +1,24 @@
+package toolkit_test
+
+import (
+	"paperdebugger/internal/services/toolkit"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolFilter_Allows(t *testing.T) {
+	assert.True(t, toolkit.ToolFilter{}.Allows("paper_score"))
+
+	filter := toolkit.ToolFilter{DisabledTools: []string{"deep_research"}}
+	assert.False(t, filter.Allows("deep_research"))
+	assert.True(t, filter.Allows("paper_score"))
+
+	filter = toolkit.ToolFilter{
+		DisabledTools: []string{"deep_research"},
+		PinnedTools:   []string{"paper_score", "deep_research"},
+	}
+	assert.True(t, filter.Allows("paper_score"))
+	assert.False(t, filter.Allows("deep_research"))
+	assert.False(t, filter.Allows("greeting"))
+}
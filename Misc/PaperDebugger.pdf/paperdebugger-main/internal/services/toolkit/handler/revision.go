@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"paperdebugger/internal/models"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+	"strings"
+)
+
+// ParseRevision decodes the structured output of an assistant message in models.ResponseModeRevision.
+// It returns false if the text is not a revision, e.g. a refusal of the model.
+func ParseRevision(text string) (*chatv1.MessageTypeRevision, bool) {
+	var revision struct {
+		Original  string `json:"original"`
+		Revised   string `json:"revised"`
+		Rationale string `json:"rationale"`
+	}
+	if err := json.Unmarshal([]byte(text), &revision); err != nil {
+		return nil, false
+	}
+	if strings.TrimSpace(revision.Revised) == "" {
+		return nil, false
+	}
+	return &chatv1.MessageTypeRevision{
+		Original:  revision.Original,
+		Revised:   revision.Revised,
+		Rationale: strings.TrimSpace(revision.Rationale),
+	}, true
+}
+
+// AssistantPayload is the in-app payload of an assistant message: a revision in
+// models.ResponseModeRevision if its text is one, the text itself otherwise.
+func AssistantPayload(text string, mode models.ResponseMode) *chatv1.MessagePayload {
+	if mode == models.ResponseModeRevision {
+		if revision, ok := ParseRevision(text); ok {
+			return &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_Revision{Revision: revision},
+			}
+		}
+	}
+	return &chatv1.MessagePayload{
+		MessageType: &chatv1.MessagePayload_Assistant{
+			Assistant: &chatv1.MessageTypeAssistant{Content: text},
+		},
+	}
+}
+
+// TextEditsPayload is the in-app payload of the edits proposed for a revision.
+func TextEditsPayload(edits []models.TextEdit) *chatv1.MessagePayload {
+	textEdits := &chatv1.MessageTypeTextEdits{}
+	for _, edit := range edits {
+		textEdits.DocPath = edit.DocPath
+		textEdits.Edits = append(textEdits.Edits, TextEditToProto(edit))
+	}
+	return &chatv1.MessagePayload{
+		MessageType: &chatv1.MessagePayload_TextEdits{TextEdits: textEdits},
+	}
+}
+
+func TextEditToProto(edit models.TextEdit) *chatv1.TextEdit {
+	status := chatv1.TextEditStatus_TEXT_EDIT_STATUS_UNSPECIFIED
+	switch edit.Status {
+	case models.CommentStatusAccepted:
+		status = chatv1.TextEditStatus_TEXT_EDIT_STATUS_ACCEPTED
+	case models.CommentStatusRejected:
+		status = chatv1.TextEditStatus_TEXT_EDIT_STATUS_REJECTED
+	}
+	return &chatv1.TextEdit{
+		EditId:      edit.ID.Hex(),
+		DocId:       edit.DocID,
+		DocVersion:  int32(edit.DocVersion),
+		StartOffset: int32(edit.StartOffset),
+		EndOffset:   int32(edit.EndOffset),
+		Replacement: edit.Replacement,
+		Original:    edit.Original,
+		Status:      status,
+	}
+}
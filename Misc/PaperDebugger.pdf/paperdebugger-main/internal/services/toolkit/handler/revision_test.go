@@ -0,0 +1,36 @@
+package handler_test
+
+import (
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit/handler"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRevision(t *testing.T) {
+	revision, ok := handler.ParseRevision(`{"original": "We propose an new method.", "revised": "We propose a new method.", "rationale": " Fixed the article. "}`)
+	require.True(t, ok)
+	assert.Equal(t, "We propose an new method.", revision.GetOriginal())
+	assert.Equal(t, "We propose a new method.", revision.GetRevised())
+	assert.Equal(t, "Fixed the article.", revision.GetRationale())
+
+	_, ok = handler.ParseRevision("I can't help with that.")
+	assert.False(t, ok)
+	_, ok = handler.ParseRevision(`{"original": "text", "revised": " ", "rationale": ""}`)
+	assert.False(t, ok, "a revision needs a revised text")
+}
+
+func TestAssistantPayload(t *testing.T) {
+	text := `{"original": "a", "revised": "b", "rationale": "c"}`
+
+	payload := handler.AssistantPayload(text, models.ResponseModeRevision)
+	assert.Equal(t, "b", payload.GetRevision().GetRevised())
+
+	payload = handler.AssistantPayload(text, models.ResponseModeText)
+	assert.Equal(t, text, payload.GetAssistant().GetContent())
+
+	payload = handler.AssistantPayload("Sorry, I can't.", models.ResponseModeRevision)
+	assert.Equal(t, "Sorry, I can't.", payload.GetAssistant().GetContent(), "falls back to the text")
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"paperdebugger/internal/models"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+	"sync"
 
 	"github.com/openai/openai-go/v2/responses"
 )
@@ -11,6 +12,9 @@ type StreamHandler struct {
 	callbackStream chatv1.ChatService_CreateConversationMessageStreamServer
 	conversationId string
 	languageModel  models.LanguageModel
+	responseMode   models.ResponseMode
+
+	mu sync.Mutex // the progress of background tool jobs is sent concurrently with the model output
 }
 
 func NewStreamHandler(
@@ -25,11 +29,23 @@ func NewStreamHandler(
 	}
 }
 
+// SetResponseMode tells how the assistant messages are sent: in models.ResponseModeRevision, the
+// JSON text is not streamed, the message ends with the parsed revision.
+func (h *StreamHandler) SetResponseMode(mode models.ResponseMode) {
+	h.responseMode = mode
+}
+
+func (h *StreamHandler) send(response *chatv1.CreateConversationMessageStreamResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbackStream.Send(response)
+}
+
 func (h *StreamHandler) SendInitialization() {
 	if h.callbackStream == nil {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamInitialization{
 			StreamInitialization: &chatv1.StreamInitialization{
 				ConversationId: h.conversationId,
@@ -43,8 +59,21 @@ func (h *StreamHandler) HandleAddedItem(chunk responses.ResponseStreamEventUnion
 	if h.callbackStream == nil {
 		return
 	}
-	if chunk.Item.Type == "message" {
-		h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	if chunk.Item.Type == "message" && h.responseMode == models.ResponseModeRevision {
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
+			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartBegin{
+				StreamPartBegin: &chatv1.StreamPartBegin{
+					MessageId: "openai_" + chunk.Item.ID,
+					Payload: &chatv1.MessagePayload{
+						MessageType: &chatv1.MessagePayload_Revision{
+							Revision: &chatv1.MessageTypeRevision{},
+						},
+					},
+				},
+			},
+		})
+	} else if chunk.Item.Type == "message" {
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
 			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartBegin{
 				StreamPartBegin: &chatv1.StreamPartBegin{
 					MessageId: "openai_" + chunk.Item.ID,
@@ -57,7 +86,7 @@ func (h *StreamHandler) HandleAddedItem(chunk responses.ResponseStreamEventUnion
 			},
 		})
 	} else if chunk.Item.Type == "function_call" {
-		h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
 			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartBegin{
 				StreamPartBegin: &chatv1.StreamPartBegin{
 					MessageId: "openai_" + chunk.Item.ID,
@@ -81,22 +110,16 @@ func (h *StreamHandler) HandleDoneItem(chunk responses.ResponseStreamEventUnion)
 	item := chunk.Item
 	switch item.Type {
 	case "message":
-		h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
 			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
 				StreamPartEnd: &chatv1.StreamPartEnd{
 					MessageId: "openai_" + item.ID,
-					Payload: &chatv1.MessagePayload{
-						MessageType: &chatv1.MessagePayload_Assistant{
-							Assistant: &chatv1.MessageTypeAssistant{
-								Content: item.Content[0].Text,
-							},
-						},
-					},
+					Payload:   AssistantPayload(item.Content[0].Text, h.responseMode),
 				},
 			},
 		})
 	case "function_call":
-		h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
 			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
 				StreamPartEnd: &chatv1.StreamPartEnd{
 					MessageId: "openai_" + item.ID,
@@ -112,7 +135,7 @@ func (h *StreamHandler) HandleDoneItem(chunk responses.ResponseStreamEventUnion)
 			},
 		})
 	default:
-		h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+		h.send(&chatv1.CreateConversationMessageStreamResponse{
 			ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
 				StreamPartEnd: &chatv1.StreamPartEnd{
 					MessageId: "openai_" + item.ID,
@@ -130,10 +153,10 @@ func (h *StreamHandler) HandleDoneItem(chunk responses.ResponseStreamEventUnion)
 }
 
 func (h *StreamHandler) HandleTextDelta(chunk responses.ResponseStreamEventUnion) {
-	if h.callbackStream == nil {
+	if h.callbackStream == nil || h.responseMode == models.ResponseModeRevision {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_MessageChunk{
 			MessageChunk: &chatv1.MessageChunk{
 				MessageId: "openai_" + chunk.ItemID,
@@ -147,7 +170,7 @@ func (h *StreamHandler) SendIncompleteIndicator(reason string, responseId string
 	if h.callbackStream == nil {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_IncompleteIndicator{
 			IncompleteIndicator: &chatv1.IncompleteIndicator{
 				Reason:     reason,
@@ -161,7 +184,7 @@ func (h *StreamHandler) SendFinalization() {
 	if h.callbackStream == nil {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamFinalization{
 			StreamFinalization: &chatv1.StreamFinalization{
 				ConversationId: h.conversationId,
@@ -174,7 +197,7 @@ func (h *StreamHandler) SendToolCallBegin(toolCall responses.ResponseFunctionToo
 	if h.callbackStream == nil {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartBegin{
 			StreamPartBegin: &chatv1.StreamPartBegin{
 				MessageId: "openai_" + toolCall.CallID,
@@ -195,7 +218,7 @@ func (h *StreamHandler) SendToolCallEnd(toolCall responses.ResponseFunctionToolC
 	if h.callbackStream == nil {
 		return
 	}
-	h.callbackStream.Send(&chatv1.CreateConversationMessageStreamResponse{
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
 		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
 			StreamPartEnd: &chatv1.StreamPartEnd{
 				MessageId: "openai_" + toolCall.CallID,
@@ -218,3 +241,70 @@ func (h *StreamHandler) SendToolCallEnd(toolCall responses.ResponseFunctionToolC
 		},
 	})
 }
+
+func (h *StreamHandler) SendToolCallApprovalRequired(toolCall responses.ResponseFunctionToolCall) {
+	if h.callbackStream == nil {
+		return
+	}
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
+		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
+			StreamPartEnd: &chatv1.StreamPartEnd{
+				MessageId: "openai_" + toolCall.CallID,
+				Payload:   approvalRequiredPayload(toolCall),
+			},
+		},
+	})
+}
+
+// SendTextEdits sends the edits proposed for a revision, as a part end without part begin.
+func (h *StreamHandler) SendTextEdits(messageId string, payload *chatv1.MessagePayload) {
+	if h.callbackStream == nil {
+		return
+	}
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
+		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_StreamPartEnd{
+			StreamPartEnd: &chatv1.StreamPartEnd{
+				MessageId: messageId,
+				Payload:   payload,
+			},
+		},
+	})
+}
+
+func (h *StreamHandler) SendToolCallProgress(job models.ToolJob) {
+	if h.callbackStream == nil {
+		return
+	}
+	progress := &chatv1.ToolCallProgress{
+		MessageId: "openai_" + job.ToolCallID,
+		JobId:     job.ID.Hex(),
+		Name:      job.ToolName,
+		Status:    toolJobStatus(job.Status),
+		Message:   job.ProgressMessage,
+	}
+	if job.Progress >= 0 {
+		progress.Progress = &job.Progress
+	}
+	if job.Status == models.ToolJobStatusFailed {
+		progress.Message = job.Error
+	}
+	h.send(&chatv1.CreateConversationMessageStreamResponse{
+		ResponsePayload: &chatv1.CreateConversationMessageStreamResponse_ToolCallProgress{
+			ToolCallProgress: progress,
+		},
+	})
+}
+
+func toolJobStatus(status models.ToolJobStatus) chatv1.ToolJobStatus {
+	switch status {
+	case models.ToolJobStatusQueued:
+		return chatv1.ToolJobStatus_TOOL_JOB_STATUS_QUEUED
+	case models.ToolJobStatusRunning:
+		return chatv1.ToolJobStatus_TOOL_JOB_STATUS_RUNNING
+	case models.ToolJobStatusSucceeded:
+		return chatv1.ToolJobStatus_TOOL_JOB_STATUS_SUCCEEDED
+	case models.ToolJobStatusFailed:
+		return chatv1.ToolJobStatus_TOOL_JOB_STATUS_FAILED
+	}
+	return chatv1.ToolJobStatus_TOOL_JOB_STATUS_UNSPECIFIED
+}
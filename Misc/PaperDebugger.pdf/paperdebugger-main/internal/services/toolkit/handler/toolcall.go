@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"paperdebugger/internal/services/toolkit"
 	"paperdebugger/internal/services/toolkit/registry"
 	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
 
@@ -29,18 +31,25 @@ func NewToolCallHandler(toolRegistry *registry.ToolRegistry) *ToolCallHandler {
 // HandleToolCalls processes a list of tool call outputs, invokes the corresponding tools, and constructs
 // both OpenAI and in-app chat histories reflecting the tool call and its result.
 //
+// Calls to tools that require approval are not run: only the function_call item is added to the OpenAI
+// chat history and a ToolCallApprovalRequired message to the in-app chat history. The conversation must not
+// be sent to the model again until ResolveToolCall produced the missing outputs (see PendingToolCalls).
+//
 // Parameters:
 // ctx:           The context for cancellation and deadlines.
 // outputs:       A slice of ResponseOutputItemUnion representing outputs from the model, possibly containing tool calls.
 // streamHandler: Optional handler for streaming tool call events (can be nil).
+// toolFilter:    Tools the user or project did not allow are refused, even if the model calls them.
 //
 // Returns:
 //   - openaiChatHistory: The OpenAI-compatible chat history including tool call and output items.
 //   - inappChatHistory:  The in-app chat history as a slice of chatv1.Message, reflecting tool call events.
+//   - awaitingApproval:  Whether some tool calls wait for the user's approval.
 //   - error:             Any error encountered during processing (always nil in current implementation).
-func (h *ToolCallHandler) HandleToolCalls(ctx context.Context, outputs []responses.ResponseOutputItemUnion, streamHandler *StreamHandler) (responses.ResponseNewParamsInputUnion, []chatv1.Message, error) {
+func (h *ToolCallHandler) HandleToolCalls(ctx context.Context, outputs []responses.ResponseOutputItemUnion, streamHandler *StreamHandler, toolFilter toolkit.ToolFilter) (responses.ResponseNewParamsInputUnion, []chatv1.Message, bool, error) {
 	openaiChatHistory := responses.ResponseNewParamsInputUnion{} // Accumulates OpenAI chat history items
 	inappChatHistory := []chatv1.Message{}                       // Accumulates in-app chat history messages
+	awaitingApproval := false
 
 	// Iterate over each output item to process tool calls
 	for _, output := range outputs {
@@ -55,49 +64,135 @@ func (h *ToolCallHandler) HandleToolCalls(ctx context.Context, outputs []respons
 				toolCall.Name,
 			))
 
+			// Sensitive tools wait for the user, the output is added once the call is resolved.
+			if toolFilter.Allows(toolCall.Name) && h.Registry.RequiresApproval(toolCall.Name) {
+				if streamHandler != nil {
+					streamHandler.SendToolCallApprovalRequired(toolCall)
+				}
+				appendApprovalRequired(&inappChatHistory, toolCall)
+				awaitingApproval = true
+				continue
+			}
+
 			// Notify the stream handler that a tool call is beginning.
 			if streamHandler != nil {
 				streamHandler.SendToolCallBegin(toolCall)
 			}
-			result, err := h.Registry.Call(ctx, toolCall.CallID, toolCall.Name, []byte(toolCall.Arguments))
+			result, err := h.call(ctx, toolCall, toolFilter)
 			if streamHandler != nil {
 				streamHandler.SendToolCallEnd(toolCall, result, err)
 			}
 
-			if err != nil {
-				// If there was an error, append an error output to OpenAI chat history and in-app chat history.
-				openaiChatHistory.OfInputItemList = append(openaiChatHistory.OfInputItemList, responses.ResponseInputItemParamOfFunctionCallOutput(toolCall.CallID, "Error: "+err.Error()))
-				inappChatHistory = append(inappChatHistory, chatv1.Message{
-					MessageId: "openai_" + toolCall.CallID,
-					Payload: &chatv1.MessagePayload{
-						MessageType: &chatv1.MessagePayload_ToolCall{
-							ToolCall: &chatv1.MessageTypeToolCall{
-								Name:  toolCall.Name,
-								Args:  toolCall.Arguments,
-								Error: err.Error(),
-							},
-						},
-					},
-				})
-			} else {
-				// On success, append the result to both OpenAI and in-app chat histories.
-				openaiChatHistory.OfInputItemList = append(openaiChatHistory.OfInputItemList, responses.ResponseInputItemParamOfFunctionCallOutput(toolCall.CallID, result))
-				inappChatHistory = append(inappChatHistory, chatv1.Message{
-					MessageId: "openai_" + toolCall.CallID,
-					Payload: &chatv1.MessagePayload{
-						MessageType: &chatv1.MessagePayload_ToolCall{
-							ToolCall: &chatv1.MessageTypeToolCall{
-								Name:   toolCall.Name,
-								Args:   toolCall.Arguments,
-								Result: result,
-							},
-						},
-					},
-				})
-			}
+			appendToolCallOutput(&openaiChatHistory, &inappChatHistory, toolCall, result, err)
 		}
 	}
 
 	// Return both chat histories and nil error (no error aggregation in this implementation)
-	return openaiChatHistory, inappChatHistory, nil
+	return openaiChatHistory, inappChatHistory, awaitingApproval, nil
+}
+
+// ResolveToolCall runs a tool call that was awaiting approval, or refuses it if the user denied it.
+// It appends the function_call_output item to the OpenAI chat history, and the in-app message that
+// replaces the ToolCallApprovalRequired message (they share the same message id) to the in-app chat history.
+func (h *ToolCallHandler) ResolveToolCall(ctx context.Context, openaiChatHistory *responses.ResponseNewParamsInputUnion, inappChatHistory *[]chatv1.Message, toolCall responses.ResponseFunctionToolCall, approved bool, reason string, streamHandler *StreamHandler, toolFilter toolkit.ToolFilter) {
+	var result string
+	var err error
+	if streamHandler != nil {
+		streamHandler.SendToolCallBegin(toolCall)
+	}
+	if approved {
+		result, err = h.call(ctx, toolCall, toolFilter)
+	} else if reason != "" {
+		err = fmt.Errorf("the user denied this tool call: %s", reason)
+	} else {
+		err = fmt.Errorf("the user denied this tool call")
+	}
+	if streamHandler != nil {
+		streamHandler.SendToolCallEnd(toolCall, result, err)
+	}
+	appendToolCallOutput(openaiChatHistory, inappChatHistory, toolCall, result, err)
+}
+
+// PendingToolCalls returns the tool calls of the chat history that have no output yet,
+// i.e. the calls awaiting the user's approval.
+func PendingToolCalls(history responses.ResponseInputParam) []responses.ResponseFunctionToolCall {
+	answered := map[string]bool{}
+	for _, item := range history {
+		if item.OfFunctionCallOutput != nil {
+			answered[item.OfFunctionCallOutput.CallID] = true
+		}
+	}
+
+	pending := []responses.ResponseFunctionToolCall{}
+	for _, item := range history {
+		if item.OfFunctionCall != nil && !answered[item.OfFunctionCall.CallID] {
+			pending = append(pending, responses.ResponseFunctionToolCall{
+				CallID:    item.OfFunctionCall.CallID,
+				Name:      item.OfFunctionCall.Name,
+				Arguments: item.OfFunctionCall.Arguments,
+			})
+		}
+	}
+	return pending
+}
+
+func (h *ToolCallHandler) call(ctx context.Context, toolCall responses.ResponseFunctionToolCall, toolFilter toolkit.ToolFilter) (string, error) {
+	if !toolFilter.Allows(toolCall.Name) {
+		return "", fmt.Errorf("tool %s is disabled in the user's or project's tool preferences", toolCall.Name)
+	}
+	return h.Registry.Call(ctx, toolCall.CallID, toolCall.Name, []byte(toolCall.Arguments))
+}
+
+// appendToolCallOutput appends the output of a finished tool call to both chat histories.
+func appendToolCallOutput(openaiChatHistory *responses.ResponseNewParamsInputUnion, inappChatHistory *[]chatv1.Message, toolCall responses.ResponseFunctionToolCall, result string, err error) {
+	if err != nil {
+		// If there was an error, append an error output to OpenAI chat history and in-app chat history.
+		openaiChatHistory.OfInputItemList = append(openaiChatHistory.OfInputItemList, responses.ResponseInputItemParamOfFunctionCallOutput(toolCall.CallID, "Error: "+err.Error()))
+		*inappChatHistory = append(*inappChatHistory, chatv1.Message{
+			MessageId: "openai_" + toolCall.CallID,
+			Payload: &chatv1.MessagePayload{
+				MessageType: &chatv1.MessagePayload_ToolCall{
+					ToolCall: &chatv1.MessageTypeToolCall{
+						Name:  toolCall.Name,
+						Args:  toolCall.Arguments,
+						Error: err.Error(),
+					},
+				},
+			},
+		})
+		return
+	}
+	// On success, append the result to both OpenAI and in-app chat histories.
+	openaiChatHistory.OfInputItemList = append(openaiChatHistory.OfInputItemList, responses.ResponseInputItemParamOfFunctionCallOutput(toolCall.CallID, result))
+	*inappChatHistory = append(*inappChatHistory, chatv1.Message{
+		MessageId: "openai_" + toolCall.CallID,
+		Payload: &chatv1.MessagePayload{
+			MessageType: &chatv1.MessagePayload_ToolCall{
+				ToolCall: &chatv1.MessageTypeToolCall{
+					Name:   toolCall.Name,
+					Args:   toolCall.Arguments,
+					Result: result,
+				},
+			},
+		},
+	})
+}
+
+func appendApprovalRequired(inappChatHistory *[]chatv1.Message, toolCall responses.ResponseFunctionToolCall) {
+	*inappChatHistory = append(*inappChatHistory, chatv1.Message{
+		MessageId: "openai_" + toolCall.CallID,
+		Payload:   approvalRequiredPayload(toolCall),
+	})
+}
+
+func approvalRequiredPayload(toolCall responses.ResponseFunctionToolCall) *chatv1.MessagePayload {
+	return &chatv1.MessagePayload{
+		MessageType: &chatv1.MessagePayload_ToolCallApprovalRequired{
+			ToolCallApprovalRequired: &chatv1.MessageTypeToolCallApprovalRequired{
+				ToolCallId: toolCall.CallID,
+				Name:       toolCall.Name,
+				Args:       toolCall.Arguments,
+			},
+		},
+	}
 }
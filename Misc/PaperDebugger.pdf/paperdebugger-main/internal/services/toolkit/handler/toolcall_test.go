@@ -0,0 +1,76 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/handler"
+	"paperdebugger/internal/services/toolkit/registry"
+	chatv1 "paperdebugger/pkg/gen/api/chat/v1"
+	"testing"
+
+	"github.com/openai/openai-go/v2/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler() *handler.ToolCallHandler {
+	r := registry.NewToolRegistry()
+	echo := func(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+		return string(args), "", nil
+	}
+	r.Register("greeting", responses.ToolUnionParam{}, echo)
+	r.Register("paper_score", responses.ToolUnionParam{}, echo)
+	r.RequireApproval("paper_score")
+	return handler.NewToolCallHandler(r)
+}
+
+func functionCallOutputs(t *testing.T, calls ...string) []responses.ResponseOutputItemUnion {
+	outputs := []responses.ResponseOutputItemUnion{}
+	for _, call := range calls {
+		var output responses.ResponseOutputItemUnion
+		require.NoError(t, json.Unmarshal([]byte(call), &output))
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+func TestHandleToolCalls_ApprovalRequired(t *testing.T) {
+	h := newTestHandler()
+	outputs := functionCallOutputs(t,
+		`{"type":"function_call","call_id":"call_1","name":"greeting","arguments":"{}"}`,
+		`{"type":"function_call","call_id":"call_2","name":"paper_score","arguments":"{\"a\":1}"}`,
+	)
+
+	openaiHistory, inappHistory, awaitingApproval, err := h.HandleToolCalls(context.Background(), outputs, nil, toolkit.ToolFilter{})
+	require.NoError(t, err)
+	assert.True(t, awaitingApproval)
+	assert.Len(t, openaiHistory.OfInputItemList, 3) // two calls, one output
+	require.Len(t, inappHistory, 2)
+	assert.Equal(t, "{}", inappHistory[0].GetPayload().GetToolCall().GetResult())
+	assert.Equal(t, "call_2", inappHistory[1].GetPayload().GetToolCallApprovalRequired().GetToolCallId())
+
+	pending := handler.PendingToolCalls(openaiHistory.OfInputItemList)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "paper_score", pending[0].Name)
+
+	resolvedHistory := responses.ResponseNewParamsInputUnion{OfInputItemList: openaiHistory.OfInputItemList}
+	resolvedInapp := []chatv1.Message{}
+	h.ResolveToolCall(context.Background(), &resolvedHistory, &resolvedInapp, pending[0], false, "too expensive", nil, toolkit.ToolFilter{})
+	assert.Empty(t, handler.PendingToolCalls(resolvedHistory.OfInputItemList))
+	require.Len(t, resolvedInapp, 1)
+	assert.Equal(t, "openai_call_2", resolvedInapp[0].GetMessageId())
+	assert.Equal(t, "the user denied this tool call: too expensive", resolvedInapp[0].GetPayload().GetToolCall().GetError())
+}
+
+func TestResolveToolCall_Approved(t *testing.T) {
+	h := newTestHandler()
+	toolCall := responses.ResponseFunctionToolCall{CallID: "call_1", Name: "paper_score", Arguments: `{"a":1}`}
+
+	openaiHistory := responses.ResponseNewParamsInputUnion{}
+	inappHistory := []chatv1.Message{}
+	h.ResolveToolCall(context.Background(), &openaiHistory, &inappHistory, toolCall, true, "", nil, toolkit.ToolFilter{})
+	require.Len(t, openaiHistory.OfInputItemList, 1)
+	assert.Equal(t, `{"a":1}`, openaiHistory.OfInputItemList[0].OfFunctionCallOutput.Output)
+	assert.Equal(t, `{"a":1}`, inappHistory[0].GetPayload().GetToolCall().GetResult())
+}
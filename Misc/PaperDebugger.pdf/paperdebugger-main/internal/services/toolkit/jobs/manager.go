@@ -0,0 +1,443 @@
+// Package jobs runs long tool calls on a worker pool, detached from the requests that started them.
+//
+// A job runs in the process that started it, which renews the lease of the job while it runs. When
+// the process stops, its unfinished jobs fail once their lease expired, other processes sharing the
+// database keep running theirs. The result of a job the caller stopped waiting for is handed to the
+// OnFinished callbacks, which add it to the conversation; if that did not happen (e.g. after a
+// restart), Undelivered still returns it.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	ErrClosed    = errors.New("the server is shutting down")
+	ErrQueueFull = errors.New("too many tool calls are running in the background, try again later")
+
+	errNotStarted = errors.New("the server shut down before the tool could run")
+)
+
+const (
+	queueSize            = 256
+	jobTimeout           = 30 * time.Minute
+	saveProgressInterval = time.Second
+	subscriberBuffer     = 64
+
+	leaseDuration      = 2 * time.Minute
+	leaseRenewInterval = 30 * time.Second
+)
+
+type task struct {
+	job       *models.ToolJob // guarded by Manager.mu
+	err       error           // the error of the job, once it failed
+	detached  bool            // the caller stopped waiting, the result must be delivered into the conversation
+	lastSaved time.Time
+
+	run  toolkit.JobFunc
+	ctx  context.Context
+	done chan struct{}
+}
+
+type subscription struct {
+	ch    chan models.ToolJob
+	done  chan struct{}
+	close sync.Once
+}
+
+type Manager struct {
+	store    Store
+	logger   *logger.Logger
+	instance string // the id of this process in the leases of its jobs
+
+	queue      chan *task
+	ctx        context.Context // cancelled by Close, stops the running jobs
+	cancel     context.CancelFunc
+	workers    sync.WaitGroup
+	deliveries sync.WaitGroup
+
+	mu          sync.Mutex
+	closed      bool
+	active      map[bson.ObjectID]*task
+	subscribers map[string]map[*subscription]struct{} // by conversation id
+	onFinished  []func(job models.ToolJob)
+}
+
+// NewManager starts workers goroutines running the jobs. The jobs left unfinished by a stopped
+// process are failed, now and every leaseRenewInterval.
+func NewManager(store Store, workers int, logger *logger.Logger) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		store:       store,
+		logger:      logger,
+		instance:    bson.NewObjectID().Hex(),
+		queue:       make(chan *task, queueSize),
+		ctx:         ctx,
+		cancel:      cancel,
+		active:      make(map[bson.ObjectID]*task),
+		subscribers: make(map[string]map[*subscription]struct{}),
+	}
+	m.failExpired()
+	for i := 0; i < workers; i++ {
+		m.workers.Add(1)
+		go m.work()
+	}
+	m.workers.Add(1)
+	go m.renewLeases()
+	return m
+}
+
+// renewLeases keeps the leases of the jobs of this process, and fails the jobs of the stopped ones.
+func (m *Manager) renewLeases() {
+	defer m.workers.Done()
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			until := time.Now().Add(leaseDuration)
+			m.mu.Lock()
+			for _, t := range m.active {
+				t.job.LeaseUntil = bson.NewDateTimeFromTime(until)
+			}
+			m.mu.Unlock()
+			if err := m.store.RenewLeases(context.Background(), m.instance, until); err != nil {
+				m.logger.Error("[Tool Jobs] failed to renew the leases", "error", err)
+			}
+			m.failExpired()
+		}
+	}
+}
+
+func (m *Manager) failExpired() {
+	if err := m.store.FailExpired(context.Background(), time.Now(), "the server stopped while the tool was running"); err != nil {
+		m.logger.Error("[Tool Jobs] failed to fail the interrupted jobs", "error", err)
+	}
+}
+
+// OnFinished registers a function delivering the results of the jobs the caller stopped waiting for.
+// The final snapshot of such a job is only published to the subscribers once the callbacks returned.
+func (m *Manager) OnFinished(deliver func(job models.ToolJob)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onFinished = append(m.onFinished, deliver)
+}
+
+// Run implements toolkit.JobRunner. The job keeps running if ctx is cancelled, e.g. because the
+// client disconnected, but it sees the values of ctx (actor, project, conversation).
+func (m *Manager) Run(ctx context.Context, toolCallId string, toolName string, args json.RawMessage, run toolkit.JobFunc, wait time.Duration) (string, string, bool, error) {
+	actor, projectID, conversationID := toolkit.GetActorProjectConversationID(ctx)
+	if actor == nil || conversationID == "" {
+		// there is no conversation to deliver the result to later
+		result, err := run(ctx, func(float64, string) {})
+		return "", result, true, err
+	}
+
+	now := bson.NewDateTimeFromTime(time.Now())
+	t := &task{
+		job: &models.ToolJob{
+			BaseModel: models.BaseModel{
+				ID:        bson.NewObjectID(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			UserID:         actor.ID,
+			ProjectID:      projectID,
+			ConversationID: conversationID,
+			ToolCallID:     toolCallId,
+			ToolName:       toolName,
+			Arguments:      string(args),
+			Status:         models.ToolJobStatusQueued,
+			Progress:       -1,
+			Instance:       m.instance,
+			LeaseUntil:     bson.NewDateTimeFromTime(time.Now().Add(leaseDuration)),
+		},
+		lastSaved: time.Now(),
+		run:       run,
+		ctx:       context.WithoutCancel(ctx),
+		done:      make(chan struct{}),
+	}
+	jobID := t.job.ID.Hex()
+	if err := m.store.Save(ctx, t.job); err != nil {
+		return "", "", false, fmt.Errorf("failed to save the tool job: %w", err)
+	}
+
+	if err := m.enqueue(t); err != nil {
+		m.mu.Lock()
+		t.job.Status = models.ToolJobStatusFailed
+		t.job.Error = err.Error()
+		t.job.Delivered = true // the error is returned right away
+		snapshot := *t.job
+		m.mu.Unlock()
+		m.save(snapshot)
+		return jobID, "", false, err
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-t.done:
+	case <-timer.C:
+		m.mu.Lock()
+		finished := t.job.Finished()
+		t.detached = !finished
+		m.mu.Unlock()
+		if !finished {
+			return jobID, "", false, nil
+		}
+		<-t.done
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t.err != nil {
+		return jobID, "", true, t.err
+	}
+	return jobID, t.job.Result, true, nil
+}
+
+func (m *Manager) enqueue(t *task) error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrClosed
+	}
+	select {
+	case m.queue <- t:
+	default:
+		m.mu.Unlock()
+		return ErrQueueFull
+	}
+	m.active[t.job.ID] = t
+	snapshot := *t.job
+	m.mu.Unlock()
+
+	m.publish(snapshot)
+	return nil
+}
+
+func (m *Manager) work() {
+	defer m.workers.Done()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case t := <-m.queue:
+			if m.ctx.Err() != nil {
+				m.finish(t, "", errNotStarted)
+				continue
+			}
+			m.execute(t)
+		}
+	}
+}
+
+func (m *Manager) execute(t *task) {
+	ctx, cancel := context.WithTimeout(t.ctx, jobTimeout)
+	defer cancel()
+	stop := context.AfterFunc(m.ctx, cancel)
+	defer stop()
+
+	m.update(t, true, func(job *models.ToolJob) {
+		job.Status = models.ToolJobStatusRunning
+	})
+	result, err := t.run(ctx, func(progress float64, message string) {
+		m.update(t, false, func(job *models.ToolJob) {
+			job.Progress = progress
+			job.ProgressMessage = message
+		})
+	})
+	if err != nil && m.ctx.Err() != nil {
+		err = fmt.Errorf("the server shut down while the tool was running: %w", err)
+	}
+	m.finish(t, result, err)
+}
+
+// update changes a running job. Progress updates are only saved once per saveProgressInterval.
+func (m *Manager) update(t *task, save bool, change func(job *models.ToolJob)) {
+	m.mu.Lock()
+	if t.job.Finished() {
+		m.mu.Unlock()
+		return
+	}
+	change(t.job)
+	t.job.UpdatedAt = bson.NewDateTimeFromTime(time.Now())
+	if save || time.Since(t.lastSaved) >= saveProgressInterval {
+		save = true
+		t.lastSaved = time.Now()
+	}
+	snapshot := *t.job
+	m.mu.Unlock()
+
+	if save {
+		m.save(snapshot)
+	}
+	m.publish(snapshot)
+}
+
+func (m *Manager) finish(t *task, result string, err error) {
+	m.mu.Lock()
+	now := bson.NewDateTimeFromTime(time.Now())
+	if err != nil {
+		t.err = err
+		t.job.Status = models.ToolJobStatusFailed
+		t.job.Error = err.Error()
+	} else {
+		t.job.Status = models.ToolJobStatusSucceeded
+		t.job.Result = result
+		t.job.Progress = 1
+	}
+	t.job.FinishedAt = now
+	t.job.UpdatedAt = now
+	t.job.Delivered = !t.detached // otherwise the waiting caller returns the result itself
+	detached := t.detached
+	snapshot := *t.job
+	callbacks := m.onFinished
+	m.mu.Unlock()
+
+	m.save(snapshot)
+	close(t.done)
+
+	if !detached || m.ctx.Err() != nil {
+		// after a shutdown, the result is delivered by the next turn of the conversation
+		m.retire(snapshot)
+		return
+	}
+	m.deliveries.Add(1)
+	go func() {
+		defer m.deliveries.Done()
+		for _, deliver := range callbacks {
+			deliver(snapshot)
+		}
+		m.retire(snapshot)
+	}()
+}
+
+// retire publishes the final snapshot of a job, and forgets it.
+func (m *Manager) retire(job models.ToolJob) {
+	m.mu.Lock()
+	delete(m.active, job.ID)
+	m.mu.Unlock()
+	m.publish(job)
+}
+
+func (m *Manager) save(job models.ToolJob) {
+	if err := m.store.Save(context.Background(), &job); err != nil {
+		m.logger.Error("[Tool Jobs] failed to save job", "error", err, "jobID", job.ID.Hex(), "tool", job.ToolName)
+	}
+}
+
+// Active returns the jobs of the conversation that are queued, running, or whose result is being delivered.
+func (m *Manager) Active(conversationID string) []models.ToolJob {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := []models.ToolJob{}
+	for _, t := range m.active {
+		if t.job.ConversationID == conversationID {
+			jobs = append(jobs, *t.job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt < jobs[j].CreatedAt })
+	return jobs
+}
+
+// Subscribe returns the snapshots of the jobs of the conversation each time they change, until
+// unsubscribe is called. Progress snapshots are dropped if the subscriber is too slow, the final
+// snapshot of a job never is.
+func (m *Manager) Subscribe(conversationID string) (<-chan models.ToolJob, func()) {
+	sub := &subscription{
+		ch:   make(chan models.ToolJob, subscriberBuffer),
+		done: make(chan struct{}),
+	}
+	m.mu.Lock()
+	if m.subscribers[conversationID] == nil {
+		m.subscribers[conversationID] = make(map[*subscription]struct{})
+	}
+	m.subscribers[conversationID][sub] = struct{}{}
+	m.mu.Unlock()
+
+	unsubscribe := func() {
+		sub.close.Do(func() {
+			m.mu.Lock()
+			delete(m.subscribers[conversationID], sub)
+			if len(m.subscribers[conversationID]) == 0 {
+				delete(m.subscribers, conversationID)
+			}
+			m.mu.Unlock()
+			close(sub.done)
+		})
+	}
+	return sub.ch, unsubscribe
+}
+
+func (m *Manager) publish(job models.ToolJob) {
+	m.mu.Lock()
+	subs := make([]*subscription, 0, len(m.subscribers[job.ConversationID]))
+	for sub := range m.subscribers[job.ConversationID] {
+		subs = append(subs, sub)
+	}
+	m.mu.Unlock()
+
+	for _, sub := range subs {
+		if job.Finished() {
+			select {
+			case sub.ch <- job:
+			case <-sub.done:
+			}
+		} else {
+			select {
+			case sub.ch <- job:
+			default:
+			}
+		}
+	}
+}
+
+// Undelivered returns the finished jobs of the conversation whose result was not added to it yet.
+func (m *Manager) Undelivered(ctx context.Context, conversationID string) ([]models.ToolJob, error) {
+	return m.store.Undelivered(ctx, conversationID)
+}
+
+func (m *Manager) MarkDelivered(ctx context.Context, ids []bson.ObjectID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return m.store.MarkDelivered(ctx, ids)
+}
+
+// Close cancels the running jobs and fails the queued ones. Their results are delivered by the
+// next turn of their conversation.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	m.mu.Unlock()
+
+	m.cancel()
+	m.workers.Wait()
+	for {
+		select {
+		case t := <-m.queue:
+			m.finish(t, "", errNotStarted)
+		default:
+			m.deliveries.Wait()
+			return
+		}
+	}
+}
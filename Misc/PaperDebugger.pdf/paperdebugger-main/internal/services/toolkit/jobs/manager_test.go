@@ -0,0 +1,250 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"paperdebugger/internal/accesscontrol"
+	"paperdebugger/internal/libs/contextutil"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/models"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/jobs"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type memStore struct {
+	mu   sync.Mutex
+	jobs map[bson.ObjectID]models.ToolJob
+}
+
+func newMemStore() *memStore {
+	return &memStore{jobs: map[bson.ObjectID]models.ToolJob{}}
+}
+
+func (s *memStore) Save(ctx context.Context, job *models.ToolJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = *job
+	return nil
+}
+
+func (s *memStore) Undelivered(ctx context.Context, conversationID string) ([]models.ToolJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := []models.ToolJob{}
+	for _, job := range s.jobs {
+		if job.ConversationID == conversationID && job.Finished() && !job.Delivered {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (s *memStore) MarkDelivered(ctx context.Context, ids []bson.ObjectID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		job := s.jobs[id]
+		job.Delivered = true
+		s.jobs[id] = job
+	}
+	return nil
+}
+
+func (s *memStore) RenewLeases(ctx context.Context, instance string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, job := range s.jobs {
+		if job.Instance == instance && !job.Finished() {
+			job.LeaseUntil = bson.NewDateTimeFromTime(until)
+			s.jobs[id] = job
+		}
+	}
+	return nil
+}
+
+func (s *memStore) FailExpired(ctx context.Context, now time.Time, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, job := range s.jobs {
+		if !job.Finished() && job.LeaseUntil.Time().Before(now) {
+			job.Status = models.ToolJobStatusFailed
+			job.Error = reason
+			s.jobs[id] = job
+		}
+	}
+	return nil
+}
+
+func conversationContext(conversationID string) context.Context {
+	ctx := contextutil.SetActor(context.Background(), &accesscontrol.Actor{ID: bson.NewObjectID()})
+	ctx = contextutil.SetProjectID(ctx, "project")
+	return contextutil.SetConversationID(ctx, conversationID)
+}
+
+func TestManager_InlineResult(t *testing.T) {
+	store := newMemStore()
+	m := jobs.NewManager(store, 2, logger.GetLogger())
+	defer m.Close()
+
+	jobID, result, done, err := m.Run(conversationContext("conv"), "call_1", "quick", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		return "ok", nil
+	}, time.Second)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, "ok", result)
+
+	id, _ := bson.ObjectIDFromHex(jobID)
+	assert.Equal(t, models.ToolJobStatusSucceeded, store.jobs[id].Status)
+	assert.True(t, store.jobs[id].Delivered, "the caller got the result, there is nothing to deliver")
+
+	_, _, done, err = m.Run(conversationContext("conv"), "call_2", "broken", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		return "", errors.New("boom")
+	}, time.Second)
+	assert.True(t, done)
+	assert.EqualError(t, err, "boom")
+}
+
+func TestManager_RunsWithoutConversationInline(t *testing.T) {
+	m := jobs.NewManager(newMemStore(), 1, logger.GetLogger())
+	defer m.Close()
+
+	jobID, result, done, err := m.Run(context.Background(), "call_1", "quick", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		return "ok", nil
+	}, 0)
+	require.NoError(t, err)
+	assert.Empty(t, jobID)
+	assert.True(t, done)
+	assert.Equal(t, "ok", result)
+}
+
+func TestManager_DeliversDetachedJobs(t *testing.T) {
+	store := newMemStore()
+	m := jobs.NewManager(store, 1, logger.GetLogger())
+	defer m.Close()
+
+	delivered := make(chan models.ToolJob, 1)
+	m.OnFinished(func(job models.ToolJob) {
+		delivered <- job
+	})
+	events, unsubscribe := m.Subscribe("conv")
+	defer unsubscribe()
+
+	release := make(chan struct{})
+	ctx, cancel := context.WithCancel(conversationContext("conv"))
+	jobID, result, done, err := m.Run(ctx, "call_1", "slow", []byte(`{"q":1}`), func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		progress(0.5, "half way")
+		<-release
+		return "late result", ctx.Err()
+	}, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.Empty(t, result)
+	assert.Len(t, m.Active("conv"), 1)
+
+	// the client disconnecting does not stop the job
+	cancel()
+	close(release)
+
+	job := <-delivered
+	assert.Equal(t, jobID, job.ID.Hex())
+	assert.Equal(t, models.ToolJobStatusSucceeded, job.Status)
+	assert.Equal(t, "late result", job.Result)
+	assert.Equal(t, `{"q":1}`, job.Arguments)
+	assert.False(t, job.Delivered)
+
+	undelivered, err := m.Undelivered(context.Background(), "conv")
+	require.NoError(t, err)
+	require.Len(t, undelivered, 1)
+	require.NoError(t, m.MarkDelivered(context.Background(), []bson.ObjectID{job.ID}))
+	undelivered, _ = m.Undelivered(context.Background(), "conv")
+	assert.Empty(t, undelivered)
+
+	var statuses []models.ToolJobStatus
+	var messages []string
+	for event := range events {
+		statuses = append(statuses, event.Status)
+		messages = append(messages, event.ProgressMessage)
+		if event.Finished() {
+			break
+		}
+	}
+	assert.Equal(t, []models.ToolJobStatus{
+		models.ToolJobStatusQueued,
+		models.ToolJobStatusRunning,
+		models.ToolJobStatusRunning,
+		models.ToolJobStatusSucceeded,
+	}, statuses)
+	assert.Contains(t, messages, "half way")
+	assert.Empty(t, m.Active("conv"))
+}
+
+func TestManager_CloseFailsUnfinishedJobs(t *testing.T) {
+	store := newMemStore()
+	m := jobs.NewManager(store, 1, logger.GetLogger())
+
+	started := make(chan struct{})
+	running, _, done, err := m.Run(conversationContext("conv"), "call_1", "slow", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		close(started)
+		<-ctx.Done()
+		return "", ctx.Err()
+	}, 0)
+	require.NoError(t, err)
+	assert.False(t, done)
+	<-started
+
+	queued, _, done, err := m.Run(conversationContext("conv"), "call_2", "slow", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		return "never", nil
+	}, 0)
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	m.Close()
+
+	for _, jobID := range []string{running, queued} {
+		id, _ := bson.ObjectIDFromHex(jobID)
+		assert.Equal(t, models.ToolJobStatusFailed, store.jobs[id].Status)
+		assert.Contains(t, store.jobs[id].Error, "the server shut down")
+		assert.False(t, store.jobs[id].Delivered)
+	}
+
+	_, _, _, err = m.Run(conversationContext("conv"), "call_3", "slow", nil, func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		return "", nil
+	}, 0)
+	assert.ErrorIs(t, err, jobs.ErrClosed)
+}
+
+func TestManager_FailsJobsInterruptedByRestart(t *testing.T) {
+	store := newMemStore()
+	interrupted := models.ToolJob{
+		BaseModel:      models.BaseModel{ID: bson.NewObjectID()},
+		ConversationID: "conv",
+		Status:         models.ToolJobStatusRunning,
+	}
+	store.jobs[interrupted.ID] = interrupted
+	// still running in another process, which renews its lease
+	elsewhere := models.ToolJob{
+		BaseModel:      models.BaseModel{ID: bson.NewObjectID()},
+		ConversationID: "conv",
+		Status:         models.ToolJobStatusRunning,
+		Instance:       "other",
+		LeaseUntil:     bson.NewDateTimeFromTime(time.Now().Add(time.Minute)),
+	}
+	store.jobs[elsewhere.ID] = elsewhere
+
+	m := jobs.NewManager(store, 1, logger.GetLogger())
+	defer m.Close()
+
+	undelivered, err := m.Undelivered(context.Background(), "conv")
+	require.NoError(t, err)
+	require.Len(t, undelivered, 1)
+	assert.Equal(t, interrupted.ID, undelivered[0].ID)
+	assert.Equal(t, models.ToolJobStatusFailed, undelivered[0].Status)
+	assert.Equal(t, models.ToolJobStatusRunning, store.jobs[elsewhere.ID].Status)
+}
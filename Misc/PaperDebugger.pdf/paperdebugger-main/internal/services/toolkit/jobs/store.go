@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Store persists the jobs, so that their results can be delivered after the request that
+// started them is gone, or after a restart.
+type Store interface {
+	Save(ctx context.Context, job *models.ToolJob) error
+	// Undelivered returns the finished jobs of the conversation whose result was not added to it yet.
+	Undelivered(ctx context.Context, conversationID string) ([]models.ToolJob, error)
+	MarkDelivered(ctx context.Context, ids []bson.ObjectID) error
+	// RenewLeases extends the leases of the unfinished jobs of the process instance.
+	RenewLeases(ctx context.Context, instance string, until time.Time) error
+	// FailExpired fails the jobs that are queued or running but whose lease expired, because the
+	// process running them was stopped. Other processes may still be running their own jobs.
+	FailExpired(ctx context.Context, now time.Time, reason string) error
+}
+
+type mongoStore struct {
+	collection *mongo.Collection
+}
+
+func NewMongoStore(db *db.DB) Store {
+	database := db.Database("paperdebugger")
+	return &mongoStore{collection: database.Collection((models.ToolJob{}).CollectionName())}
+}
+
+func (s *mongoStore) Save(ctx context.Context, job *models.ToolJob) error {
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, job, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (s *mongoStore) Undelivered(ctx context.Context, conversationID string) ([]models.ToolJob, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{
+		"conversation_id": conversationID,
+		"delivered":       false,
+		"status":          bson.M{"$in": []models.ToolJobStatus{models.ToolJobStatusSucceeded, models.ToolJobStatusFailed}},
+	}, options.Find().SetSort(bson.M{"finished_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var jobs []models.ToolJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *mongoStore) MarkDelivered(ctx context.Context, ids []bson.ObjectID) error {
+	_, err := s.collection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"delivered": true, "updated_at": bson.NewDateTimeFromTime(time.Now())}},
+	)
+	return err
+}
+
+func (s *mongoStore) RenewLeases(ctx context.Context, instance string, until time.Time) error {
+	_, err := s.collection.UpdateMany(ctx,
+		bson.M{"instance": instance, "status": bson.M{"$in": unfinishedStatuses}},
+		bson.M{"$set": bson.M{"lease_until": bson.NewDateTimeFromTime(until)}},
+	)
+	return err
+}
+
+func (s *mongoStore) FailExpired(ctx context.Context, now time.Time, reason string) error {
+	at := bson.NewDateTimeFromTime(now)
+	_, err := s.collection.UpdateMany(ctx,
+		bson.M{
+			"status": bson.M{"$in": unfinishedStatuses},
+			// the jobs saved before the leases have none
+			"$or": []bson.M{
+				{"lease_until": bson.M{"$lt": at}},
+				{"lease_until": bson.M{"$exists": false}},
+			},
+		},
+		bson.M{"$set": bson.M{
+			"status":      models.ToolJobStatusFailed,
+			"error":       reason,
+			"finished_at": at,
+			"updated_at":  at,
+		}},
+	)
+	return err
+}
+
+var unfinishedStatuses = []models.ToolJobStatus{models.ToolJobStatusQueued, models.ToolJobStatusRunning}
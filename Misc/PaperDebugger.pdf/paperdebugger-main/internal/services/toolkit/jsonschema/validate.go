@@ -0,0 +1,416 @@
+// Package jsonschema validates JSON values against the subset of JSON Schema used
+// by tool declarations: types, properties, required, additionalProperties, items,
+// enum, const, numeric and length bounds, pattern, anyOf/oneOf/allOf and local $ref.
+// Unknown keywords (format, title, default, ...) are ignored.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Violation is one reason why a value does not match a schema.
+type Violation struct {
+	Path    string `json:"path"` // JSONPath of the offending value, e.g. $.filters[0].year
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// Validate reports the violations of value against schema, nil if the value is valid.
+// value must be decoded by encoding/json into interface{} (numbers are float64).
+func Validate(schema map[string]any, value any) []Violation {
+	v := &validator{root: schema, following: make(map[string]bool)}
+	v.validate(schema, value, "$")
+	return v.violations
+}
+
+// ValidateJSON is Validate for raw JSON.
+func ValidateJSON(schema map[string]any, data []byte) []Violation {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return []Violation{{Path: "$", Message: "invalid JSON: " + err.Error()}}
+	}
+	return Validate(schema, value)
+}
+
+// Normalize converts a schema built in Go (e.g. with []string or int values) to the
+// representation produced by encoding/json, which Validate expects.
+func Normalize(schema map[string]any) (map[string]any, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+type validator struct {
+	root       map[string]any
+	violations []Violation
+
+	// the references being followed, by reference and value path: a schema referencing itself for
+	// the same value would recurse forever. refDepth counts the references followed one after the
+	// other for the value at refPath.
+	following map[string]bool
+	refPath   string
+	refDepth  int
+}
+
+// maxRefDepth bounds the references followed one after the other for a value, e.g. #/$defs/A
+// referencing #/$defs/B and so on. The nesting of the value itself is not bounded.
+const maxRefDepth = 64
+
+func (v *validator) fail(path string, format string, args ...any) {
+	v.violations = append(v.violations, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// check validates value in a sub validator, used by the combinators.
+func (v *validator) check(schema any, value any, path string) []Violation {
+	sub := &validator{root: v.root, following: v.following, refPath: v.refPath, refDepth: v.refDepth}
+	sub.validate(schema, value, path)
+	return sub.violations
+}
+
+func (v *validator) validate(rawSchema any, value any, path string) {
+	switch schema := rawSchema.(type) {
+	case bool:
+		if !schema {
+			v.fail(path, "no value is allowed here")
+		}
+		return
+	case map[string]any:
+		v.validateObjectSchema(schema, value, path)
+	}
+}
+
+func (v *validator) validateObjectSchema(schema map[string]any, value any, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		if !v.followRef(ref, value, path) {
+			return
+		}
+	}
+
+	if types, ok := schemaTypes(schema["type"]); ok && !matchesAnyType(types, value) {
+		v.fail(path, "expected %s, got %s", strings.Join(types, " or "), typeOf(value))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && !containsValue(enum, value) {
+		v.fail(path, "must be one of %s", compactJSON(enum))
+	}
+	if constant, ok := schema["const"]; ok && !equalValues(constant, value) {
+		v.fail(path, "must be %s", compactJSON(constant))
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		v.validateObject(schema, value, path)
+	case []any:
+		v.validateArray(schema, value, path)
+	case string:
+		v.validateString(schema, value, path)
+	case float64:
+		v.validateNumber(schema, value, path)
+	}
+
+	v.validateCombinators(schema, value, path)
+}
+
+func (v *validator) validateObject(schema map[string]any, value map[string]any, path string) {
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := value[name]; !present {
+					v.fail(path, "missing required property %q", name)
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	for _, name := range sortedKeys(value) {
+		propertyPath := path + "." + name
+		if propertySchema, ok := properties[name]; ok {
+			v.validate(propertySchema, value[name], propertyPath)
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				v.fail(propertyPath, "unknown property, expected one of %s", compactJSON(sortedKeys(properties)))
+			}
+		case map[string]any:
+			v.validate(additional, value[name], propertyPath)
+		}
+	}
+
+	if minProperties, ok := number(schema["minProperties"]); ok && float64(len(value)) < minProperties {
+		v.fail(path, "must have at least %v properties", minProperties)
+	}
+	if maxProperties, ok := number(schema["maxProperties"]); ok && float64(len(value)) > maxProperties {
+		v.fail(path, "must have at most %v properties", maxProperties)
+	}
+}
+
+func (v *validator) validateArray(schema map[string]any, value []any, path string) {
+	if items, ok := schema["items"]; ok {
+		for i, item := range value {
+			v.validate(items, item, path+"["+strconv.Itoa(i)+"]")
+		}
+	}
+	if minItems, ok := number(schema["minItems"]); ok && float64(len(value)) < minItems {
+		v.fail(path, "must have at least %v items", minItems)
+	}
+	if maxItems, ok := number(schema["maxItems"]); ok && float64(len(value)) > maxItems {
+		v.fail(path, "must have at most %v items", maxItems)
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if equalValues(value[i], value[j]) {
+					v.fail(path, "items %d and %d are equal, items must be unique", i, j)
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) validateString(schema map[string]any, value string, path string) {
+	length := float64(utf8.RuneCountInString(value))
+	if minLength, ok := number(schema["minLength"]); ok && length < minLength {
+		v.fail(path, "must be at least %v characters long", minLength)
+	}
+	if maxLength, ok := number(schema["maxLength"]); ok && length > maxLength {
+		v.fail(path, "must be at most %v characters long", maxLength)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err == nil && !re.MatchString(value) {
+			v.fail(path, "must match the pattern %q", pattern)
+		}
+	}
+}
+
+func (v *validator) validateNumber(schema map[string]any, value float64, path string) {
+	if minimum, ok := number(schema["minimum"]); ok && value < minimum {
+		v.fail(path, "must be >= %v", minimum)
+	}
+	if maximum, ok := number(schema["maximum"]); ok && value > maximum {
+		v.fail(path, "must be <= %v", maximum)
+	}
+	if minimum, ok := number(schema["exclusiveMinimum"]); ok && value <= minimum {
+		v.fail(path, "must be > %v", minimum)
+	}
+	if maximum, ok := number(schema["exclusiveMaximum"]); ok && value >= maximum {
+		v.fail(path, "must be < %v", maximum)
+	}
+	if multipleOf, ok := number(schema["multipleOf"]); ok && multipleOf > 0 {
+		if quotient := value / multipleOf; quotient != math.Trunc(quotient) {
+			v.fail(path, "must be a multiple of %v", multipleOf)
+		}
+	}
+}
+
+func (v *validator) validateCombinators(schema map[string]any, value any, path string) {
+	if allOf, ok := schema["allOf"].([]any); ok {
+		for _, sub := range allOf {
+			v.violations = append(v.violations, v.check(sub, value, path)...)
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		if matched, closest := v.matchCount(anyOf, value, path); matched == 0 {
+			v.failAlternatives(path, "must match at least one of the allowed schemas", closest)
+		}
+	}
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		matched, closest := v.matchCount(oneOf, value, path)
+		if matched == 0 {
+			v.failAlternatives(path, "must match exactly one of the allowed schemas", closest)
+		} else if matched > 1 {
+			v.fail(path, "must match exactly one of the allowed schemas, but matches %d", matched)
+		}
+	}
+	if not, ok := schema["not"]; ok && len(v.check(not, value, path)) == 0 {
+		v.fail(path, "must not match %s", compactJSON(not))
+	}
+}
+
+// matchCount returns how many alternatives value matches, and the violations of the alternative it came closest to.
+func (v *validator) matchCount(alternatives []any, value any, path string) (int, []Violation) {
+	matched := 0
+	var closest []Violation
+	for _, alternative := range alternatives {
+		violations := v.check(alternative, value, path)
+		if len(violations) == 0 {
+			matched++
+		} else if closest == nil || len(violations) < len(closest) {
+			closest = violations
+		}
+	}
+	return matched, closest
+}
+
+func (v *validator) failAlternatives(path string, message string, closest []Violation) {
+	details := make([]string, len(closest))
+	for i, violation := range closest {
+		details[i] = violation.String()
+	}
+	if len(details) > 0 {
+		message += " (closest: " + strings.Join(details, "; ") + ")"
+	}
+	v.fail(path, "%s", message)
+}
+
+// followRef validates value against the schema referenced by ref. It reports false, after a
+// violation, if the reference cannot be followed.
+func (v *validator) followRef(ref string, value any, path string) bool {
+	key := ref + " " + path
+	if v.following[key] {
+		v.fail(path, "schema reference %q is cyclic", ref)
+		return false
+	}
+	refPath, refDepth := v.refPath, v.refDepth
+	if path != refPath {
+		v.refPath, v.refDepth = path, 0
+	}
+	defer func() { v.refPath, v.refDepth = refPath, refDepth }()
+	if v.refDepth >= maxRefDepth {
+		v.fail(path, "schema references are chained more than %d times", maxRefDepth)
+		return false
+	}
+	target, err := v.resolve(ref)
+	if err != nil {
+		v.fail(path, "%v", err)
+		return false
+	}
+
+	v.following[key] = true
+	v.refDepth++
+	v.validate(target, value, path)
+	delete(v.following, key)
+	return true
+}
+
+// resolve looks up a local reference, e.g. #/$defs/Filter.
+func (v *validator) resolve(ref string) (any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported schema reference %q", ref)
+	}
+	var current any = v.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unresolvable schema reference %q", ref)
+		}
+		if current, ok = object[token]; !ok {
+			return nil, fmt.Errorf("unresolvable schema reference %q", ref)
+		}
+	}
+	return current, nil
+}
+
+func schemaTypes(raw any) ([]string, bool) {
+	switch raw := raw.(type) {
+	case string:
+		return []string{raw}, true
+	case []any:
+		types := []string{}
+		for _, t := range raw {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+		return types, len(types) > 0
+	}
+	return nil, false
+}
+
+func matchesAnyType(types []string, value any) bool {
+	actual := typeOf(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if value == math.Trunc(value) && !math.IsInf(value, 0) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func number(raw any) (float64, bool) {
+	switch raw := raw.(type) {
+	case float64:
+		return raw, true
+	case int:
+		return float64(raw), true
+	case int64:
+		return float64(raw), true
+	}
+	return 0, false
+}
+
+func containsValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if equalValues(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalValues(a, b any) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+	return compactJSON(a) == compactJSON(b)
+}
+
+func compactJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
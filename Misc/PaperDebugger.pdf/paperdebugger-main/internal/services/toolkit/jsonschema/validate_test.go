@@ -0,0 +1,117 @@
+package jsonschema_test
+
+import (
+	"fmt"
+	"paperdebugger/internal/services/toolkit/jsonschema"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var searchSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"query": map[string]any{"type": "string", "minLength": 1},
+		"limit": map[string]any{"type": "integer", "minimum": 1, "maximum": 50},
+		"venue": map[string]any{"enum": []string{"ICLR", "NeurIPS"}},
+		"filters": map[string]any{
+			"type":  "array",
+			"items": map[string]any{"$ref": "#/$defs/Filter"},
+		},
+		"year": map[string]any{"anyOf": []any{
+			map[string]any{"type": "integer"},
+			map[string]any{"type": "null"},
+		}},
+	},
+	"required":             []string{"query"},
+	"additionalProperties": false,
+	"$defs": map[string]any{
+		"Filter": map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"field": map[string]any{"type": "string"}},
+			"required":   []string{"field"},
+		},
+	},
+}
+
+func normalized(t *testing.T) map[string]any {
+	schema, err := jsonschema.Normalize(searchSchema)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestValidateJSON_Valid(t *testing.T) {
+	violations := jsonschema.ValidateJSON(normalized(t), []byte(`{"query":"diffusion","limit":10,"venue":"ICLR","filters":[{"field":"title"}],"year":null}`))
+	assert.Empty(t, violations)
+}
+
+func TestValidateJSON_Violations(t *testing.T) {
+	violations := jsonschema.ValidateJSON(normalized(t), []byte(`{"limit":1.5,"venue":"CVPR","filters":[{}],"year":"2024","extra":true}`))
+	assert.Equal(t, []jsonschema.Violation{
+		{Path: "$", Message: `missing required property "query"`},
+		{Path: "$.extra", Message: `unknown property, expected one of ["filters","limit","query","venue","year"]`},
+		{Path: "$.filters[0]", Message: `missing required property "field"`},
+		{Path: "$.limit", Message: "expected integer, got number"},
+		{Path: "$.venue", Message: `must be one of ["ICLR","NeurIPS"]`},
+		{Path: "$.year", Message: "must match at least one of the allowed schemas (closest: $.year: expected integer, got string)"},
+	}, violations)
+}
+
+func TestValidateJSON_InvalidJSON(t *testing.T) {
+	violations := jsonschema.ValidateJSON(normalized(t), []byte(`{"query":`))
+	require.Len(t, violations, 1)
+	assert.Equal(t, "$", violations[0].Path)
+}
+
+func TestValidate_CyclicReferences(t *testing.T) {
+	violations := jsonschema.ValidateJSON(map[string]any{"$ref": "#"}, []byte(`{}`))
+	assert.Equal(t, []jsonschema.Violation{{Path: "$", Message: `schema reference "#" is cyclic`}}, violations)
+
+	schema := map[string]any{
+		"$ref": "#/$defs/A",
+		"$defs": map[string]any{
+			"A": map[string]any{"anyOf": []any{map[string]any{"$ref": "#/$defs/B"}}},
+			"B": map[string]any{"allOf": []any{map[string]any{"$ref": "#/$defs/A"}}},
+		},
+	}
+	violations = jsonschema.ValidateJSON(schema, []byte(`"value"`))
+	require.NotEmpty(t, violations)
+	assert.Contains(t, violations[0].Message, "cyclic")
+}
+
+func TestValidate_RecursiveReferences(t *testing.T) {
+	// a tree refers to itself for the children, which is not a cycle
+	schema := map[string]any{
+		"$ref": "#/$defs/Node",
+		"$defs": map[string]any{
+			"Node": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"children": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/Node"}}},
+			},
+		},
+	}
+	assert.Empty(t, jsonschema.ValidateJSON(schema, []byte(`{"children":[{"children":[{}]},{}]}`)))
+
+	// the nesting of the value is not bounded
+	deep := strings.Repeat(`{"children":[`, 500) + "{}" + strings.Repeat("]}", 500)
+	assert.Empty(t, jsonschema.ValidateJSON(schema, []byte(deep)))
+
+	invalid := strings.Repeat(`{"children":[`, 500) + "1" + strings.Repeat("]}", 500)
+	violations := jsonschema.ValidateJSON(schema, []byte(invalid))
+	require.Len(t, violations, 1)
+	assert.Equal(t, "expected object, got integer", violations[0].Message)
+}
+
+func TestValidate_ChainedReferences(t *testing.T) {
+	defs := map[string]any{"D100": map[string]any{"type": "string"}}
+	for i := 0; i < 100; i++ {
+		defs[fmt.Sprintf("D%d", i)] = map[string]any{"$ref": fmt.Sprintf("#/$defs/D%d", i+1)}
+	}
+	schema := map[string]any{"$ref": "#/$defs/D0", "$defs": defs}
+
+	violations := jsonschema.ValidateJSON(schema, []byte(`"value"`))
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0].Message, "chained more than")
+}
@@ -5,45 +5,299 @@ import (
 	"encoding/json"
 	"fmt"
 	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/jsonschema"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/openai/openai-go/v2/responses"
 	"github.com/samber/lo"
 )
 
+// ToolRegistry is safe for concurrent use, tools may be (un)registered while
+// conversations are running, e.g. when a MCP backend reports that its tool list changed.
 type ToolRegistry struct {
+	mu          sync.RWMutex
 	tools       map[string]toolkit.ToolHandler
+	jobs        map[string]toolkit.JobHandler // tools which may run in the background
 	description map[string]responses.ToolUnionParam
+	approval    map[string]bool   // tools that must be approved by the user before they run
+	owners      map[string]string // the MCP backend which registered a tool, see RegisterOwnedJob
+
+	inputSchema     map[string]map[string]any // from the declared parameters
+	outputSchema    map[string]map[string]any
+	validateResults bool
+
+	jobRunner     toolkit.JobRunner
+	jobInlineWait time.Duration
 }
 
 func NewToolRegistry() *ToolRegistry {
 	return &ToolRegistry{
 		tools:       make(map[string]toolkit.ToolHandler),
+		jobs:        make(map[string]toolkit.JobHandler),
 		description: make(map[string]responses.ToolUnionParam),
+		approval:    make(map[string]bool),
+		owners:      make(map[string]string),
+
+		inputSchema:  make(map[string]map[string]any),
+		outputSchema: make(map[string]map[string]any),
 	}
 }
 
 func (r *ToolRegistry) Register(name string, description responses.ToolUnionParam, handler toolkit.ToolHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.tools[name] = handler
+	delete(r.jobs, name)
+	delete(r.owners, name)
+	r.setDescription(name, description)
+}
+
+// RegisterJob registers a tool whose calls may take minutes. The calls run on the job runner (see
+// SetJobRunner): if one is not done within the inline wait, the model gets a job handle instead of
+// the result, which is added to the conversation once the job is done.
+func (r *ToolRegistry) RegisterJob(name string, description responses.ToolUnionParam, handler toolkit.JobHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[name] = handler
+	delete(r.tools, name)
+	delete(r.owners, name)
+	r.setDescription(name, description)
+}
+
+// RegisterOwnedJob is RegisterJob for a tool loaded from a MCP backend, owner names the backend.
+// Several backends may offer a tool with the same name, the last one registered serves it.
+func (r *ToolRegistry) RegisterOwnedJob(owner string, name string, description responses.ToolUnionParam, handler toolkit.JobHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[name] = handler
+	delete(r.tools, name)
+	r.owners[name] = owner
+	r.setDescription(name, description)
+}
+
+func (r *ToolRegistry) setDescription(name string, description responses.ToolUnionParam) {
 	r.description[name] = description
+
+	delete(r.inputSchema, name)
+	if description.OfFunction != nil && len(description.OfFunction.Parameters) > 0 {
+		if schema, err := jsonschema.Normalize(description.OfFunction.Parameters); err == nil {
+			r.inputSchema[name] = schema
+		}
+	}
 }
 
+// SetOutputSchema declares the JSON schema of the structured results of a tool (MCP outputSchema).
+// Results are only checked against it once EnableResultValidation was called.
+func (r *ToolRegistry) SetOutputSchema(name string, schema map[string]any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if normalized, err := jsonschema.Normalize(schema); err == nil && len(normalized) > 0 {
+		r.outputSchema[name] = normalized
+	} else {
+		delete(r.outputSchema, name)
+	}
+}
+
+// SetJobRunner makes the tools registered with RegisterJob run in the background. Without a
+// runner, their calls block until they are done.
+func (r *ToolRegistry) SetJobRunner(runner toolkit.JobRunner, inlineWait time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobRunner = runner
+	r.jobInlineWait = inlineWait
+}
+
+func (r *ToolRegistry) EnableResultValidation() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.validateResults = true
+}
+
+func (r *ToolRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unregister(name)
+}
+
+// UnregisterOwned unregisters a tool registered with RegisterOwnedJob, unless another backend
+// registered it since.
+func (r *ToolRegistry) UnregisterOwned(owner string, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.owners[name] == owner {
+		r.unregister(name)
+	}
+}
+
+func (r *ToolRegistry) unregister(name string) {
+	delete(r.tools, name)
+	delete(r.owners, name)
+	delete(r.jobs, name)
+	delete(r.description, name)
+	delete(r.inputSchema, name)
+	delete(r.outputSchema, name)
+}
+
+// RequireApproval marks the tools as sensitive: calls to them pause the conversation
+// until the user approves or denies them. The mark outlives (un)registration, so it
+// can be set before a tool is loaded.
+func (r *ToolRegistry) RequireApproval(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		r.approval[name] = true
+	}
+}
+
+func (r *ToolRegistry) RequiresApproval(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.approval[name]
+}
+
+// Call runs a tool. The arguments are validated against the declared parameters first, so that the
+// model gets an InvalidArgumentsError it can correct instead of a failure of the tool.
 func (r *ToolRegistry) Call(ctx context.Context, toolCallId string, toolCallName string, toolCallArgs json.RawMessage) (result string, err error) {
+	r.mu.RLock()
 	handler, ok := r.tools[toolCallName]
-	if !ok {
+	jobHandler, isJob := r.jobs[toolCallName]
+	inputSchema := r.inputSchema[toolCallName]
+	outputSchema := r.outputSchema[toolCallName]
+	validateResults := r.validateResults
+	r.mu.RUnlock()
+	if !ok && !isJob {
 		return "", fmt.Errorf("unknown tool: %s", toolCallName)
 	}
+
+	if inputSchema != nil {
+		if violations := jsonschema.ValidateJSON(inputSchema, toolCallArgs); len(violations) > 0 {
+			return "", &InvalidArgumentsError{Tool: toolCallName, Violations: violations}
+		}
+	}
+	if !validateResults {
+		outputSchema = nil
+	}
+
+	if isJob {
+		return r.runJob(ctx, toolCallId, toolCallName, toolCallArgs, jobHandler, outputSchema)
+	}
+
 	result, furtherInstruction, err := handler(ctx, toolCallId, toolCallArgs)
 	if err != nil {
 		return result, err
 	}
 
+	if outputSchema != nil {
+		if violations := validateResult(outputSchema, result); len(violations) > 0 {
+			return "", &InvalidResultError{Tool: toolCallName, Violations: violations}
+		}
+	}
+
+	return withInstruction(result, furtherInstruction), nil
+}
+
+func withInstruction(result string, furtherInstruction string) string {
 	if furtherInstruction == "" {
-		return result, nil
+		return result
 	} else {
-		return fmt.Sprintf(`<RESULT>%s</RESULT>\n<INSTRUCTION>%s</INSTRUCTION>`, result, furtherInstruction), nil
+		return fmt.Sprintf(`<RESULT>%s</RESULT>\n<INSTRUCTION>%s</INSTRUCTION>`, result, furtherInstruction)
+	}
+}
+
+const jobRunningInstruction = "The tool is still running in the background, its result will be added to this conversation when it is done. " +
+	"Tell the user so, and do not call the tool again for the same request."
+
+// runJob prepares a job and hands it to the job runner. If the job is not done within the inline wait,
+// the returned result is a job handle, e.g. {"job_id":"...","status":"running"}.
+func (r *ToolRegistry) runJob(ctx context.Context, toolCallId string, toolCallName string, toolCallArgs json.RawMessage, handler toolkit.JobHandler, outputSchema map[string]any) (string, error) {
+	job, err := handler(ctx, toolCallId, toolCallArgs)
+	if err != nil {
+		return "", err
+	}
+	if outputSchema != nil {
+		job = validatedJob(toolCallName, job, outputSchema)
+	}
+
+	r.mu.RLock()
+	runner, inlineWait := r.jobRunner, r.jobInlineWait
+	r.mu.RUnlock()
+	if runner == nil {
+		return job(ctx, func(float64, string) {})
+	}
+
+	jobID, result, done, err := runner.Run(ctx, toolCallId, toolCallName, toolCallArgs, job, inlineWait)
+	if err != nil || done {
+		return result, err
+	}
+	handle, err := json.Marshal(map[string]string{"job_id": jobID, "status": "running"})
+	if err != nil {
+		return "", err
+	}
+	return withInstruction(string(handle), jobRunningInstruction), nil
+}
+
+// validatedJob checks the result of job against the output schema, including when it is delivered later.
+func validatedJob(toolCallName string, job toolkit.JobFunc, outputSchema map[string]any) toolkit.JobFunc {
+	return func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		result, err := job(ctx, progress)
+		if err != nil {
+			return result, err
+		}
+		if violations := validateResult(outputSchema, result); len(violations) > 0 {
+			return "", &InvalidResultError{Tool: toolCallName, Violations: violations}
+		}
+		return result, nil
 	}
 }
 
 func (r *ToolRegistry) GetTools() []responses.ToolUnionParam {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	return lo.Values(r.description)
 }
+
+// GetAllowedTools returns the tools permitted by filter.
+func (r *ToolRegistry) GetAllowedTools(filter toolkit.ToolFilter) []responses.ToolUnionParam {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tools := make([]responses.ToolUnionParam, 0, len(r.description))
+	for name, description := range r.description {
+		if filter.Allows(name) {
+			tools = append(tools, description)
+		}
+	}
+	return tools
+}
+
+// ToolInfo describes a registered tool to users.
+type ToolInfo struct {
+	Name        string
+	Description string
+}
+
+// List returns the registered tools sorted by name.
+func (r *ToolRegistry) List() []ToolInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	infos := make([]ToolInfo, 0, len(r.description))
+	for name, description := range r.description {
+		info := ToolInfo{Name: name}
+		if description.OfFunction != nil {
+			info.Description = description.OfFunction.Description.Value
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Names returns the sorted names of the registered tools.
+func (r *ToolRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := lo.Keys(r.description)
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,193 @@
+package registry_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/registry"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/openai/openai-go/v2/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func echo(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+	return string(args), "", nil
+}
+
+func TestToolRegistry_RegisterUnregister(t *testing.T) {
+	r := registry.NewToolRegistry()
+	r.Register("b", responses.ToolUnionParam{}, echo)
+	r.Register("a", responses.ToolUnionParam{}, echo)
+	assert.Equal(t, []string{"a", "b"}, r.Names())
+	assert.Len(t, r.GetTools(), 2)
+
+	r.Unregister("a")
+	assert.Equal(t, []string{"b"}, r.Names())
+	_, err := r.Call(context.Background(), "call_1", "a", json.RawMessage(`{}`))
+	assert.EqualError(t, err, "unknown tool: a")
+}
+
+func TestToolRegistry_GetAllowedTools(t *testing.T) {
+	r := registry.NewToolRegistry()
+	for _, name := range []string{"paper_score", "deep_research", "greeting"} {
+		r.Register(name, responses.ToolUnionParam{OfFunction: &responses.FunctionToolParam{
+			Name:        name,
+			Description: param.NewOpt("tool " + name),
+		}}, echo)
+	}
+
+	tools := r.GetAllowedTools(toolkit.ToolFilter{
+		DisabledTools: []string{"deep_research"},
+		PinnedTools:   []string{"paper_score", "deep_research"},
+	})
+	assert.Len(t, tools, 1)
+	assert.Equal(t, "paper_score", tools[0].OfFunction.Name)
+
+	assert.Equal(t, []registry.ToolInfo{
+		{Name: "deep_research", Description: "tool deep_research"},
+		{Name: "greeting", Description: "tool greeting"},
+		{Name: "paper_score", Description: "tool paper_score"},
+	}, r.List())
+}
+
+func TestToolRegistry_ConcurrentAccess(t *testing.T) {
+	r := registry.NewToolRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("tool_%d", i)
+			r.Register(name, responses.ToolUnionParam{}, echo)
+			r.Unregister(name)
+		}(i)
+		go func() {
+			defer wg.Done()
+			r.GetTools()
+			r.Names()
+			r.Call(context.Background(), "call_1", "tool_0", json.RawMessage(`{}`))
+		}()
+	}
+	wg.Wait()
+	assert.Empty(t, r.Names())
+}
+
+func TestToolRegistry_ValidatesArguments(t *testing.T) {
+	r := registry.NewToolRegistry()
+	called := false
+	r.Register("greeting", responses.ToolUnionParam{OfFunction: &responses.FunctionToolParam{
+		Name: "greeting",
+		Parameters: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"name": map[string]any{"type": "string"}},
+			"required":   []string{"name"},
+		},
+	}}, func(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+		called = true
+		return "hello", "", nil
+	})
+
+	_, err := r.Call(context.Background(), "call_1", "greeting", json.RawMessage(`{"name":42}`))
+	var invalid *registry.InvalidArgumentsError
+	assert.ErrorAs(t, err, &invalid)
+	assert.False(t, called)
+	assert.JSONEq(t, `{
+		"error": "invalid_arguments",
+		"tool": "greeting",
+		"violations": [{"path": "$.name", "message": "expected string, got integer"}],
+		"hint": "Fix the arguments so that they match the parameters schema of the tool, then call the tool again."
+	}`, err.Error())
+
+	result, err := r.Call(context.Background(), "call_2", "greeting", json.RawMessage(`{"name":"Ada"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", result)
+}
+
+func TestToolRegistry_ValidatesResults(t *testing.T) {
+	r := registry.NewToolRegistry()
+	r.Register("score", responses.ToolUnionParam{}, func(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+		return `{"content":[],"structuredContent":{"score":"high"}}`, "", nil
+	})
+	r.SetOutputSchema("score", map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"score": map[string]any{"type": "number"}},
+	})
+
+	// results are only validated when enabled
+	_, err := r.Call(context.Background(), "call_1", "score", json.RawMessage(`{}`))
+	assert.NoError(t, err)
+
+	r.EnableResultValidation()
+	_, err = r.Call(context.Background(), "call_2", "score", json.RawMessage(`{}`))
+	var invalid *registry.InvalidResultError
+	assert.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "$.score", invalid.Violations[0].Path)
+}
+
+// backgroundRunner never waits for the jobs, they run after Run returned.
+type backgroundRunner struct {
+	wg      sync.WaitGroup
+	results chan string
+}
+
+func (b *backgroundRunner) Run(ctx context.Context, toolCallId string, toolName string, args json.RawMessage, job toolkit.JobFunc, wait time.Duration) (string, string, bool, error) {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		result, _ := job(context.Background(), func(float64, string) {})
+		b.results <- result
+	}()
+	return "job_1", "", false, nil
+}
+
+func TestToolRegistry_RegisterJob(t *testing.T) {
+	r := registry.NewToolRegistry()
+	r.RegisterJob("research", responses.ToolUnionParam{}, func(ctx context.Context, toolCallId string, args json.RawMessage) (toolkit.JobFunc, error) {
+		return func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+			progress(0.5, "half way")
+			return "found " + string(args), nil
+		}, nil
+	})
+	assert.Equal(t, []string{"research"}, r.Names())
+
+	// without a runner, the job runs inline
+	result, err := r.Call(context.Background(), "call_1", "research", json.RawMessage(`"papers"`))
+	assert.NoError(t, err)
+	assert.Equal(t, `found "papers"`, result)
+
+	runner := &backgroundRunner{results: make(chan string, 1)}
+	r.SetJobRunner(runner, time.Second)
+	result, err = r.Call(context.Background(), "call_2", "research", json.RawMessage(`"papers"`))
+	assert.NoError(t, err)
+	assert.Contains(t, result, `<RESULT>{"job_id":"job_1","status":"running"}</RESULT>`)
+	assert.Contains(t, result, "do not call the tool again")
+	assert.Equal(t, `found "papers"`, <-runner.results)
+	runner.wg.Wait()
+
+	r.Unregister("research")
+	_, err = r.Call(context.Background(), "call_3", "research", json.RawMessage(`{}`))
+	assert.EqualError(t, err, "unknown tool: research")
+}
+
+func TestToolRegistry_UnregisterOwned(t *testing.T) {
+	job := func(ctx context.Context, toolCallId string, args json.RawMessage) (toolkit.JobFunc, error) {
+		return func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+			return "done", nil
+		}, nil
+	}
+	r := registry.NewToolRegistry()
+	r.RegisterOwnedJob("xtramcp", "search", responses.ToolUnionParam{}, job)
+	r.RegisterOwnedJob("stdio:local", "search", responses.ToolUnionParam{}, job)
+
+	// the first backend dropped the tool, the second one still serves it
+	r.UnregisterOwned("xtramcp", "search")
+	assert.Equal(t, []string{"search"}, r.Names())
+
+	r.UnregisterOwned("stdio:local", "search")
+	assert.Empty(t, r.Names())
+}
@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"encoding/json"
+	"paperdebugger/internal/services/toolkit/jsonschema"
+)
+
+// InvalidArgumentsError is returned when the model called a tool with arguments that do not
+// match its parameters schema. The message is JSON, so the model can fix the call and retry.
+type InvalidArgumentsError struct {
+	Tool       string
+	Violations []jsonschema.Violation
+}
+
+func (e *InvalidArgumentsError) Error() string {
+	return validationErrorJSON("invalid_arguments", e.Tool, e.Violations,
+		"Fix the arguments so that they match the parameters schema of the tool, then call the tool again.")
+}
+
+// InvalidResultError is returned when a tool result does not match the output schema of the tool.
+type InvalidResultError struct {
+	Tool       string
+	Violations []jsonschema.Violation
+}
+
+func (e *InvalidResultError) Error() string {
+	return validationErrorJSON("invalid_result", e.Tool, e.Violations,
+		"The tool returned an unexpected result, do not rely on it.")
+}
+
+func validationErrorJSON(kind string, tool string, violations []jsonschema.Violation, hint string) string {
+	data, _ := json.Marshal(struct {
+		Error      string                 `json:"error"`
+		Tool       string                 `json:"tool"`
+		Violations []jsonschema.Violation `json:"violations"`
+		Hint       string                 `json:"hint"`
+	}{kind, tool, violations, hint})
+	return string(data)
+}
+
+// validateResult checks the structured output of a tool. MCP tools return a JSON-RPC
+// response (or its result) holding the structured output in structuredContent.
+func validateResult(schema map[string]any, result string) []jsonschema.Violation {
+	var value any
+	if err := json.Unmarshal([]byte(result), &value); err != nil {
+		return []jsonschema.Violation{{Path: "$", Message: "result is not JSON"}}
+	}
+	if object, ok := value.(map[string]any); ok {
+		if inner, ok := object["result"].(map[string]any); ok {
+			object = inner
+		}
+		if structured, ok := object["structuredContent"]; ok {
+			value = structured
+		} else if _, ok := object["content"]; ok {
+			return []jsonschema.Violation{{Path: "$", Message: "result has no structuredContent"}}
+		}
+	}
+	return jsonschema.Validate(schema, value)
+}
@@ -155,6 +155,8 @@ func TestChatCompletion_SingleRoundChat_NotCallTool(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -222,6 +224,8 @@ func TestChatCompletion_TwoRoundChat_NotCallTool(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -316,6 +320,8 @@ func TestChatCompletion_OneRoundChat_CallOneTool_MessageAfterToolCall(t *testing
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -392,6 +398,8 @@ func TestChatCompletion_OneRoundChat_CallOneTool_AlwaysException(t *testing.T) {
 		dbInstance,
 		&services.ReverseCommentService{},
 		&services.ProjectService{},
+		&services.UserService{},
+		&services.TextEditService{},
 		cfg.GetCfg(),
 		logger.GetLogger(),
 	)
@@ -1,14 +1,12 @@
 package tools
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/scoring"
 	"paperdebugger/internal/services"
 	"paperdebugger/internal/services/toolkit"
 	toolCallRecordDB "paperdebugger/internal/services/toolkit/db"
@@ -24,8 +22,7 @@ type PaperScoreTool struct {
 	toolCallRecordDB *toolCallRecordDB.ToolCallRecordDB
 	projectService   *services.ProjectService
 	coolDownTime     time.Duration
-	baseURL          string
-	client           *http.Client
+	scoringClient    *scoring.Client
 }
 
 var PaperScoreToolDescription = responses.ToolUnionParam{
@@ -36,34 +33,60 @@ var PaperScoreToolDescription = responses.ToolUnionParam{
 	},
 }
 
-func NewPaperScoreTool(db *db.DB, projectService *services.ProjectService) *PaperScoreTool {
+func NewPaperScoreTool(db *db.DB, projectService *services.ProjectService, scoringClient *scoring.Client) *PaperScoreTool {
 	toolCallRecordDB := toolCallRecordDB.NewToolCallRecordDB(db)
 	return &PaperScoreTool{
 		Description:      PaperScoreToolDescription,
 		toolCallRecordDB: toolCallRecordDB,
 		projectService:   projectService,
 		coolDownTime:     5 * time.Minute,
-		baseURL:          "http://paperdebugger-mcp-server:8000/paper-score",
-		client:           &http.Client{},
+		scoringClient:    scoringClient,
 	}
 }
 
 func (t *PaperScoreTool) Call(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+	if !t.scoringClient.Configured() {
+		return "", "", scoring.ErrNotConfigured
+	}
+
 	fullContent, category, err := t.prepare(ctx)
 	if err != nil {
 		return "", "", err
 	}
 
+	// The score of an unchanged paper is reused, the cool down only applies once the paper changed.
+	key, err := toolCallRecordDB.NewResultKey(*t.Description.GetName(), map[string]any{"category": category}, fullContent)
+	if err != nil {
+		return "", "", err
+	}
+	cached, err := t.toolCallRecordDB.GetCached(ctx, toolCallId, key)
+	if err != nil {
+		return "", "", err
+	}
+	if cached != nil {
+		var resp projectv1.PaperScoreResult
+		if err := json.Unmarshal([]byte(cached.FunctionResult), &resp); err != nil {
+			return "", "", fmt.Errorf("failed to unmarshal cached paper score result: %v", err)
+		}
+		return t.respond(&resp)
+	}
+
+	actor, projectId, _ := toolkit.GetActorProjectConversationID(ctx)
+	err = t.toolCallRecordDB.CheckCoolDown(ctx, *t.Description.GetName(), actor.ID.Hex(), projectId, t.coolDownTime)
+	if err != nil {
+		return "", "", errors.New("cool down: " + err.Error())
+	}
+
 	// Create function call record
-	record, err := t.toolCallRecordDB.Create(ctx, toolCallId, *t.Description.GetName(), map[string]any{
+	record, err := t.toolCallRecordDB.CreateWithKey(ctx, toolCallId, *t.Description.GetName(), map[string]any{
 		"latexSource": fullContent,
 		"category":    category,
-	})
+	}, key)
 	if err != nil {
 		return "", "", err
 	}
 
-	resp, err := t.ScorePaper(fullContent, category)
+	resp, err := t.ScorePaper(ctx, fullContent, category)
 	if err != nil {
 		err = fmt.Errorf("failed to score paper: %v", err)
 		t.toolCallRecordDB.OnError(ctx, record, err)
@@ -78,15 +101,18 @@ func (t *PaperScoreTool) Call(ctx context.Context, toolCallId string, args json.
 	}
 	t.toolCallRecordDB.OnSuccess(ctx, record, string(rawJson))
 
-	// Return the JSON format to LLM. Do not return details and suggestions here, because they are already included in rawJson.
+	return t.respond(resp)
+}
+
+// respond returns the JSON format to LLM. Do not return details and suggestions here, because they are
+// already included in the function call record.
+func (t *PaperScoreTool) respond(resp *projectv1.PaperScoreResult) (string, string, error) {
 	responseJSON, err := json.Marshal(map[string]any{
 		"score":      resp.Score,
 		"percentile": resp.Percentile,
 	})
 	if err != nil {
-		err = fmt.Errorf("failed to marshal paper score result to LLM: %v, rawJson: %v", err, string(rawJson))
-		t.toolCallRecordDB.OnError(ctx, record, err)
-		return "", "", err
+		return "", "", fmt.Errorf("failed to marshal paper score result to LLM: %v", err)
 	}
 
 	furtherInstruction := "Then, call the paper_score_comment function to get the actionable comment for the paper score."
@@ -114,51 +140,9 @@ func (t *PaperScoreTool) prepare(ctx context.Context) (fullContent string, categ
 		return "", "", errors.New("failed to get paper category: " + err.Error())
 	}
 
-	err = t.toolCallRecordDB.CheckCoolDown(ctx, *t.Description.GetName(), actor.ID.Hex(), projectId, t.coolDownTime)
-	if err != nil {
-		return "", "", errors.New("cool down: " + err.Error())
-	}
-
 	return fullContent, projectCategory.Category, nil
 }
 
-func (t *PaperScoreTool) ScorePaper(latexSource string, category string) (*projectv1.PaperScoreResult, error) {
-	reqBody := struct {
-		LatexSource string `json:"latexSource"`
-		Category    string `json:"category"`
-	}{
-		LatexSource: latexSource,
-		Category:    category,
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", t.baseURL, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := t.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var scores projectv1.PaperScoreResult
-	err = json.Unmarshal(body, &scores)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w, body: %s", err, string(body))
-	}
-
-	return &scores, nil
+func (t *PaperScoreTool) ScorePaper(ctx context.Context, latexSource string, category string) (*projectv1.PaperScoreResult, error) {
+	return t.scoringClient.ScorePaper(ctx, latexSource, category)
 }
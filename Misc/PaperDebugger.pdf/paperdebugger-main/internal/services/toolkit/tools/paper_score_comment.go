@@ -1,14 +1,11 @@
 package tools
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
-	"net/http"
 	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/scoring"
 	"paperdebugger/internal/models"
 	"paperdebugger/internal/services"
 	"paperdebugger/internal/services/toolkit"
@@ -20,22 +17,16 @@ import (
 	"github.com/openai/openai-go/v2/responses"
 )
 
-type PaperScoreCommentRequest struct {
-	LatexSource      string                      `json:"latexSource"`
-	PaperScoreResult *projectv1.PaperScoreResult `json:"paperScoreResult"`
-}
-
 type PaperScoreCommentTool struct {
 	Description           responses.ToolUnionParam
 	toolCallRecordDB      *toolCallRecordDB.ToolCallRecordDB
 	projectService        *services.ProjectService
 	reverseCommentService *services.ReverseCommentService
 	coolDownTime          time.Duration
-	baseURL               string
-	client                *http.Client
+	scoringClient         *scoring.Client
 }
 
-func NewPaperScoreCommentTool(db *db.DB, projectService *services.ProjectService, reverseCommentService *services.ReverseCommentService) *PaperScoreCommentTool {
+func NewPaperScoreCommentTool(db *db.DB, projectService *services.ProjectService, reverseCommentService *services.ReverseCommentService, scoringClient *scoring.Client) *PaperScoreCommentTool {
 	toolCallRecordDB := toolCallRecordDB.NewToolCallRecordDB(db)
 	paperScoreCommentToolDescription := responses.ToolUnionParam{
 		OfFunction: &responses.FunctionToolParam{
@@ -50,8 +41,7 @@ func NewPaperScoreCommentTool(db *db.DB, projectService *services.ProjectService
 		projectService:        projectService,
 		reverseCommentService: reverseCommentService,
 		coolDownTime:          5 * time.Minute,
-		baseURL:               "http://paperdebugger-mcp-server:8000/paper-score-comments",
-		client:                &http.Client{},
+		scoringClient:         scoringClient,
 	}
 }
 
@@ -62,6 +52,10 @@ func (t *PaperScoreCommentTool) Call(ctx context.Context, toolCallId string, arg
 
 	// If there are multiple functions calling at the same time, we can consider returning a unique ID, and then let LLM pass this ID.
 	// But this optimization can be considered later, because there is only one function call now.
+	if !t.scoringClient.Configured() {
+		return "", "", scoring.ErrNotConfigured
+	}
+
 	actor, projectId, conversationID := toolkit.GetActorProjectConversationID(ctx)
 	if actor == nil || projectId == "" || conversationID == "" {
 		return "", "", errors.New("Failed to get actor, project id, or conversation id")
@@ -77,6 +71,10 @@ func (t *PaperScoreCommentTool) Call(ctx context.Context, toolCallId string, arg
 		return "", "", err
 	}
 
+	if paperScoreRecord == nil {
+		return "", "", errors.New("paper score is missing, paper score comments cannot be generated. please run the paper score function first.")
+	}
+
 	if paperScoreRecord.FunctionStatus != models.FunctionCallStatusSuccess {
 		switch paperScoreRecord.FunctionStatus {
 		case models.FunctionCallStatusError:
@@ -95,10 +93,36 @@ func (t *PaperScoreCommentTool) Call(ctx context.Context, toolCallId string, arg
 		return "", "", errors.New("failed to unmarshal paper score result: " + err.Error())
 	}
 
-	record, err := t.toolCallRecordDB.Create(ctx, toolCallId, *t.Description.GetName(), map[string]any{
+	// The comments on an unchanged paper and score are reused, the cool down only applies once they changed.
+	key, err := toolCallRecordDB.NewResultKey(*t.Description.GetName(), map[string]any{
+		"paperScoreResult": paperScoreRecord.FunctionResult,
+	}, fullContent)
+	if err != nil {
+		return "", "", err
+	}
+	cached, err := t.toolCallRecordDB.GetCached(ctx, toolCallId, key)
+	if err != nil {
+		return "", "", err
+	}
+	if cached != nil {
+		var result struct {
+			Comments json.RawMessage `json:"comments"`
+		}
+		if err := json.Unmarshal([]byte(cached.FunctionResult), &result); err != nil {
+			return "", "", errors.New("failed to unmarshal cached paper score comment result: " + err.Error())
+		}
+		return string(result.Comments), "", nil
+	}
+
+	err = t.toolCallRecordDB.CheckCoolDown(ctx, *t.Description.GetName(), actor.ID.Hex(), projectId, t.coolDownTime)
+	if err != nil {
+		return "", "", errors.New("CoolDown: " + err.Error())
+	}
+
+	record, err := t.toolCallRecordDB.CreateWithKey(ctx, toolCallId, *t.Description.GetName(), map[string]any{
 		"latexSource":      fullContent,
 		"paperScoreResult": paperScoreResult,
-	})
+	}, key)
 	if err != nil {
 		return "", "", errors.New("failed to create paper score comment record: " + err.Error())
 	}
@@ -148,18 +172,13 @@ func (t *PaperScoreCommentTool) prepare(ctx context.Context) (fullContent string
 		return "", "", errors.New("Failed to get paper category: " + err.Error())
 	}
 
-	err = t.toolCallRecordDB.CheckCoolDown(ctx, *t.Description.GetName(), actor.ID.Hex(), projectId, t.coolDownTime)
-	if err != nil {
-		return "", "", errors.New("CoolDown: " + err.Error())
-	}
-
 	return fullContent, projectCategory.Explanation, nil
 }
 
 func (t *PaperScoreCommentTool) execute(ctx context.Context, fullContent string, paperScoreResult *projectv1.PaperScoreResult) (
 	paperScoreCommentResult *projectv1.PaperScoreCommentResult,
 	comments []*projectv1.OverleafComment, err error) {
-	resp, err := t.PaperScoreComment(fullContent, paperScoreResult)
+	resp, err := t.PaperScoreComment(ctx, fullContent, paperScoreResult)
 	if err != nil {
 		return nil, nil, errors.New("failed to get paper score comment: " + err.Error())
 	}
@@ -182,40 +201,6 @@ func (t *PaperScoreCommentTool) unmarshalPaperScoreResult(data string) (*project
 	return &result, nil
 }
 
-func (t *PaperScoreCommentTool) PaperScoreComment(latexSource string, paperScoreResult *projectv1.PaperScoreResult) (*projectv1.PaperScoreCommentResult, error) {
-	reqBody := PaperScoreCommentRequest{
-		LatexSource:      latexSource,
-		PaperScoreResult: paperScoreResult,
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", t.baseURL, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := t.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var scores projectv1.PaperScoreCommentResult
-	err = json.Unmarshal(body, &scores)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response body: %w, body: %s", err, string(body))
-	}
-
-	return &scores, nil
+func (t *PaperScoreCommentTool) PaperScoreComment(ctx context.Context, latexSource string, paperScoreResult *projectv1.PaperScoreResult) (*projectv1.PaperScoreCommentResult, error) {
+	return t.scoringClient.CommentPaperScore(ctx, latexSource, paperScoreResult)
 }
@@ -1,10 +1,22 @@
 package xtramcp
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
+	"io"
+	"paperdebugger/internal/services/toolkit"
+	"paperdebugger/internal/services/toolkit/registry"
 	"strings"
+	"sync"
 )
 
+// NotificationToolsListChanged is sent by MCP servers when their tool list changed
+const NotificationToolsListChanged = "notifications/tools/list_changed"
+
+// NotificationProgress is sent by MCP servers about the requests carrying a progress token
+const NotificationProgress = "notifications/progress"
+
 // extracts JSON data from SSE format response
 // SSE format:
 //
@@ -22,3 +34,77 @@ func parseSSEResponse(body []byte) (string, error) {
 
 	return "", fmt.Errorf("no data line found in SSE response")
 }
+
+// readSSEResponse reads a SSE stream until the JSON-RPC response, the progress notifications
+// sent before it are reported to progress.
+func readSSEResponse(body io.Reader, progress toolkit.ProgressFunc) (string, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		jsonData := strings.TrimPrefix(line, "data: ")
+
+		var notification struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal([]byte(jsonData), &notification); err == nil && notification.Method == NotificationProgress {
+			reportProgress(notification.Params, progress)
+			continue
+		}
+		return jsonData, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("no data line found in SSE response")
+}
+
+// reportProgress forwards the params of a progress notification. MCP progress values increase
+// with every notification, they are only turned into a fraction if the total is known.
+func reportProgress(params json.RawMessage, progress toolkit.ProgressFunc) {
+	var notification struct {
+		Progress float64  `json:"progress"`
+		Total    *float64 `json:"total"`
+		Message  string   `json:"message"`
+	}
+	if progress == nil || json.Unmarshal(params, &notification) != nil {
+		return
+	}
+	fraction := -1.0
+	if notification.Total != nil && *notification.Total > 0 {
+		fraction = min(notification.Progress / *notification.Total, 1)
+	}
+	progress(fraction, notification.Message)
+}
+
+// registeredTools remembers which tools a loader put into the registry, so that
+// tools which disappeared from the backend can be dropped on reload. owner names the
+// backend in the registry (see registry.RegisterOwnedJob).
+type registeredTools struct {
+	mu    sync.Mutex
+	owner string
+	names map[string]bool
+}
+
+// replace unregisters the previously registered tools that are not in names, unless another
+// backend registered a tool with the same name since.
+func (r *registeredTools) replace(toolRegistry *registry.ToolRegistry, names []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := make(map[string]bool, len(names))
+	for _, name := range names {
+		current[name] = true
+	}
+	for name := range r.names {
+		if !current[name] {
+			toolRegistry.UnregisterOwned(r.owner, name)
+		}
+	}
+	r.names = current
+}
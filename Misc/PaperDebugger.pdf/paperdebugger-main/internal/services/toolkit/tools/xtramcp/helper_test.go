@@ -0,0 +1,34 @@
+package xtramcp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSSEResponse(t *testing.T) {
+	body := strings.Join([]string{
+		"event: message",
+		`data: {"jsonrpc":"2.0","method":"notifications/progress","params":{"progressToken":"call_1","progress":3,"message":"searching"}}`,
+		"",
+		"event: message",
+		`data: {"jsonrpc":"2.0","id":1,"result":{"content":[]}}`,
+		"",
+	}, "\n")
+
+	var progresses []float64
+	var messages []string
+	result, err := readSSEResponse(strings.NewReader(body), func(progress float64, message string) {
+		progresses = append(progresses, progress)
+		messages = append(messages, message)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":{"content":[]}}`, result)
+	assert.Equal(t, []float64{-1}, progresses, "the total is unknown")
+	assert.Equal(t, []string{"searching"}, messages)
+
+	_, err = readSSEResponse(strings.NewReader("event: message\n"), nil)
+	assert.EqualError(t, err, "no data line found in SSE response")
+}
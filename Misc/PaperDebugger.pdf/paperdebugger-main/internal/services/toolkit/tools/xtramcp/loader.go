@@ -1,16 +1,25 @@
 package xtramcp
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"paperdebugger/internal/libs/db"
 	"paperdebugger/internal/services"
 	"paperdebugger/internal/services/toolkit/registry"
+	"strings"
+	"sync"
 )
 
+// ErrNotificationStreamUnsupported is returned by ListenNotifications when the
+// backend does not offer a server-to-client SSE stream.
+var ErrNotificationStreamUnsupported = errors.New("MCP backend does not support notification stream")
+
 // MCPListToolsResponse represents the JSON-RPC response from tools/list method
 type MCPListToolsResponse struct {
 	JSONRPC string `json:"jsonrpc"`
@@ -26,7 +35,10 @@ type XtraMCPLoader struct {
 	projectService *services.ProjectService
 	baseURL        string
 	client         *http.Client
-	sessionID      string // Store the MCP session ID after initialization for re-use
+
+	mu         sync.Mutex
+	sessionID  string // Store the MCP session ID after initialization for re-use
+	registered registeredTools
 }
 
 // NewXtraMCPLoader creates a new dynamic XtraMCP loader
@@ -36,12 +48,14 @@ func NewXtraMCPLoader(db *db.DB, projectService *services.ProjectService, baseUR
 		projectService: projectService,
 		baseURL:        baseURL,
 		client:         &http.Client{},
+		registered:     registeredTools{owner: "xtramcp:" + baseURL},
 	}
 }
 
 // LoadToolsFromBackend fetches tool schemas from backend and registers them
 func (loader *XtraMCPLoader) LoadToolsFromBackend(toolRegistry *registry.ToolRegistry) error {
-	if loader.sessionID == "" {
+	sessionID := loader.getSessionID()
+	if sessionID == "" {
 		return fmt.Errorf("MCP session not initialized - call InitializeMCP first")
 	}
 
@@ -52,18 +66,96 @@ func (loader *XtraMCPLoader) LoadToolsFromBackend(toolRegistry *registry.ToolReg
 	}
 
 	// Register each tool dynamically, passing the session ID
+	names := make([]string, 0, len(toolSchemas))
 	for _, toolSchema := range toolSchemas {
-		dynamicTool := NewDynamicTool(loader.db, loader.projectService, toolSchema, loader.baseURL, loader.sessionID)
+		dynamicTool := NewDynamicTool(loader.db, loader.projectService, toolSchema, loader.baseURL, sessionID)
 
-		// Register the tool with the registry
-		toolRegistry.Register(toolSchema.Name, dynamicTool.Description, dynamicTool.Call)
+		// Register the tool with the registry,
+		// XtraMCP tools send the manuscript to the research backend so the user must approve their calls
+		toolRegistry.RequireApproval(toolSchema.Name)
+		toolRegistry.RegisterOwnedJob(loader.registered.owner, toolSchema.Name, dynamicTool.Description, dynamicTool.Job)
+		toolRegistry.SetOutputSchema(toolSchema.Name, toolSchema.OutputSchema)
+		names = append(names, toolSchema.Name)
 
 		fmt.Printf("Registered dynamic tool: %s\n", toolSchema.Name)
 	}
 
+	// Drop the tools which are no longer offered by the backend
+	loader.registered.replace(toolRegistry, names)
+
 	return nil
 }
 
+// Reload re-fetches the tools from backend, re-initializing the MCP session if it is missing or expired
+func (loader *XtraMCPLoader) Reload(toolRegistry *registry.ToolRegistry) error {
+	if loader.getSessionID() != "" {
+		if err := loader.LoadToolsFromBackend(toolRegistry); err == nil {
+			return nil
+		}
+	}
+
+	if _, err := loader.InitializeMCP(); err != nil {
+		return err
+	}
+	return loader.LoadToolsFromBackend(toolRegistry)
+}
+
+// ListenNotifications opens the server-to-client SSE stream of the session and calls
+// onNotification with the method of each notification received. It blocks until the
+// stream is closed or ctx is done.
+func (loader *XtraMCPLoader) ListenNotifications(ctx context.Context, onNotification func(method string)) error {
+	sessionID := loader.getSessionID()
+	if sessionID == "" {
+		return fmt.Errorf("MCP session not initialized - call InitializeMCP first")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", loader.baseURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create notification stream request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("mcp-session-id", sessionID)
+
+	resp, err := loader.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to open notification stream: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		return ErrNotificationStreamUnsupported
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("notification stream returned status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var notification struct {
+			Method string `json:"method"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &notification); err != nil || notification.Method == "" {
+			continue
+		}
+		onNotification(notification.Method)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("notification stream failed: %w", err)
+	}
+	return fmt.Errorf("notification stream closed")
+}
+
+func (loader *XtraMCPLoader) getSessionID() string {
+	loader.mu.Lock()
+	defer loader.mu.Unlock()
+	return loader.sessionID
+}
+
 // InitializeMCP performs the full MCP initialization handshake, stores session ID, and returns it
 func (loader *XtraMCPLoader) InitializeMCP() (string, error) {
 	// Step 1: Initialize
@@ -79,7 +171,9 @@ func (loader *XtraMCPLoader) InitializeMCP() (string, error) {
 	}
 
 	// Store session ID for future use and return it
+	loader.mu.Lock()
 	loader.sessionID = sessionID
+	loader.mu.Unlock()
 
 	return sessionID, nil
 }
@@ -181,7 +275,7 @@ func (loader *XtraMCPLoader) fetchAvailableTools() ([]ToolSchema, error) {
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json, text/event-stream")
-	req.Header.Set("mcp-session-id", loader.sessionID)
+	req.Header.Set("mcp-session-id", loader.getSessionID())
 
 	resp, err := loader.client.Do(req)
 	if err != nil {
@@ -0,0 +1,455 @@
+package xtramcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/services/toolkit"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	stdioRequestTimeout  = 2 * time.Minute
+	stdioShutdownTimeout = 5 * time.Second
+	stdioMinBackoff      = 1 * time.Second
+	stdioMaxBackoff      = 30 * time.Second
+	// a process that stayed up this long is considered healthy, the restart backoff is reset.
+	stdioHealthyUptime = 1 * time.Minute
+)
+
+var ErrStdioTransportClosed = errors.New("stdio transport closed")
+
+// rpcMessage is a JSON-RPC 2.0 message exchanged with a local MCP server.
+// Requests, responses and notifications share the same envelope.
+type rpcMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  any              `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *rpcError        `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("jsonrpc error %d: %s", e.Code, e.Message)
+}
+
+// StdioTransport speaks MCP (newline delimited JSON-RPC) with a local executable
+// over its stdin/stdout. The process is supervised: if it exits unexpectedly it is
+// restarted with exponential backoff and the MCP handshake is performed again.
+type StdioTransport struct {
+	name    string
+	command string
+	args    []string
+	logger  *logger.Logger
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	pending map[int64]chan rpcMessage
+	nextID  int64
+	closed  bool
+
+	progress          map[string]toolkit.ProgressFunc // by progress token
+	nextProgressToken int64
+
+	// OnNotification, if set, is called for every notification sent by the server.
+	// It runs on the reader goroutine and must not block on requests to the server.
+	OnNotification func(method string, params json.RawMessage)
+	// OnRestart, if set, is called after the process was restarted and initialized again.
+	OnRestart func()
+
+	done   chan struct{} // closed when Close is called
+	exited chan struct{} // closed when the supervisor has stopped
+}
+
+// NewStdioTransport creates a transport for the given command. The process is not
+// started until Start is called.
+func NewStdioTransport(name string, command string, args []string, logger *logger.Logger) *StdioTransport {
+	return &StdioTransport{
+		name:     name,
+		command:  command,
+		args:     args,
+		logger:   logger,
+		pending:  make(map[int64]chan rpcMessage),
+		progress: make(map[string]toolkit.ProgressFunc),
+		done:     make(chan struct{}),
+		exited:   make(chan struct{}),
+	}
+}
+
+// Start spawns the process, performs the MCP handshake and starts supervising it.
+func (t *StdioTransport) Start(ctx context.Context) error {
+	cmd, err := t.spawn()
+	if err != nil {
+		return err
+	}
+	if err := t.initialize(ctx); err != nil {
+		t.stop(cmd)
+		return err
+	}
+	go t.supervise(cmd)
+	return nil
+}
+
+// Request sends a JSON-RPC request and waits for its result.
+func (t *StdioTransport) Request(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, stdioRequestTimeout)
+		defer cancel()
+	}
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil, ErrStdioTransportClosed
+	}
+	if t.stdin == nil {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("mcp server %s is not running", t.name)
+	}
+	t.nextID++
+	id := t.nextID
+	rawID := json.RawMessage(fmt.Sprintf("%d", id))
+	ch := make(chan rpcMessage, 1)
+	t.pending[id] = ch
+	err := t.writeLocked(rpcMessage{JSONRPC: "2.0", ID: &rawID, Method: method, Params: params})
+	if err != nil {
+		delete(t.pending, id)
+	}
+	t.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", method, err)
+	}
+
+	select {
+	case <-ctx.Done():
+		t.mu.Lock()
+		delete(t.pending, id)
+		t.mu.Unlock()
+		return nil, fmt.Errorf("%s request: %w", method, ctx.Err())
+	case msg := <-ch:
+		if msg.Error != nil {
+			return nil, msg.Error
+		}
+		return msg.Result, nil
+	}
+}
+
+// Notify sends a JSON-RPC notification (no response is expected).
+func (t *StdioTransport) Notify(method string, params any) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return ErrStdioTransportClosed
+	}
+	if t.stdin == nil {
+		return fmt.Errorf("mcp server %s is not running", t.name)
+	}
+	return t.writeLocked(rpcMessage{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// ListTools returns the tools exposed by the local MCP server.
+func (t *StdioTransport) ListTools(ctx context.Context) ([]ToolSchema, error) {
+	result, err := t.Request(ctx, "tools/list", map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+	var parsed struct {
+		Tools []ToolSchema `json:"tools"`
+	}
+	if err := json.Unmarshal(result, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse tools/list result: %w", err)
+	}
+	return parsed.Tools, nil
+}
+
+// CallTool invokes a tool and returns the raw JSON result.
+func (t *StdioTransport) CallTool(ctx context.Context, name string, args map[string]any) (string, error) {
+	return t.CallToolWithProgress(ctx, name, args, nil)
+}
+
+// CallToolWithProgress is CallTool, with the progress notifications of the server about the call
+// reported to progress.
+func (t *StdioTransport) CallToolWithProgress(ctx context.Context, name string, args map[string]any, progress toolkit.ProgressFunc) (string, error) {
+	params := MCPParams{Name: name, Arguments: args}
+	if progress != nil {
+		t.mu.Lock()
+		t.nextProgressToken++
+		token := fmt.Sprintf("%s-%d", t.name, t.nextProgressToken)
+		t.progress[token] = progress
+		t.mu.Unlock()
+		defer func() {
+			t.mu.Lock()
+			delete(t.progress, token)
+			t.mu.Unlock()
+		}()
+		params.Meta = map[string]any{"progressToken": token}
+	}
+
+	result, err := t.Request(ctx, "tools/call", params)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// Close gracefully stops the process: stdin is closed first, then SIGTERM and
+// finally SIGKILL are sent if the process does not exit in time.
+func (t *StdioTransport) Close() error {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil
+	}
+	t.closed = true
+	close(t.done)
+	cmd := t.cmd
+	if t.stdin != nil {
+		t.stdin.Close()
+	}
+	t.mu.Unlock()
+
+	if cmd == nil {
+		return nil
+	}
+	select {
+	case <-t.exited:
+		return nil
+	case <-time.After(stdioShutdownTimeout):
+	}
+	t.logger.Warn("[MCP stdio] server did not exit after stdin was closed, sending SIGTERM", "server", t.name)
+	_ = cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-t.exited:
+		return nil
+	case <-time.After(stdioShutdownTimeout):
+	}
+	t.logger.Warn("[MCP stdio] server did not exit after SIGTERM, killing it", "server", t.name)
+	_ = cmd.Process.Kill()
+	<-t.exited
+	return nil
+}
+
+func (t *StdioTransport) initialize(ctx context.Context) error {
+	_, err := t.Request(ctx, "initialize", map[string]any{
+		"protocolVersion": "2024-11-05",
+		"capabilities":    map[string]any{},
+		"clientInfo": map[string]any{
+			"name":    "paperdebugger-client",
+			"version": "1.0.0",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("initialize failed: %w", err)
+	}
+	if err := t.Notify("notifications/initialized", map[string]any{}); err != nil {
+		return fmt.Errorf("notifications/initialized failed: %w", err)
+	}
+	return nil
+}
+
+// spawn starts a new process and its reader goroutines.
+func (t *StdioTransport) spawn() (*exec.Cmd, error) {
+	cmd := exec.Command(t.command, t.args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdin of mcp server %s: %w", t.name, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdout of mcp server %s: %w", t.name, err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stderr of mcp server %s: %w", t.name, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start mcp server %s: %w", t.name, err)
+	}
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return nil, ErrStdioTransportClosed
+	}
+	t.cmd = cmd
+	t.stdin = stdin
+	t.mu.Unlock()
+
+	go t.readLoop(stdout, stdin)
+	go t.logStderr(stderr)
+
+	t.logger.Info("[MCP stdio] server started", "server", t.name, "pid", cmd.Process.Pid)
+	return cmd, nil
+}
+
+// stop kills a process that failed during startup and reaps it.
+func (t *StdioTransport) stop(cmd *exec.Cmd) {
+	_ = cmd.Process.Kill()
+	_ = cmd.Wait()
+	t.detach()
+}
+
+// detach forgets the current process and fails all in-flight requests.
+func (t *StdioTransport) detach() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cmd = nil
+	t.stdin = nil
+	for id, ch := range t.pending {
+		ch <- rpcMessage{Error: &rpcError{Code: -32000, Message: fmt.Sprintf("mcp server %s exited", t.name)}}
+		delete(t.pending, id)
+	}
+}
+
+// supervise waits for the process to exit and restarts it until Close is called.
+func (t *StdioTransport) supervise(cmd *exec.Cmd) {
+	defer close(t.exited)
+
+	backoff := stdioMinBackoff
+	for {
+		startedAt := time.Now()
+		err := cmd.Wait()
+		t.detach()
+
+		select {
+		case <-t.done:
+			t.logger.Info("[MCP stdio] server stopped", "server", t.name)
+			return
+		default:
+		}
+
+		if time.Since(startedAt) > stdioHealthyUptime {
+			backoff = stdioMinBackoff
+		}
+		t.logger.Error("[MCP stdio] server exited unexpectedly", "server", t.name, "error", err, "restartIn", backoff)
+
+		for {
+			select {
+			case <-t.done:
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, stdioMaxBackoff)
+
+			next, err := t.spawn()
+			if err != nil {
+				t.logger.Error("[MCP stdio] failed to restart server", "server", t.name, "error", err, "retryIn", backoff)
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), stdioRequestTimeout)
+			err = t.initialize(ctx)
+			cancel()
+			if err != nil {
+				t.logger.Error("[MCP stdio] failed to initialize restarted server", "server", t.name, "error", err, "retryIn", backoff)
+				t.stop(next)
+				continue
+			}
+			cmd = next
+			if t.OnRestart != nil {
+				go t.OnRestart()
+			}
+			break
+		}
+	}
+}
+
+// readLoop dispatches messages received on stdout until the pipe is closed.
+func (t *StdioTransport) readLoop(stdout io.Reader, stdin io.Writer) {
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			t.dispatch(line, stdin)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (t *StdioTransport) dispatch(line []byte, stdin io.Writer) {
+	var msg struct {
+		rpcMessage
+		Params json.RawMessage `json:"params,omitempty"`
+	}
+	if err := json.Unmarshal(line, &msg); err != nil {
+		t.logger.Warn("[MCP stdio] ignoring malformed message", "server", t.name, "error", err)
+		return
+	}
+
+	switch {
+	case msg.Method != "" && msg.ID != nil:
+		// server -> client request, only ping is supported
+		reply := rpcMessage{JSONRPC: "2.0", ID: msg.ID}
+		if msg.Method == "ping" {
+			reply.Result = json.RawMessage("{}")
+		} else {
+			reply.Error = &rpcError{Code: -32601, Message: "method not found"}
+		}
+		t.mu.Lock()
+		if t.stdin == stdin {
+			_ = t.writeLocked(reply)
+		}
+		t.mu.Unlock()
+	case msg.Method == NotificationProgress:
+		var token struct {
+			ProgressToken any `json:"progressToken"`
+		}
+		_ = json.Unmarshal(msg.Params, &token)
+		t.mu.Lock()
+		progress := t.progress[fmt.Sprint(token.ProgressToken)]
+		t.mu.Unlock()
+		if progress != nil {
+			reportProgress(msg.Params, progress)
+		}
+	case msg.Method != "":
+		if t.OnNotification != nil {
+			t.OnNotification(msg.Method, msg.Params)
+		}
+	case msg.ID != nil:
+		var id int64
+		if err := json.Unmarshal(*msg.ID, &id); err != nil {
+			return
+		}
+		t.mu.Lock()
+		ch, ok := t.pending[id]
+		delete(t.pending, id)
+		t.mu.Unlock()
+		if ok {
+			ch <- msg.rpcMessage
+		}
+	}
+}
+
+func (t *StdioTransport) logStderr(stderr io.Reader) {
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		t.logger.Debug("[MCP stdio] "+scanner.Text(), "server", t.name)
+	}
+}
+
+func (t *StdioTransport) writeLocked(msg rpcMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = t.stdin.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,140 @@
+package xtramcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"paperdebugger/internal/libs/db"
+	"paperdebugger/internal/libs/logger"
+	"paperdebugger/internal/services/toolkit"
+	toolCallRecordDB "paperdebugger/internal/services/toolkit/db"
+	"paperdebugger/internal/services/toolkit/registry"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/openai/openai-go/v2/responses"
+)
+
+// StdioMCPLoader loads tools from a local MCP server spoken to over stdio
+type StdioMCPLoader struct {
+	db         *db.DB
+	transport  *StdioTransport
+	logger     *logger.Logger
+	registered registeredTools
+}
+
+// NewStdioMCPLoader creates a loader for the local MCP server started by command
+func NewStdioMCPLoader(db *db.DB, name string, command string, args []string, logger *logger.Logger) *StdioMCPLoader {
+	return &StdioMCPLoader{
+		db:         db,
+		transport:  NewStdioTransport(name, command, args, logger),
+		logger:     logger,
+		registered: registeredTools{owner: "stdio:" + name},
+	}
+}
+
+// Start spawns the local MCP server and performs the initialization handshake
+func (loader *StdioMCPLoader) Start(ctx context.Context) error {
+	return loader.transport.Start(ctx)
+}
+
+// Close gracefully shuts down the local MCP server
+func (loader *StdioMCPLoader) Close() error {
+	return loader.transport.Close()
+}
+
+// LoadToolsFromBackend fetches tool schemas from the local MCP server and registers them
+func (loader *StdioMCPLoader) LoadToolsFromBackend(toolRegistry *registry.ToolRegistry) error {
+	toolSchemas, err := loader.transport.ListTools(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to fetch tools from %s: %w", loader.transport.name, err)
+	}
+
+	names := make([]string, 0, len(toolSchemas))
+	for _, toolSchema := range toolSchemas {
+		stdioTool := NewStdioTool(loader.db, toolSchema, loader.transport)
+		toolRegistry.RegisterOwnedJob(loader.registered.owner, toolSchema.Name, stdioTool.Description, stdioTool.Job)
+		toolRegistry.SetOutputSchema(toolSchema.Name, toolSchema.OutputSchema)
+		names = append(names, toolSchema.Name)
+		loader.logger.Info("[MCP stdio] registered tool", "server", loader.transport.name, "tool", toolSchema.Name)
+	}
+	loader.registered.replace(toolRegistry, names)
+
+	return nil
+}
+
+// Watch keeps toolRegistry in sync with the server: the tools are reloaded when the
+// server reports that its tool list changed and after the server was restarted.
+// It must be called before Start.
+func (loader *StdioMCPLoader) Watch(toolRegistry *registry.ToolRegistry) {
+	reload := func() {
+		if err := loader.LoadToolsFromBackend(toolRegistry); err != nil {
+			loader.logger.Error("[MCP stdio] failed to reload tools", "server", loader.transport.name, "error", err)
+		}
+	}
+	loader.transport.OnNotification = func(method string, _ json.RawMessage) {
+		if method == NotificationToolsListChanged {
+			go reload()
+		}
+	}
+	loader.transport.OnRestart = reload
+}
+
+// StdioTool is the stdio counterpart of DynamicTool
+type StdioTool struct {
+	Name             string
+	Description      responses.ToolUnionParam
+	toolCallRecordDB *toolCallRecordDB.ToolCallRecordDB
+	transport        *StdioTransport
+}
+
+// NewStdioTool creates a new tool backed by a local MCP server
+func NewStdioTool(db *db.DB, toolSchema ToolSchema, transport *StdioTransport) *StdioTool {
+	description := responses.ToolUnionParam{
+		OfFunction: &responses.FunctionToolParam{
+			Name:        toolSchema.Name,
+			Description: param.NewOpt(toolSchema.Description),
+			Parameters:  openai.FunctionParameters(toolSchema.InputSchema),
+		},
+	}
+
+	return &StdioTool{
+		Name:             toolSchema.Name,
+		Description:      description,
+		toolCallRecordDB: toolCallRecordDB.NewToolCallRecordDB(db),
+		transport:        transport,
+	}
+}
+
+// Job prepares the tool execution, the call to the server runs as a background job
+func (t *StdioTool) Job(ctx context.Context, toolCallId string, args json.RawMessage) (toolkit.JobFunc, error) {
+	var argsMap map[string]interface{}
+	err := json.Unmarshal(args, &argsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := t.toolCallRecordDB.Create(ctx, toolCallId, t.Name, argsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		respStr, err := t.transport.CallToolWithProgress(ctx, t.Name, argsMap, progress)
+		if err != nil {
+			err = fmt.Errorf("failed to execute tool %s: %v", t.Name, err)
+			t.toolCallRecordDB.OnError(ctx, record, err)
+			return "", err
+		}
+
+		rawJson, err := json.Marshal(respStr)
+		if err != nil {
+			err = fmt.Errorf("failed to marshal tool result: %v", err)
+			t.toolCallRecordDB.OnError(ctx, record, err)
+			return "", err
+		}
+		t.toolCallRecordDB.OnSuccess(ctx, record, string(rawJson))
+
+		return respStr, nil
+	}, nil
+}
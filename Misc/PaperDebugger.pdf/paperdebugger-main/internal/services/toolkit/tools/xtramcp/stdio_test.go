@@ -0,0 +1,116 @@
+package xtramcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"paperdebugger/internal/libs/logger"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperMCPServer is not a real test, it is the fake MCP server spawned by the
+// tests below (the test binary re-executes itself).
+func TestHelperMCPServer(t *testing.T) {
+	if os.Getenv("PD_HELPER_MCP_SERVER") != "1" {
+		t.Skip("helper process")
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			os.Exit(0)
+		}
+		var req struct {
+			ID     *json.RawMessage `json:"id"`
+			Method string           `json:"method"`
+			Params MCPParams        `json:"params"`
+		}
+		if err := json.Unmarshal(line, &req); err != nil || req.ID == nil {
+			continue
+		}
+		var result any
+		switch req.Method {
+		case "initialize":
+			result = map[string]any{"protocolVersion": "2024-11-05", "capabilities": map[string]any{}}
+		case "tools/list":
+			result = map[string]any{"tools": []ToolSchema{{Name: "echo", Description: "echoes the input"}}}
+		case "tools/call":
+			if req.Params.Name == "crash" {
+				os.Exit(1)
+			}
+			if token, ok := req.Params.Meta["progressToken"]; ok {
+				notification, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "method": NotificationProgress, "params": map[string]any{
+					"progressToken": token, "progress": 1, "total": 4, "message": "working",
+				}})
+				fmt.Fprintf(os.Stdout, "%s\n", notification)
+			}
+			result = map[string]any{"content": []map[string]any{{"type": "text", "text": fmt.Sprint(req.Params.Arguments["text"])}}}
+		}
+		resp, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		fmt.Fprintf(os.Stdout, "%s\n", resp)
+	}
+}
+
+func newHelperTransport(t *testing.T) *StdioTransport {
+	t.Setenv("PD_HELPER_MCP_SERVER", "1")
+	transport := NewStdioTransport("helper", os.Args[0], []string{"-test.run=^TestHelperMCPServer$"}, logger.GetLogger())
+	require.NoError(t, transport.Start(context.Background()))
+	t.Cleanup(func() { transport.Close() })
+	return transport
+}
+
+func TestStdioTransport_ListAndCallTools(t *testing.T) {
+	transport := newHelperTransport(t)
+
+	tools, err := transport.ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "echo", tools[0].Name)
+
+	result, err := transport.CallTool(context.Background(), "echo", map[string]any{"text": "hello"})
+	require.NoError(t, err)
+	assert.Contains(t, result, `"text":"hello"`)
+}
+
+func TestStdioTransport_RestartsAfterCrash(t *testing.T) {
+	transport := newHelperTransport(t)
+
+	_, err := transport.CallTool(context.Background(), "crash", map[string]any{})
+	assert.Error(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, err := transport.CallTool(context.Background(), "echo", map[string]any{"text": "back"})
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestStdioTransport_CallToolWithProgress(t *testing.T) {
+	transport := newHelperTransport(t)
+
+	type report struct {
+		progress float64
+		message  string
+	}
+	reports := make(chan report, 1)
+	result, err := transport.CallToolWithProgress(context.Background(), "echo", map[string]any{"text": "hi"}, func(progress float64, message string) {
+		reports <- report{progress, message}
+	})
+	require.NoError(t, err)
+	assert.Contains(t, result, `"text":"hi"`)
+	assert.Equal(t, report{0.25, "working"}, <-reports)
+}
+
+func TestStdioTransport_Close(t *testing.T) {
+	transport := newHelperTransport(t)
+
+	require.NoError(t, transport.Close())
+	_, err := transport.ListTools(context.Background())
+	assert.ErrorIs(t, err, ErrStdioTransportClosed)
+}
@@ -5,10 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"paperdebugger/internal/libs/db"
 	"paperdebugger/internal/services"
+	"paperdebugger/internal/services/toolkit"
 	toolCallRecordDB "paperdebugger/internal/services/toolkit/db"
 	"time"
 
@@ -37,6 +37,7 @@ type MCPRequest struct {
 type MCPParams struct {
 	Name      string                 `json:"name"`
 	Arguments map[string]interface{} `json:"arguments"`
+	Meta      map[string]interface{} `json:"_meta,omitempty"`
 }
 
 // DynamicTool represents a generic tool that can handle any schema
@@ -77,42 +78,44 @@ func NewDynamicTool(db *db.DB, projectService *services.ProjectService, toolSche
 	}
 }
 
-// Call handles the tool execution (generic for any tool)
-func (t *DynamicTool) Call(ctx context.Context, toolCallId string, args json.RawMessage) (string, string, error) {
+// Job prepares the tool execution (generic for any tool), the MCP request runs as a background job
+func (t *DynamicTool) Job(ctx context.Context, toolCallId string, args json.RawMessage) (toolkit.JobFunc, error) {
 	// Parse arguments as generic map since we don't know the structure
 	var argsMap map[string]interface{}
 	err := json.Unmarshal(args, &argsMap)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
 	// Create function call record
 	record, err := t.toolCallRecordDB.Create(ctx, toolCallId, t.Name, argsMap)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
-	// Execute the tool via MCP
-	respStr, err := t.executeTool(argsMap)
-	if err != nil {
-		err = fmt.Errorf("failed to execute tool %s: %v", t.Name, err)
-		t.toolCallRecordDB.OnError(ctx, record, err)
-		return "", "", err
-	}
-
-	rawJson, err := json.Marshal(respStr)
-	if err != nil {
-		err = fmt.Errorf("failed to marshal tool result: %v", err)
-		t.toolCallRecordDB.OnError(ctx, record, err)
-		return "", "", err
-	}
-	t.toolCallRecordDB.OnSuccess(ctx, record, string(rawJson))
-
-	return respStr, "", nil
+	return func(ctx context.Context, progress toolkit.ProgressFunc) (string, error) {
+		// Execute the tool via MCP
+		respStr, err := t.executeTool(ctx, toolCallId, argsMap, progress)
+		if err != nil {
+			err = fmt.Errorf("failed to execute tool %s: %v", t.Name, err)
+			t.toolCallRecordDB.OnError(ctx, record, err)
+			return "", err
+		}
+
+		rawJson, err := json.Marshal(respStr)
+		if err != nil {
+			err = fmt.Errorf("failed to marshal tool result: %v", err)
+			t.toolCallRecordDB.OnError(ctx, record, err)
+			return "", err
+		}
+		t.toolCallRecordDB.OnSuccess(ctx, record, string(rawJson))
+
+		return respStr, nil
+	}, nil
 }
 
 // executeTool makes the MCP request (generic for any tool)
-func (t *DynamicTool) executeTool(args map[string]interface{}) (string, error) {
+func (t *DynamicTool) executeTool(ctx context.Context, toolCallId string, args map[string]interface{}, progress toolkit.ProgressFunc) (string, error) {
 
 	request := MCPRequest{
 		JSONRPC: "2.0",
@@ -121,6 +124,7 @@ func (t *DynamicTool) executeTool(args map[string]interface{}) (string, error) {
 		Params: MCPParams{
 			Name:      t.Name,
 			Arguments: args,
+			Meta:      map[string]interface{}{"progressToken": toolCallId},
 		},
 	}
 
@@ -131,7 +135,7 @@ func (t *DynamicTool) executeTool(args map[string]interface{}) (string, error) {
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", t.baseURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", t.baseURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return "", fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -148,13 +152,8 @@ func (t *DynamicTool) executeTool(args map[string]interface{}) (string, error) {
 	}
 	defer resp.Body.Close()
 
-	// Read response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read response: %w", err)
-	}
-
-	extractedJSON, err := parseSSEResponse(body)
+	// The progress notifications are streamed before the response
+	extractedJSON, err := readSSEResponse(resp.Body, progress)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse SSE response: %w", err)
 	}
@@ -3,6 +3,7 @@ package toolkit
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 type ToolHandler func(ctx context.Context, toolCallId string, args json.RawMessage) (result string, furtherInstruction string, err error)
@@ -11,3 +12,18 @@ type ToolRegistry interface {
 	Register(name string, handler ToolHandler)
 	Call(ctx context.Context, toolCallId string, toolCallName string, toolCallArgs json.RawMessage) (result string, furtherInstruction string, err error)
 }
+
+// ProgressFunc reports the progress of a job, between 0 and 1 (negative if unknown), with a short message.
+type ProgressFunc func(progress float64, message string)
+
+// JobFunc is the long-running part of a tool call, it may outlive the request that started it.
+type JobFunc func(ctx context.Context, progress ProgressFunc) (result string, err error)
+
+// JobHandler prepares a tool call (parses the arguments, records the call, ...) and returns the job doing the work.
+type JobHandler func(ctx context.Context, toolCallId string, args json.RawMessage) (JobFunc, error)
+
+type JobRunner interface {
+	// Run starts job in the background and waits up to wait for it. If the job finished in time, done is
+	// true and its result or error is returned. Otherwise the result is delivered into the conversation later.
+	Run(ctx context.Context, toolCallId string, toolName string, args json.RawMessage, job JobFunc, wait time.Duration) (jobID string, result string, done bool, err error)
+}
@@ -55,9 +55,12 @@ func (s *UserService) UpsertUserByEmail(ctx context.Context, user *models.User)
 		user.CreatedAt = existingUser.CreatedAt
 		user.UpdatedAt = bson.NewDateTimeFromTime(time.Now())
 		user.Settings = existingUser.Settings
+		user.ToolPreferences = existingUser.ToolPreferences
+		user.PromptPreferences = existingUser.PromptPreferences
 
+		// logging in cancels a requested deletion of the account
 		filter := bson.M{"email": user.Email}
-		update := bson.M{"$set": user}
+		update := bson.M{"$set": user, "$unset": bson.M{"deletion_requested_at": ""}}
 		_, err := s.userCollection.UpdateOne(ctx, filter, update)
 		if err != nil {
 			return nil, err
@@ -156,3 +159,35 @@ func (s *UserService) UpsertUserInstructions(ctx context.Context, userID bson.Ob
 
 	return instructions, nil
 }
+
+func (s *UserService) UpdateToolPreferences(ctx context.Context, userID bson.ObjectID, preferences models.ToolPreferences) (*models.ToolPreferences, error) {
+	filter := bson.M{"_id": userID}
+	update := bson.M{
+		"$set": bson.M{
+			"tool_preferences": preferences,
+			"updated_at":       bson.NewDateTimeFromTime(time.Now()),
+		},
+	}
+	_, err := s.userCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preferences, nil
+}
+
+func (s *UserService) UpdatePromptPreferences(ctx context.Context, userID bson.ObjectID, preferences models.PromptPreferences) (*models.PromptPreferences, error) {
+	filter := bson.M{"_id": userID}
+	update := bson.M{
+		"$set": bson.M{
+			"prompt_preferences": preferences,
+			"updated_at":         bson.NewDateTimeFromTime(time.Now()),
+		},
+	}
+	_, err := s.userCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preferences, nil
+}
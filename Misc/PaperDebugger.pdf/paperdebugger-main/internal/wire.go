@@ -5,9 +5,11 @@ package internal
 
 import (
 	"paperdebugger/internal/api"
+	"paperdebugger/internal/api/admin"
 	"paperdebugger/internal/api/auth"
 	"paperdebugger/internal/api/chat"
 	"paperdebugger/internal/api/comment"
+	"paperdebugger/internal/api/organization"
 	"paperdebugger/internal/api/project"
 	"paperdebugger/internal/api/user"
 	"paperdebugger/internal/libs/cfg"
@@ -31,6 +33,8 @@ var Set = wire.NewSet(
 	user.NewUserServer,
 	project.NewProjectServer,
 	comment.NewCommentServer,
+	admin.NewAdminServer,
+	organization.NewOrganizationServer,
 
 	aiclient.NewAIClient,
 	services.NewReverseCommentService,
@@ -39,7 +43,16 @@ var Set = wire.NewSet(
 	services.NewUserService,
 	services.NewProjectService,
 	services.NewPromptService,
+	services.NewToolCallService,
+	services.NewPaperScoreService,
+	services.NewTextEditService,
 	services.NewOAuthService,
+	services.NewRetentionService,
+	services.NewAccountService,
+	services.NewProjectMemberService,
+	services.NewOrganizationService,
+	services.NewDefaultPromptService,
+	services.NewSystemPromptService,
 
 	cfg.GetCfg,
 	logger.GetLogger,
@@ -9,9 +9,11 @@ package internal
 import (
 	"github.com/google/wire"
 	"paperdebugger/internal/api"
+	"paperdebugger/internal/api/admin"
 	"paperdebugger/internal/api/auth"
 	"paperdebugger/internal/api/chat"
 	"paperdebugger/internal/api/comment"
+	"paperdebugger/internal/api/organization"
 	"paperdebugger/internal/api/project"
 	"paperdebugger/internal/api/user"
 	"paperdebugger/internal/libs/cfg"
@@ -35,21 +37,32 @@ func InitializeApp() (*api.Server, error) {
 	authServiceServer := auth.NewAuthServer(tokenService, userService, cfgCfg, loggerLogger)
 	projectService := services.NewProjectService(dbDB, cfgCfg, loggerLogger)
 	reverseCommentService := services.NewReverseCommentService(dbDB, cfgCfg, loggerLogger, projectService)
-	aiClient := client.NewAIClient(dbDB, reverseCommentService, projectService, cfgCfg, loggerLogger)
-	chatService := services.NewChatService(dbDB, cfgCfg, loggerLogger)
-	chatServiceServer := chat.NewChatServer(aiClient, chatService, projectService, userService, loggerLogger, cfgCfg)
-	promptService := services.NewPromptService(dbDB, cfgCfg, loggerLogger)
-	userServiceServer := user.NewUserServer(userService, promptService, cfgCfg, loggerLogger)
-	projectServiceServer := project.NewProjectServer(projectService, loggerLogger, cfgCfg)
+	textEditService := services.NewTextEditService(dbDB, cfgCfg, loggerLogger, projectService)
+	aiClient := client.NewAIClient(dbDB, reverseCommentService, projectService, userService, textEditService, cfgCfg, loggerLogger)
+	projectMemberService := services.NewProjectMemberService(dbDB, cfgCfg, loggerLogger)
+	systemPromptService := services.NewSystemPromptService(dbDB, cfgCfg, loggerLogger)
+	chatService := services.NewChatService(dbDB, cfgCfg, loggerLogger, projectMemberService, systemPromptService)
+	organizationService := services.NewOrganizationService(dbDB, cfgCfg, loggerLogger)
+	defaultPromptService := services.NewDefaultPromptService(dbDB, cfgCfg, loggerLogger)
+	promptService := services.NewPromptService(dbDB, cfgCfg, loggerLogger, organizationService, defaultPromptService)
+	chatServiceServer := chat.NewChatServer(aiClient, chatService, projectService, userService, textEditService, organizationService, promptService, loggerLogger, cfgCfg)
+	accountService := services.NewAccountService(dbDB, cfgCfg, loggerLogger, tokenService)
+	userServiceServer := user.NewUserServer(userService, promptService, projectService, accountService, organizationService, defaultPromptService, aiClient, cfgCfg, loggerLogger)
+	paperScoreService := services.NewPaperScoreService(dbDB, cfgCfg, loggerLogger, projectService, reverseCommentService)
+	projectServiceServer := project.NewProjectServer(projectService, paperScoreService, reverseCommentService, projectMemberService, loggerLogger, cfgCfg)
 	commentServiceServer := comment.NewCommentServer(projectService, chatService, reverseCommentService, loggerLogger, cfgCfg)
-	grpcServer := api.NewGrpcServer(userService, cfgCfg, authServiceServer, chatServiceServer, userServiceServer, projectServiceServer, commentServiceServer)
+	toolCallService := services.NewToolCallService(dbDB, cfgCfg, loggerLogger)
+	adminServiceServer := admin.NewAdminServer(aiClient, userService, toolCallService, defaultPromptService, systemPromptService, loggerLogger, cfgCfg)
+	organizationServiceServer := organization.NewOrganizationServer(organizationService, loggerLogger, cfgCfg)
+	grpcServer := api.NewGrpcServer(userService, cfgCfg, authServiceServer, chatServiceServer, userServiceServer, projectServiceServer, commentServiceServer, adminServiceServer, organizationServiceServer)
 	oAuthService := services.NewOAuthService(dbDB, cfgCfg, loggerLogger)
 	oAuthHandler := auth.NewOAuthHandler(oAuthService)
 	ginServer := api.NewGinServer(cfgCfg, oAuthHandler)
-	server := api.NewServer(grpcServer, ginServer, loggerLogger)
+	retentionService := services.NewRetentionService(dbDB, cfgCfg, loggerLogger)
+	server := api.NewServer(grpcServer, ginServer, aiClient, retentionService, userService, accountService, loggerLogger)
 	return server, nil
 }
 
 // wire.go:
 
-var Set = wire.NewSet(api.NewServer, api.NewGrpcServer, api.NewGinServer, auth.NewOAuthHandler, auth.NewAuthServer, chat.NewChatServer, user.NewUserServer, project.NewProjectServer, comment.NewCommentServer, client.NewAIClient, services.NewReverseCommentService, services.NewChatService, services.NewTokenService, services.NewUserService, services.NewProjectService, services.NewPromptService, services.NewOAuthService, cfg.GetCfg, logger.GetLogger, db.NewDB)
+var Set = wire.NewSet(api.NewServer, api.NewGrpcServer, api.NewGinServer, auth.NewOAuthHandler, auth.NewAuthServer, chat.NewChatServer, user.NewUserServer, project.NewProjectServer, comment.NewCommentServer, admin.NewAdminServer, organization.NewOrganizationServer, client.NewAIClient, services.NewReverseCommentService, services.NewChatService, services.NewTokenService, services.NewUserService, services.NewProjectService, services.NewPromptService, services.NewToolCallService, services.NewPaperScoreService, services.NewTextEditService, services.NewOAuthService, services.NewRetentionService, services.NewAccountService, services.NewProjectMemberService, services.NewOrganizationService, services.NewDefaultPromptService, services.NewSystemPromptService, cfg.GetCfg, logger.GetLogger, db.NewDB)
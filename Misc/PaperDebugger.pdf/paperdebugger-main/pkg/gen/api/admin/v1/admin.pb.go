@@ -0,0 +1,2067 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: admin/v1/admin.proto
+
+package adminv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SystemPromptSource int32
+
+const (
+	SystemPromptSource_SYSTEM_PROMPT_SOURCE_UNSPECIFIED SystemPromptSource = 0
+	SystemPromptSource_SYSTEM_PROMPT_SOURCE_BUILT_IN    SystemPromptSource = 1
+	SystemPromptSource_SYSTEM_PROMPT_SOURCE_CONFIG      SystemPromptSource = 2 // a file of PD_SYSTEM_PROMPT_DIR
+	SystemPromptSource_SYSTEM_PROMPT_SOURCE_DATABASE    SystemPromptSource = 3 // set with UpdateSystemPrompt
+)
+
+// Enum value maps for SystemPromptSource.
+var (
+	SystemPromptSource_name = map[int32]string{
+		0: "SYSTEM_PROMPT_SOURCE_UNSPECIFIED",
+		1: "SYSTEM_PROMPT_SOURCE_BUILT_IN",
+		2: "SYSTEM_PROMPT_SOURCE_CONFIG",
+		3: "SYSTEM_PROMPT_SOURCE_DATABASE",
+	}
+	SystemPromptSource_value = map[string]int32{
+		"SYSTEM_PROMPT_SOURCE_UNSPECIFIED": 0,
+		"SYSTEM_PROMPT_SOURCE_BUILT_IN":    1,
+		"SYSTEM_PROMPT_SOURCE_CONFIG":      2,
+		"SYSTEM_PROMPT_SOURCE_DATABASE":    3,
+	}
+)
+
+func (x SystemPromptSource) Enum() *SystemPromptSource {
+	p := new(SystemPromptSource)
+	*p = x
+	return p
+}
+
+func (x SystemPromptSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemPromptSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (SystemPromptSource) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[0]
+}
+
+func (x SystemPromptSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemPromptSource.Descriptor instead.
+func (SystemPromptSource) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ReloadToolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadToolsRequest) Reset() {
+	*x = ReloadToolsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadToolsRequest) ProtoMessage() {}
+
+func (x *ReloadToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadToolsRequest.ProtoReflect.Descriptor instead.
+func (*ReloadToolsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ReloadToolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tools         []string               `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`   // names of the tools registered after the reload
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // backends which failed to reload, the previous tools are kept
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadToolsResponse) Reset() {
+	*x = ReloadToolsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadToolsResponse) ProtoMessage() {}
+
+func (x *ReloadToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadToolsResponse.ProtoReflect.Descriptor instead.
+func (*ReloadToolsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReloadToolsResponse) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *ReloadToolsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ToolCall is one invocation of a tool, as recorded by the tools which keep a record of their calls.
+type ToolCall struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,4,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ToolCallId     string                 `protobuf:"bytes,5,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
+	Name           string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`       // "pending", "success", "error" or "timeout"
+	Arguments      string                 `protobuf:"bytes,8,opt,name=arguments,proto3" json:"arguments,omitempty"` // json string
+	Result         string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`       // json string
+	Error          string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs      *int64                 `protobuf:"varint,11,opt,name=latency_ms,json=latencyMs,proto3,oneof" json:"latency_ms,omitempty"` // unset while pending, after a timeout, and for cached results
+	Cached         bool                   `protobuf:"varint,12,opt,name=cached,proto3" json:"cached,omitempty"`                              // the result of an earlier call on the same content was reused
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ToolCall) Reset() {
+	*x = ToolCall{}
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCall) ProtoMessage() {}
+
+func (x *ToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
+func (*ToolCall) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ToolCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToolCall) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ToolCall) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ToolCall) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ToolCall) GetToolCallId() string {
+	if x != nil {
+		return x.ToolCallId
+	}
+	return ""
+}
+
+func (x *ToolCall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCall) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ToolCall) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+func (x *ToolCall) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ToolCall) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ToolCall) GetLatencyMs() int64 {
+	if x != nil && x.LatencyMs != nil {
+		return *x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ToolCall) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *ToolCall) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ToolCall) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListToolCallsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId *string                `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
+	ProjectId      *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ToolName       *string                `protobuf:"bytes,3,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	Status         *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                               // defaults to 50, at most 200
+	PageToken      *string                `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListToolCallsRequest) Reset() {
+	*x = ListToolCallsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsRequest) ProtoMessage() {}
+
+func (x *ListToolCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListToolCallsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListToolCallsRequest) GetConversationId() string {
+	if x != nil && x.ConversationId != nil {
+		return *x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetToolName() string {
+	if x != nil && x.ToolName != nil {
+		return *x.ToolName
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListToolCallsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListToolCallsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListToolCallsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolCalls     []*ToolCall            `protobuf:"bytes,1,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`                     // newest first
+	NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"` // unset on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToolCallsResponse) Reset() {
+	*x = ListToolCallsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToolCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToolCallsResponse) ProtoMessage() {}
+
+func (x *ListToolCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToolCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListToolCallsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListToolCallsResponse) GetToolCalls() []*ToolCall {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+func (x *ListToolCallsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type GetToolCallStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     *string                `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ToolName      *string                `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"` // defaults to 7 days ago
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolCallStatsRequest) Reset() {
+	*x = GetToolCallStatsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolCallStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolCallStatsRequest) ProtoMessage() {}
+
+func (x *GetToolCallStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolCallStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetToolCallStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetToolCallStatsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetToolCallStatsRequest) GetToolName() string {
+	if x != nil && x.ToolName != nil {
+		return *x.ToolName
+	}
+	return ""
+}
+
+func (x *GetToolCallStatsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type ToolErrorCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolErrorCount) Reset() {
+	*x = ToolErrorCount{}
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolErrorCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolErrorCount) ProtoMessage() {}
+
+func (x *ToolErrorCount) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolErrorCount.ProtoReflect.Descriptor instead.
+func (*ToolErrorCount) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ToolErrorCount) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ToolErrorCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ToolCallStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	TimedOut      int32                  `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Pending       int32                  `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	Cached        int32                  `protobuf:"varint,7,opt,name=cached,proto3" json:"cached,omitempty"`                               // included in succeeded
+	SuccessRate   float64                `protobuf:"fixed64,8,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"` // succeeded / (succeeded + failed + timed_out)
+	P50LatencyMs  int64                  `protobuf:"varint,9,opt,name=p50_latency_ms,json=p50LatencyMs,proto3" json:"p50_latency_ms,omitempty"`
+	P95LatencyMs  int64                  `protobuf:"varint,10,opt,name=p95_latency_ms,json=p95LatencyMs,proto3" json:"p95_latency_ms,omitempty"`
+	TopErrors     []*ToolErrorCount      `protobuf:"bytes,11,rep,name=top_errors,json=topErrors,proto3" json:"top_errors,omitempty"` // most frequent first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCallStats) Reset() {
+	*x = ToolCallStats{}
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCallStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallStats) ProtoMessage() {}
+
+func (x *ToolCallStats) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallStats.ProtoReflect.Descriptor instead.
+func (*ToolCallStats) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ToolCallStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCallStats) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetTimedOut() int32 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetCached() int32 {
+	if x != nil {
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetP50LatencyMs() int64 {
+	if x != nil {
+		return x.P50LatencyMs
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetP95LatencyMs() int64 {
+	if x != nil {
+		return x.P95LatencyMs
+	}
+	return 0
+}
+
+func (x *ToolCallStats) GetTopErrors() []*ToolErrorCount {
+	if x != nil {
+		return x.TopErrors
+	}
+	return nil
+}
+
+type GetToolCallStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tools         []*ToolCallStats       `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"` // lowest success rate first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToolCallStatsResponse) Reset() {
+	*x = GetToolCallStatsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToolCallStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolCallStatsResponse) ProtoMessage() {}
+
+func (x *GetToolCallStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolCallStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetToolCallStatsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetToolCallStatsResponse) GetTools() []*ToolCallStats {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+// DefaultPrompt is a built-in prompt of the catalog shown to every user.
+type DefaultPrompt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key             string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`          // stable identifier, does not change
+	Version         int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // incremented on every change
+	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Order           int32                  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"` // lower first
+	Title           string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	LocalizedTitles map[string]string      `protobuf:"bytes,8,rep,name=localized_titles,json=localizedTitles,proto3" json:"localized_titles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by language tag, e.g. "zh" or "zh-TW"
+	Content         string                 `protobuf:"bytes,9,opt,name=content,proto3" json:"content,omitempty"`
+	Deleted         bool                   `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DefaultPrompt) Reset() {
+	*x = DefaultPrompt{}
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultPrompt) ProtoMessage() {}
+
+func (x *DefaultPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultPrompt.ProtoReflect.Descriptor instead.
+func (*DefaultPrompt) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DefaultPrompt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DefaultPrompt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DefaultPrompt) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DefaultPrompt) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DefaultPrompt) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DefaultPrompt) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *DefaultPrompt) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DefaultPrompt) GetLocalizedTitles() map[string]string {
+	if x != nil {
+		return x.LocalizedTitles
+	}
+	return nil
+}
+
+func (x *DefaultPrompt) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DefaultPrompt) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DefaultPrompt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DefaultPrompt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListDefaultPromptsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDeleted bool                   `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDefaultPromptsRequest) Reset() {
+	*x = ListDefaultPromptsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDefaultPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDefaultPromptsRequest) ProtoMessage() {}
+
+func (x *ListDefaultPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDefaultPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListDefaultPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDefaultPromptsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type ListDefaultPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*DefaultPrompt       `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"` // by order, then title
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDefaultPromptsResponse) Reset() {
+	*x = ListDefaultPromptsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDefaultPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDefaultPromptsResponse) ProtoMessage() {}
+
+func (x *ListDefaultPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDefaultPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListDefaultPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDefaultPromptsResponse) GetPrompts() []*DefaultPrompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type CreateDefaultPromptRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Key             string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Tags            []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Order           int32                  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	LocalizedTitles map[string]string      `protobuf:"bytes,6,rep,name=localized_titles,json=localizedTitles,proto3" json:"localized_titles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Content         string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateDefaultPromptRequest) Reset() {
+	*x = CreateDefaultPromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDefaultPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDefaultPromptRequest) ProtoMessage() {}
+
+func (x *CreateDefaultPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDefaultPromptRequest.ProtoReflect.Descriptor instead.
+func (*CreateDefaultPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateDefaultPromptRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateDefaultPromptRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateDefaultPromptRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateDefaultPromptRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *CreateDefaultPromptRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateDefaultPromptRequest) GetLocalizedTitles() map[string]string {
+	if x != nil {
+		return x.LocalizedTitles
+	}
+	return nil
+}
+
+func (x *CreateDefaultPromptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateDefaultPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *DefaultPrompt         `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDefaultPromptResponse) Reset() {
+	*x = CreateDefaultPromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDefaultPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDefaultPromptResponse) ProtoMessage() {}
+
+func (x *CreateDefaultPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDefaultPromptResponse.ProtoReflect.Descriptor instead.
+func (*CreateDefaultPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateDefaultPromptResponse) GetPrompt() *DefaultPrompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type DefaultPromptTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultPromptTags) Reset() {
+	*x = DefaultPromptTags{}
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultPromptTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultPromptTags) ProtoMessage() {}
+
+func (x *DefaultPromptTags) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultPromptTags.ProtoReflect.Descriptor instead.
+func (*DefaultPromptTags) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DefaultPromptTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type LocalizedTitles struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Titles        map[string]string      `protobuf:"bytes,1,rep,name=titles,proto3" json:"titles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalizedTitles) Reset() {
+	*x = LocalizedTitles{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalizedTitles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedTitles) ProtoMessage() {}
+
+func (x *LocalizedTitles) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedTitles.ProtoReflect.Descriptor instead.
+func (*LocalizedTitles) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LocalizedTitles) GetTitles() map[string]string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+type UpdateDefaultPromptRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PromptId string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	// The version read by the admin, the update fails if the prompt changed since.
+	Version         int32              `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Category        *string            `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Tags            *DefaultPromptTags `protobuf:"bytes,4,opt,name=tags,proto3" json:"tags,omitempty"` // left unset, the tags are kept
+	Order           *int32             `protobuf:"varint,5,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Title           *string            `protobuf:"bytes,6,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	LocalizedTitles *LocalizedTitles   `protobuf:"bytes,7,opt,name=localized_titles,json=localizedTitles,proto3" json:"localized_titles,omitempty"` // left unset, the localized titles are kept
+	Content         *string            `protobuf:"bytes,8,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateDefaultPromptRequest) Reset() {
+	*x = UpdateDefaultPromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDefaultPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefaultPromptRequest) ProtoMessage() {}
+
+func (x *UpdateDefaultPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefaultPromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDefaultPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateDefaultPromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *UpdateDefaultPromptRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateDefaultPromptRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateDefaultPromptRequest) GetTags() *DefaultPromptTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateDefaultPromptRequest) GetOrder() int32 {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return 0
+}
+
+func (x *UpdateDefaultPromptRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateDefaultPromptRequest) GetLocalizedTitles() *LocalizedTitles {
+	if x != nil {
+		return x.LocalizedTitles
+	}
+	return nil
+}
+
+func (x *UpdateDefaultPromptRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+type UpdateDefaultPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *DefaultPrompt         `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDefaultPromptResponse) Reset() {
+	*x = UpdateDefaultPromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDefaultPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDefaultPromptResponse) ProtoMessage() {}
+
+func (x *UpdateDefaultPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDefaultPromptResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDefaultPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateDefaultPromptResponse) GetPrompt() *DefaultPrompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type DeleteDefaultPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDefaultPromptRequest) Reset() {
+	*x = DeleteDefaultPromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDefaultPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDefaultPromptRequest) ProtoMessage() {}
+
+func (x *DeleteDefaultPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDefaultPromptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDefaultPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteDefaultPromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type DeleteDefaultPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDefaultPromptResponse) Reset() {
+	*x = DeleteDefaultPromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDefaultPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDefaultPromptResponse) ProtoMessage() {}
+
+func (x *DeleteDefaultPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDefaultPromptResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDefaultPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+// SystemPrompt is the text/template of the system prompt of a persona, see the README for the
+// fields and the blocks it can use.
+type SystemPrompt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Persona        string                 `protobuf:"bytes,1,opt,name=persona,proto3" json:"persona,omitempty"` // e.g. "default", "debug" or "reviewer"
+	Source         SystemPromptSource     `protobuf:"varint,2,opt,name=source,proto3,enum=admin.v1.SystemPromptSource" json:"source,omitempty"`
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // the template in use
+	BuiltInContent string                 `protobuf:"bytes,4,opt,name=built_in_content,json=builtInContent,proto3" json:"built_in_content,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"` // of the override in the database
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SystemPrompt) Reset() {
+	*x = SystemPrompt{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPrompt) ProtoMessage() {}
+
+func (x *SystemPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPrompt.ProtoReflect.Descriptor instead.
+func (*SystemPrompt) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SystemPrompt) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *SystemPrompt) GetSource() SystemPromptSource {
+	if x != nil {
+		return x.Source
+	}
+	return SystemPromptSource_SYSTEM_PROMPT_SOURCE_UNSPECIFIED
+}
+
+func (x *SystemPrompt) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SystemPrompt) GetBuiltInContent() string {
+	if x != nil {
+		return x.BuiltInContent
+	}
+	return ""
+}
+
+func (x *SystemPrompt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSystemPromptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSystemPromptsRequest) Reset() {
+	*x = ListSystemPromptsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemPromptsRequest) ProtoMessage() {}
+
+func (x *ListSystemPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListSystemPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+type ListSystemPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemPrompts []*SystemPrompt        `protobuf:"bytes,1,rep,name=system_prompts,json=systemPrompts,proto3" json:"system_prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSystemPromptsResponse) Reset() {
+	*x = ListSystemPromptsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSystemPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemPromptsResponse) ProtoMessage() {}
+
+func (x *ListSystemPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListSystemPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSystemPromptsResponse) GetSystemPrompts() []*SystemPrompt {
+	if x != nil {
+		return x.SystemPrompts
+	}
+	return nil
+}
+
+type UpdateSystemPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Persona       string                 `protobuf:"bytes,1,opt,name=persona,proto3" json:"persona,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ValidateOnly  bool                   `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // checks the template and renders the preview without saving it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSystemPromptRequest) Reset() {
+	*x = UpdateSystemPromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSystemPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSystemPromptRequest) ProtoMessage() {}
+
+func (x *UpdateSystemPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSystemPromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSystemPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateSystemPromptRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *UpdateSystemPromptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateSystemPromptRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type UpdateSystemPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemPrompt  *SystemPrompt          `protobuf:"bytes,1,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	Preview       string                 `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"` // the template rendered with sample instructions and paper content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSystemPromptResponse) Reset() {
+	*x = UpdateSystemPromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSystemPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSystemPromptResponse) ProtoMessage() {}
+
+func (x *UpdateSystemPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSystemPromptResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSystemPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateSystemPromptResponse) GetSystemPrompt() *SystemPrompt {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return nil
+}
+
+func (x *UpdateSystemPromptResponse) GetPreview() string {
+	if x != nil {
+		return x.Preview
+	}
+	return ""
+}
+
+type ResetSystemPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Persona       string                 `protobuf:"bytes,1,opt,name=persona,proto3" json:"persona,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSystemPromptRequest) Reset() {
+	*x = ResetSystemPromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSystemPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSystemPromptRequest) ProtoMessage() {}
+
+func (x *ResetSystemPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSystemPromptRequest.ProtoReflect.Descriptor instead.
+func (*ResetSystemPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResetSystemPromptRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+type ResetSystemPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemPrompt  *SystemPrompt          `protobuf:"bytes,1,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSystemPromptResponse) Reset() {
+	*x = ResetSystemPromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSystemPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSystemPromptResponse) ProtoMessage() {}
+
+func (x *ResetSystemPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSystemPromptResponse.ProtoReflect.Descriptor instead.
+func (*ResetSystemPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResetSystemPromptResponse) GetSystemPrompt() *SystemPrompt {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return nil
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x14\n" +
+	"\x12ReloadToolsRequest\"C\n" +
+	"\x13ReloadToolsResponse\x12\x14\n" +
+	"\x05tools\x18\x01 \x03(\tR\x05tools\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\"\xd6\x03\n" +
+	"\bToolCall\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12'\n" +
+	"\x0fconversation_id\x18\x04 \x01(\tR\x0econversationId\x12 \n" +
+	"\ftool_call_id\x18\x05 \x01(\tR\n" +
+	"toolCallId\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\targuments\x18\b \x01(\tR\targuments\x12\x16\n" +
+	"\x06result\x18\t \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\"\n" +
+	"\n" +
+	"latency_ms\x18\v \x01(\x03H\x00R\tlatencyMs\x88\x01\x01\x12\x16\n" +
+	"\x06cached\x18\f \x01(\bR\x06cached\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_latency_ms\"\xac\x02\n" +
+	"\x14ListToolCallsRequest\x12,\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tH\x00R\x0econversationId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tH\x01R\tprojectId\x88\x01\x01\x12 \n" +
+	"\ttool_name\x18\x03 \x01(\tH\x02R\btoolName\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tH\x04R\tpageToken\x88\x01\x01B\x12\n" +
+	"\x10_conversation_idB\r\n" +
+	"\v_project_idB\f\n" +
+	"\n" +
+	"_tool_nameB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_page_token\"\x8b\x01\n" +
+	"\x15ListToolCallsResponse\x121\n" +
+	"\n" +
+	"tool_calls\x18\x01 \x03(\v2\x12.admin.v1.ToolCallR\ttoolCalls\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tH\x00R\rnextPageToken\x88\x01\x01B\x12\n" +
+	"\x10_next_page_token\"\xbd\x01\n" +
+	"\x17GetToolCallStatsRequest\x12\"\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12 \n" +
+	"\ttool_name\x18\x02 \x01(\tH\x01R\btoolName\x88\x01\x01\x125\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05since\x88\x01\x01B\r\n" +
+	"\v_project_idB\f\n" +
+	"\n" +
+	"_tool_nameB\b\n" +
+	"\x06_since\"<\n" +
+	"\x0eToolErrorCount\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xe6\x02\n" +
+	"\rToolCallStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\x05R\btimedOut\x12\x18\n" +
+	"\apending\x18\x06 \x01(\x05R\apending\x12\x16\n" +
+	"\x06cached\x18\a \x01(\x05R\x06cached\x12!\n" +
+	"\fsuccess_rate\x18\b \x01(\x01R\vsuccessRate\x12$\n" +
+	"\x0ep50_latency_ms\x18\t \x01(\x03R\fp50LatencyMs\x12$\n" +
+	"\x0ep95_latency_ms\x18\n" +
+	" \x01(\x03R\fp95LatencyMs\x127\n" +
+	"\n" +
+	"top_errors\x18\v \x03(\v2\x18.admin.v1.ToolErrorCountR\ttopErrors\"I\n" +
+	"\x18GetToolCallStatsResponse\x12-\n" +
+	"\x05tools\x18\x01 \x03(\v2\x17.admin.v1.ToolCallStatsR\x05tools\"\xee\x03\n" +
+	"\rDefaultPrompt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05order\x18\x06 \x01(\x05R\x05order\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12W\n" +
+	"\x10localized_titles\x18\b \x03(\v2,.admin.v1.DefaultPrompt.LocalizedTitlesEntryR\x0flocalizedTitles\x12\x18\n" +
+	"\acontent\x18\t \x01(\tR\acontent\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aB\n" +
+	"\x14LocalizedTitlesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x19ListDefaultPromptsRequest\x12'\n" +
+	"\x0finclude_deleted\x18\x01 \x01(\bR\x0eincludeDeleted\"O\n" +
+	"\x1aListDefaultPromptsResponse\x121\n" +
+	"\aprompts\x18\x01 \x03(\v2\x17.admin.v1.DefaultPromptR\aprompts\"\xce\x02\n" +
+	"\x1aCreateDefaultPromptRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\x05R\x05order\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12d\n" +
+	"\x10localized_titles\x18\x06 \x03(\v29.admin.v1.CreateDefaultPromptRequest.LocalizedTitlesEntryR\x0flocalizedTitles\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x1aB\n" +
+	"\x14LocalizedTitlesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x1bCreateDefaultPromptResponse\x12/\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x17.admin.v1.DefaultPromptR\x06prompt\"'\n" +
+	"\x11DefaultPromptTags\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"\x8b\x01\n" +
+	"\x0fLocalizedTitles\x12=\n" +
+	"\x06titles\x18\x01 \x03(\v2%.admin.v1.LocalizedTitles.TitlesEntryR\x06titles\x1a9\n" +
+	"\vTitlesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xed\x02\n" +
+	"\x1aUpdateDefaultPromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1f\n" +
+	"\bcategory\x18\x03 \x01(\tH\x00R\bcategory\x88\x01\x01\x12/\n" +
+	"\x04tags\x18\x04 \x01(\v2\x1b.admin.v1.DefaultPromptTagsR\x04tags\x12\x19\n" +
+	"\x05order\x18\x05 \x01(\x05H\x01R\x05order\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x06 \x01(\tH\x02R\x05title\x88\x01\x01\x12D\n" +
+	"\x10localized_titles\x18\a \x01(\v2\x19.admin.v1.LocalizedTitlesR\x0flocalizedTitles\x12\x1d\n" +
+	"\acontent\x18\b \x01(\tH\x03R\acontent\x88\x01\x01B\v\n" +
+	"\t_categoryB\b\n" +
+	"\x06_orderB\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_content\"N\n" +
+	"\x1bUpdateDefaultPromptResponse\x12/\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x17.admin.v1.DefaultPromptR\x06prompt\"9\n" +
+	"\x1aDeleteDefaultPromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"\x1d\n" +
+	"\x1bDeleteDefaultPromptResponse\"\xf1\x01\n" +
+	"\fSystemPrompt\x12\x18\n" +
+	"\apersona\x18\x01 \x01(\tR\apersona\x124\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x1c.admin.v1.SystemPromptSourceR\x06source\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12(\n" +
+	"\x10built_in_content\x18\x04 \x01(\tR\x0ebuiltInContent\x12>\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tupdatedAt\x88\x01\x01B\r\n" +
+	"\v_updated_at\"\x1a\n" +
+	"\x18ListSystemPromptsRequest\"Z\n" +
+	"\x19ListSystemPromptsResponse\x12=\n" +
+	"\x0esystem_prompts\x18\x01 \x03(\v2\x16.admin.v1.SystemPromptR\rsystemPrompts\"t\n" +
+	"\x19UpdateSystemPromptRequest\x12\x18\n" +
+	"\apersona\x18\x01 \x01(\tR\apersona\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\"s\n" +
+	"\x1aUpdateSystemPromptResponse\x12;\n" +
+	"\rsystem_prompt\x18\x01 \x01(\v2\x16.admin.v1.SystemPromptR\fsystemPrompt\x12\x18\n" +
+	"\apreview\x18\x02 \x01(\tR\apreview\"4\n" +
+	"\x18ResetSystemPromptRequest\x12\x18\n" +
+	"\apersona\x18\x01 \x01(\tR\apersona\"X\n" +
+	"\x19ResetSystemPromptResponse\x12;\n" +
+	"\rsystem_prompt\x18\x01 \x01(\v2\x16.admin.v1.SystemPromptR\fsystemPrompt*\xa1\x01\n" +
+	"\x12SystemPromptSource\x12$\n" +
+	" SYSTEM_PROMPT_SOURCE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSYSTEM_PROMPT_SOURCE_BUILT_IN\x10\x01\x12\x1f\n" +
+	"\x1bSYSTEM_PROMPT_SOURCE_CONFIG\x10\x02\x12!\n" +
+	"\x1dSYSTEM_PROMPT_SOURCE_DATABASE\x10\x032\x95\v\n" +
+	"\fAdminService\x12u\n" +
+	"\vReloadTools\x12\x1c.admin.v1.ReloadToolsRequest\x1a\x1d.admin.v1.ReloadToolsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/_pd/api/v1/admin/tools/reload\x12v\n" +
+	"\rListToolCalls\x12\x1e.admin.v1.ListToolCallsRequest\x1a\x1f.admin.v1.ListToolCallsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/_pd/api/v1/admin/tool-calls\x12\x85\x01\n" +
+	"\x10GetToolCallStats\x12!.admin.v1.GetToolCallStatsRequest\x1a\".admin.v1.GetToolCallStatsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/_pd/api/v1/admin/tool-calls/stats\x12\x8a\x01\n" +
+	"\x12ListDefaultPrompts\x12#.admin.v1.ListDefaultPromptsRequest\x1a$.admin.v1.ListDefaultPromptsResponse\")\x82\xd3\xe4\x93\x02#\x12!/_pd/api/v1/admin/default-prompts\x12\x90\x01\n" +
+	"\x13CreateDefaultPrompt\x12$.admin.v1.CreateDefaultPromptRequest\x1a%.admin.v1.CreateDefaultPromptResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/_pd/api/v1/admin/default-prompts\x12\x9c\x01\n" +
+	"\x13UpdateDefaultPrompt\x12$.admin.v1.UpdateDefaultPromptRequest\x1a%.admin.v1.UpdateDefaultPromptResponse\"8\x82\xd3\xe4\x93\x022:\x01*2-/_pd/api/v1/admin/default-prompts/{prompt_id}\x12\x99\x01\n" +
+	"\x13DeleteDefaultPrompt\x12$.admin.v1.DeleteDefaultPromptRequest\x1a%.admin.v1.DeleteDefaultPromptResponse\"5\x82\xd3\xe4\x93\x02/*-/_pd/api/v1/admin/default-prompts/{prompt_id}\x12\x86\x01\n" +
+	"\x11ListSystemPrompts\x12\".admin.v1.ListSystemPromptsRequest\x1a#.admin.v1.ListSystemPromptsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /_pd/api/v1/admin/system-prompts\x12\x96\x01\n" +
+	"\x12UpdateSystemPrompt\x12#.admin.v1.UpdateSystemPromptRequest\x1a$.admin.v1.UpdateSystemPromptResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/_pd/api/v1/admin/system-prompts/{persona}\x12\x90\x01\n" +
+	"\x11ResetSystemPrompt\x12\".admin.v1.ResetSystemPromptRequest\x1a#.admin.v1.ResetSystemPromptResponse\"2\x82\xd3\xe4\x93\x02,**/_pd/api/v1/admin/system-prompts/{persona}B\x87\x01\n" +
+	"\fcom.admin.v1B\n" +
+	"AdminProtoP\x01Z*paperdebugger/pkg/gen/api/admin/v1;adminv1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
+
+var (
+	file_admin_v1_admin_proto_rawDescOnce sync.Once
+	file_admin_v1_admin_proto_rawDescData []byte
+)
+
+func file_admin_v1_admin_proto_rawDescGZIP() []byte {
+	file_admin_v1_admin_proto_rawDescOnce.Do(func() {
+		file_admin_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)))
+	})
+	return file_admin_v1_admin_proto_rawDescData
+}
+
+var file_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_admin_v1_admin_proto_goTypes = []any{
+	(SystemPromptSource)(0),             // 0: admin.v1.SystemPromptSource
+	(*ReloadToolsRequest)(nil),          // 1: admin.v1.ReloadToolsRequest
+	(*ReloadToolsResponse)(nil),         // 2: admin.v1.ReloadToolsResponse
+	(*ToolCall)(nil),                    // 3: admin.v1.ToolCall
+	(*ListToolCallsRequest)(nil),        // 4: admin.v1.ListToolCallsRequest
+	(*ListToolCallsResponse)(nil),       // 5: admin.v1.ListToolCallsResponse
+	(*GetToolCallStatsRequest)(nil),     // 6: admin.v1.GetToolCallStatsRequest
+	(*ToolErrorCount)(nil),              // 7: admin.v1.ToolErrorCount
+	(*ToolCallStats)(nil),               // 8: admin.v1.ToolCallStats
+	(*GetToolCallStatsResponse)(nil),    // 9: admin.v1.GetToolCallStatsResponse
+	(*DefaultPrompt)(nil),               // 10: admin.v1.DefaultPrompt
+	(*ListDefaultPromptsRequest)(nil),   // 11: admin.v1.ListDefaultPromptsRequest
+	(*ListDefaultPromptsResponse)(nil),  // 12: admin.v1.ListDefaultPromptsResponse
+	(*CreateDefaultPromptRequest)(nil),  // 13: admin.v1.CreateDefaultPromptRequest
+	(*CreateDefaultPromptResponse)(nil), // 14: admin.v1.CreateDefaultPromptResponse
+	(*DefaultPromptTags)(nil),           // 15: admin.v1.DefaultPromptTags
+	(*LocalizedTitles)(nil),             // 16: admin.v1.LocalizedTitles
+	(*UpdateDefaultPromptRequest)(nil),  // 17: admin.v1.UpdateDefaultPromptRequest
+	(*UpdateDefaultPromptResponse)(nil), // 18: admin.v1.UpdateDefaultPromptResponse
+	(*DeleteDefaultPromptRequest)(nil),  // 19: admin.v1.DeleteDefaultPromptRequest
+	(*DeleteDefaultPromptResponse)(nil), // 20: admin.v1.DeleteDefaultPromptResponse
+	(*SystemPrompt)(nil),                // 21: admin.v1.SystemPrompt
+	(*ListSystemPromptsRequest)(nil),    // 22: admin.v1.ListSystemPromptsRequest
+	(*ListSystemPromptsResponse)(nil),   // 23: admin.v1.ListSystemPromptsResponse
+	(*UpdateSystemPromptRequest)(nil),   // 24: admin.v1.UpdateSystemPromptRequest
+	(*UpdateSystemPromptResponse)(nil),  // 25: admin.v1.UpdateSystemPromptResponse
+	(*ResetSystemPromptRequest)(nil),    // 26: admin.v1.ResetSystemPromptRequest
+	(*ResetSystemPromptResponse)(nil),   // 27: admin.v1.ResetSystemPromptResponse
+	nil,                                 // 28: admin.v1.DefaultPrompt.LocalizedTitlesEntry
+	nil,                                 // 29: admin.v1.CreateDefaultPromptRequest.LocalizedTitlesEntry
+	nil,                                 // 30: admin.v1.LocalizedTitles.TitlesEntry
+	(*timestamppb.Timestamp)(nil),       // 31: google.protobuf.Timestamp
+}
+var file_admin_v1_admin_proto_depIdxs = []int32{
+	31, // 0: admin.v1.ToolCall.created_at:type_name -> google.protobuf.Timestamp
+	31, // 1: admin.v1.ToolCall.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: admin.v1.ListToolCallsResponse.tool_calls:type_name -> admin.v1.ToolCall
+	31, // 3: admin.v1.GetToolCallStatsRequest.since:type_name -> google.protobuf.Timestamp
+	7,  // 4: admin.v1.ToolCallStats.top_errors:type_name -> admin.v1.ToolErrorCount
+	8,  // 5: admin.v1.GetToolCallStatsResponse.tools:type_name -> admin.v1.ToolCallStats
+	28, // 6: admin.v1.DefaultPrompt.localized_titles:type_name -> admin.v1.DefaultPrompt.LocalizedTitlesEntry
+	31, // 7: admin.v1.DefaultPrompt.created_at:type_name -> google.protobuf.Timestamp
+	31, // 8: admin.v1.DefaultPrompt.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 9: admin.v1.ListDefaultPromptsResponse.prompts:type_name -> admin.v1.DefaultPrompt
+	29, // 10: admin.v1.CreateDefaultPromptRequest.localized_titles:type_name -> admin.v1.CreateDefaultPromptRequest.LocalizedTitlesEntry
+	10, // 11: admin.v1.CreateDefaultPromptResponse.prompt:type_name -> admin.v1.DefaultPrompt
+	30, // 12: admin.v1.LocalizedTitles.titles:type_name -> admin.v1.LocalizedTitles.TitlesEntry
+	15, // 13: admin.v1.UpdateDefaultPromptRequest.tags:type_name -> admin.v1.DefaultPromptTags
+	16, // 14: admin.v1.UpdateDefaultPromptRequest.localized_titles:type_name -> admin.v1.LocalizedTitles
+	10, // 15: admin.v1.UpdateDefaultPromptResponse.prompt:type_name -> admin.v1.DefaultPrompt
+	0,  // 16: admin.v1.SystemPrompt.source:type_name -> admin.v1.SystemPromptSource
+	31, // 17: admin.v1.SystemPrompt.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 18: admin.v1.ListSystemPromptsResponse.system_prompts:type_name -> admin.v1.SystemPrompt
+	21, // 19: admin.v1.UpdateSystemPromptResponse.system_prompt:type_name -> admin.v1.SystemPrompt
+	21, // 20: admin.v1.ResetSystemPromptResponse.system_prompt:type_name -> admin.v1.SystemPrompt
+	1,  // 21: admin.v1.AdminService.ReloadTools:input_type -> admin.v1.ReloadToolsRequest
+	4,  // 22: admin.v1.AdminService.ListToolCalls:input_type -> admin.v1.ListToolCallsRequest
+	6,  // 23: admin.v1.AdminService.GetToolCallStats:input_type -> admin.v1.GetToolCallStatsRequest
+	11, // 24: admin.v1.AdminService.ListDefaultPrompts:input_type -> admin.v1.ListDefaultPromptsRequest
+	13, // 25: admin.v1.AdminService.CreateDefaultPrompt:input_type -> admin.v1.CreateDefaultPromptRequest
+	17, // 26: admin.v1.AdminService.UpdateDefaultPrompt:input_type -> admin.v1.UpdateDefaultPromptRequest
+	19, // 27: admin.v1.AdminService.DeleteDefaultPrompt:input_type -> admin.v1.DeleteDefaultPromptRequest
+	22, // 28: admin.v1.AdminService.ListSystemPrompts:input_type -> admin.v1.ListSystemPromptsRequest
+	24, // 29: admin.v1.AdminService.UpdateSystemPrompt:input_type -> admin.v1.UpdateSystemPromptRequest
+	26, // 30: admin.v1.AdminService.ResetSystemPrompt:input_type -> admin.v1.ResetSystemPromptRequest
+	2,  // 31: admin.v1.AdminService.ReloadTools:output_type -> admin.v1.ReloadToolsResponse
+	5,  // 32: admin.v1.AdminService.ListToolCalls:output_type -> admin.v1.ListToolCallsResponse
+	9,  // 33: admin.v1.AdminService.GetToolCallStats:output_type -> admin.v1.GetToolCallStatsResponse
+	12, // 34: admin.v1.AdminService.ListDefaultPrompts:output_type -> admin.v1.ListDefaultPromptsResponse
+	14, // 35: admin.v1.AdminService.CreateDefaultPrompt:output_type -> admin.v1.CreateDefaultPromptResponse
+	18, // 36: admin.v1.AdminService.UpdateDefaultPrompt:output_type -> admin.v1.UpdateDefaultPromptResponse
+	20, // 37: admin.v1.AdminService.DeleteDefaultPrompt:output_type -> admin.v1.DeleteDefaultPromptResponse
+	23, // 38: admin.v1.AdminService.ListSystemPrompts:output_type -> admin.v1.ListSystemPromptsResponse
+	25, // 39: admin.v1.AdminService.UpdateSystemPrompt:output_type -> admin.v1.UpdateSystemPromptResponse
+	27, // 40: admin.v1.AdminService.ResetSystemPrompt:output_type -> admin.v1.ResetSystemPromptResponse
+	31, // [31:41] is the sub-list for method output_type
+	21, // [21:31] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_admin_proto_init() }
+func file_admin_v1_admin_proto_init() {
+	if File_admin_v1_admin_proto != nil {
+		return
+	}
+	file_admin_v1_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[3].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[4].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[5].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[16].OneofWrappers = []any{}
+	file_admin_v1_admin_proto_msgTypes[20].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_admin_proto_goTypes,
+		DependencyIndexes: file_admin_v1_admin_proto_depIdxs,
+		EnumInfos:         file_admin_v1_admin_proto_enumTypes,
+		MessageInfos:      file_admin_v1_admin_proto_msgTypes,
+	}.Build()
+	File_admin_v1_admin_proto = out.File
+	file_admin_v1_admin_proto_goTypes = nil
+	file_admin_v1_admin_proto_depIdxs = nil
+}
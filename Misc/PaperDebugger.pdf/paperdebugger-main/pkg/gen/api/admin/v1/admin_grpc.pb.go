@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: admin/v1/admin.proto
+
+package adminv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_ReloadTools_FullMethodName         = "/admin.v1.AdminService/ReloadTools"
+	AdminService_ListToolCalls_FullMethodName       = "/admin.v1.AdminService/ListToolCalls"
+	AdminService_GetToolCallStats_FullMethodName    = "/admin.v1.AdminService/GetToolCallStats"
+	AdminService_ListDefaultPrompts_FullMethodName  = "/admin.v1.AdminService/ListDefaultPrompts"
+	AdminService_CreateDefaultPrompt_FullMethodName = "/admin.v1.AdminService/CreateDefaultPrompt"
+	AdminService_UpdateDefaultPrompt_FullMethodName = "/admin.v1.AdminService/UpdateDefaultPrompt"
+	AdminService_DeleteDefaultPrompt_FullMethodName = "/admin.v1.AdminService/DeleteDefaultPrompt"
+	AdminService_ListSystemPrompts_FullMethodName   = "/admin.v1.AdminService/ListSystemPrompts"
+	AdminService_UpdateSystemPrompt_FullMethodName  = "/admin.v1.AdminService/UpdateSystemPrompt"
+	AdminService_ResetSystemPrompt_FullMethodName   = "/admin.v1.AdminService/ResetSystemPrompt"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService is only available to the users listed in PD_ADMIN_EMAILS.
+type AdminServiceClient interface {
+	ReloadTools(ctx context.Context, in *ReloadToolsRequest, opts ...grpc.CallOption) (*ReloadToolsResponse, error)
+	ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error)
+	GetToolCallStats(ctx context.Context, in *GetToolCallStatsRequest, opts ...grpc.CallOption) (*GetToolCallStatsResponse, error)
+	ListDefaultPrompts(ctx context.Context, in *ListDefaultPromptsRequest, opts ...grpc.CallOption) (*ListDefaultPromptsResponse, error)
+	// Adds a built-in prompt, or brings back a deleted one with the same key.
+	CreateDefaultPrompt(ctx context.Context, in *CreateDefaultPromptRequest, opts ...grpc.CallOption) (*CreateDefaultPromptResponse, error)
+	UpdateDefaultPrompt(ctx context.Context, in *UpdateDefaultPromptRequest, opts ...grpc.CallOption) (*UpdateDefaultPromptResponse, error)
+	DeleteDefaultPrompt(ctx context.Context, in *DeleteDefaultPromptRequest, opts ...grpc.CallOption) (*DeleteDefaultPromptResponse, error)
+	// Lists the system prompts of the personas, with the template in use and the built-in one.
+	ListSystemPrompts(ctx context.Context, in *ListSystemPromptsRequest, opts ...grpc.CallOption) (*ListSystemPromptsResponse, error)
+	// Overrides the system prompt template of a persona, it applies to the new conversations.
+	UpdateSystemPrompt(ctx context.Context, in *UpdateSystemPromptRequest, opts ...grpc.CallOption) (*UpdateSystemPromptResponse, error)
+	// Removes the override, the persona gets back the template of the configuration or the built-in one.
+	ResetSystemPrompt(ctx context.Context, in *ResetSystemPromptRequest, opts ...grpc.CallOption) (*ResetSystemPromptResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ReloadTools(ctx context.Context, in *ReloadToolsRequest, opts ...grpc.CallOption) (*ReloadToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadToolsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReloadTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListToolCalls(ctx context.Context, in *ListToolCallsRequest, opts ...grpc.CallOption) (*ListToolCallsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListToolCallsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListToolCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetToolCallStats(ctx context.Context, in *GetToolCallStatsRequest, opts ...grpc.CallOption) (*GetToolCallStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetToolCallStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetToolCallStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDefaultPrompts(ctx context.Context, in *ListDefaultPromptsRequest, opts ...grpc.CallOption) (*ListDefaultPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDefaultPromptsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDefaultPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateDefaultPrompt(ctx context.Context, in *CreateDefaultPromptRequest, opts ...grpc.CallOption) (*CreateDefaultPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDefaultPromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateDefaultPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateDefaultPrompt(ctx context.Context, in *UpdateDefaultPromptRequest, opts ...grpc.CallOption) (*UpdateDefaultPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDefaultPromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateDefaultPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDefaultPrompt(ctx context.Context, in *DeleteDefaultPromptRequest, opts ...grpc.CallOption) (*DeleteDefaultPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDefaultPromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteDefaultPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSystemPrompts(ctx context.Context, in *ListSystemPromptsRequest, opts ...grpc.CallOption) (*ListSystemPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSystemPromptsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSystemPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSystemPrompt(ctx context.Context, in *UpdateSystemPromptRequest, opts ...grpc.CallOption) (*UpdateSystemPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSystemPromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateSystemPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetSystemPrompt(ctx context.Context, in *ResetSystemPromptRequest, opts ...grpc.CallOption) (*ResetSystemPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetSystemPromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResetSystemPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService is only available to the users listed in PD_ADMIN_EMAILS.
+type AdminServiceServer interface {
+	ReloadTools(context.Context, *ReloadToolsRequest) (*ReloadToolsResponse, error)
+	ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error)
+	GetToolCallStats(context.Context, *GetToolCallStatsRequest) (*GetToolCallStatsResponse, error)
+	ListDefaultPrompts(context.Context, *ListDefaultPromptsRequest) (*ListDefaultPromptsResponse, error)
+	// Adds a built-in prompt, or brings back a deleted one with the same key.
+	CreateDefaultPrompt(context.Context, *CreateDefaultPromptRequest) (*CreateDefaultPromptResponse, error)
+	UpdateDefaultPrompt(context.Context, *UpdateDefaultPromptRequest) (*UpdateDefaultPromptResponse, error)
+	DeleteDefaultPrompt(context.Context, *DeleteDefaultPromptRequest) (*DeleteDefaultPromptResponse, error)
+	// Lists the system prompts of the personas, with the template in use and the built-in one.
+	ListSystemPrompts(context.Context, *ListSystemPromptsRequest) (*ListSystemPromptsResponse, error)
+	// Overrides the system prompt template of a persona, it applies to the new conversations.
+	UpdateSystemPrompt(context.Context, *UpdateSystemPromptRequest) (*UpdateSystemPromptResponse, error)
+	// Removes the override, the persona gets back the template of the configuration or the built-in one.
+	ResetSystemPrompt(context.Context, *ResetSystemPromptRequest) (*ResetSystemPromptResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ReloadTools(context.Context, *ReloadToolsRequest) (*ReloadToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadTools not implemented")
+}
+func (UnimplementedAdminServiceServer) ListToolCalls(context.Context, *ListToolCallsRequest) (*ListToolCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListToolCalls not implemented")
+}
+func (UnimplementedAdminServiceServer) GetToolCallStats(context.Context, *GetToolCallStatsRequest) (*GetToolCallStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToolCallStats not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDefaultPrompts(context.Context, *ListDefaultPromptsRequest) (*ListDefaultPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDefaultPrompts not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateDefaultPrompt(context.Context, *CreateDefaultPromptRequest) (*CreateDefaultPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultPrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateDefaultPrompt(context.Context, *UpdateDefaultPromptRequest) (*UpdateDefaultPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDefaultPrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteDefaultPrompt(context.Context, *DeleteDefaultPromptRequest) (*DeleteDefaultPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDefaultPrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSystemPrompts(context.Context, *ListSystemPromptsRequest) (*ListSystemPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSystemPrompts not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateSystemPrompt(context.Context, *UpdateSystemPromptRequest) (*UpdateSystemPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSystemPrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) ResetSystemPrompt(context.Context, *ResetSystemPromptRequest) (*ResetSystemPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSystemPrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ReloadTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReloadTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadTools(ctx, req.(*ReloadToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListToolCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToolCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListToolCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListToolCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListToolCalls(ctx, req.(*ListToolCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetToolCallStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToolCallStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetToolCallStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetToolCallStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetToolCallStats(ctx, req.(*GetToolCallStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDefaultPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDefaultPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDefaultPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDefaultPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDefaultPrompts(ctx, req.(*ListDefaultPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateDefaultPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDefaultPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateDefaultPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateDefaultPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateDefaultPrompt(ctx, req.(*CreateDefaultPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDefaultPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDefaultPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDefaultPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateDefaultPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDefaultPrompt(ctx, req.(*UpdateDefaultPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDefaultPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDefaultPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDefaultPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteDefaultPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDefaultPrompt(ctx, req.(*DeleteDefaultPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSystemPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSystemPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSystemPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSystemPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSystemPrompts(ctx, req.(*ListSystemPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSystemPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSystemPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSystemPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateSystemPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSystemPrompt(ctx, req.(*UpdateSystemPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetSystemPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSystemPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetSystemPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResetSystemPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetSystemPrompt(ctx, req.(*ResetSystemPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReloadTools",
+			Handler:    _AdminService_ReloadTools_Handler,
+		},
+		{
+			MethodName: "ListToolCalls",
+			Handler:    _AdminService_ListToolCalls_Handler,
+		},
+		{
+			MethodName: "GetToolCallStats",
+			Handler:    _AdminService_GetToolCallStats_Handler,
+		},
+		{
+			MethodName: "ListDefaultPrompts",
+			Handler:    _AdminService_ListDefaultPrompts_Handler,
+		},
+		{
+			MethodName: "CreateDefaultPrompt",
+			Handler:    _AdminService_CreateDefaultPrompt_Handler,
+		},
+		{
+			MethodName: "UpdateDefaultPrompt",
+			Handler:    _AdminService_UpdateDefaultPrompt_Handler,
+		},
+		{
+			MethodName: "DeleteDefaultPrompt",
+			Handler:    _AdminService_DeleteDefaultPrompt_Handler,
+		},
+		{
+			MethodName: "ListSystemPrompts",
+			Handler:    _AdminService_ListSystemPrompts_Handler,
+		},
+		{
+			MethodName: "UpdateSystemPrompt",
+			Handler:    _AdminService_UpdateSystemPrompt_Handler,
+		},
+		{
+			MethodName: "ResetSystemPrompt",
+			Handler:    _AdminService_ResetSystemPrompt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/admin.proto",
+}
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -83,11 +84,178 @@ func (LanguageModel) EnumDescriptor() ([]byte, []int) {
 	return file_chat_v1_chat_proto_rawDescGZIP(), []int{0}
 }
 
+type TextEditStatus int32
+
+const (
+	TextEditStatus_TEXT_EDIT_STATUS_UNSPECIFIED TextEditStatus = 0 // proposed, not applied yet
+	TextEditStatus_TEXT_EDIT_STATUS_ACCEPTED    TextEditStatus = 1
+	TextEditStatus_TEXT_EDIT_STATUS_REJECTED    TextEditStatus = 2
+)
+
+// Enum value maps for TextEditStatus.
+var (
+	TextEditStatus_name = map[int32]string{
+		0: "TEXT_EDIT_STATUS_UNSPECIFIED",
+		1: "TEXT_EDIT_STATUS_ACCEPTED",
+		2: "TEXT_EDIT_STATUS_REJECTED",
+	}
+	TextEditStatus_value = map[string]int32{
+		"TEXT_EDIT_STATUS_UNSPECIFIED": 0,
+		"TEXT_EDIT_STATUS_ACCEPTED":    1,
+		"TEXT_EDIT_STATUS_REJECTED":    2,
+	}
+)
+
+func (x TextEditStatus) Enum() *TextEditStatus {
+	p := new(TextEditStatus)
+	*p = x
+	return p
+}
+
+func (x TextEditStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextEditStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_v1_chat_proto_enumTypes[1].Descriptor()
+}
+
+func (TextEditStatus) Type() protoreflect.EnumType {
+	return &file_chat_v1_chat_proto_enumTypes[1]
+}
+
+func (x TextEditStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextEditStatus.Descriptor instead.
+func (TextEditStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{1}
+}
+
+type ConversationExportFormat int32
+
+const (
+	ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_UNSPECIFIED ConversationExportFormat = 0 // same as JSON
+	// Both chat histories and all branches, the format ImportConversation accepts.
+	ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_JSON ConversationExportFormat = 1
+	// The shown branch, tool calls are collapsible blocks.
+	ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_MARKDOWN ConversationExportFormat = 2
+	// The model and the input items of the OpenAI Responses API.
+	ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_OPENAI ConversationExportFormat = 3
+)
+
+// Enum value maps for ConversationExportFormat.
+var (
+	ConversationExportFormat_name = map[int32]string{
+		0: "CONVERSATION_EXPORT_FORMAT_UNSPECIFIED",
+		1: "CONVERSATION_EXPORT_FORMAT_JSON",
+		2: "CONVERSATION_EXPORT_FORMAT_MARKDOWN",
+		3: "CONVERSATION_EXPORT_FORMAT_OPENAI",
+	}
+	ConversationExportFormat_value = map[string]int32{
+		"CONVERSATION_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"CONVERSATION_EXPORT_FORMAT_JSON":        1,
+		"CONVERSATION_EXPORT_FORMAT_MARKDOWN":    2,
+		"CONVERSATION_EXPORT_FORMAT_OPENAI":      3,
+	}
+)
+
+func (x ConversationExportFormat) Enum() *ConversationExportFormat {
+	p := new(ConversationExportFormat)
+	*p = x
+	return p
+}
+
+func (x ConversationExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConversationExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_v1_chat_proto_enumTypes[2].Descriptor()
+}
+
+func (ConversationExportFormat) Type() protoreflect.EnumType {
+	return &file_chat_v1_chat_proto_enumTypes[2]
+}
+
+func (x ConversationExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConversationExportFormat.Descriptor instead.
+func (ConversationExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{2}
+}
+
+type ToolJobStatus int32
+
+const (
+	ToolJobStatus_TOOL_JOB_STATUS_UNSPECIFIED ToolJobStatus = 0
+	ToolJobStatus_TOOL_JOB_STATUS_QUEUED      ToolJobStatus = 1
+	ToolJobStatus_TOOL_JOB_STATUS_RUNNING     ToolJobStatus = 2
+	ToolJobStatus_TOOL_JOB_STATUS_SUCCEEDED   ToolJobStatus = 3
+	ToolJobStatus_TOOL_JOB_STATUS_FAILED      ToolJobStatus = 4
+)
+
+// Enum value maps for ToolJobStatus.
+var (
+	ToolJobStatus_name = map[int32]string{
+		0: "TOOL_JOB_STATUS_UNSPECIFIED",
+		1: "TOOL_JOB_STATUS_QUEUED",
+		2: "TOOL_JOB_STATUS_RUNNING",
+		3: "TOOL_JOB_STATUS_SUCCEEDED",
+		4: "TOOL_JOB_STATUS_FAILED",
+	}
+	ToolJobStatus_value = map[string]int32{
+		"TOOL_JOB_STATUS_UNSPECIFIED": 0,
+		"TOOL_JOB_STATUS_QUEUED":      1,
+		"TOOL_JOB_STATUS_RUNNING":     2,
+		"TOOL_JOB_STATUS_SUCCEEDED":   3,
+		"TOOL_JOB_STATUS_FAILED":      4,
+	}
+)
+
+func (x ToolJobStatus) Enum() *ToolJobStatus {
+	p := new(ToolJobStatus)
+	*p = x
+	return p
+}
+
+func (x ToolJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToolJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_v1_chat_proto_enumTypes[3].Descriptor()
+}
+
+func (ToolJobStatus) Type() protoreflect.EnumType {
+	return &file_chat_v1_chat_proto_enumTypes[3]
+}
+
+func (x ToolJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToolJobStatus.Descriptor instead.
+func (ToolJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{3}
+}
+
 type ConversationType int32
 
 const (
 	ConversationType_CONVERSATION_TYPE_UNSPECIFIED ConversationType = 0
 	ConversationType_CONVERSATION_TYPE_DEBUG       ConversationType = 1 // does not contain any customized messages, the inapp_history and openai_history are synced.
+	// CONVERSATION_TYPE_NO_SYSTEM_MESSAGE_INJECTION = 2;
+	// CONVERSATION_TYPE_NO_USER_MESSAGE_INJECTION = 3;
+	// The personas, each one has its own system prompt. They apply when the conversation is
+	// created, a new conversation left unspecified gets the default persona of the project.
+	ConversationType_CONVERSATION_TYPE_REVIEWER        ConversationType = 4
+	ConversationType_CONVERSATION_TYPE_TRANSLATOR      ConversationType = 5
+	ConversationType_CONVERSATION_TYPE_PROOFREADER     ConversationType = 6
+	ConversationType_CONVERSATION_TYPE_REBUTTAL_WRITER ConversationType = 7
 )
 
 // Enum value maps for ConversationType.
@@ -95,10 +263,18 @@ var (
 	ConversationType_name = map[int32]string{
 		0: "CONVERSATION_TYPE_UNSPECIFIED",
 		1: "CONVERSATION_TYPE_DEBUG",
+		4: "CONVERSATION_TYPE_REVIEWER",
+		5: "CONVERSATION_TYPE_TRANSLATOR",
+		6: "CONVERSATION_TYPE_PROOFREADER",
+		7: "CONVERSATION_TYPE_REBUTTAL_WRITER",
 	}
 	ConversationType_value = map[string]int32{
-		"CONVERSATION_TYPE_UNSPECIFIED": 0,
-		"CONVERSATION_TYPE_DEBUG":       1,
+		"CONVERSATION_TYPE_UNSPECIFIED":     0,
+		"CONVERSATION_TYPE_DEBUG":           1,
+		"CONVERSATION_TYPE_REVIEWER":        4,
+		"CONVERSATION_TYPE_TRANSLATOR":      5,
+		"CONVERSATION_TYPE_PROOFREADER":     6,
+		"CONVERSATION_TYPE_REBUTTAL_WRITER": 7,
 	}
 )
 
@@ -113,11 +289,11 @@ func (x ConversationType) String() string {
 }
 
 func (ConversationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_chat_v1_chat_proto_enumTypes[1].Descriptor()
+	return file_chat_v1_chat_proto_enumTypes[4].Descriptor()
 }
 
 func (ConversationType) Type() protoreflect.EnumType {
-	return &file_chat_v1_chat_proto_enumTypes[1]
+	return &file_chat_v1_chat_proto_enumTypes[4]
 }
 
 func (x ConversationType) Number() protoreflect.EnumNumber {
@@ -126,7 +302,56 @@ func (x ConversationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConversationType.Descriptor instead.
 func (ConversationType) EnumDescriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{1}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{4}
+}
+
+// How the assistant replies to a message.
+type ResponseMode int32
+
+const (
+	ResponseMode_RESPONSE_MODE_UNSPECIFIED ResponseMode = 0 // free text
+	// The assistant replies with structured output, a revision of the user selected text,
+	// streamed as a MessageTypeRevision instead of message chunks.
+	ResponseMode_RESPONSE_MODE_REVISION ResponseMode = 1
+)
+
+// Enum value maps for ResponseMode.
+var (
+	ResponseMode_name = map[int32]string{
+		0: "RESPONSE_MODE_UNSPECIFIED",
+		1: "RESPONSE_MODE_REVISION",
+	}
+	ResponseMode_value = map[string]int32{
+		"RESPONSE_MODE_UNSPECIFIED": 0,
+		"RESPONSE_MODE_REVISION":    1,
+	}
+)
+
+func (x ResponseMode) Enum() *ResponseMode {
+	p := new(ResponseMode)
+	*p = x
+	return p
+}
+
+func (x ResponseMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_v1_chat_proto_enumTypes[5].Descriptor()
+}
+
+func (ResponseMode) Type() protoreflect.EnumType {
+	return &file_chat_v1_chat_proto_enumTypes[5]
+}
+
+func (x ResponseMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseMode.Descriptor instead.
+func (ResponseMode) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{5}
 }
 
 type MessageTypeToolCall struct {
@@ -249,6 +474,68 @@ func (x *MessageTypeToolCallPrepareArguments) GetArgs() string {
 	return ""
 }
 
+// The tool sends data outside of PaperDebugger, it only runs after the user
+// approved it with ApproveToolCall.
+type MessageTypeToolCallApprovalRequired struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToolCallId    string                 `protobuf:"bytes,1,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Args          string                 `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"` // Json string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageTypeToolCallApprovalRequired) Reset() {
+	*x = MessageTypeToolCallApprovalRequired{}
+	mi := &file_chat_v1_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageTypeToolCallApprovalRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTypeToolCallApprovalRequired) ProtoMessage() {}
+
+func (x *MessageTypeToolCallApprovalRequired) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTypeToolCallApprovalRequired.ProtoReflect.Descriptor instead.
+func (*MessageTypeToolCallApprovalRequired) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageTypeToolCallApprovalRequired) GetToolCallId() string {
+	if x != nil {
+		return x.ToolCallId
+	}
+	return ""
+}
+
+func (x *MessageTypeToolCallApprovalRequired) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MessageTypeToolCallApprovalRequired) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
 type MessageTypeSystem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
@@ -258,7 +545,7 @@ type MessageTypeSystem struct {
 
 func (x *MessageTypeSystem) Reset() {
 	*x = MessageTypeSystem{}
-	mi := &file_chat_v1_chat_proto_msgTypes[2]
+	mi := &file_chat_v1_chat_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +557,7 @@ func (x *MessageTypeSystem) String() string {
 func (*MessageTypeSystem) ProtoMessage() {}
 
 func (x *MessageTypeSystem) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[2]
+	mi := &file_chat_v1_chat_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +570,7 @@ func (x *MessageTypeSystem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageTypeSystem.ProtoReflect.Descriptor instead.
 func (*MessageTypeSystem) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{2}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MessageTypeSystem) GetContent() string {
@@ -302,7 +589,7 @@ type MessageTypeAssistant struct {
 
 func (x *MessageTypeAssistant) Reset() {
 	*x = MessageTypeAssistant{}
-	mi := &file_chat_v1_chat_proto_msgTypes[3]
+	mi := &file_chat_v1_chat_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +601,7 @@ func (x *MessageTypeAssistant) String() string {
 func (*MessageTypeAssistant) ProtoMessage() {}
 
 func (x *MessageTypeAssistant) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[3]
+	mi := &file_chat_v1_chat_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +614,7 @@ func (x *MessageTypeAssistant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageTypeAssistant.ProtoReflect.Descriptor instead.
 func (*MessageTypeAssistant) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{3}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MessageTypeAssistant) GetContent() string {
@@ -341,13 +628,14 @@ type MessageTypeUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	SelectedText  *string                `protobuf:"bytes,2,opt,name=selected_text,json=selectedText,proto3,oneof" json:"selected_text,omitempty"`
+	PromptId      *string                `protobuf:"bytes,3,opt,name=prompt_id,json=promptId,proto3,oneof" json:"prompt_id,omitempty"` // the prompt of the library the message was written from
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageTypeUser) Reset() {
 	*x = MessageTypeUser{}
-	mi := &file_chat_v1_chat_proto_msgTypes[4]
+	mi := &file_chat_v1_chat_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +647,7 @@ func (x *MessageTypeUser) String() string {
 func (*MessageTypeUser) ProtoMessage() {}
 
 func (x *MessageTypeUser) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[4]
+	mi := &file_chat_v1_chat_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +660,7 @@ func (x *MessageTypeUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageTypeUser.ProtoReflect.Descriptor instead.
 func (*MessageTypeUser) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{4}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MessageTypeUser) GetContent() string {
@@ -389,28 +677,38 @@ func (x *MessageTypeUser) GetSelectedText() string {
 	return ""
 }
 
-type MessageTypeUnknown struct {
+func (x *MessageTypeUser) GetPromptId() string {
+	if x != nil && x.PromptId != nil {
+		return *x.PromptId
+	}
+	return ""
+}
+
+// A revision of the text selected by the user, replied in RESPONSE_MODE_REVISION.
+type MessageTypeRevision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Original      string                 `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Revised       string                 `protobuf:"bytes,2,opt,name=revised,proto3" json:"revised,omitempty"`
+	Rationale     string                 `protobuf:"bytes,3,opt,name=rationale,proto3" json:"rationale,omitempty"` // Why the text was revised this way
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MessageTypeUnknown) Reset() {
-	*x = MessageTypeUnknown{}
-	mi := &file_chat_v1_chat_proto_msgTypes[5]
+func (x *MessageTypeRevision) Reset() {
+	*x = MessageTypeRevision{}
+	mi := &file_chat_v1_chat_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessageTypeUnknown) String() string {
+func (x *MessageTypeRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageTypeUnknown) ProtoMessage() {}
+func (*MessageTypeRevision) ProtoMessage() {}
 
-func (x *MessageTypeUnknown) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[5]
+func (x *MessageTypeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,48 +719,63 @@ func (x *MessageTypeUnknown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageTypeUnknown.ProtoReflect.Descriptor instead.
-func (*MessageTypeUnknown) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MessageTypeRevision.ProtoReflect.Descriptor instead.
+func (*MessageTypeRevision) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MessageTypeUnknown) GetDescription() string {
+func (x *MessageTypeRevision) GetOriginal() string {
 	if x != nil {
-		return x.Description
+		return x.Original
 	}
 	return ""
 }
 
-type MessagePayload struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to MessageType:
-	//
-	//	*MessagePayload_System
-	//	*MessagePayload_User
-	//	*MessagePayload_Assistant
-	//	*MessagePayload_ToolCallPrepareArguments
-	//	*MessagePayload_ToolCall
-	//	*MessagePayload_Unknown
-	MessageType   isMessagePayload_MessageType `protobuf_oneof:"message_type"`
+func (x *MessageTypeRevision) GetRevised() string {
+	if x != nil {
+		return x.Revised
+	}
+	return ""
+}
+
+func (x *MessageTypeRevision) GetRationale() string {
+	if x != nil {
+		return x.Rationale
+	}
+	return ""
+}
+
+// A change of a project document. The offsets count the UTF-16 code units of the document
+// content (its lines joined with "\n") at doc_version, like the JavaScript string indices.
+type TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EditId        string                 `protobuf:"bytes,1,opt,name=edit_id,json=editId,proto3" json:"edit_id,omitempty"`
+	DocId         string                 `protobuf:"bytes,2,opt,name=doc_id,json=docId,proto3" json:"doc_id,omitempty"`
+	DocVersion    int32                  `protobuf:"varint,3,opt,name=doc_version,json=docVersion,proto3" json:"doc_version,omitempty"`
+	StartOffset   int32                  `protobuf:"varint,4,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset     int32                  `protobuf:"varint,5,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"` // exclusive
+	Replacement   string                 `protobuf:"bytes,6,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	Original      string                 `protobuf:"bytes,7,opt,name=original,proto3" json:"original,omitempty"` // the text between start_offset and end_offset
+	Status        TextEditStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=chat.v1.TextEditStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MessagePayload) Reset() {
-	*x = MessagePayload{}
-	mi := &file_chat_v1_chat_proto_msgTypes[6]
+func (x *TextEdit) Reset() {
+	*x = TextEdit{}
+	mi := &file_chat_v1_chat_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessagePayload) String() string {
+func (x *TextEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagePayload) ProtoMessage() {}
+func (*TextEdit) ProtoMessage() {}
 
-func (x *MessagePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[6]
+func (x *TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,57 +786,259 @@ func (x *MessagePayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagePayload.ProtoReflect.Descriptor instead.
-func (*MessagePayload) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TextEdit.ProtoReflect.Descriptor instead.
+func (*TextEdit) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MessagePayload) GetMessageType() isMessagePayload_MessageType {
+func (x *TextEdit) GetEditId() string {
 	if x != nil {
-		return x.MessageType
+		return x.EditId
 	}
-	return nil
+	return ""
 }
 
-func (x *MessagePayload) GetSystem() *MessageTypeSystem {
+func (x *TextEdit) GetDocId() string {
 	if x != nil {
-		if x, ok := x.MessageType.(*MessagePayload_System); ok {
-			return x.System
-		}
+		return x.DocId
 	}
-	return nil
+	return ""
 }
 
-func (x *MessagePayload) GetUser() *MessageTypeUser {
+func (x *TextEdit) GetDocVersion() int32 {
 	if x != nil {
-		if x, ok := x.MessageType.(*MessagePayload_User); ok {
-			return x.User
-		}
+		return x.DocVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *MessagePayload) GetAssistant() *MessageTypeAssistant {
+func (x *TextEdit) GetStartOffset() int32 {
 	if x != nil {
-		if x, ok := x.MessageType.(*MessagePayload_Assistant); ok {
-			return x.Assistant
-		}
+		return x.StartOffset
 	}
-	return nil
+	return 0
 }
 
-func (x *MessagePayload) GetToolCallPrepareArguments() *MessageTypeToolCallPrepareArguments {
+func (x *TextEdit) GetEndOffset() int32 {
 	if x != nil {
-		if x, ok := x.MessageType.(*MessagePayload_ToolCallPrepareArguments); ok {
-			return x.ToolCallPrepareArguments
-		}
+		return x.EndOffset
 	}
-	return nil
+	return 0
 }
 
-func (x *MessagePayload) GetToolCall() *MessageTypeToolCall {
+func (x *TextEdit) GetReplacement() string {
 	if x != nil {
-		if x, ok := x.MessageType.(*MessagePayload_ToolCall); ok {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *TextEdit) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *TextEdit) GetStatus() TextEditStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TextEditStatus_TEXT_EDIT_STATUS_UNSPECIFIED
+}
+
+// The edits of a project document turning the selected text into its revision,
+// sent after the MessageTypeRevision when the selected text was found in the project.
+type MessageTypeTextEdits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocPath       string                 `protobuf:"bytes,1,opt,name=doc_path,json=docPath,proto3" json:"doc_path,omitempty"`
+	Edits         []*TextEdit            `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageTypeTextEdits) Reset() {
+	*x = MessageTypeTextEdits{}
+	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageTypeTextEdits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTypeTextEdits) ProtoMessage() {}
+
+func (x *MessageTypeTextEdits) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTypeTextEdits.ProtoReflect.Descriptor instead.
+func (*MessageTypeTextEdits) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MessageTypeTextEdits) GetDocPath() string {
+	if x != nil {
+		return x.DocPath
+	}
+	return ""
+}
+
+func (x *MessageTypeTextEdits) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type MessageTypeUnknown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageTypeUnknown) Reset() {
+	*x = MessageTypeUnknown{}
+	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageTypeUnknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTypeUnknown) ProtoMessage() {}
+
+func (x *MessageTypeUnknown) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTypeUnknown.ProtoReflect.Descriptor instead.
+func (*MessageTypeUnknown) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MessageTypeUnknown) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type MessagePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to MessageType:
+	//
+	//	*MessagePayload_System
+	//	*MessagePayload_User
+	//	*MessagePayload_Assistant
+	//	*MessagePayload_ToolCallPrepareArguments
+	//	*MessagePayload_ToolCall
+	//	*MessagePayload_Unknown
+	//	*MessagePayload_ToolCallApprovalRequired
+	//	*MessagePayload_Revision
+	//	*MessagePayload_TextEdits
+	MessageType   isMessagePayload_MessageType `protobuf_oneof:"message_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagePayload) Reset() {
+	*x = MessagePayload{}
+	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePayload) ProtoMessage() {}
+
+func (x *MessagePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePayload.ProtoReflect.Descriptor instead.
+func (*MessagePayload) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MessagePayload) GetMessageType() isMessagePayload_MessageType {
+	if x != nil {
+		return x.MessageType
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetSystem() *MessageTypeSystem {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_System); ok {
+			return x.System
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetUser() *MessageTypeUser {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetAssistant() *MessageTypeAssistant {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_Assistant); ok {
+			return x.Assistant
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetToolCallPrepareArguments() *MessageTypeToolCallPrepareArguments {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_ToolCallPrepareArguments); ok {
+			return x.ToolCallPrepareArguments
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetToolCall() *MessageTypeToolCall {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_ToolCall); ok {
 			return x.ToolCall
 		}
 	}
@@ -539,6 +1054,33 @@ func (x *MessagePayload) GetUnknown() *MessageTypeUnknown {
 	return nil
 }
 
+func (x *MessagePayload) GetToolCallApprovalRequired() *MessageTypeToolCallApprovalRequired {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_ToolCallApprovalRequired); ok {
+			return x.ToolCallApprovalRequired
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetRevision() *MessageTypeRevision {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_Revision); ok {
+			return x.Revision
+		}
+	}
+	return nil
+}
+
+func (x *MessagePayload) GetTextEdits() *MessageTypeTextEdits {
+	if x != nil {
+		if x, ok := x.MessageType.(*MessagePayload_TextEdits); ok {
+			return x.TextEdits
+		}
+	}
+	return nil
+}
+
 type isMessagePayload_MessageType interface {
 	isMessagePayload_MessageType()
 }
@@ -567,6 +1109,18 @@ type MessagePayload_Unknown struct {
 	Unknown *MessageTypeUnknown `protobuf:"bytes,6,opt,name=unknown,proto3,oneof"`
 }
 
+type MessagePayload_ToolCallApprovalRequired struct {
+	ToolCallApprovalRequired *MessageTypeToolCallApprovalRequired `protobuf:"bytes,7,opt,name=tool_call_approval_required,json=toolCallApprovalRequired,proto3,oneof"`
+}
+
+type MessagePayload_Revision struct {
+	Revision *MessageTypeRevision `protobuf:"bytes,8,opt,name=revision,proto3,oneof"`
+}
+
+type MessagePayload_TextEdits struct {
+	TextEdits *MessageTypeTextEdits `protobuf:"bytes,9,opt,name=text_edits,json=textEdits,proto3,oneof"`
+}
+
 func (*MessagePayload_System) isMessagePayload_MessageType() {}
 
 func (*MessagePayload_User) isMessagePayload_MessageType() {}
@@ -579,17 +1133,26 @@ func (*MessagePayload_ToolCall) isMessagePayload_MessageType() {}
 
 func (*MessagePayload_Unknown) isMessagePayload_MessageType() {}
 
+func (*MessagePayload_ToolCallApprovalRequired) isMessagePayload_MessageType() {}
+
+func (*MessagePayload_Revision) isMessagePayload_MessageType() {}
+
+func (*MessagePayload_TextEdits) isMessagePayload_MessageType() {}
+
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Payload       *MessagePayload        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MessageId string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Payload   *MessagePayload        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Only set on user messages: the versions of the message, i.e. the user messages that follow the
+	// same message in the conversation tree, oldest first. It includes this message.
+	SiblingIds    []string `protobuf:"bytes,4,rep,name=sibling_ids,json=siblingIds,proto3" json:"sibling_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	mi := &file_chat_v1_chat_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +1164,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[7]
+	mi := &file_chat_v1_chat_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +1177,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{7}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Message) GetMessageId() string {
@@ -631,20 +1194,29 @@ func (x *Message) GetPayload() *MessagePayload {
 	return nil
 }
 
+func (x *Message) GetSiblingIds() []string {
+	if x != nil {
+		return x.SiblingIds
+	}
+	return nil
+}
+
 type Conversation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	LanguageModel LanguageModel          `protobuf:"varint,2,opt,name=language_model,json=languageModel,proto3,enum=chat.v1.LanguageModel" json:"language_model,omitempty"`
 	// If list conversations, then messages length is 0.
-	Messages      []*Message `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Messages         []*Message       `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	Shared           bool             `protobuf:"varint,5,opt,name=shared,proto3" json:"shared,omitempty"`                                                                           // the members of the project can read it
+	ConversationType ConversationType `protobuf:"varint,6,opt,name=conversation_type,json=conversationType,proto3,enum=chat.v1.ConversationType" json:"conversation_type,omitempty"` // the persona of the system prompt, chosen when the conversation was created
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Conversation) Reset() {
 	*x = Conversation{}
-	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	mi := &file_chat_v1_chat_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +1228,7 @@ func (x *Conversation) String() string {
 func (*Conversation) ProtoMessage() {}
 
 func (x *Conversation) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[8]
+	mi := &file_chat_v1_chat_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1241,7 @@ func (x *Conversation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
 func (*Conversation) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{8}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Conversation) GetId() string {
@@ -700,16 +1272,32 @@ func (x *Conversation) GetMessages() []*Message {
 	return nil
 }
 
+func (x *Conversation) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *Conversation) GetConversationType() ConversationType {
+	if x != nil {
+		return x.ConversationType
+	}
+	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
+
 type ListConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     *string                `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	PageToken     *string                `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"` // next_page_token of the previous page
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // defaults to 50, at most 200
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListConversationsRequest) Reset() {
 	*x = ListConversationsRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	mi := &file_chat_v1_chat_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +1309,7 @@ func (x *ListConversationsRequest) String() string {
 func (*ListConversationsRequest) ProtoMessage() {}
 
 func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[9]
+	mi := &file_chat_v1_chat_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +1322,7 @@ func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
 func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{9}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListConversationsRequest) GetProjectId() string {
@@ -744,17 +1332,32 @@ func (x *ListConversationsRequest) GetProjectId() string {
 	return ""
 }
 
-type ListConversationsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// In this response, the length of conversations[i].messages should be 0.
+func (x *ListConversationsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListConversationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In this response, the length of conversations[i].messages should be 0.
 	Conversations []*Conversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	NextPageToken *string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"` // unset on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListConversationsResponse) Reset() {
 	*x = ListConversationsResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	mi := &file_chat_v1_chat_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +1369,7 @@ func (x *ListConversationsResponse) String() string {
 func (*ListConversationsResponse) ProtoMessage() {}
 
 func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[10]
+	mi := &file_chat_v1_chat_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1382,7 @@ func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
 func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{10}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListConversationsResponse) GetConversations() []*Conversation {
@@ -789,28 +1392,37 @@ func (x *ListConversationsResponse) GetConversations() []*Conversation {
 	return nil
 }
 
-type GetConversationRequest struct {
+func (x *ListConversationsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type ListConversationMessagesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	PageToken      *string                `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"` // next_page_token of the previous page
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`         // defaults to 50, at most 200
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetConversationRequest) Reset() {
-	*x = GetConversationRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[11]
+func (x *ListConversationMessagesRequest) Reset() {
+	*x = ListConversationMessagesRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConversationRequest) String() string {
+func (x *ListConversationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationRequest) ProtoMessage() {}
+func (*ListConversationMessagesRequest) ProtoMessage() {}
 
-func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[11]
+func (x *ListConversationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,40 +1433,196 @@ func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
-func (*GetConversationRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListConversationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetConversationRequest) GetConversationId() string {
+func (x *ListConversationMessagesRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-type GetConversationResponse struct {
+func (x *ListConversationMessagesRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListConversationMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListConversationMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The messages of the shown branch, in order. The first page holds the last messages, the next
+	// pages the ones before.
+	Messages      []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextPageToken *string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"` // unset on the page with the first message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationMessagesResponse) Reset() {
+	*x = ListConversationMessagesResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationMessagesResponse) ProtoMessage() {}
+
+func (x *ListConversationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListConversationMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListConversationMessagesResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type SearchConversationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MongoDB text search syntax: words, "exact phrases" and -excluded words. If empty, the
+	// conversations matching the filters are returned, most recently updated first.
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ProjectId     *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	LanguageModel *LanguageModel         `protobuf:"varint,3,opt,name=language_model,json=languageModel,proto3,enum=chat.v1.LanguageModel,oneof" json:"language_model,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 20, at most 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchConversationsRequest) Reset() {
+	*x = SearchConversationsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConversationsRequest) ProtoMessage() {}
+
+func (x *SearchConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConversationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchConversationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchConversationsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchConversationsRequest) GetLanguageModel() LanguageModel {
+	if x != nil && x.LanguageModel != nil {
+		return *x.LanguageModel
+	}
+	return LanguageModel_LANGUAGE_MODEL_UNSPECIFIED
+}
+
+func (x *SearchConversationsRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *SearchConversationsRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *SearchConversationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A range of runes of a text.
+type TextRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConversationResponse) Reset() {
-	*x = GetConversationResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[12]
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_chat_v1_chat_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConversationResponse) String() string {
+func (x *TextRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationResponse) ProtoMessage() {}
+func (*TextRange) ProtoMessage() {}
 
-func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[12]
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,47 +1633,111 @@ func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
-func (*GetConversationResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetConversationResponse) GetConversation() *Conversation {
+func (x *TextRange) GetStart() int32 {
 	if x != nil {
-		return x.Conversation
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SearchSnippet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // empty for the title
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                            // the matching part of the title or message
+	Highlights    []*TextRange           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`                // the matching words in text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSnippet) Reset() {
+	*x = SearchSnippet{}
+	mi := &file_chat_v1_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSnippet) ProtoMessage() {}
+
+func (x *SearchSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSnippet.ProtoReflect.Descriptor instead.
+func (*SearchSnippet) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchSnippet) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SearchSnippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchSnippet) GetHighlights() []*TextRange {
+	if x != nil {
+		return x.Highlights
 	}
 	return nil
 }
 
-type CreateConversationMessageRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// If conversation_id is not provided,
-	// a new conversation will be created and the id will be returned.
-	ConversationId   *string           `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
-	LanguageModel    LanguageModel     `protobuf:"varint,3,opt,name=language_model,json=languageModel,proto3,enum=chat.v1.LanguageModel" json:"language_model,omitempty"`
-	UserMessage      string            `protobuf:"bytes,4,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`
-	UserSelectedText *string           `protobuf:"bytes,5,opt,name=user_selected_text,json=userSelectedText,proto3,oneof" json:"user_selected_text,omitempty"`
-	ConversationType *ConversationType `protobuf:"varint,6,opt,name=conversation_type,json=conversationType,proto3,enum=chat.v1.ConversationType,oneof" json:"conversation_type,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ConversationSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"` // without messages
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`     // the text search relevance, 0 without query
+	Snippets      []*SearchSnippet       `protobuf:"bytes,5,rep,name=snippets,proto3" json:"snippets,omitempty"` // the title and the first matching messages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateConversationMessageRequest) Reset() {
-	*x = CreateConversationMessageRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[13]
+func (x *ConversationSearchResult) Reset() {
+	*x = ConversationSearchResult{}
+	mi := &file_chat_v1_chat_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConversationMessageRequest) String() string {
+func (x *ConversationSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConversationMessageRequest) ProtoMessage() {}
+func (*ConversationSearchResult) ProtoMessage() {}
 
-func (x *CreateConversationMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[13]
+func (x *ConversationSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,75 +1748,1628 @@ func (x *CreateConversationMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConversationMessageRequest.ProtoReflect.Descriptor instead.
-func (*CreateConversationMessageRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ConversationSearchResult.ProtoReflect.Descriptor instead.
+func (*ConversationSearchResult) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateConversationMessageRequest) GetProjectId() string {
+func (x *ConversationSearchResult) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *ConversationSearchResult) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateConversationMessageRequest) GetConversationId() string {
-	if x != nil && x.ConversationId != nil {
-		return *x.ConversationId
+func (x *ConversationSearchResult) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ConversationSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ConversationSearchResult) GetSnippets() []*SearchSnippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+type SearchConversationsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Results       []*ConversationSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // best matches first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchConversationsResponse) Reset() {
+	*x = SearchConversationsResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConversationsResponse) ProtoMessage() {}
+
+func (x *SearchConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConversationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchConversationsResponse) GetResults() []*ConversationSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type GetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type CreateConversationMessageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// If conversation_id is not provided,
+	// a new conversation will be created and the id will be returned.
+	ConversationId   *string           `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
+	LanguageModel    LanguageModel     `protobuf:"varint,3,opt,name=language_model,json=languageModel,proto3,enum=chat.v1.LanguageModel" json:"language_model,omitempty"`
+	UserMessage      string            `protobuf:"bytes,4,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`
+	UserSelectedText *string           `protobuf:"bytes,5,opt,name=user_selected_text,json=userSelectedText,proto3,oneof" json:"user_selected_text,omitempty"`
+	ConversationType *ConversationType `protobuf:"varint,6,opt,name=conversation_type,json=conversationType,proto3,enum=chat.v1.ConversationType,oneof" json:"conversation_type,omitempty"`
+	ResponseMode     *ResponseMode     `protobuf:"varint,7,opt,name=response_mode,json=responseMode,proto3,enum=chat.v1.ResponseMode,oneof" json:"response_mode,omitempty"`
+	// The prompt of the library the message was rendered from, recorded with the message.
+	PromptId *string `protobuf:"bytes,8,opt,name=prompt_id,json=promptId,proto3,oneof" json:"prompt_id,omitempty"`
+	// The UTF-16 offset of the selected text in the document open in the editor, which tells its
+	// occurrences apart when it appears several times in the project.
+	UserSelectionOffset *int32 `protobuf:"varint,9,opt,name=user_selection_offset,json=userSelectionOffset,proto3,oneof" json:"user_selection_offset,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateConversationMessageRequest) Reset() {
+	*x = CreateConversationMessageRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationMessageRequest) ProtoMessage() {}
+
+func (x *CreateConversationMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationMessageRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversationMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateConversationMessageRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageRequest) GetConversationId() string {
+	if x != nil && x.ConversationId != nil {
+		return *x.ConversationId
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageRequest) GetLanguageModel() LanguageModel {
+	if x != nil {
+		return x.LanguageModel
+	}
+	return LanguageModel_LANGUAGE_MODEL_UNSPECIFIED
+}
+
+func (x *CreateConversationMessageRequest) GetUserMessage() string {
+	if x != nil {
+		return x.UserMessage
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageRequest) GetUserSelectedText() string {
+	if x != nil && x.UserSelectedText != nil {
+		return *x.UserSelectedText
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageRequest) GetConversationType() ConversationType {
+	if x != nil && x.ConversationType != nil {
+		return *x.ConversationType
+	}
+	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateConversationMessageRequest) GetResponseMode() ResponseMode {
+	if x != nil && x.ResponseMode != nil {
+		return *x.ResponseMode
+	}
+	return ResponseMode_RESPONSE_MODE_UNSPECIFIED
+}
+
+func (x *CreateConversationMessageRequest) GetPromptId() string {
+	if x != nil && x.PromptId != nil {
+		return *x.PromptId
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageRequest) GetUserSelectionOffset() int32 {
+	if x != nil && x.UserSelectionOffset != nil {
+		return *x.UserSelectionOffset
+	}
+	return 0
+}
+
+type CreateConversationMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConversationMessageResponse) Reset() {
+	*x = CreateConversationMessageResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationMessageResponse) ProtoMessage() {}
+
+func (x *CreateConversationMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationMessageResponse.ProtoReflect.Descriptor instead.
+func (*CreateConversationMessageResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateConversationMessageResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type UpdateConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateConversationRequest) Reset() {
+	*x = UpdateConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationRequest) ProtoMessage() {}
+
+func (x *UpdateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type UpdateConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConversationResponse) Reset() {
+	*x = UpdateConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationResponse) ProtoMessage() {}
+
+func (x *UpdateConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type DeleteConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteConversationRequest) Reset() {
+	*x = DeleteConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationRequest) ProtoMessage() {}
+
+func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type DeleteConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConversationResponse) Reset() {
+	*x = DeleteConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationResponse) ProtoMessage() {}
+
+func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{29}
+}
+
+type DeletedConversation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Conversation *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"` // without messages
+	ProjectId    string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DeletedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// When the conversation is deleted for good, unset if it is kept forever.
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedConversation) Reset() {
+	*x = DeletedConversation{}
+	mi := &file_chat_v1_chat_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedConversation) ProtoMessage() {}
+
+func (x *DeletedConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedConversation.ProtoReflect.Descriptor instead.
+func (*DeletedConversation) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeletedConversation) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *DeletedConversation) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeletedConversation) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedConversation) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListDeletedConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     *string                `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"` // all projects if unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedConversationsRequest) Reset() {
+	*x = ListDeletedConversationsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedConversationsRequest) ProtoMessage() {}
+
+func (x *ListDeletedConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDeletedConversationsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+type ListDeletedConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*DeletedConversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedConversationsResponse) Reset() {
+	*x = ListDeletedConversationsResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedConversationsResponse) ProtoMessage() {}
+
+func (x *ListDeletedConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDeletedConversationsResponse) GetConversations() []*DeletedConversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type RestoreConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RestoreConversationRequest) Reset() {
+	*x = RestoreConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreConversationRequest) ProtoMessage() {}
+
+func (x *RestoreConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreConversationRequest.ProtoReflect.Descriptor instead.
+func (*RestoreConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type RestoreConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreConversationResponse) Reset() {
+	*x = RestoreConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreConversationResponse) ProtoMessage() {}
+
+func (x *RestoreConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreConversationResponse.ProtoReflect.Descriptor instead.
+func (*RestoreConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ShareConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Shared         bool                   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShareConversationRequest) Reset() {
+	*x = ShareConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareConversationRequest) ProtoMessage() {}
+
+func (x *ShareConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareConversationRequest.ProtoReflect.Descriptor instead.
+func (*ShareConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShareConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ShareConversationRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type ShareConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"` // without messages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareConversationResponse) Reset() {
+	*x = ShareConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareConversationResponse) ProtoMessage() {}
+
+func (x *ShareConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareConversationResponse.ProtoReflect.Descriptor instead.
+func (*ShareConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShareConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type SharedConversation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"` // without messages
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerName     string                 `protobuf:"bytes,3,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	OwnerEmail    string                 `protobuf:"bytes,4,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedConversation) Reset() {
+	*x = SharedConversation{}
+	mi := &file_chat_v1_chat_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedConversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedConversation) ProtoMessage() {}
+
+func (x *SharedConversation) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedConversation.ProtoReflect.Descriptor instead.
+func (*SharedConversation) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SharedConversation) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *SharedConversation) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SharedConversation) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *SharedConversation) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *SharedConversation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSharedConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedConversationsRequest) Reset() {
+	*x = ListSharedConversationsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedConversationsRequest) ProtoMessage() {}
+
+func (x *ListSharedConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSharedConversationsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListSharedConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSharedConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*SharedConversation  `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"` // most recently updated first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedConversationsResponse) Reset() {
+	*x = ListSharedConversationsResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedConversationsResponse) ProtoMessage() {}
+
+func (x *ListSharedConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSharedConversationsResponse) GetConversations() []*SharedConversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type ApproveToolCallRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ToolCallId     string                 `protobuf:"bytes,2,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApproveToolCallRequest) Reset() {
+	*x = ApproveToolCallRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveToolCallRequest) ProtoMessage() {}
+
+func (x *ApproveToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveToolCallRequest.ProtoReflect.Descriptor instead.
+func (*ApproveToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApproveToolCallRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ApproveToolCallRequest) GetToolCallId() string {
+	if x != nil {
+		return x.ToolCallId
+	}
+	return ""
+}
+
+type DenyToolCallRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ToolCallId     string                 `protobuf:"bytes,2,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
+	Reason         *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"` // told to the model
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DenyToolCallRequest) Reset() {
+	*x = DenyToolCallRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyToolCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyToolCallRequest) ProtoMessage() {}
+
+func (x *DenyToolCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyToolCallRequest.ProtoReflect.Descriptor instead.
+func (*DenyToolCallRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DenyToolCallRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DenyToolCallRequest) GetToolCallId() string {
+	if x != nil {
+		return x.ToolCallId
+	}
+	return ""
+}
+
+func (x *DenyToolCallRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type WatchToolJobsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchToolJobsRequest) Reset() {
+	*x = WatchToolJobsRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchToolJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchToolJobsRequest) ProtoMessage() {}
+
+func (x *WatchToolJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchToolJobsRequest.ProtoReflect.Descriptor instead.
+func (*WatchToolJobsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchToolJobsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ApplyEditRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	EditId         string                 `protobuf:"bytes,2,opt,name=edit_id,json=editId,proto3" json:"edit_id,omitempty"`
+	Accepted       bool                   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"` // false if the user rejected the edit
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApplyEditRequest) Reset() {
+	*x = ApplyEditRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyEditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyEditRequest) ProtoMessage() {}
+
+func (x *ApplyEditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyEditRequest.ProtoReflect.Descriptor instead.
+func (*ApplyEditRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApplyEditRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ApplyEditRequest) GetEditId() string {
+	if x != nil {
+		return x.EditId
+	}
+	return ""
+}
+
+func (x *ApplyEditRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type ApplyEditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edit          *TextEdit              `protobuf:"bytes,1,opt,name=edit,proto3" json:"edit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyEditResponse) Reset() {
+	*x = ApplyEditResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyEditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyEditResponse) ProtoMessage() {}
+
+func (x *ApplyEditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyEditResponse.ProtoReflect.Descriptor instead.
+func (*ApplyEditResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ApplyEditResponse) GetEdit() *TextEdit {
+	if x != nil {
+		return x.Edit
+	}
+	return nil
+}
+
+type EditMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId   string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId        string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // a user message of the shown branch
+	ProjectId        string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserMessage      string                 `protobuf:"bytes,4,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`
+	UserSelectedText *string                `protobuf:"bytes,5,opt,name=user_selected_text,json=userSelectedText,proto3,oneof" json:"user_selected_text,omitempty"`
+	ConversationType *ConversationType      `protobuf:"varint,6,opt,name=conversation_type,json=conversationType,proto3,enum=chat.v1.ConversationType,oneof" json:"conversation_type,omitempty"`
+	ResponseMode     *ResponseMode          `protobuf:"varint,7,opt,name=response_mode,json=responseMode,proto3,enum=chat.v1.ResponseMode,oneof" json:"response_mode,omitempty"`
+	// The UTF-16 offset of the selected text in the document open in the editor, which tells its
+	// occurrences apart when it appears several times in the project.
+	UserSelectionOffset *int32 `protobuf:"varint,8,opt,name=user_selection_offset,json=userSelectionOffset,proto3,oneof" json:"user_selection_offset,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EditMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetUserMessage() string {
+	if x != nil {
+		return x.UserMessage
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetUserSelectedText() string {
+	if x != nil && x.UserSelectedText != nil {
+		return *x.UserSelectedText
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetConversationType() ConversationType {
+	if x != nil && x.ConversationType != nil {
+		return *x.ConversationType
+	}
+	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
+
+func (x *EditMessageRequest) GetResponseMode() ResponseMode {
+	if x != nil && x.ResponseMode != nil {
+		return *x.ResponseMode
+	}
+	return ResponseMode_RESPONSE_MODE_UNSPECIFIED
+}
+
+func (x *EditMessageRequest) GetUserSelectionOffset() int32 {
+	if x != nil && x.UserSelectionOffset != nil {
+		return *x.UserSelectionOffset
+	}
+	return 0
+}
+
+type RegenerateMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // a user message of the shown branch
+	ResponseMode   *ResponseMode          `protobuf:"varint,3,opt,name=response_mode,json=responseMode,proto3,enum=chat.v1.ResponseMode,oneof" json:"response_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegenerateMessageRequest) Reset() {
+	*x = RegenerateMessageRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateMessageRequest) ProtoMessage() {}
+
+func (x *RegenerateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateMessageRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RegenerateMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *RegenerateMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RegenerateMessageRequest) GetResponseMode() ResponseMode {
+	if x != nil && x.ResponseMode != nil {
+		return *x.ResponseMode
+	}
+	return ResponseMode_RESPONSE_MODE_UNSPECIFIED
+}
+
+type SwitchBranchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // a user message of any branch
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SwitchBranchRequest) Reset() {
+	*x = SwitchBranchRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchBranchRequest) ProtoMessage() {}
+
+func (x *SwitchBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchBranchRequest.ProtoReflect.Descriptor instead.
+func (*SwitchBranchRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SwitchBranchRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SwitchBranchRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type SwitchBranchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchBranchResponse) Reset() {
+	*x = SwitchBranchResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchBranchResponse) ProtoMessage() {}
+
+func (x *SwitchBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchBranchResponse.ProtoReflect.Descriptor instead.
+func (*SwitchBranchResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SwitchBranchResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type ForkConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	// The fork ends with the turn of this message, i.e. with the answer to its user message. All
+	// messages are copied if it is empty.
+	UpToMessageId string `protobuf:"bytes,2,opt,name=up_to_message_id,json=upToMessageId,proto3" json:"up_to_message_id,omitempty"`
+	// The fork is grounded on the current content of this project instead of the content the
+	// conversation started with.
+	TargetProjectId *string `protobuf:"bytes,3,opt,name=target_project_id,json=targetProjectId,proto3,oneof" json:"target_project_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ForkConversationRequest) Reset() {
+	*x = ForkConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkConversationRequest) ProtoMessage() {}
+
+func (x *ForkConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateConversationMessageRequest) GetLanguageModel() LanguageModel {
-	if x != nil {
-		return x.LanguageModel
-	}
-	return LanguageModel_LANGUAGE_MODEL_UNSPECIFIED
+// Deprecated: Use ForkConversationRequest.ProtoReflect.Descriptor instead.
+func (*ForkConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateConversationMessageRequest) GetUserMessage() string {
+func (x *ForkConversationRequest) GetConversationId() string {
 	if x != nil {
-		return x.UserMessage
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *CreateConversationMessageRequest) GetUserSelectedText() string {
-	if x != nil && x.UserSelectedText != nil {
-		return *x.UserSelectedText
+func (x *ForkConversationRequest) GetUpToMessageId() string {
+	if x != nil {
+		return x.UpToMessageId
 	}
 	return ""
 }
 
-func (x *CreateConversationMessageRequest) GetConversationType() ConversationType {
-	if x != nil && x.ConversationType != nil {
-		return *x.ConversationType
+func (x *ForkConversationRequest) GetTargetProjectId() string {
+	if x != nil && x.TargetProjectId != nil {
+		return *x.TargetProjectId
 	}
-	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+	return ""
 }
 
-type CreateConversationMessageResponse struct {
+type ForkConversationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateConversationMessageResponse) Reset() {
-	*x = CreateConversationMessageResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[14]
+func (x *ForkConversationResponse) Reset() {
+	*x = ForkConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConversationMessageResponse) String() string {
+func (x *ForkConversationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConversationMessageResponse) ProtoMessage() {}
+func (*ForkConversationResponse) ProtoMessage() {}
 
-func (x *CreateConversationMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[14]
+func (x *ForkConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,41 +3380,41 @@ func (x *CreateConversationMessageResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConversationMessageResponse.ProtoReflect.Descriptor instead.
-func (*CreateConversationMessageResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ForkConversationResponse.ProtoReflect.Descriptor instead.
+func (*ForkConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateConversationMessageResponse) GetConversation() *Conversation {
+func (x *ForkConversationResponse) GetConversation() *Conversation {
 	if x != nil {
 		return x.Conversation
 	}
 	return nil
 }
 
-type UpdateConversationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+type ExportConversationRequest struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	ConversationId string                   `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Format         ConversationExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=chat.v1.ConversationExportFormat" json:"format,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateConversationRequest) Reset() {
-	*x = UpdateConversationRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[15]
+func (x *ExportConversationRequest) Reset() {
+	*x = ExportConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateConversationRequest) String() string {
+func (x *ExportConversationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateConversationRequest) ProtoMessage() {}
+func (*ExportConversationRequest) ProtoMessage() {}
 
-func (x *UpdateConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[15]
+func (x *ExportConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,47 +3425,49 @@ func (x *UpdateConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateConversationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateConversationRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ExportConversationRequest.ProtoReflect.Descriptor instead.
+func (*ExportConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UpdateConversationRequest) GetConversationId() string {
+func (x *ExportConversationRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *UpdateConversationRequest) GetTitle() string {
+func (x *ExportConversationRequest) GetFormat() ConversationExportFormat {
 	if x != nil {
-		return x.Title
+		return x.Format
 	}
-	return ""
+	return ConversationExportFormat_CONVERSATION_EXPORT_FORMAT_UNSPECIFIED
 }
 
-type UpdateConversationResponse struct {
+type ExportConversationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateConversationResponse) Reset() {
-	*x = UpdateConversationResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[16]
+func (x *ExportConversationResponse) Reset() {
+	*x = ExportConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateConversationResponse) String() string {
+func (x *ExportConversationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateConversationResponse) ProtoMessage() {}
+func (*ExportConversationResponse) ProtoMessage() {}
 
-func (x *UpdateConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[16]
+func (x *ExportConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,40 +3478,56 @@ func (x *UpdateConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateConversationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateConversationResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExportConversationResponse.ProtoReflect.Descriptor instead.
+func (*ExportConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UpdateConversationResponse) GetConversation() *Conversation {
+func (x *ExportConversationResponse) GetFilename() string {
 	if x != nil {
-		return x.Conversation
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-type DeleteConversationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ExportConversationResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
 }
 
-func (x *DeleteConversationRequest) Reset() {
-	*x = DeleteConversationRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[17]
+func (x *ExportConversationResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportConversationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// The content of a JSON export.
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConversationRequest) Reset() {
+	*x = ImportConversationRequest{}
+	mi := &file_chat_v1_chat_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationRequest) String() string {
+func (x *ImportConversationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationRequest) ProtoMessage() {}
+func (*ImportConversationRequest) ProtoMessage() {}
 
-func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[17]
+func (x *ImportConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,39 +3538,47 @@ func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ImportConversationRequest.ProtoReflect.Descriptor instead.
+func (*ImportConversationRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DeleteConversationRequest) GetConversationId() string {
+func (x *ImportConversationRequest) GetProjectId() string {
 	if x != nil {
-		return x.ConversationId
+		return x.ProjectId
 	}
 	return ""
 }
 
-type DeleteConversationResponse struct {
+func (x *ImportConversationRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportConversationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteConversationResponse) Reset() {
-	*x = DeleteConversationResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[18]
+func (x *ImportConversationResponse) Reset() {
+	*x = ImportConversationResponse{}
+	mi := &file_chat_v1_chat_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationResponse) String() string {
+func (x *ImportConversationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationResponse) ProtoMessage() {}
+func (*ImportConversationResponse) ProtoMessage() {}
 
-func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[18]
+func (x *ImportConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,9 +3589,16 @@ func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ImportConversationResponse.ProtoReflect.Descriptor instead.
+func (*ImportConversationResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportConversationResponse) GetConversation() *Conversation {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
 }
 
 // Information sent once at the beginning of a new conversation stream
@@ -1194,7 +3612,7 @@ type StreamInitialization struct {
 
 func (x *StreamInitialization) Reset() {
 	*x = StreamInitialization{}
-	mi := &file_chat_v1_chat_proto_msgTypes[19]
+	mi := &file_chat_v1_chat_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1206,7 +3624,7 @@ func (x *StreamInitialization) String() string {
 func (*StreamInitialization) ProtoMessage() {}
 
 func (x *StreamInitialization) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[19]
+	mi := &file_chat_v1_chat_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +3637,7 @@ func (x *StreamInitialization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamInitialization.ProtoReflect.Descriptor instead.
 func (*StreamInitialization) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{19}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *StreamInitialization) GetConversationId() string {
@@ -1251,7 +3669,7 @@ type StreamPartBegin struct {
 
 func (x *StreamPartBegin) Reset() {
 	*x = StreamPartBegin{}
-	mi := &file_chat_v1_chat_proto_msgTypes[20]
+	mi := &file_chat_v1_chat_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1263,7 +3681,7 @@ func (x *StreamPartBegin) String() string {
 func (*StreamPartBegin) ProtoMessage() {}
 
 func (x *StreamPartBegin) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[20]
+	mi := &file_chat_v1_chat_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +3694,7 @@ func (x *StreamPartBegin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamPartBegin.ProtoReflect.Descriptor instead.
 func (*StreamPartBegin) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{20}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *StreamPartBegin) GetMessageId() string {
@@ -1306,7 +3724,7 @@ type MessageChunk struct {
 
 func (x *MessageChunk) Reset() {
 	*x = MessageChunk{}
-	mi := &file_chat_v1_chat_proto_msgTypes[21]
+	mi := &file_chat_v1_chat_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +3736,7 @@ func (x *MessageChunk) String() string {
 func (*MessageChunk) ProtoMessage() {}
 
 func (x *MessageChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[21]
+	mi := &file_chat_v1_chat_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +3749,7 @@ func (x *MessageChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageChunk.ProtoReflect.Descriptor instead.
 func (*MessageChunk) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{21}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *MessageChunk) GetMessageId() string {
@@ -1358,7 +3776,7 @@ type IncompleteIndicator struct {
 
 func (x *IncompleteIndicator) Reset() {
 	*x = IncompleteIndicator{}
-	mi := &file_chat_v1_chat_proto_msgTypes[22]
+	mi := &file_chat_v1_chat_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1370,7 +3788,7 @@ func (x *IncompleteIndicator) String() string {
 func (*IncompleteIndicator) ProtoMessage() {}
 
 func (x *IncompleteIndicator) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[22]
+	mi := &file_chat_v1_chat_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,7 +3801,7 @@ func (x *IncompleteIndicator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncompleteIndicator.ProtoReflect.Descriptor instead.
 func (*IncompleteIndicator) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{22}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *IncompleteIndicator) GetReason() string {
@@ -1410,7 +3828,7 @@ type StreamPartEnd struct {
 
 func (x *StreamPartEnd) Reset() {
 	*x = StreamPartEnd{}
-	mi := &file_chat_v1_chat_proto_msgTypes[23]
+	mi := &file_chat_v1_chat_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +3840,7 @@ func (x *StreamPartEnd) String() string {
 func (*StreamPartEnd) ProtoMessage() {}
 
 func (x *StreamPartEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[23]
+	mi := &file_chat_v1_chat_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +3853,7 @@ func (x *StreamPartEnd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamPartEnd.ProtoReflect.Descriptor instead.
 func (*StreamPartEnd) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{23}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *StreamPartEnd) GetMessageId() string {
@@ -1462,7 +3880,7 @@ type StreamFinalization struct {
 
 func (x *StreamFinalization) Reset() {
 	*x = StreamFinalization{}
-	mi := &file_chat_v1_chat_proto_msgTypes[24]
+	mi := &file_chat_v1_chat_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1474,7 +3892,7 @@ func (x *StreamFinalization) String() string {
 func (*StreamFinalization) ProtoMessage() {}
 
 func (x *StreamFinalization) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[24]
+	mi := &file_chat_v1_chat_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,7 +3905,7 @@ func (x *StreamFinalization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamFinalization.ProtoReflect.Descriptor instead.
 func (*StreamFinalization) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{24}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *StreamFinalization) GetConversationId() string {
@@ -1506,7 +3924,7 @@ type StreamError struct {
 
 func (x *StreamError) Reset() {
 	*x = StreamError{}
-	mi := &file_chat_v1_chat_proto_msgTypes[25]
+	mi := &file_chat_v1_chat_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1518,7 +3936,7 @@ func (x *StreamError) String() string {
 func (*StreamError) ProtoMessage() {}
 
 func (x *StreamError) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[25]
+	mi := &file_chat_v1_chat_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +3949,7 @@ func (x *StreamError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamError.ProtoReflect.Descriptor instead.
 func (*StreamError) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{25}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *StreamError) GetErrorMessage() string {
@@ -1541,6 +3959,92 @@ func (x *StreamError) GetErrorMessage() string {
 	return ""
 }
 
+// Progress of a tool call running as a background job.
+// Once the job succeeded or failed, its result is in the tool_call message (see GetConversation).
+type ToolCallProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // The id of the tool_call message
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status        ToolJobStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=chat.v1.ToolJobStatus" json:"status,omitempty"`
+	Progress      *float64               `protobuf:"fixed64,5,opt,name=progress,proto3,oneof" json:"progress,omitempty"` // Between 0 and 1, unset if the tool does not know
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolCallProgress) Reset() {
+	*x = ToolCallProgress{}
+	mi := &file_chat_v1_chat_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolCallProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallProgress) ProtoMessage() {}
+
+func (x *ToolCallProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_chat_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallProgress.ProtoReflect.Descriptor instead.
+func (*ToolCallProgress) Descriptor() ([]byte, []int) {
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ToolCallProgress) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCallProgress) GetStatus() ToolJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ToolJobStatus_TOOL_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ToolCallProgress) GetProgress() float64 {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return 0
+}
+
+func (x *ToolCallProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // This message should be the same as CreateConversationMessageRequest
 // Note: If conversation_id is provided,
 //
@@ -1553,13 +4057,19 @@ type CreateConversationMessageStreamRequest struct {
 	UserMessage      string                 `protobuf:"bytes,4,opt,name=user_message,json=userMessage,proto3" json:"user_message,omitempty"`
 	UserSelectedText *string                `protobuf:"bytes,5,opt,name=user_selected_text,json=userSelectedText,proto3,oneof" json:"user_selected_text,omitempty"`
 	ConversationType *ConversationType      `protobuf:"varint,6,opt,name=conversation_type,json=conversationType,proto3,enum=chat.v1.ConversationType,oneof" json:"conversation_type,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	ResponseMode     *ResponseMode          `protobuf:"varint,7,opt,name=response_mode,json=responseMode,proto3,enum=chat.v1.ResponseMode,oneof" json:"response_mode,omitempty"`
+	// The prompt of the library the message was rendered from, recorded with the message.
+	PromptId *string `protobuf:"bytes,8,opt,name=prompt_id,json=promptId,proto3,oneof" json:"prompt_id,omitempty"`
+	// The UTF-16 offset of the selected text in the document open in the editor, which tells its
+	// occurrences apart when it appears several times in the project.
+	UserSelectionOffset *int32 `protobuf:"varint,9,opt,name=user_selection_offset,json=userSelectionOffset,proto3,oneof" json:"user_selection_offset,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreateConversationMessageStreamRequest) Reset() {
 	*x = CreateConversationMessageStreamRequest{}
-	mi := &file_chat_v1_chat_proto_msgTypes[26]
+	mi := &file_chat_v1_chat_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +4081,7 @@ func (x *CreateConversationMessageStreamRequest) String() string {
 func (*CreateConversationMessageStreamRequest) ProtoMessage() {}
 
 func (x *CreateConversationMessageStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[26]
+	mi := &file_chat_v1_chat_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +4094,7 @@ func (x *CreateConversationMessageStreamRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use CreateConversationMessageStreamRequest.ProtoReflect.Descriptor instead.
 func (*CreateConversationMessageStreamRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{26}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateConversationMessageStreamRequest) GetProjectId() string {
@@ -1629,6 +4139,27 @@ func (x *CreateConversationMessageStreamRequest) GetConversationType() Conversat
 	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
 }
 
+func (x *CreateConversationMessageStreamRequest) GetResponseMode() ResponseMode {
+	if x != nil && x.ResponseMode != nil {
+		return *x.ResponseMode
+	}
+	return ResponseMode_RESPONSE_MODE_UNSPECIFIED
+}
+
+func (x *CreateConversationMessageStreamRequest) GetPromptId() string {
+	if x != nil && x.PromptId != nil {
+		return *x.PromptId
+	}
+	return ""
+}
+
+func (x *CreateConversationMessageStreamRequest) GetUserSelectionOffset() int32 {
+	if x != nil && x.UserSelectionOffset != nil {
+		return *x.UserSelectionOffset
+	}
+	return 0
+}
+
 // Response for streaming a message within an existing conversation
 type CreateConversationMessageStreamResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1641,6 +4172,7 @@ type CreateConversationMessageStreamResponse struct {
 	//	*CreateConversationMessageStreamResponse_StreamPartEnd
 	//	*CreateConversationMessageStreamResponse_StreamFinalization
 	//	*CreateConversationMessageStreamResponse_StreamError
+	//	*CreateConversationMessageStreamResponse_ToolCallProgress
 	ResponsePayload isCreateConversationMessageStreamResponse_ResponsePayload `protobuf_oneof:"response_payload"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -1648,7 +4180,7 @@ type CreateConversationMessageStreamResponse struct {
 
 func (x *CreateConversationMessageStreamResponse) Reset() {
 	*x = CreateConversationMessageStreamResponse{}
-	mi := &file_chat_v1_chat_proto_msgTypes[27]
+	mi := &file_chat_v1_chat_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1660,7 +4192,7 @@ func (x *CreateConversationMessageStreamResponse) String() string {
 func (*CreateConversationMessageStreamResponse) ProtoMessage() {}
 
 func (x *CreateConversationMessageStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_chat_proto_msgTypes[27]
+	mi := &file_chat_v1_chat_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +4205,7 @@ func (x *CreateConversationMessageStreamResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use CreateConversationMessageStreamResponse.ProtoReflect.Descriptor instead.
 func (*CreateConversationMessageStreamResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_chat_proto_rawDescGZIP(), []int{27}
+	return file_chat_v1_chat_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *CreateConversationMessageStreamResponse) GetResponsePayload() isCreateConversationMessageStreamResponse_ResponsePayload {
@@ -1746,6 +4278,15 @@ func (x *CreateConversationMessageStreamResponse) GetStreamError() *StreamError
 	return nil
 }
 
+func (x *CreateConversationMessageStreamResponse) GetToolCallProgress() *ToolCallProgress {
+	if x != nil {
+		if x, ok := x.ResponsePayload.(*CreateConversationMessageStreamResponse_ToolCallProgress); ok {
+			return x.ToolCallProgress
+		}
+	}
+	return nil
+}
+
 type isCreateConversationMessageStreamResponse_ResponsePayload interface {
 	isCreateConversationMessageStreamResponse_ResponsePayload()
 }
@@ -1778,6 +4319,10 @@ type CreateConversationMessageStreamResponse_StreamError struct {
 	StreamError *StreamError `protobuf:"bytes,7,opt,name=stream_error,json=streamError,proto3,oneof"`
 }
 
+type CreateConversationMessageStreamResponse_ToolCallProgress struct {
+	ToolCallProgress *ToolCallProgress `protobuf:"bytes,8,opt,name=tool_call_progress,json=toolCallProgress,proto3,oneof"`
+}
+
 func (*CreateConversationMessageStreamResponse_StreamInitialization) isCreateConversationMessageStreamResponse_ResponsePayload() {
 }
 
@@ -1799,11 +4344,14 @@ func (*CreateConversationMessageStreamResponse_StreamFinalization) isCreateConve
 func (*CreateConversationMessageStreamResponse_StreamError) isCreateConversationMessageStreamResponse_ResponsePayload() {
 }
 
+func (*CreateConversationMessageStreamResponse_ToolCallProgress) isCreateConversationMessageStreamResponse_ResponsePayload() {
+}
+
 var File_chat_v1_chat_proto protoreflect.FileDescriptor
 
 const file_chat_v1_chat_proto_rawDesc = "" +
 	"\n" +
-	"\x12chat/v1/chat.proto\x12\achat.v1\x1a\x1cgoogle/api/annotations.proto\"k\n" +
+	"\x12chat/v1/chat.proto\x12\achat.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"k\n" +
 	"\x13MessageTypeToolCall\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04args\x18\x02 \x01(\tR\x04args\x12\x16\n" +
@@ -1811,44 +4359,126 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x05error\x18\x04 \x01(\tR\x05error\"M\n" +
 	"#MessageTypeToolCallPrepareArguments\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04args\x18\x02 \x01(\tR\x04args\"-\n" +
+	"\x04args\x18\x02 \x01(\tR\x04args\"o\n" +
+	"#MessageTypeToolCallApprovalRequired\x12 \n" +
+	"\ftool_call_id\x18\x01 \x01(\tR\n" +
+	"toolCallId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x03 \x01(\tR\x04args\"-\n" +
 	"\x11MessageTypeSystem\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\"0\n" +
 	"\x14MessageTypeAssistant\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\"g\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\x97\x01\n" +
 	"\x0fMessageTypeUser\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12(\n" +
-	"\rselected_text\x18\x02 \x01(\tH\x00R\fselectedText\x88\x01\x01B\x10\n" +
-	"\x0e_selected_text\"6\n" +
+	"\rselected_text\x18\x02 \x01(\tH\x00R\fselectedText\x88\x01\x01\x12 \n" +
+	"\tprompt_id\x18\x03 \x01(\tH\x01R\bpromptId\x88\x01\x01B\x10\n" +
+	"\x0e_selected_textB\f\n" +
+	"\n" +
+	"_prompt_id\"i\n" +
+	"\x13MessageTypeRevision\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\tR\boriginal\x12\x18\n" +
+	"\arevised\x18\x02 \x01(\tR\arevised\x12\x1c\n" +
+	"\trationale\x18\x03 \x01(\tR\trationale\"\x8c\x02\n" +
+	"\bTextEdit\x12\x17\n" +
+	"\aedit_id\x18\x01 \x01(\tR\x06editId\x12\x15\n" +
+	"\x06doc_id\x18\x02 \x01(\tR\x05docId\x12\x1f\n" +
+	"\vdoc_version\x18\x03 \x01(\x05R\n" +
+	"docVersion\x12!\n" +
+	"\fstart_offset\x18\x04 \x01(\x05R\vstartOffset\x12\x1d\n" +
+	"\n" +
+	"end_offset\x18\x05 \x01(\x05R\tendOffset\x12 \n" +
+	"\vreplacement\x18\x06 \x01(\tR\vreplacement\x12\x1a\n" +
+	"\boriginal\x18\a \x01(\tR\boriginal\x12/\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.chat.v1.TextEditStatusR\x06status\"Z\n" +
+	"\x14MessageTypeTextEdits\x12\x19\n" +
+	"\bdoc_path\x18\x01 \x01(\tR\adocPath\x12'\n" +
+	"\x05edits\x18\x02 \x03(\v2\x11.chat.v1.TextEditR\x05edits\"6\n" +
 	"\x12MessageTypeUnknown\x12 \n" +
-	"\vdescription\x18\x01 \x01(\tR\vdescription\"\xaa\x03\n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\"\x95\x05\n" +
 	"\x0eMessagePayload\x124\n" +
 	"\x06system\x18\x01 \x01(\v2\x1a.chat.v1.MessageTypeSystemH\x00R\x06system\x12.\n" +
 	"\x04user\x18\x02 \x01(\v2\x18.chat.v1.MessageTypeUserH\x00R\x04user\x12=\n" +
 	"\tassistant\x18\x03 \x01(\v2\x1d.chat.v1.MessageTypeAssistantH\x00R\tassistant\x12m\n" +
 	"\x1btool_call_prepare_arguments\x18\x04 \x01(\v2,.chat.v1.MessageTypeToolCallPrepareArgumentsH\x00R\x18toolCallPrepareArguments\x12;\n" +
 	"\ttool_call\x18\x05 \x01(\v2\x1c.chat.v1.MessageTypeToolCallH\x00R\btoolCall\x127\n" +
-	"\aunknown\x18\x06 \x01(\v2\x1b.chat.v1.MessageTypeUnknownH\x00R\aunknownB\x0e\n" +
-	"\fmessage_type\"[\n" +
+	"\aunknown\x18\x06 \x01(\v2\x1b.chat.v1.MessageTypeUnknownH\x00R\aunknown\x12m\n" +
+	"\x1btool_call_approval_required\x18\a \x01(\v2,.chat.v1.MessageTypeToolCallApprovalRequiredH\x00R\x18toolCallApprovalRequired\x12:\n" +
+	"\brevision\x18\b \x01(\v2\x1c.chat.v1.MessageTypeRevisionH\x00R\brevision\x12>\n" +
+	"\n" +
+	"text_edits\x18\t \x01(\v2\x1d.chat.v1.MessageTypeTextEditsH\x00R\ttextEditsB\x0e\n" +
+	"\fmessage_type\"|\n" +
 	"\aMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x121\n" +
-	"\apayload\x18\x03 \x01(\v2\x17.chat.v1.MessagePayloadR\apayload\"\xa1\x01\n" +
+	"\apayload\x18\x03 \x01(\v2\x17.chat.v1.MessagePayloadR\apayload\x12\x1f\n" +
+	"\vsibling_ids\x18\x04 \x03(\tR\n" +
+	"siblingIds\"\x81\x02\n" +
 	"\fConversation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12=\n" +
 	"\x0elanguage_model\x18\x02 \x01(\x0e2\x16.chat.v1.LanguageModelR\rlanguageModel\x12,\n" +
-	"\bmessages\x18\x04 \x03(\v2\x10.chat.v1.MessageR\bmessages\"M\n" +
+	"\bmessages\x18\x04 \x03(\v2\x10.chat.v1.MessageR\bmessages\x12\x16\n" +
+	"\x06shared\x18\x05 \x01(\bR\x06shared\x12F\n" +
+	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeR\x10conversationType\"\x9d\x01\n" +
 	"\x18ListConversationsRequest\x12\"\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01B\r\n" +
-	"\v_project_id\"X\n" +
+	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSizeB\r\n" +
+	"\v_project_idB\r\n" +
+	"\v_page_token\"\x99\x01\n" +
 	"\x19ListConversationsResponse\x12;\n" +
-	"\rconversations\x18\x01 \x03(\v2\x15.chat.v1.ConversationR\rconversations\"A\n" +
+	"\rconversations\x18\x01 \x03(\v2\x15.chat.v1.ConversationR\rconversations\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tH\x00R\rnextPageToken\x88\x01\x01B\x12\n" +
+	"\x10_next_page_token\"\x9a\x01\n" +
+	"\x1fListConversationMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSizeB\r\n" +
+	"\v_page_token\"\x91\x01\n" +
+	" ListConversationMessagesResponse\x12,\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.chat.v1.MessageR\bmessages\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tH\x00R\rnextPageToken\x88\x01\x01B\x12\n" +
+	"\x10_next_page_token\"\x85\x03\n" +
+	"\x1aSearchConversationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\"\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12B\n" +
+	"\x0elanguage_model\x18\x03 \x01(\x0e2\x16.chat.v1.LanguageModelH\x01R\rlanguageModel\x88\x01\x01\x12D\n" +
+	"\rupdated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\fupdatedAfter\x88\x01\x01\x12F\n" +
+	"\x0eupdated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\rupdatedBefore\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limitB\r\n" +
+	"\v_project_idB\x11\n" +
+	"\x0f_language_modelB\x10\n" +
+	"\x0e_updated_afterB\x11\n" +
+	"\x0f_updated_before\"3\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"v\n" +
+	"\rSearchSnippet\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x122\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x12.chat.v1.TextRangeR\n" +
+	"highlights\"\xf9\x01\n" +
+	"\x18ConversationSearchResult\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x122\n" +
+	"\bsnippets\x18\x05 \x03(\v2\x16.chat.v1.SearchSnippetR\bsnippets\"Z\n" +
+	"\x1bSearchConversationsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.chat.v1.ConversationSearchResultR\aresults\"A\n" +
 	"\x16GetConversationRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"T\n" +
 	"\x17GetConversationResponse\x129\n" +
-	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"\x92\x03\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"\xe8\x04\n" +
 	" CreateConversationMessageRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12,\n" +
@@ -1856,10 +4486,17 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x0elanguage_model\x18\x03 \x01(\x0e2\x16.chat.v1.LanguageModelR\rlanguageModel\x12!\n" +
 	"\fuser_message\x18\x04 \x01(\tR\vuserMessage\x121\n" +
 	"\x12user_selected_text\x18\x05 \x01(\tH\x01R\x10userSelectedText\x88\x01\x01\x12K\n" +
-	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeH\x02R\x10conversationType\x88\x01\x01B\x12\n" +
+	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeH\x02R\x10conversationType\x88\x01\x01\x12?\n" +
+	"\rresponse_mode\x18\a \x01(\x0e2\x15.chat.v1.ResponseModeH\x03R\fresponseMode\x88\x01\x01\x12 \n" +
+	"\tprompt_id\x18\b \x01(\tH\x04R\bpromptId\x88\x01\x01\x127\n" +
+	"\x15user_selection_offset\x18\t \x01(\x05H\x05R\x13userSelectionOffset\x88\x01\x01B\x12\n" +
 	"\x10_conversation_idB\x15\n" +
 	"\x13_user_selected_textB\x14\n" +
-	"\x12_conversation_type\"^\n" +
+	"\x12_conversation_typeB\x10\n" +
+	"\x0e_response_modeB\f\n" +
+	"\n" +
+	"_prompt_idB\x18\n" +
+	"\x16_user_selection_offset\"^\n" +
 	"!CreateConversationMessageResponse\x129\n" +
 	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"Z\n" +
 	"\x19UpdateConversationRequest\x12'\n" +
@@ -1869,7 +4506,109 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"D\n" +
 	"\x19DeleteConversationRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"\x1c\n" +
-	"\x1aDeleteConversationResponse\"~\n" +
+	"\x1aDeleteConversationResponse\"\xe1\x01\n" +
+	"\x13DeletedConversation\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"T\n" +
+	"\x1fListDeletedConversationsRequest\x12\"\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01B\r\n" +
+	"\v_project_id\"f\n" +
+	" ListDeletedConversationsResponse\x12B\n" +
+	"\rconversations\x18\x01 \x03(\v2\x1c.chat.v1.DeletedConversationR\rconversations\"E\n" +
+	"\x1aRestoreConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"X\n" +
+	"\x1bRestoreConversationResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"[\n" +
+	"\x18ShareConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x16\n" +
+	"\x06shared\x18\x02 \x01(\bR\x06shared\"V\n" +
+	"\x19ShareConversationResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"\xe5\x01\n" +
+	"\x12SharedConversation\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x03 \x01(\tR\townerName\x12\x1f\n" +
+	"\vowner_email\x18\x04 \x01(\tR\n" +
+	"ownerEmail\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\\\n" +
+	"\x1eListSharedConversationsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"d\n" +
+	"\x1fListSharedConversationsResponse\x12A\n" +
+	"\rconversations\x18\x01 \x03(\v2\x1b.chat.v1.SharedConversationR\rconversations\"c\n" +
+	"\x16ApproveToolCallRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12 \n" +
+	"\ftool_call_id\x18\x02 \x01(\tR\n" +
+	"toolCallId\"\x88\x01\n" +
+	"\x13DenyToolCallRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12 \n" +
+	"\ftool_call_id\x18\x02 \x01(\tR\n" +
+	"toolCallId\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"?\n" +
+	"\x14WatchToolJobsRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"p\n" +
+	"\x10ApplyEditRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\aedit_id\x18\x02 \x01(\tR\x06editId\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\bR\baccepted\":\n" +
+	"\x11ApplyEditResponse\x12%\n" +
+	"\x04edit\x18\x01 \x01(\v2\x11.chat.v1.TextEditR\x04edit\"\xf1\x03\n" +
+	"\x12EditMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12!\n" +
+	"\fuser_message\x18\x04 \x01(\tR\vuserMessage\x121\n" +
+	"\x12user_selected_text\x18\x05 \x01(\tH\x00R\x10userSelectedText\x88\x01\x01\x12K\n" +
+	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeH\x01R\x10conversationType\x88\x01\x01\x12?\n" +
+	"\rresponse_mode\x18\a \x01(\x0e2\x15.chat.v1.ResponseModeH\x02R\fresponseMode\x88\x01\x01\x127\n" +
+	"\x15user_selection_offset\x18\b \x01(\x05H\x03R\x13userSelectionOffset\x88\x01\x01B\x15\n" +
+	"\x13_user_selected_textB\x14\n" +
+	"\x12_conversation_typeB\x10\n" +
+	"\x0e_response_modeB\x18\n" +
+	"\x16_user_selection_offset\"\xb5\x01\n" +
+	"\x18RegenerateMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12?\n" +
+	"\rresponse_mode\x18\x03 \x01(\x0e2\x15.chat.v1.ResponseModeH\x00R\fresponseMode\x88\x01\x01B\x10\n" +
+	"\x0e_response_mode\"]\n" +
+	"\x13SwitchBranchRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"Q\n" +
+	"\x14SwitchBranchResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"\xb2\x01\n" +
+	"\x17ForkConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12'\n" +
+	"\x10up_to_message_id\x18\x02 \x01(\tR\rupToMessageId\x12/\n" +
+	"\x11target_project_id\x18\x03 \x01(\tH\x00R\x0ftargetProjectId\x88\x01\x01B\x14\n" +
+	"\x12_target_project_id\"U\n" +
+	"\x18ForkConversationResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"\x7f\n" +
+	"\x19ExportConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x129\n" +
+	"\x06format\x18\x02 \x01(\x0e2!.chat.v1.ConversationExportFormatR\x06format\"o\n" +
+	"\x1aExportConversationResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"T\n" +
+	"\x19ImportConversationRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"W\n" +
+	"\x1aImportConversationResponse\x129\n" +
+	"\fconversation\x18\x01 \x01(\v2\x15.chat.v1.ConversationR\fconversation\"~\n" +
 	"\x14StreamInitialization\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12=\n" +
 	"\x0elanguage_model\x18\x05 \x01(\x0e2\x16.chat.v1.LanguageModelR\rlanguageModel\"c\n" +
@@ -1892,7 +4631,16 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x12StreamFinalization\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"2\n" +
 	"\vStreamError\x12#\n" +
-	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x98\x03\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\xd4\x01\n" +
+	"\x10ToolCallProgress\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.chat.v1.ToolJobStatusR\x06status\x12\x1f\n" +
+	"\bprogress\x18\x05 \x01(\x01H\x00R\bprogress\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessageB\v\n" +
+	"\t_progress\"\xee\x04\n" +
 	"&CreateConversationMessageStreamRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12,\n" +
@@ -1900,10 +4648,17 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x0elanguage_model\x18\x03 \x01(\x0e2\x16.chat.v1.LanguageModelR\rlanguageModel\x12!\n" +
 	"\fuser_message\x18\x04 \x01(\tR\vuserMessage\x121\n" +
 	"\x12user_selected_text\x18\x05 \x01(\tH\x01R\x10userSelectedText\x88\x01\x01\x12K\n" +
-	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeH\x02R\x10conversationType\x88\x01\x01B\x12\n" +
+	"\x11conversation_type\x18\x06 \x01(\x0e2\x19.chat.v1.ConversationTypeH\x02R\x10conversationType\x88\x01\x01\x12?\n" +
+	"\rresponse_mode\x18\a \x01(\x0e2\x15.chat.v1.ResponseModeH\x03R\fresponseMode\x88\x01\x01\x12 \n" +
+	"\tprompt_id\x18\b \x01(\tH\x04R\bpromptId\x88\x01\x01\x127\n" +
+	"\x15user_selection_offset\x18\t \x01(\x05H\x05R\x13userSelectionOffset\x88\x01\x01B\x12\n" +
 	"\x10_conversation_idB\x15\n" +
 	"\x13_user_selected_textB\x14\n" +
-	"\x12_conversation_type\"\xb9\x04\n" +
+	"\x12_conversation_typeB\x10\n" +
+	"\x0e_response_modeB\f\n" +
+	"\n" +
+	"_prompt_idB\x18\n" +
+	"\x16_user_selection_offset\"\x84\x05\n" +
 	"'CreateConversationMessageStreamResponse\x12T\n" +
 	"\x15stream_initialization\x18\x01 \x01(\v2\x1d.chat.v1.StreamInitializationH\x00R\x14streamInitialization\x12F\n" +
 	"\x11stream_part_begin\x18\x02 \x01(\v2\x18.chat.v1.StreamPartBeginH\x00R\x0fstreamPartBegin\x12<\n" +
@@ -1911,7 +4666,8 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x14incomplete_indicator\x18\x04 \x01(\v2\x1c.chat.v1.IncompleteIndicatorH\x00R\x13incompleteIndicator\x12@\n" +
 	"\x0fstream_part_end\x18\x05 \x01(\v2\x16.chat.v1.StreamPartEndH\x00R\rstreamPartEnd\x12N\n" +
 	"\x13stream_finalization\x18\x06 \x01(\v2\x1b.chat.v1.StreamFinalizationH\x00R\x12streamFinalization\x129\n" +
-	"\fstream_error\x18\a \x01(\v2\x14.chat.v1.StreamErrorH\x00R\vstreamErrorB\x12\n" +
+	"\fstream_error\x18\a \x01(\v2\x14.chat.v1.StreamErrorH\x00R\vstreamError\x12I\n" +
+	"\x12tool_call_progress\x18\b \x01(\v2\x19.chat.v1.ToolCallProgressH\x00R\x10toolCallProgressB\x12\n" +
 	"\x10response_payload*\x81\x02\n" +
 	"\rLanguageModel\x12\x1e\n" +
 	"\x1aLANGUAGE_MODEL_UNSPECIFIED\x10\x00\x12\x1f\n" +
@@ -1920,17 +4676,55 @@ const file_chat_v1_chat_proto_rawDesc = "" +
 	"\x1bLANGUAGE_MODEL_OPENAI_GPT41\x10\x04\x12\x1e\n" +
 	"\x1aLANGUAGE_MODEL_OPENAI_GPT5\x10\a\x12#\n" +
 	"\x1fLANGUAGE_MODEL_OPENAI_GPT5_MINI\x10\b\x12#\n" +
-	"\x1fLANGUAGE_MODEL_OPENAI_GPT5_NANO\x10\t*R\n" +
+	"\x1fLANGUAGE_MODEL_OPENAI_GPT5_NANO\x10\t*p\n" +
+	"\x0eTextEditStatus\x12 \n" +
+	"\x1cTEXT_EDIT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TEXT_EDIT_STATUS_ACCEPTED\x10\x01\x12\x1d\n" +
+	"\x19TEXT_EDIT_STATUS_REJECTED\x10\x02*\xbb\x01\n" +
+	"\x18ConversationExportFormat\x12*\n" +
+	"&CONVERSATION_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fCONVERSATION_EXPORT_FORMAT_JSON\x10\x01\x12'\n" +
+	"#CONVERSATION_EXPORT_FORMAT_MARKDOWN\x10\x02\x12%\n" +
+	"!CONVERSATION_EXPORT_FORMAT_OPENAI\x10\x03*\xa4\x01\n" +
+	"\rToolJobStatus\x12\x1f\n" +
+	"\x1bTOOL_JOB_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TOOL_JOB_STATUS_QUEUED\x10\x01\x12\x1b\n" +
+	"\x17TOOL_JOB_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19TOOL_JOB_STATUS_SUCCEEDED\x10\x03\x12\x1a\n" +
+	"\x16TOOL_JOB_STATUS_FAILED\x10\x04*\xde\x01\n" +
 	"\x10ConversationType\x12!\n" +
 	"\x1dCONVERSATION_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
-	"\x17CONVERSATION_TYPE_DEBUG\x10\x012\xcd\a\n" +
+	"\x17CONVERSATION_TYPE_DEBUG\x10\x01\x12\x1e\n" +
+	"\x1aCONVERSATION_TYPE_REVIEWER\x10\x04\x12 \n" +
+	"\x1cCONVERSATION_TYPE_TRANSLATOR\x10\x05\x12!\n" +
+	"\x1dCONVERSATION_TYPE_PROOFREADER\x10\x06\x12%\n" +
+	"!CONVERSATION_TYPE_REBUTTAL_WRITER\x10\a*I\n" +
+	"\fResponseMode\x12\x1d\n" +
+	"\x19RESPONSE_MODE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RESPONSE_MODE_REVISION\x10\x012\xec\x1c\n" +
 	"\vChatService\x12\x83\x01\n" +
-	"\x11ListConversations\x12!.chat.v1.ListConversationsRequest\x1a\".chat.v1.ListConversationsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/_pd/api/v1/chats/conversations\x12\x8f\x01\n" +
+	"\x11ListConversations\x12!.chat.v1.ListConversationsRequest\x1a\".chat.v1.ListConversationsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/_pd/api/v1/chats/conversations\x12\x93\x01\n" +
+	"\x13SearchConversations\x12#.chat.v1.SearchConversationsRequest\x1a$.chat.v1.SearchConversationsResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/_pd/api/v1/chats/conversations/search\x12\xb3\x01\n" +
+	"\x18ListConversationMessages\x12(.chat.v1.ListConversationMessagesRequest\x1a).chat.v1.ListConversationMessagesResponse\"B\x82\xd3\xe4\x93\x02<\x12:/_pd/api/v1/chats/conversations/{conversation_id}/messages\x12\x8f\x01\n" +
 	"\x0fGetConversation\x12\x1f.chat.v1.GetConversationRequest\x1a .chat.v1.GetConversationResponse\"9\x82\xd3\xe4\x93\x023\x121/_pd/api/v1/chats/conversations/{conversation_id}\x12\xa7\x01\n" +
 	"\x19CreateConversationMessage\x12).chat.v1.CreateConversationMessageRequest\x1a*.chat.v1.CreateConversationMessageResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/_pd/api/v1/chats/conversations/messages\x12\xc2\x01\n" +
 	"\x1fCreateConversationMessageStream\x12/.chat.v1.CreateConversationMessageStreamRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//_pd/api/v1/chats/conversations/messages/stream0\x01\x12\x9b\x01\n" +
 	"\x12UpdateConversation\x12\".chat.v1.UpdateConversationRequest\x1a#.chat.v1.UpdateConversationResponse\"<\x82\xd3\xe4\x93\x026:\x01*21/_pd/api/v1/chats/conversations/{conversation_id}\x12\x98\x01\n" +
-	"\x12DeleteConversation\x12\".chat.v1.DeleteConversationRequest\x1a#.chat.v1.DeleteConversationResponse\"9\x82\xd3\xe4\x93\x023*1/_pd/api/v1/chats/conversations/{conversation_id}B\x7f\n" +
+	"\x12DeleteConversation\x12\".chat.v1.DeleteConversationRequest\x1a#.chat.v1.DeleteConversationResponse\"9\x82\xd3\xe4\x93\x023*1/_pd/api/v1/chats/conversations/{conversation_id}\x12\xa0\x01\n" +
+	"\x18ListDeletedConversations\x12(.chat.v1.ListDeletedConversationsRequest\x1a).chat.v1.ListDeletedConversationsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/_pd/api/v1/chats/deleted-conversations\x12\xa6\x01\n" +
+	"\x13RestoreConversation\x12#.chat.v1.RestoreConversationRequest\x1a$.chat.v1.RestoreConversationResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/_pd/api/v1/chats/conversations/{conversation_id}/restore\x12\x9e\x01\n" +
+	"\x11ShareConversation\x12!.chat.v1.ShareConversationRequest\x1a\".chat.v1.ShareConversationResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/_pd/api/v1/chats/conversations/{conversation_id}/share\x12\x9c\x01\n" +
+	"\x17ListSharedConversations\x12'.chat.v1.ListSharedConversationsRequest\x1a(.chat.v1.ListSharedConversationsResponse\".\x82\xd3\xe4\x93\x02(\x12&/_pd/api/v1/chats/shared-conversations\x12\xc6\x01\n" +
+	"\x0fApproveToolCall\x12\x1f.chat.v1.ApproveToolCallRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\"^\x82\xd3\xe4\x93\x02X:\x01*\"S/_pd/api/v1/chats/conversations/{conversation_id}/tool-calls/{tool_call_id}/approve0\x01\x12\xbd\x01\n" +
+	"\fDenyToolCall\x12\x1c.chat.v1.DenyToolCallRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\"[\x82\xd3\xe4\x93\x02U:\x01*\"P/_pd/api/v1/chats/conversations/{conversation_id}/tool-calls/{tool_call_id}/deny0\x01\x12\xa7\x01\n" +
+	"\rWatchToolJobs\x12\x1d.chat.v1.WatchToolJobsRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\"C\x82\xd3\xe4\x93\x02=\x12;/_pd/api/v1/chats/conversations/{conversation_id}/tool-jobs0\x01\x12\x90\x01\n" +
+	"\tApplyEdit\x12\x19.chat.v1.ApplyEditRequest\x1a\x1a.chat.v1.ApplyEditResponse\"L\x82\xd3\xe4\x93\x02F:\x01*\"A/_pd/api/v1/chats/conversations/{conversation_id}/edits/{edit_id}\x12\xb7\x01\n" +
+	"\vEditMessage\x12\x1b.chat.v1.EditMessageRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\"W\x82\xd3\xe4\x93\x02Q:\x01*\"L/_pd/api/v1/chats/conversations/{conversation_id}/messages/{message_id}/edit0\x01\x12\xc9\x01\n" +
+	"\x11RegenerateMessage\x12!.chat.v1.RegenerateMessageRequest\x1a0.chat.v1.CreateConversationMessageStreamResponse\"]\x82\xd3\xe4\x93\x02W:\x01*\"R/_pd/api/v1/chats/conversations/{conversation_id}/messages/{message_id}/regenerate0\x01\x12\xa6\x01\n" +
+	"\fSwitchBranch\x12\x1c.chat.v1.SwitchBranchRequest\x1a\x1d.chat.v1.SwitchBranchResponse\"Y\x82\xd3\xe4\x93\x02S:\x01*\"N/_pd/api/v1/chats/conversations/{conversation_id}/messages/{message_id}/switch\x12\x9a\x01\n" +
+	"\x10ForkConversation\x12 .chat.v1.ForkConversationRequest\x1a!.chat.v1.ForkConversationResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/_pd/api/v1/chats/conversations/{conversation_id}/fork\x12\x9f\x01\n" +
+	"\x12ExportConversation\x12\".chat.v1.ExportConversationRequest\x1a#.chat.v1.ExportConversationResponse\"@\x82\xd3\xe4\x93\x02:\x128/_pd/api/v1/chats/conversations/{conversation_id}/export\x12\x90\x01\n" +
+	"\x12ImportConversation\x12\".chat.v1.ImportConversationRequest\x1a#.chat.v1.ImportConversationResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/_pd/api/v1/chats/conversations/importB\x7f\n" +
 	"\vcom.chat.v1B\tChatProtoP\x01Z(paperdebugger/pkg/gen/api/chat/v1;chatv1\xa2\x02\x03CXX\xaa\x02\aChat.V1\xca\x02\aChat\\V1\xe2\x02\x13Chat\\V1\\GPBMetadata\xea\x02\bChat::V1b\x06proto3"
 
 var (
@@ -1945,85 +4739,195 @@ func file_chat_v1_chat_proto_rawDescGZIP() []byte {
 	return file_chat_v1_chat_proto_rawDescData
 }
 
-var file_chat_v1_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_chat_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_chat_v1_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_chat_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_chat_v1_chat_proto_goTypes = []any{
 	(LanguageModel)(0),                              // 0: chat.v1.LanguageModel
-	(ConversationType)(0),                           // 1: chat.v1.ConversationType
-	(*MessageTypeToolCall)(nil),                     // 2: chat.v1.MessageTypeToolCall
-	(*MessageTypeToolCallPrepareArguments)(nil),     // 3: chat.v1.MessageTypeToolCallPrepareArguments
-	(*MessageTypeSystem)(nil),                       // 4: chat.v1.MessageTypeSystem
-	(*MessageTypeAssistant)(nil),                    // 5: chat.v1.MessageTypeAssistant
-	(*MessageTypeUser)(nil),                         // 6: chat.v1.MessageTypeUser
-	(*MessageTypeUnknown)(nil),                      // 7: chat.v1.MessageTypeUnknown
-	(*MessagePayload)(nil),                          // 8: chat.v1.MessagePayload
-	(*Message)(nil),                                 // 9: chat.v1.Message
-	(*Conversation)(nil),                            // 10: chat.v1.Conversation
-	(*ListConversationsRequest)(nil),                // 11: chat.v1.ListConversationsRequest
-	(*ListConversationsResponse)(nil),               // 12: chat.v1.ListConversationsResponse
-	(*GetConversationRequest)(nil),                  // 13: chat.v1.GetConversationRequest
-	(*GetConversationResponse)(nil),                 // 14: chat.v1.GetConversationResponse
-	(*CreateConversationMessageRequest)(nil),        // 15: chat.v1.CreateConversationMessageRequest
-	(*CreateConversationMessageResponse)(nil),       // 16: chat.v1.CreateConversationMessageResponse
-	(*UpdateConversationRequest)(nil),               // 17: chat.v1.UpdateConversationRequest
-	(*UpdateConversationResponse)(nil),              // 18: chat.v1.UpdateConversationResponse
-	(*DeleteConversationRequest)(nil),               // 19: chat.v1.DeleteConversationRequest
-	(*DeleteConversationResponse)(nil),              // 20: chat.v1.DeleteConversationResponse
-	(*StreamInitialization)(nil),                    // 21: chat.v1.StreamInitialization
-	(*StreamPartBegin)(nil),                         // 22: chat.v1.StreamPartBegin
-	(*MessageChunk)(nil),                            // 23: chat.v1.MessageChunk
-	(*IncompleteIndicator)(nil),                     // 24: chat.v1.IncompleteIndicator
-	(*StreamPartEnd)(nil),                           // 25: chat.v1.StreamPartEnd
-	(*StreamFinalization)(nil),                      // 26: chat.v1.StreamFinalization
-	(*StreamError)(nil),                             // 27: chat.v1.StreamError
-	(*CreateConversationMessageStreamRequest)(nil),  // 28: chat.v1.CreateConversationMessageStreamRequest
-	(*CreateConversationMessageStreamResponse)(nil), // 29: chat.v1.CreateConversationMessageStreamResponse
+	(TextEditStatus)(0),                             // 1: chat.v1.TextEditStatus
+	(ConversationExportFormat)(0),                   // 2: chat.v1.ConversationExportFormat
+	(ToolJobStatus)(0),                              // 3: chat.v1.ToolJobStatus
+	(ConversationType)(0),                           // 4: chat.v1.ConversationType
+	(ResponseMode)(0),                               // 5: chat.v1.ResponseMode
+	(*MessageTypeToolCall)(nil),                     // 6: chat.v1.MessageTypeToolCall
+	(*MessageTypeToolCallPrepareArguments)(nil),     // 7: chat.v1.MessageTypeToolCallPrepareArguments
+	(*MessageTypeToolCallApprovalRequired)(nil),     // 8: chat.v1.MessageTypeToolCallApprovalRequired
+	(*MessageTypeSystem)(nil),                       // 9: chat.v1.MessageTypeSystem
+	(*MessageTypeAssistant)(nil),                    // 10: chat.v1.MessageTypeAssistant
+	(*MessageTypeUser)(nil),                         // 11: chat.v1.MessageTypeUser
+	(*MessageTypeRevision)(nil),                     // 12: chat.v1.MessageTypeRevision
+	(*TextEdit)(nil),                                // 13: chat.v1.TextEdit
+	(*MessageTypeTextEdits)(nil),                    // 14: chat.v1.MessageTypeTextEdits
+	(*MessageTypeUnknown)(nil),                      // 15: chat.v1.MessageTypeUnknown
+	(*MessagePayload)(nil),                          // 16: chat.v1.MessagePayload
+	(*Message)(nil),                                 // 17: chat.v1.Message
+	(*Conversation)(nil),                            // 18: chat.v1.Conversation
+	(*ListConversationsRequest)(nil),                // 19: chat.v1.ListConversationsRequest
+	(*ListConversationsResponse)(nil),               // 20: chat.v1.ListConversationsResponse
+	(*ListConversationMessagesRequest)(nil),         // 21: chat.v1.ListConversationMessagesRequest
+	(*ListConversationMessagesResponse)(nil),        // 22: chat.v1.ListConversationMessagesResponse
+	(*SearchConversationsRequest)(nil),              // 23: chat.v1.SearchConversationsRequest
+	(*TextRange)(nil),                               // 24: chat.v1.TextRange
+	(*SearchSnippet)(nil),                           // 25: chat.v1.SearchSnippet
+	(*ConversationSearchResult)(nil),                // 26: chat.v1.ConversationSearchResult
+	(*SearchConversationsResponse)(nil),             // 27: chat.v1.SearchConversationsResponse
+	(*GetConversationRequest)(nil),                  // 28: chat.v1.GetConversationRequest
+	(*GetConversationResponse)(nil),                 // 29: chat.v1.GetConversationResponse
+	(*CreateConversationMessageRequest)(nil),        // 30: chat.v1.CreateConversationMessageRequest
+	(*CreateConversationMessageResponse)(nil),       // 31: chat.v1.CreateConversationMessageResponse
+	(*UpdateConversationRequest)(nil),               // 32: chat.v1.UpdateConversationRequest
+	(*UpdateConversationResponse)(nil),              // 33: chat.v1.UpdateConversationResponse
+	(*DeleteConversationRequest)(nil),               // 34: chat.v1.DeleteConversationRequest
+	(*DeleteConversationResponse)(nil),              // 35: chat.v1.DeleteConversationResponse
+	(*DeletedConversation)(nil),                     // 36: chat.v1.DeletedConversation
+	(*ListDeletedConversationsRequest)(nil),         // 37: chat.v1.ListDeletedConversationsRequest
+	(*ListDeletedConversationsResponse)(nil),        // 38: chat.v1.ListDeletedConversationsResponse
+	(*RestoreConversationRequest)(nil),              // 39: chat.v1.RestoreConversationRequest
+	(*RestoreConversationResponse)(nil),             // 40: chat.v1.RestoreConversationResponse
+	(*ShareConversationRequest)(nil),                // 41: chat.v1.ShareConversationRequest
+	(*ShareConversationResponse)(nil),               // 42: chat.v1.ShareConversationResponse
+	(*SharedConversation)(nil),                      // 43: chat.v1.SharedConversation
+	(*ListSharedConversationsRequest)(nil),          // 44: chat.v1.ListSharedConversationsRequest
+	(*ListSharedConversationsResponse)(nil),         // 45: chat.v1.ListSharedConversationsResponse
+	(*ApproveToolCallRequest)(nil),                  // 46: chat.v1.ApproveToolCallRequest
+	(*DenyToolCallRequest)(nil),                     // 47: chat.v1.DenyToolCallRequest
+	(*WatchToolJobsRequest)(nil),                    // 48: chat.v1.WatchToolJobsRequest
+	(*ApplyEditRequest)(nil),                        // 49: chat.v1.ApplyEditRequest
+	(*ApplyEditResponse)(nil),                       // 50: chat.v1.ApplyEditResponse
+	(*EditMessageRequest)(nil),                      // 51: chat.v1.EditMessageRequest
+	(*RegenerateMessageRequest)(nil),                // 52: chat.v1.RegenerateMessageRequest
+	(*SwitchBranchRequest)(nil),                     // 53: chat.v1.SwitchBranchRequest
+	(*SwitchBranchResponse)(nil),                    // 54: chat.v1.SwitchBranchResponse
+	(*ForkConversationRequest)(nil),                 // 55: chat.v1.ForkConversationRequest
+	(*ForkConversationResponse)(nil),                // 56: chat.v1.ForkConversationResponse
+	(*ExportConversationRequest)(nil),               // 57: chat.v1.ExportConversationRequest
+	(*ExportConversationResponse)(nil),              // 58: chat.v1.ExportConversationResponse
+	(*ImportConversationRequest)(nil),               // 59: chat.v1.ImportConversationRequest
+	(*ImportConversationResponse)(nil),              // 60: chat.v1.ImportConversationResponse
+	(*StreamInitialization)(nil),                    // 61: chat.v1.StreamInitialization
+	(*StreamPartBegin)(nil),                         // 62: chat.v1.StreamPartBegin
+	(*MessageChunk)(nil),                            // 63: chat.v1.MessageChunk
+	(*IncompleteIndicator)(nil),                     // 64: chat.v1.IncompleteIndicator
+	(*StreamPartEnd)(nil),                           // 65: chat.v1.StreamPartEnd
+	(*StreamFinalization)(nil),                      // 66: chat.v1.StreamFinalization
+	(*StreamError)(nil),                             // 67: chat.v1.StreamError
+	(*ToolCallProgress)(nil),                        // 68: chat.v1.ToolCallProgress
+	(*CreateConversationMessageStreamRequest)(nil),  // 69: chat.v1.CreateConversationMessageStreamRequest
+	(*CreateConversationMessageStreamResponse)(nil), // 70: chat.v1.CreateConversationMessageStreamResponse
+	(*timestamppb.Timestamp)(nil),                   // 71: google.protobuf.Timestamp
 }
 var file_chat_v1_chat_proto_depIdxs = []int32{
-	4,  // 0: chat.v1.MessagePayload.system:type_name -> chat.v1.MessageTypeSystem
-	6,  // 1: chat.v1.MessagePayload.user:type_name -> chat.v1.MessageTypeUser
-	5,  // 2: chat.v1.MessagePayload.assistant:type_name -> chat.v1.MessageTypeAssistant
-	3,  // 3: chat.v1.MessagePayload.tool_call_prepare_arguments:type_name -> chat.v1.MessageTypeToolCallPrepareArguments
-	2,  // 4: chat.v1.MessagePayload.tool_call:type_name -> chat.v1.MessageTypeToolCall
-	7,  // 5: chat.v1.MessagePayload.unknown:type_name -> chat.v1.MessageTypeUnknown
-	8,  // 6: chat.v1.Message.payload:type_name -> chat.v1.MessagePayload
-	0,  // 7: chat.v1.Conversation.language_model:type_name -> chat.v1.LanguageModel
-	9,  // 8: chat.v1.Conversation.messages:type_name -> chat.v1.Message
-	10, // 9: chat.v1.ListConversationsResponse.conversations:type_name -> chat.v1.Conversation
-	10, // 10: chat.v1.GetConversationResponse.conversation:type_name -> chat.v1.Conversation
-	0,  // 11: chat.v1.CreateConversationMessageRequest.language_model:type_name -> chat.v1.LanguageModel
-	1,  // 12: chat.v1.CreateConversationMessageRequest.conversation_type:type_name -> chat.v1.ConversationType
-	10, // 13: chat.v1.CreateConversationMessageResponse.conversation:type_name -> chat.v1.Conversation
-	10, // 14: chat.v1.UpdateConversationResponse.conversation:type_name -> chat.v1.Conversation
-	0,  // 15: chat.v1.StreamInitialization.language_model:type_name -> chat.v1.LanguageModel
-	8,  // 16: chat.v1.StreamPartBegin.payload:type_name -> chat.v1.MessagePayload
-	8,  // 17: chat.v1.StreamPartEnd.payload:type_name -> chat.v1.MessagePayload
-	0,  // 18: chat.v1.CreateConversationMessageStreamRequest.language_model:type_name -> chat.v1.LanguageModel
-	1,  // 19: chat.v1.CreateConversationMessageStreamRequest.conversation_type:type_name -> chat.v1.ConversationType
-	21, // 20: chat.v1.CreateConversationMessageStreamResponse.stream_initialization:type_name -> chat.v1.StreamInitialization
-	22, // 21: chat.v1.CreateConversationMessageStreamResponse.stream_part_begin:type_name -> chat.v1.StreamPartBegin
-	23, // 22: chat.v1.CreateConversationMessageStreamResponse.message_chunk:type_name -> chat.v1.MessageChunk
-	24, // 23: chat.v1.CreateConversationMessageStreamResponse.incomplete_indicator:type_name -> chat.v1.IncompleteIndicator
-	25, // 24: chat.v1.CreateConversationMessageStreamResponse.stream_part_end:type_name -> chat.v1.StreamPartEnd
-	26, // 25: chat.v1.CreateConversationMessageStreamResponse.stream_finalization:type_name -> chat.v1.StreamFinalization
-	27, // 26: chat.v1.CreateConversationMessageStreamResponse.stream_error:type_name -> chat.v1.StreamError
-	11, // 27: chat.v1.ChatService.ListConversations:input_type -> chat.v1.ListConversationsRequest
-	13, // 28: chat.v1.ChatService.GetConversation:input_type -> chat.v1.GetConversationRequest
-	15, // 29: chat.v1.ChatService.CreateConversationMessage:input_type -> chat.v1.CreateConversationMessageRequest
-	28, // 30: chat.v1.ChatService.CreateConversationMessageStream:input_type -> chat.v1.CreateConversationMessageStreamRequest
-	17, // 31: chat.v1.ChatService.UpdateConversation:input_type -> chat.v1.UpdateConversationRequest
-	19, // 32: chat.v1.ChatService.DeleteConversation:input_type -> chat.v1.DeleteConversationRequest
-	12, // 33: chat.v1.ChatService.ListConversations:output_type -> chat.v1.ListConversationsResponse
-	14, // 34: chat.v1.ChatService.GetConversation:output_type -> chat.v1.GetConversationResponse
-	16, // 35: chat.v1.ChatService.CreateConversationMessage:output_type -> chat.v1.CreateConversationMessageResponse
-	29, // 36: chat.v1.ChatService.CreateConversationMessageStream:output_type -> chat.v1.CreateConversationMessageStreamResponse
-	18, // 37: chat.v1.ChatService.UpdateConversation:output_type -> chat.v1.UpdateConversationResponse
-	20, // 38: chat.v1.ChatService.DeleteConversation:output_type -> chat.v1.DeleteConversationResponse
-	33, // [33:39] is the sub-list for method output_type
-	27, // [27:33] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	1,  // 0: chat.v1.TextEdit.status:type_name -> chat.v1.TextEditStatus
+	13, // 1: chat.v1.MessageTypeTextEdits.edits:type_name -> chat.v1.TextEdit
+	9,  // 2: chat.v1.MessagePayload.system:type_name -> chat.v1.MessageTypeSystem
+	11, // 3: chat.v1.MessagePayload.user:type_name -> chat.v1.MessageTypeUser
+	10, // 4: chat.v1.MessagePayload.assistant:type_name -> chat.v1.MessageTypeAssistant
+	7,  // 5: chat.v1.MessagePayload.tool_call_prepare_arguments:type_name -> chat.v1.MessageTypeToolCallPrepareArguments
+	6,  // 6: chat.v1.MessagePayload.tool_call:type_name -> chat.v1.MessageTypeToolCall
+	15, // 7: chat.v1.MessagePayload.unknown:type_name -> chat.v1.MessageTypeUnknown
+	8,  // 8: chat.v1.MessagePayload.tool_call_approval_required:type_name -> chat.v1.MessageTypeToolCallApprovalRequired
+	12, // 9: chat.v1.MessagePayload.revision:type_name -> chat.v1.MessageTypeRevision
+	14, // 10: chat.v1.MessagePayload.text_edits:type_name -> chat.v1.MessageTypeTextEdits
+	16, // 11: chat.v1.Message.payload:type_name -> chat.v1.MessagePayload
+	0,  // 12: chat.v1.Conversation.language_model:type_name -> chat.v1.LanguageModel
+	17, // 13: chat.v1.Conversation.messages:type_name -> chat.v1.Message
+	4,  // 14: chat.v1.Conversation.conversation_type:type_name -> chat.v1.ConversationType
+	18, // 15: chat.v1.ListConversationsResponse.conversations:type_name -> chat.v1.Conversation
+	17, // 16: chat.v1.ListConversationMessagesResponse.messages:type_name -> chat.v1.Message
+	0,  // 17: chat.v1.SearchConversationsRequest.language_model:type_name -> chat.v1.LanguageModel
+	71, // 18: chat.v1.SearchConversationsRequest.updated_after:type_name -> google.protobuf.Timestamp
+	71, // 19: chat.v1.SearchConversationsRequest.updated_before:type_name -> google.protobuf.Timestamp
+	24, // 20: chat.v1.SearchSnippet.highlights:type_name -> chat.v1.TextRange
+	18, // 21: chat.v1.ConversationSearchResult.conversation:type_name -> chat.v1.Conversation
+	71, // 22: chat.v1.ConversationSearchResult.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 23: chat.v1.ConversationSearchResult.snippets:type_name -> chat.v1.SearchSnippet
+	26, // 24: chat.v1.SearchConversationsResponse.results:type_name -> chat.v1.ConversationSearchResult
+	18, // 25: chat.v1.GetConversationResponse.conversation:type_name -> chat.v1.Conversation
+	0,  // 26: chat.v1.CreateConversationMessageRequest.language_model:type_name -> chat.v1.LanguageModel
+	4,  // 27: chat.v1.CreateConversationMessageRequest.conversation_type:type_name -> chat.v1.ConversationType
+	5,  // 28: chat.v1.CreateConversationMessageRequest.response_mode:type_name -> chat.v1.ResponseMode
+	18, // 29: chat.v1.CreateConversationMessageResponse.conversation:type_name -> chat.v1.Conversation
+	18, // 30: chat.v1.UpdateConversationResponse.conversation:type_name -> chat.v1.Conversation
+	18, // 31: chat.v1.DeletedConversation.conversation:type_name -> chat.v1.Conversation
+	71, // 32: chat.v1.DeletedConversation.deleted_at:type_name -> google.protobuf.Timestamp
+	71, // 33: chat.v1.DeletedConversation.purge_at:type_name -> google.protobuf.Timestamp
+	36, // 34: chat.v1.ListDeletedConversationsResponse.conversations:type_name -> chat.v1.DeletedConversation
+	18, // 35: chat.v1.RestoreConversationResponse.conversation:type_name -> chat.v1.Conversation
+	18, // 36: chat.v1.ShareConversationResponse.conversation:type_name -> chat.v1.Conversation
+	18, // 37: chat.v1.SharedConversation.conversation:type_name -> chat.v1.Conversation
+	71, // 38: chat.v1.SharedConversation.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 39: chat.v1.ListSharedConversationsResponse.conversations:type_name -> chat.v1.SharedConversation
+	13, // 40: chat.v1.ApplyEditResponse.edit:type_name -> chat.v1.TextEdit
+	4,  // 41: chat.v1.EditMessageRequest.conversation_type:type_name -> chat.v1.ConversationType
+	5,  // 42: chat.v1.EditMessageRequest.response_mode:type_name -> chat.v1.ResponseMode
+	5,  // 43: chat.v1.RegenerateMessageRequest.response_mode:type_name -> chat.v1.ResponseMode
+	18, // 44: chat.v1.SwitchBranchResponse.conversation:type_name -> chat.v1.Conversation
+	18, // 45: chat.v1.ForkConversationResponse.conversation:type_name -> chat.v1.Conversation
+	2,  // 46: chat.v1.ExportConversationRequest.format:type_name -> chat.v1.ConversationExportFormat
+	18, // 47: chat.v1.ImportConversationResponse.conversation:type_name -> chat.v1.Conversation
+	0,  // 48: chat.v1.StreamInitialization.language_model:type_name -> chat.v1.LanguageModel
+	16, // 49: chat.v1.StreamPartBegin.payload:type_name -> chat.v1.MessagePayload
+	16, // 50: chat.v1.StreamPartEnd.payload:type_name -> chat.v1.MessagePayload
+	3,  // 51: chat.v1.ToolCallProgress.status:type_name -> chat.v1.ToolJobStatus
+	0,  // 52: chat.v1.CreateConversationMessageStreamRequest.language_model:type_name -> chat.v1.LanguageModel
+	4,  // 53: chat.v1.CreateConversationMessageStreamRequest.conversation_type:type_name -> chat.v1.ConversationType
+	5,  // 54: chat.v1.CreateConversationMessageStreamRequest.response_mode:type_name -> chat.v1.ResponseMode
+	61, // 55: chat.v1.CreateConversationMessageStreamResponse.stream_initialization:type_name -> chat.v1.StreamInitialization
+	62, // 56: chat.v1.CreateConversationMessageStreamResponse.stream_part_begin:type_name -> chat.v1.StreamPartBegin
+	63, // 57: chat.v1.CreateConversationMessageStreamResponse.message_chunk:type_name -> chat.v1.MessageChunk
+	64, // 58: chat.v1.CreateConversationMessageStreamResponse.incomplete_indicator:type_name -> chat.v1.IncompleteIndicator
+	65, // 59: chat.v1.CreateConversationMessageStreamResponse.stream_part_end:type_name -> chat.v1.StreamPartEnd
+	66, // 60: chat.v1.CreateConversationMessageStreamResponse.stream_finalization:type_name -> chat.v1.StreamFinalization
+	67, // 61: chat.v1.CreateConversationMessageStreamResponse.stream_error:type_name -> chat.v1.StreamError
+	68, // 62: chat.v1.CreateConversationMessageStreamResponse.tool_call_progress:type_name -> chat.v1.ToolCallProgress
+	19, // 63: chat.v1.ChatService.ListConversations:input_type -> chat.v1.ListConversationsRequest
+	23, // 64: chat.v1.ChatService.SearchConversations:input_type -> chat.v1.SearchConversationsRequest
+	21, // 65: chat.v1.ChatService.ListConversationMessages:input_type -> chat.v1.ListConversationMessagesRequest
+	28, // 66: chat.v1.ChatService.GetConversation:input_type -> chat.v1.GetConversationRequest
+	30, // 67: chat.v1.ChatService.CreateConversationMessage:input_type -> chat.v1.CreateConversationMessageRequest
+	69, // 68: chat.v1.ChatService.CreateConversationMessageStream:input_type -> chat.v1.CreateConversationMessageStreamRequest
+	32, // 69: chat.v1.ChatService.UpdateConversation:input_type -> chat.v1.UpdateConversationRequest
+	34, // 70: chat.v1.ChatService.DeleteConversation:input_type -> chat.v1.DeleteConversationRequest
+	37, // 71: chat.v1.ChatService.ListDeletedConversations:input_type -> chat.v1.ListDeletedConversationsRequest
+	39, // 72: chat.v1.ChatService.RestoreConversation:input_type -> chat.v1.RestoreConversationRequest
+	41, // 73: chat.v1.ChatService.ShareConversation:input_type -> chat.v1.ShareConversationRequest
+	44, // 74: chat.v1.ChatService.ListSharedConversations:input_type -> chat.v1.ListSharedConversationsRequest
+	46, // 75: chat.v1.ChatService.ApproveToolCall:input_type -> chat.v1.ApproveToolCallRequest
+	47, // 76: chat.v1.ChatService.DenyToolCall:input_type -> chat.v1.DenyToolCallRequest
+	48, // 77: chat.v1.ChatService.WatchToolJobs:input_type -> chat.v1.WatchToolJobsRequest
+	49, // 78: chat.v1.ChatService.ApplyEdit:input_type -> chat.v1.ApplyEditRequest
+	51, // 79: chat.v1.ChatService.EditMessage:input_type -> chat.v1.EditMessageRequest
+	52, // 80: chat.v1.ChatService.RegenerateMessage:input_type -> chat.v1.RegenerateMessageRequest
+	53, // 81: chat.v1.ChatService.SwitchBranch:input_type -> chat.v1.SwitchBranchRequest
+	55, // 82: chat.v1.ChatService.ForkConversation:input_type -> chat.v1.ForkConversationRequest
+	57, // 83: chat.v1.ChatService.ExportConversation:input_type -> chat.v1.ExportConversationRequest
+	59, // 84: chat.v1.ChatService.ImportConversation:input_type -> chat.v1.ImportConversationRequest
+	20, // 85: chat.v1.ChatService.ListConversations:output_type -> chat.v1.ListConversationsResponse
+	27, // 86: chat.v1.ChatService.SearchConversations:output_type -> chat.v1.SearchConversationsResponse
+	22, // 87: chat.v1.ChatService.ListConversationMessages:output_type -> chat.v1.ListConversationMessagesResponse
+	29, // 88: chat.v1.ChatService.GetConversation:output_type -> chat.v1.GetConversationResponse
+	31, // 89: chat.v1.ChatService.CreateConversationMessage:output_type -> chat.v1.CreateConversationMessageResponse
+	70, // 90: chat.v1.ChatService.CreateConversationMessageStream:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	33, // 91: chat.v1.ChatService.UpdateConversation:output_type -> chat.v1.UpdateConversationResponse
+	35, // 92: chat.v1.ChatService.DeleteConversation:output_type -> chat.v1.DeleteConversationResponse
+	38, // 93: chat.v1.ChatService.ListDeletedConversations:output_type -> chat.v1.ListDeletedConversationsResponse
+	40, // 94: chat.v1.ChatService.RestoreConversation:output_type -> chat.v1.RestoreConversationResponse
+	42, // 95: chat.v1.ChatService.ShareConversation:output_type -> chat.v1.ShareConversationResponse
+	45, // 96: chat.v1.ChatService.ListSharedConversations:output_type -> chat.v1.ListSharedConversationsResponse
+	70, // 97: chat.v1.ChatService.ApproveToolCall:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	70, // 98: chat.v1.ChatService.DenyToolCall:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	70, // 99: chat.v1.ChatService.WatchToolJobs:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	50, // 100: chat.v1.ChatService.ApplyEdit:output_type -> chat.v1.ApplyEditResponse
+	70, // 101: chat.v1.ChatService.EditMessage:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	70, // 102: chat.v1.ChatService.RegenerateMessage:output_type -> chat.v1.CreateConversationMessageStreamResponse
+	54, // 103: chat.v1.ChatService.SwitchBranch:output_type -> chat.v1.SwitchBranchResponse
+	56, // 104: chat.v1.ChatService.ForkConversation:output_type -> chat.v1.ForkConversationResponse
+	58, // 105: chat.v1.ChatService.ExportConversation:output_type -> chat.v1.ExportConversationResponse
+	60, // 106: chat.v1.ChatService.ImportConversation:output_type -> chat.v1.ImportConversationResponse
+	85, // [85:107] is the sub-list for method output_type
+	63, // [63:85] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_chat_proto_init() }
@@ -2031,19 +4935,32 @@ func file_chat_v1_chat_proto_init() {
 	if File_chat_v1_chat_proto != nil {
 		return
 	}
-	file_chat_v1_chat_proto_msgTypes[4].OneofWrappers = []any{}
-	file_chat_v1_chat_proto_msgTypes[6].OneofWrappers = []any{
+	file_chat_v1_chat_proto_msgTypes[5].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[10].OneofWrappers = []any{
 		(*MessagePayload_System)(nil),
 		(*MessagePayload_User)(nil),
 		(*MessagePayload_Assistant)(nil),
 		(*MessagePayload_ToolCallPrepareArguments)(nil),
 		(*MessagePayload_ToolCall)(nil),
 		(*MessagePayload_Unknown)(nil),
+		(*MessagePayload_ToolCallApprovalRequired)(nil),
+		(*MessagePayload_Revision)(nil),
+		(*MessagePayload_TextEdits)(nil),
 	}
-	file_chat_v1_chat_proto_msgTypes[9].OneofWrappers = []any{}
 	file_chat_v1_chat_proto_msgTypes[13].OneofWrappers = []any{}
-	file_chat_v1_chat_proto_msgTypes[26].OneofWrappers = []any{}
-	file_chat_v1_chat_proto_msgTypes[27].OneofWrappers = []any{
+	file_chat_v1_chat_proto_msgTypes[14].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[15].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[16].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[17].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[24].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[31].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[41].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[45].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[46].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[49].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[62].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[63].OneofWrappers = []any{}
+	file_chat_v1_chat_proto_msgTypes[64].OneofWrappers = []any{
 		(*CreateConversationMessageStreamResponse_StreamInitialization)(nil),
 		(*CreateConversationMessageStreamResponse_StreamPartBegin)(nil),
 		(*CreateConversationMessageStreamResponse_MessageChunk)(nil),
@@ -2051,14 +4968,15 @@ func file_chat_v1_chat_proto_init() {
 		(*CreateConversationMessageStreamResponse_StreamPartEnd)(nil),
 		(*CreateConversationMessageStreamResponse_StreamFinalization)(nil),
 		(*CreateConversationMessageStreamResponse_StreamError)(nil),
+		(*CreateConversationMessageStreamResponse_ToolCallProgress)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_v1_chat_proto_rawDesc), len(file_chat_v1_chat_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   28,
+			NumEnums:      6,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
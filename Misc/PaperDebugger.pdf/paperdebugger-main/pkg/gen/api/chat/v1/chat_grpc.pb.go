@@ -20,11 +20,27 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ChatService_ListConversations_FullMethodName               = "/chat.v1.ChatService/ListConversations"
+	ChatService_SearchConversations_FullMethodName             = "/chat.v1.ChatService/SearchConversations"
+	ChatService_ListConversationMessages_FullMethodName        = "/chat.v1.ChatService/ListConversationMessages"
 	ChatService_GetConversation_FullMethodName                 = "/chat.v1.ChatService/GetConversation"
 	ChatService_CreateConversationMessage_FullMethodName       = "/chat.v1.ChatService/CreateConversationMessage"
 	ChatService_CreateConversationMessageStream_FullMethodName = "/chat.v1.ChatService/CreateConversationMessageStream"
 	ChatService_UpdateConversation_FullMethodName              = "/chat.v1.ChatService/UpdateConversation"
 	ChatService_DeleteConversation_FullMethodName              = "/chat.v1.ChatService/DeleteConversation"
+	ChatService_ListDeletedConversations_FullMethodName        = "/chat.v1.ChatService/ListDeletedConversations"
+	ChatService_RestoreConversation_FullMethodName             = "/chat.v1.ChatService/RestoreConversation"
+	ChatService_ShareConversation_FullMethodName               = "/chat.v1.ChatService/ShareConversation"
+	ChatService_ListSharedConversations_FullMethodName         = "/chat.v1.ChatService/ListSharedConversations"
+	ChatService_ApproveToolCall_FullMethodName                 = "/chat.v1.ChatService/ApproveToolCall"
+	ChatService_DenyToolCall_FullMethodName                    = "/chat.v1.ChatService/DenyToolCall"
+	ChatService_WatchToolJobs_FullMethodName                   = "/chat.v1.ChatService/WatchToolJobs"
+	ChatService_ApplyEdit_FullMethodName                       = "/chat.v1.ChatService/ApplyEdit"
+	ChatService_EditMessage_FullMethodName                     = "/chat.v1.ChatService/EditMessage"
+	ChatService_RegenerateMessage_FullMethodName               = "/chat.v1.ChatService/RegenerateMessage"
+	ChatService_SwitchBranch_FullMethodName                    = "/chat.v1.ChatService/SwitchBranch"
+	ChatService_ForkConversation_FullMethodName                = "/chat.v1.ChatService/ForkConversation"
+	ChatService_ExportConversation_FullMethodName              = "/chat.v1.ChatService/ExportConversation"
+	ChatService_ImportConversation_FullMethodName              = "/chat.v1.ChatService/ImportConversation"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -32,11 +48,46 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChatServiceClient interface {
 	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	// Finds the conversations of the user whose title or messages match a full-text query.
+	SearchConversations(ctx context.Context, in *SearchConversationsRequest, opts ...grpc.CallOption) (*SearchConversationsResponse, error)
+	// Returns the messages of a conversation page by page, from the last one back.
+	ListConversationMessages(ctx context.Context, in *ListConversationMessagesRequest, opts ...grpc.CallOption) (*ListConversationMessagesResponse, error)
 	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
 	CreateConversationMessage(ctx context.Context, in *CreateConversationMessageRequest, opts ...grpc.CallOption) (*CreateConversationMessageResponse, error)
 	CreateConversationMessageStream(ctx context.Context, in *CreateConversationMessageStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
 	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error)
 	DeleteConversation(ctx context.Context, in *DeleteConversationRequest, opts ...grpc.CallOption) (*DeleteConversationResponse, error)
+	// Lists the deleted conversations, they can be restored until they are deleted for good.
+	ListDeletedConversations(ctx context.Context, in *ListDeletedConversationsRequest, opts ...grpc.CallOption) (*ListDeletedConversationsResponse, error)
+	RestoreConversation(ctx context.Context, in *RestoreConversationRequest, opts ...grpc.CallOption) (*RestoreConversationResponse, error)
+	// Shares a conversation with the members of its project, or stops sharing it.
+	ShareConversation(ctx context.Context, in *ShareConversationRequest, opts ...grpc.CallOption) (*ShareConversationResponse, error)
+	// Lists the conversations the other members shared in the project, they can be read and forked.
+	ListSharedConversations(ctx context.Context, in *ListSharedConversationsRequest, opts ...grpc.CallOption) (*ListSharedConversationsResponse, error)
+	// Runs a tool call that is awaiting approval and resumes the conversation.
+	ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
+	// Refuses a tool call that is awaiting approval, the model is told that the user denied it.
+	DenyToolCall(ctx context.Context, in *DenyToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
+	// Streams the progress of the background tool jobs of a conversation, until all of them are done
+	// and their results were added to the conversation.
+	WatchToolJobs(ctx context.Context, in *WatchToolJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
+	// Records that the user applied (accepted) or rejected a proposed text edit.
+	ApplyEdit(ctx context.Context, in *ApplyEditRequest, opts ...grpc.CallOption) (*ApplyEditResponse, error)
+	// Answers a new version of a past user message, the messages that followed the old version stay
+	// on their own branch of the conversation.
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
+	// Answers a past user message again, on a new branch of the conversation.
+	RegenerateMessage(ctx context.Context, in *RegenerateMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error)
+	// Shows the branch of a user message, with the messages last shown after it.
+	SwitchBranch(ctx context.Context, in *SwitchBranchRequest, opts ...grpc.CallOption) (*SwitchBranchResponse, error)
+	// Copies the shown branch of a conversation up to a message into a new conversation, optionally
+	// in another project.
+	ForkConversation(ctx context.Context, in *ForkConversationRequest, opts ...grpc.CallOption) (*ForkConversationResponse, error)
+	// Exports a conversation as Markdown, as a lossless JSON file that ImportConversation restores,
+	// or as the input of the OpenAI Responses API.
+	ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (*ExportConversationResponse, error)
+	// Restores a conversation from its JSON export into a project.
+	ImportConversation(ctx context.Context, in *ImportConversationRequest, opts ...grpc.CallOption) (*ImportConversationResponse, error)
 }
 
 type chatServiceClient struct {
@@ -57,6 +108,26 @@ func (c *chatServiceClient) ListConversations(ctx context.Context, in *ListConve
 	return out, nil
 }
 
+func (c *chatServiceClient) SearchConversations(ctx context.Context, in *SearchConversationsRequest, opts ...grpc.CallOption) (*SearchConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchConversationsResponse)
+	err := c.cc.Invoke(ctx, ChatService_SearchConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListConversationMessages(ctx context.Context, in *ListConversationMessagesRequest, opts ...grpc.CallOption) (*ListConversationMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListConversationMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetConversationResponse)
@@ -116,16 +187,236 @@ func (c *chatServiceClient) DeleteConversation(ctx context.Context, in *DeleteCo
 	return out, nil
 }
 
+func (c *chatServiceClient) ListDeletedConversations(ctx context.Context, in *ListDeletedConversationsRequest, opts ...grpc.CallOption) (*ListDeletedConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedConversationsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListDeletedConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RestoreConversation(ctx context.Context, in *RestoreConversationRequest, opts ...grpc.CallOption) (*RestoreConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_RestoreConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ShareConversation(ctx context.Context, in *ShareConversationRequest, opts ...grpc.CallOption) (*ShareConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_ShareConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListSharedConversations(ctx context.Context, in *ListSharedConversationsRequest, opts ...grpc.CallOption) (*ListSharedConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedConversationsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListSharedConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[1], ChatService_ApproveToolCall_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ApproveToolCallRequest, CreateConversationMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_ApproveToolCallClient = grpc.ServerStreamingClient[CreateConversationMessageStreamResponse]
+
+func (c *chatServiceClient) DenyToolCall(ctx context.Context, in *DenyToolCallRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[2], ChatService_DenyToolCall_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DenyToolCallRequest, CreateConversationMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_DenyToolCallClient = grpc.ServerStreamingClient[CreateConversationMessageStreamResponse]
+
+func (c *chatServiceClient) WatchToolJobs(ctx context.Context, in *WatchToolJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[3], ChatService_WatchToolJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchToolJobsRequest, CreateConversationMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_WatchToolJobsClient = grpc.ServerStreamingClient[CreateConversationMessageStreamResponse]
+
+func (c *chatServiceClient) ApplyEdit(ctx context.Context, in *ApplyEditRequest, opts ...grpc.CallOption) (*ApplyEditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyEditResponse)
+	err := c.cc.Invoke(ctx, ChatService_ApplyEdit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[4], ChatService_EditMessage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EditMessageRequest, CreateConversationMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_EditMessageClient = grpc.ServerStreamingClient[CreateConversationMessageStreamResponse]
+
+func (c *chatServiceClient) RegenerateMessage(ctx context.Context, in *RegenerateMessageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CreateConversationMessageStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[5], ChatService_RegenerateMessage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RegenerateMessageRequest, CreateConversationMessageStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_RegenerateMessageClient = grpc.ServerStreamingClient[CreateConversationMessageStreamResponse]
+
+func (c *chatServiceClient) SwitchBranch(ctx context.Context, in *SwitchBranchRequest, opts ...grpc.CallOption) (*SwitchBranchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwitchBranchResponse)
+	err := c.cc.Invoke(ctx, ChatService_SwitchBranch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ForkConversation(ctx context.Context, in *ForkConversationRequest, opts ...grpc.CallOption) (*ForkConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForkConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_ForkConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ExportConversation(ctx context.Context, in *ExportConversationRequest, opts ...grpc.CallOption) (*ExportConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_ExportConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ImportConversation(ctx context.Context, in *ImportConversationRequest, opts ...grpc.CallOption) (*ImportConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_ImportConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
 type ChatServiceServer interface {
 	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	// Finds the conversations of the user whose title or messages match a full-text query.
+	SearchConversations(context.Context, *SearchConversationsRequest) (*SearchConversationsResponse, error)
+	// Returns the messages of a conversation page by page, from the last one back.
+	ListConversationMessages(context.Context, *ListConversationMessagesRequest) (*ListConversationMessagesResponse, error)
 	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
 	CreateConversationMessage(context.Context, *CreateConversationMessageRequest) (*CreateConversationMessageResponse, error)
 	CreateConversationMessageStream(*CreateConversationMessageStreamRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
 	UpdateConversation(context.Context, *UpdateConversationRequest) (*UpdateConversationResponse, error)
 	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+	// Lists the deleted conversations, they can be restored until they are deleted for good.
+	ListDeletedConversations(context.Context, *ListDeletedConversationsRequest) (*ListDeletedConversationsResponse, error)
+	RestoreConversation(context.Context, *RestoreConversationRequest) (*RestoreConversationResponse, error)
+	// Shares a conversation with the members of its project, or stops sharing it.
+	ShareConversation(context.Context, *ShareConversationRequest) (*ShareConversationResponse, error)
+	// Lists the conversations the other members shared in the project, they can be read and forked.
+	ListSharedConversations(context.Context, *ListSharedConversationsRequest) (*ListSharedConversationsResponse, error)
+	// Runs a tool call that is awaiting approval and resumes the conversation.
+	ApproveToolCall(*ApproveToolCallRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
+	// Refuses a tool call that is awaiting approval, the model is told that the user denied it.
+	DenyToolCall(*DenyToolCallRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
+	// Streams the progress of the background tool jobs of a conversation, until all of them are done
+	// and their results were added to the conversation.
+	WatchToolJobs(*WatchToolJobsRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
+	// Records that the user applied (accepted) or rejected a proposed text edit.
+	ApplyEdit(context.Context, *ApplyEditRequest) (*ApplyEditResponse, error)
+	// Answers a new version of a past user message, the messages that followed the old version stay
+	// on their own branch of the conversation.
+	EditMessage(*EditMessageRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
+	// Answers a past user message again, on a new branch of the conversation.
+	RegenerateMessage(*RegenerateMessageRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error
+	// Shows the branch of a user message, with the messages last shown after it.
+	SwitchBranch(context.Context, *SwitchBranchRequest) (*SwitchBranchResponse, error)
+	// Copies the shown branch of a conversation up to a message into a new conversation, optionally
+	// in another project.
+	ForkConversation(context.Context, *ForkConversationRequest) (*ForkConversationResponse, error)
+	// Exports a conversation as Markdown, as a lossless JSON file that ImportConversation restores,
+	// or as the input of the OpenAI Responses API.
+	ExportConversation(context.Context, *ExportConversationRequest) (*ExportConversationResponse, error)
+	// Restores a conversation from its JSON export into a project.
+	ImportConversation(context.Context, *ImportConversationRequest) (*ImportConversationResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -139,6 +430,12 @@ type UnimplementedChatServiceServer struct{}
 func (UnimplementedChatServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
 }
+func (UnimplementedChatServiceServer) SearchConversations(context.Context, *SearchConversationsRequest) (*SearchConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchConversations not implemented")
+}
+func (UnimplementedChatServiceServer) ListConversationMessages(context.Context, *ListConversationMessagesRequest) (*ListConversationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversationMessages not implemented")
+}
 func (UnimplementedChatServiceServer) GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
 }
@@ -154,6 +451,48 @@ func (UnimplementedChatServiceServer) UpdateConversation(context.Context, *Updat
 func (UnimplementedChatServiceServer) DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversation not implemented")
 }
+func (UnimplementedChatServiceServer) ListDeletedConversations(context.Context, *ListDeletedConversationsRequest) (*ListDeletedConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedConversations not implemented")
+}
+func (UnimplementedChatServiceServer) RestoreConversation(context.Context, *RestoreConversationRequest) (*RestoreConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ShareConversation(context.Context, *ShareConversationRequest) (*ShareConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ListSharedConversations(context.Context, *ListSharedConversationsRequest) (*ListSharedConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedConversations not implemented")
+}
+func (UnimplementedChatServiceServer) ApproveToolCall(*ApproveToolCallRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ApproveToolCall not implemented")
+}
+func (UnimplementedChatServiceServer) DenyToolCall(*DenyToolCallRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DenyToolCall not implemented")
+}
+func (UnimplementedChatServiceServer) WatchToolJobs(*WatchToolJobsRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchToolJobs not implemented")
+}
+func (UnimplementedChatServiceServer) ApplyEdit(context.Context, *ApplyEditRequest) (*ApplyEditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyEdit not implemented")
+}
+func (UnimplementedChatServiceServer) EditMessage(*EditMessageRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatServiceServer) RegenerateMessage(*RegenerateMessageRequest, grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RegenerateMessage not implemented")
+}
+func (UnimplementedChatServiceServer) SwitchBranch(context.Context, *SwitchBranchRequest) (*SwitchBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchBranch not implemented")
+}
+func (UnimplementedChatServiceServer) ForkConversation(context.Context, *ForkConversationRequest) (*ForkConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ExportConversation(context.Context, *ExportConversationRequest) (*ExportConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ImportConversation(context.Context, *ImportConversationRequest) (*ImportConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConversation not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -193,6 +532,42 @@ func _ChatService_ListConversations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_SearchConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SearchConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SearchConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SearchConversations(ctx, req.(*SearchConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListConversationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListConversationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListConversationMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListConversationMessages(ctx, req.(*ListConversationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChatService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConversationRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +651,223 @@ func _ChatService_DeleteConversation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_ListDeletedConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListDeletedConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListDeletedConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListDeletedConversations(ctx, req.(*ListDeletedConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RestoreConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RestoreConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RestoreConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RestoreConversation(ctx, req.(*RestoreConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ShareConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ShareConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ShareConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ShareConversation(ctx, req.(*ShareConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListSharedConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListSharedConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListSharedConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListSharedConversations(ctx, req.(*ListSharedConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ApproveToolCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApproveToolCallRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).ApproveToolCall(m, &grpc.GenericServerStream[ApproveToolCallRequest, CreateConversationMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_ApproveToolCallServer = grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]
+
+func _ChatService_DenyToolCall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DenyToolCallRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).DenyToolCall(m, &grpc.GenericServerStream[DenyToolCallRequest, CreateConversationMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_DenyToolCallServer = grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]
+
+func _ChatService_WatchToolJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchToolJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).WatchToolJobs(m, &grpc.GenericServerStream[WatchToolJobsRequest, CreateConversationMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_WatchToolJobsServer = grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]
+
+func _ChatService_ApplyEdit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyEditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ApplyEdit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ApplyEdit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ApplyEdit(ctx, req.(*ApplyEditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_EditMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EditMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).EditMessage(m, &grpc.GenericServerStream[EditMessageRequest, CreateConversationMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_EditMessageServer = grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]
+
+func _ChatService_RegenerateMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegenerateMessageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).RegenerateMessage(m, &grpc.GenericServerStream[RegenerateMessageRequest, CreateConversationMessageStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_RegenerateMessageServer = grpc.ServerStreamingServer[CreateConversationMessageStreamResponse]
+
+func _ChatService_SwitchBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SwitchBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SwitchBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SwitchBranch(ctx, req.(*SwitchBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ForkConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ForkConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ForkConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ForkConversation(ctx, req.(*ForkConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ExportConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ExportConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ExportConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ExportConversation(ctx, req.(*ExportConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ImportConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ImportConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ImportConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ImportConversation(ctx, req.(*ImportConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -287,6 +879,14 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListConversations",
 			Handler:    _ChatService_ListConversations_Handler,
 		},
+		{
+			MethodName: "SearchConversations",
+			Handler:    _ChatService_SearchConversations_Handler,
+		},
+		{
+			MethodName: "ListConversationMessages",
+			Handler:    _ChatService_ListConversationMessages_Handler,
+		},
 		{
 			MethodName: "GetConversation",
 			Handler:    _ChatService_GetConversation_Handler,
@@ -303,6 +903,42 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConversation",
 			Handler:    _ChatService_DeleteConversation_Handler,
 		},
+		{
+			MethodName: "ListDeletedConversations",
+			Handler:    _ChatService_ListDeletedConversations_Handler,
+		},
+		{
+			MethodName: "RestoreConversation",
+			Handler:    _ChatService_RestoreConversation_Handler,
+		},
+		{
+			MethodName: "ShareConversation",
+			Handler:    _ChatService_ShareConversation_Handler,
+		},
+		{
+			MethodName: "ListSharedConversations",
+			Handler:    _ChatService_ListSharedConversations_Handler,
+		},
+		{
+			MethodName: "ApplyEdit",
+			Handler:    _ChatService_ApplyEdit_Handler,
+		},
+		{
+			MethodName: "SwitchBranch",
+			Handler:    _ChatService_SwitchBranch_Handler,
+		},
+		{
+			MethodName: "ForkConversation",
+			Handler:    _ChatService_ForkConversation_Handler,
+		},
+		{
+			MethodName: "ExportConversation",
+			Handler:    _ChatService_ExportConversation_Handler,
+		},
+		{
+			MethodName: "ImportConversation",
+			Handler:    _ChatService_ImportConversation_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -310,6 +946,31 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ChatService_CreateConversationMessageStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ApproveToolCall",
+			Handler:       _ChatService_ApproveToolCall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DenyToolCall",
+			Handler:       _ChatService_DenyToolCall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchToolJobs",
+			Handler:       _ChatService_WatchToolJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EditMessage",
+			Handler:       _ChatService_EditMessage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegenerateMessage",
+			Handler:       _ChatService_RegenerateMessage_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "chat/v1/chat.proto",
 }
@@ -23,16 +23,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Members
+type ProjectRole int32
+
+const (
+	ProjectRole_PROJECT_ROLE_UNSPECIFIED ProjectRole = 0 // not a member
+	ProjectRole_PROJECT_ROLE_OWNER       ProjectRole = 1 // manages the members
+	ProjectRole_PROJECT_ROLE_EDITOR      ProjectRole = 2 // shares conversations and accepts the comments of shared ones
+	ProjectRole_PROJECT_ROLE_VIEWER      ProjectRole = 3 // reads the shared conversations
+)
+
+// Enum value maps for ProjectRole.
+var (
+	ProjectRole_name = map[int32]string{
+		0: "PROJECT_ROLE_UNSPECIFIED",
+		1: "PROJECT_ROLE_OWNER",
+		2: "PROJECT_ROLE_EDITOR",
+		3: "PROJECT_ROLE_VIEWER",
+	}
+	ProjectRole_value = map[string]int32{
+		"PROJECT_ROLE_UNSPECIFIED": 0,
+		"PROJECT_ROLE_OWNER":       1,
+		"PROJECT_ROLE_EDITOR":      2,
+		"PROJECT_ROLE_VIEWER":      3,
+	}
+)
+
+func (x ProjectRole) Enum() *ProjectRole {
+	p := new(ProjectRole)
+	*p = x
+	return p
+}
+
+func (x ProjectRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_project_v1_project_proto_enumTypes[0].Descriptor()
+}
+
+func (ProjectRole) Type() protoreflect.EnumType {
+	return &file_project_v1_project_proto_enumTypes[0]
+}
+
+func (x ProjectRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectRole.Descriptor instead.
+func (ProjectRole) EnumDescriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	RootDocId     string                 `protobuf:"bytes,5,opt,name=root_doc_id,json=rootDocId,proto3" json:"root_doc_id,omitempty"`
-	Docs          []*ProjectDoc          `protobuf:"bytes,6,rep,name=docs,proto3" json:"docs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	RootDocId string                 `protobuf:"bytes,5,opt,name=root_doc_id,json=rootDocId,proto3" json:"root_doc_id,omitempty"`
+	Docs      []*ProjectDoc          `protobuf:"bytes,6,rep,name=docs,proto3" json:"docs,omitempty"`
+	// The persona of the new conversations: "reviewer", "translator", "proofreader" or
+	// "rebuttal_writer", empty for the default one.
+	DefaultPersona string `protobuf:"bytes,7,opt,name=default_persona,json=defaultPersona,proto3" json:"default_persona,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -107,6 +163,13 @@ func (x *Project) GetDocs() []*ProjectDoc {
 	return nil
 }
 
+func (x *Project) GetDefaultPersona() string {
+	if x != nil {
+		return x.DefaultPersona
+	}
+	return ""
+}
+
 type ProjectDoc struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -432,6 +495,8 @@ type RunProjectPaperScoreResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	PaperScore    *PaperScoreResult      `protobuf:"bytes,2,opt,name=paper_score,json=paperScore,proto3" json:"paper_score,omitempty"`
+	Record        *PaperScoreRecord      `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+	Reused        bool                   `protobuf:"varint,4,opt,name=reused,proto3" json:"reused,omitempty"` // the paper did not change since its last score, which is returned
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -480,6 +545,201 @@ func (x *RunProjectPaperScoreResponse) GetPaperScore() *PaperScoreResult {
 	return nil
 }
 
+func (x *RunProjectPaperScoreResponse) GetRecord() *PaperScoreRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *RunProjectPaperScoreResponse) GetReused() bool {
+	if x != nil {
+		return x.Reused
+	}
+	return false
+}
+
+// PaperScoreRecord is a score of the project, kept to follow the score across revisions.
+type PaperScoreRecord struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaperScore     *PaperScoreResult      `protobuf:"bytes,3,opt,name=paper_score,json=paperScore,proto3" json:"paper_score,omitempty"`
+	Category       string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	ContentHash    string                 `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`          // sha256 of the scored content, the same for unchanged revisions
+	ConversationId string                 `protobuf:"bytes,6,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // if it was requested from a conversation
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaperScoreRecord) Reset() {
+	*x = PaperScoreRecord{}
+	mi := &file_project_v1_project_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaperScoreRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaperScoreRecord) ProtoMessage() {}
+
+func (x *PaperScoreRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaperScoreRecord.ProtoReflect.Descriptor instead.
+func (*PaperScoreRecord) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PaperScoreRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaperScoreRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PaperScoreRecord) GetPaperScore() *PaperScoreResult {
+	if x != nil {
+		return x.PaperScore
+	}
+	return nil
+}
+
+func (x *PaperScoreRecord) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PaperScoreRecord) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *PaperScoreRecord) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ListProjectPaperScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectPaperScoresRequest) Reset() {
+	*x = ListProjectPaperScoresRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectPaperScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectPaperScoresRequest) ProtoMessage() {}
+
+func (x *ListProjectPaperScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectPaperScoresRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectPaperScoresRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProjectPaperScoresRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListProjectPaperScoresRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListProjectPaperScoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaperScores   []*PaperScoreRecord    `protobuf:"bytes,1,rep,name=paper_scores,json=paperScores,proto3" json:"paper_scores,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectPaperScoresResponse) Reset() {
+	*x = ListProjectPaperScoresResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectPaperScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectPaperScoresResponse) ProtoMessage() {}
+
+func (x *ListProjectPaperScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectPaperScoresResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectPaperScoresResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProjectPaperScoresResponse) GetPaperScores() []*PaperScoreRecord {
+	if x != nil {
+		return x.PaperScores
+	}
+	return nil
+}
+
 // Paper score comment
 type RunProjectPaperScoreCommentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -491,7 +751,7 @@ type RunProjectPaperScoreCommentRequest struct {
 
 func (x *RunProjectPaperScoreCommentRequest) Reset() {
 	*x = RunProjectPaperScoreCommentRequest{}
-	mi := &file_project_v1_project_proto_msgTypes[8]
+	mi := &file_project_v1_project_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +763,7 @@ func (x *RunProjectPaperScoreCommentRequest) String() string {
 func (*RunProjectPaperScoreCommentRequest) ProtoMessage() {}
 
 func (x *RunProjectPaperScoreCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[8]
+	mi := &file_project_v1_project_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +776,7 @@ func (x *RunProjectPaperScoreCommentRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RunProjectPaperScoreCommentRequest.ProtoReflect.Descriptor instead.
 func (*RunProjectPaperScoreCommentRequest) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{8}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RunProjectPaperScoreCommentRequest) GetProjectId() string {
@@ -534,16 +794,17 @@ func (x *RunProjectPaperScoreCommentRequest) GetConversationId() string {
 }
 
 type RunProjectPaperScoreCommentResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	ProjectId     string                     `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Comments      []*PaperScoreCommentResult `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	ProjectId        string                     `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Comments         []*PaperScoreCommentResult `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+	OverleafComments []*OverleafComment         `protobuf:"bytes,3,rep,name=overleaf_comments,json=overleafComments,proto3" json:"overleaf_comments,omitempty"` // the comments anchored in the project documents
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RunProjectPaperScoreCommentResponse) Reset() {
 	*x = RunProjectPaperScoreCommentResponse{}
-	mi := &file_project_v1_project_proto_msgTypes[9]
+	mi := &file_project_v1_project_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +816,7 @@ func (x *RunProjectPaperScoreCommentResponse) String() string {
 func (*RunProjectPaperScoreCommentResponse) ProtoMessage() {}
 
 func (x *RunProjectPaperScoreCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[9]
+	mi := &file_project_v1_project_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +829,7 @@ func (x *RunProjectPaperScoreCommentResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RunProjectPaperScoreCommentResponse.ProtoReflect.Descriptor instead.
 func (*RunProjectPaperScoreCommentResponse) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{9}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RunProjectPaperScoreCommentResponse) GetProjectId() string {
@@ -585,6 +846,13 @@ func (x *RunProjectPaperScoreCommentResponse) GetComments() []*PaperScoreComment
 	return nil
 }
 
+func (x *RunProjectPaperScoreCommentResponse) GetOverleafComments() []*OverleafComment {
+	if x != nil {
+		return x.OverleafComments
+	}
+	return nil
+}
+
 // Overleaf comment
 type RunProjectOverleafCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -599,7 +867,7 @@ type RunProjectOverleafCommentRequest struct {
 
 func (x *RunProjectOverleafCommentRequest) Reset() {
 	*x = RunProjectOverleafCommentRequest{}
-	mi := &file_project_v1_project_proto_msgTypes[10]
+	mi := &file_project_v1_project_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +879,7 @@ func (x *RunProjectOverleafCommentRequest) String() string {
 func (*RunProjectOverleafCommentRequest) ProtoMessage() {}
 
 func (x *RunProjectOverleafCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[10]
+	mi := &file_project_v1_project_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +892,7 @@ func (x *RunProjectOverleafCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunProjectOverleafCommentRequest.ProtoReflect.Descriptor instead.
 func (*RunProjectOverleafCommentRequest) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{10}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RunProjectOverleafCommentRequest) GetProjectId() string {
@@ -672,7 +940,7 @@ type RunProjectOverleafCommentResponse struct {
 
 func (x *RunProjectOverleafCommentResponse) Reset() {
 	*x = RunProjectOverleafCommentResponse{}
-	mi := &file_project_v1_project_proto_msgTypes[11]
+	mi := &file_project_v1_project_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -684,7 +952,7 @@ func (x *RunProjectOverleafCommentResponse) String() string {
 func (*RunProjectOverleafCommentResponse) ProtoMessage() {}
 
 func (x *RunProjectOverleafCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[11]
+	mi := &file_project_v1_project_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +965,7 @@ func (x *RunProjectOverleafCommentResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RunProjectOverleafCommentResponse.ProtoReflect.Descriptor instead.
 func (*RunProjectOverleafCommentResponse) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{11}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RunProjectOverleafCommentResponse) GetProjectId() string {
@@ -734,7 +1002,7 @@ type OverleafComment struct {
 
 func (x *OverleafComment) Reset() {
 	*x = OverleafComment{}
-	mi := &file_project_v1_project_proto_msgTypes[12]
+	mi := &file_project_v1_project_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -746,7 +1014,7 @@ func (x *OverleafComment) String() string {
 func (*OverleafComment) ProtoMessage() {}
 
 func (x *OverleafComment) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[12]
+	mi := &file_project_v1_project_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1027,7 @@ func (x *OverleafComment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OverleafComment.ProtoReflect.Descriptor instead.
 func (*OverleafComment) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{12}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OverleafComment) GetCommentId() string {
@@ -848,7 +1116,7 @@ type PaperScoreCommentResult struct {
 
 func (x *PaperScoreCommentResult) Reset() {
 	*x = PaperScoreCommentResult{}
-	mi := &file_project_v1_project_proto_msgTypes[13]
+	mi := &file_project_v1_project_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1128,7 @@ func (x *PaperScoreCommentResult) String() string {
 func (*PaperScoreCommentResult) ProtoMessage() {}
 
 func (x *PaperScoreCommentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[13]
+	mi := &file_project_v1_project_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1141,7 @@ func (x *PaperScoreCommentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaperScoreCommentResult.ProtoReflect.Descriptor instead.
 func (*PaperScoreCommentResult) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{13}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PaperScoreCommentResult) GetResults() []*PaperScoreCommentEntry {
@@ -896,7 +1164,7 @@ type PaperScoreCommentEntry struct {
 
 func (x *PaperScoreCommentEntry) Reset() {
 	*x = PaperScoreCommentEntry{}
-	mi := &file_project_v1_project_proto_msgTypes[14]
+	mi := &file_project_v1_project_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1176,7 @@ func (x *PaperScoreCommentEntry) String() string {
 func (*PaperScoreCommentEntry) ProtoMessage() {}
 
 func (x *PaperScoreCommentEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[14]
+	mi := &file_project_v1_project_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1189,7 @@ func (x *PaperScoreCommentEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaperScoreCommentEntry.ProtoReflect.Descriptor instead.
 func (*PaperScoreCommentEntry) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{14}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PaperScoreCommentEntry) GetSection() string {
@@ -964,7 +1232,7 @@ type PaperScoreResult struct {
 
 func (x *PaperScoreResult) Reset() {
 	*x = PaperScoreResult{}
-	mi := &file_project_v1_project_proto_msgTypes[15]
+	mi := &file_project_v1_project_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -976,7 +1244,7 @@ func (x *PaperScoreResult) String() string {
 func (*PaperScoreResult) ProtoMessage() {}
 
 func (x *PaperScoreResult) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[15]
+	mi := &file_project_v1_project_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +1257,7 @@ func (x *PaperScoreResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaperScoreResult.ProtoReflect.Descriptor instead.
 func (*PaperScoreResult) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{15}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PaperScoreResult) GetScore() float32 {
@@ -1029,7 +1297,7 @@ type SuggestionList struct {
 
 func (x *SuggestionList) Reset() {
 	*x = SuggestionList{}
-	mi := &file_project_v1_project_proto_msgTypes[16]
+	mi := &file_project_v1_project_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1041,7 +1309,7 @@ func (x *SuggestionList) String() string {
 func (*SuggestionList) ProtoMessage() {}
 
 func (x *SuggestionList) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[16]
+	mi := &file_project_v1_project_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1322,7 @@ func (x *SuggestionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuggestionList.ProtoReflect.Descriptor instead.
 func (*SuggestionList) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{16}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SuggestionList) GetSuggestions() []string {
@@ -1074,7 +1342,7 @@ type GetProjectInstructionsRequest struct {
 
 func (x *GetProjectInstructionsRequest) Reset() {
 	*x = GetProjectInstructionsRequest{}
-	mi := &file_project_v1_project_proto_msgTypes[17]
+	mi := &file_project_v1_project_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1354,7 @@ func (x *GetProjectInstructionsRequest) String() string {
 func (*GetProjectInstructionsRequest) ProtoMessage() {}
 
 func (x *GetProjectInstructionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[17]
+	mi := &file_project_v1_project_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1367,7 @@ func (x *GetProjectInstructionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectInstructionsRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectInstructionsRequest) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{17}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetProjectInstructionsRequest) GetProjectId() string {
@@ -1119,7 +1387,7 @@ type GetProjectInstructionsResponse struct {
 
 func (x *GetProjectInstructionsResponse) Reset() {
 	*x = GetProjectInstructionsResponse{}
-	mi := &file_project_v1_project_proto_msgTypes[18]
+	mi := &file_project_v1_project_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1131,7 +1399,7 @@ func (x *GetProjectInstructionsResponse) String() string {
 func (*GetProjectInstructionsResponse) ProtoMessage() {}
 
 func (x *GetProjectInstructionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[18]
+	mi := &file_project_v1_project_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,7 +1412,7 @@ func (x *GetProjectInstructionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectInstructionsResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectInstructionsResponse) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{18}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetProjectInstructionsResponse) GetProjectId() string {
@@ -1171,7 +1439,7 @@ type UpsertProjectInstructionsRequest struct {
 
 func (x *UpsertProjectInstructionsRequest) Reset() {
 	*x = UpsertProjectInstructionsRequest{}
-	mi := &file_project_v1_project_proto_msgTypes[19]
+	mi := &file_project_v1_project_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1183,7 +1451,7 @@ func (x *UpsertProjectInstructionsRequest) String() string {
 func (*UpsertProjectInstructionsRequest) ProtoMessage() {}
 
 func (x *UpsertProjectInstructionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[19]
+	mi := &file_project_v1_project_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,7 +1464,7 @@ func (x *UpsertProjectInstructionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertProjectInstructionsRequest.ProtoReflect.Descriptor instead.
 func (*UpsertProjectInstructionsRequest) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{19}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpsertProjectInstructionsRequest) GetProjectId() string {
@@ -1223,7 +1491,7 @@ type UpsertProjectInstructionsResponse struct {
 
 func (x *UpsertProjectInstructionsResponse) Reset() {
 	*x = UpsertProjectInstructionsResponse{}
-	mi := &file_project_v1_project_proto_msgTypes[20]
+	mi := &file_project_v1_project_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1235,7 +1503,7 @@ func (x *UpsertProjectInstructionsResponse) String() string {
 func (*UpsertProjectInstructionsResponse) ProtoMessage() {}
 
 func (x *UpsertProjectInstructionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_project_v1_project_proto_msgTypes[20]
+	mi := &file_project_v1_project_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1516,7 @@ func (x *UpsertProjectInstructionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpsertProjectInstructionsResponse.ProtoReflect.Descriptor instead.
 func (*UpsertProjectInstructionsResponse) Descriptor() ([]byte, []int) {
-	return file_project_v1_project_proto_rawDescGZIP(), []int{20}
+	return file_project_v1_project_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpsertProjectInstructionsResponse) GetProjectId() string {
@@ -1265,12 +1533,593 @@ func (x *UpsertProjectInstructionsResponse) GetInstructions() string {
 	return ""
 }
 
-var File_project_v1_project_proto protoreflect.FileDescriptor
-
-const file_project_v1_project_proto_rawDesc = "" +
+// Persona
+type UpdateProjectPersonaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DefaultPersona string                 `protobuf:"bytes,2,opt,name=default_persona,json=defaultPersona,proto3" json:"default_persona,omitempty"` // empty for the default one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProjectPersonaRequest) Reset() {
+	*x = UpdateProjectPersonaRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectPersonaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectPersonaRequest) ProtoMessage() {}
+
+func (x *UpdateProjectPersonaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectPersonaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectPersonaRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateProjectPersonaRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectPersonaRequest) GetDefaultPersona() string {
+	if x != nil {
+		return x.DefaultPersona
+	}
+	return ""
+}
+
+type UpdateProjectPersonaResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId      string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DefaultPersona string                 `protobuf:"bytes,2,opt,name=default_persona,json=defaultPersona,proto3" json:"default_persona,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProjectPersonaResponse) Reset() {
+	*x = UpdateProjectPersonaResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectPersonaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectPersonaResponse) ProtoMessage() {}
+
+func (x *UpdateProjectPersonaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectPersonaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectPersonaResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateProjectPersonaResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectPersonaResponse) GetDefaultPersona() string {
+	if x != nil {
+		return x.DefaultPersona
+	}
+	return ""
+}
+
+type ProjectMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // empty if the account was deleted
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Picture       string                 `protobuf:"bytes,4,opt,name=picture,proto3" json:"picture,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,5,opt,name=role,proto3,enum=project.v1.ProjectRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_project_v1_project_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProjectMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+func (x *ProjectMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListProjectMembersRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListProjectMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ProjectMember       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // the owner first
+	MyRole        ProjectRole            `protobuf:"varint,2,opt,name=my_role,json=myRole,proto3,enum=project.v1.ProjectRole" json:"my_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListProjectMembersResponse) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListProjectMembersResponse) GetMyRole() ProjectRole {
+	if x != nil {
+		return x.MyRole
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type AddProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                            // the user must have signed in to PaperDebugger once
+	Role          ProjectRole            `protobuf:"varint,3,opt,name=role,proto3,enum=project.v1.ProjectRole" json:"role,omitempty"` // editor or viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type AddProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberResponse) Reset() {
+	*x = AddProjectMemberResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberResponse) ProtoMessage() {}
+
+func (x *AddProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddProjectMemberResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ProjectRole            `protobuf:"varint,3,opt,name=role,proto3,enum=project.v1.ProjectRole" json:"role,omitempty"` // editor or viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectMemberRequest) Reset() {
+	*x = UpdateProjectMemberRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectMemberRequest) ProtoMessage() {}
+
+func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateProjectMemberRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_PROJECT_ROLE_UNSPECIFIED
+}
+
+type UpdateProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectMemberResponse) Reset() {
+	*x = UpdateProjectMemberResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectMemberResponse) ProtoMessage() {}
+
+func (x *UpdateProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateProjectMemberResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectMemberRequest) Reset() {
+	*x = RemoveProjectMemberRequest{}
+	mi := &file_project_v1_project_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberRequest) ProtoMessage() {}
+
+func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveProjectMemberRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RemoveProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectMemberResponse) Reset() {
+	*x = RemoveProjectMemberResponse{}
+	mi := &file_project_v1_project_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberResponse) ProtoMessage() {}
+
+func (x *RemoveProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_project_v1_project_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_project_v1_project_proto_rawDescGZIP(), []int{34}
+}
+
+var File_project_v1_project_proto protoreflect.FileDescriptor
+
+const file_project_v1_project_proto_rawDesc = "" +
 	"\n" +
 	"\x18project/v1/project.proto\x12\n" +
-	"project.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
+	"project.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x02\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -1279,7 +2128,8 @@ const file_project_v1_project_proto_rawDesc = "" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1e\n" +
 	"\vroot_doc_id\x18\x05 \x01(\tR\trootDocId\x12*\n" +
-	"\x04docs\x18\x06 \x03(\v2\x16.project.v1.ProjectDocR\x04docs\"h\n" +
+	"\x04docs\x18\x06 \x03(\v2\x16.project.v1.ProjectDocR\x04docs\x12'\n" +
+	"\x0fdefault_persona\x18\a \x01(\tR\x0edefaultPersona\"h\n" +
 	"\n" +
 	"ProjectDoc\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
@@ -1302,20 +2152,38 @@ const file_project_v1_project_proto_rawDesc = "" +
 	"\x1bRunProjectPaperScoreRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12'\n" +
-	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"|\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"\xca\x01\n" +
 	"\x1cRunProjectPaperScoreResponse\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12=\n" +
 	"\vpaper_score\x18\x02 \x01(\v2\x1c.project.v1.PaperScoreResultR\n" +
-	"paperScore\"l\n" +
+	"paperScore\x124\n" +
+	"\x06record\x18\x03 \x01(\v2\x1c.project.v1.PaperScoreRecordR\x06record\x12\x16\n" +
+	"\x06reused\x18\x04 \x01(\bR\x06reused\"\x84\x02\n" +
+	"\x10PaperScoreRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\vpaper_score\x18\x03 \x01(\v2\x1c.project.v1.PaperScoreResultR\n" +
+	"paperScore\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12!\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\vcontentHash\x12'\n" +
+	"\x0fconversation_id\x18\x06 \x01(\tR\x0econversationId\"T\n" +
+	"\x1dListProjectPaperScoresRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"a\n" +
+	"\x1eListProjectPaperScoresResponse\x12?\n" +
+	"\fpaper_scores\x18\x01 \x03(\v2\x1c.project.v1.PaperScoreRecordR\vpaperScores\"l\n" +
 	"\"RunProjectPaperScoreCommentRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12'\n" +
-	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"\x85\x01\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"\xcf\x01\n" +
 	"#RunProjectPaperScoreCommentResponse\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12?\n" +
-	"\bcomments\x18\x02 \x03(\v2#.project.v1.PaperScoreCommentResultR\bcomments\"\xb6\x01\n" +
+	"\bcomments\x18\x02 \x03(\v2#.project.v1.PaperScoreCommentResultR\bcomments\x12H\n" +
+	"\x11overleaf_comments\x18\x03 \x03(\v2\x1b.project.v1.OverleafCommentR\x10overleafComments\"\xb6\x01\n" +
 	" RunProjectOverleafCommentRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x18\n" +
@@ -1389,16 +2257,68 @@ const file_project_v1_project_proto_rawDesc = "" +
 	"!UpsertProjectInstructionsResponse\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\"\n" +
-	"\finstructions\x18\x02 \x01(\tR\finstructions2\x90\t\n" +
+	"\finstructions\x18\x02 \x01(\tR\finstructions\"e\n" +
+	"\x1bUpdateProjectPersonaRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12'\n" +
+	"\x0fdefault_persona\x18\x02 \x01(\tR\x0edefaultPersona\"f\n" +
+	"\x1cUpdateProjectPersonaResponse\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12'\n" +
+	"\x0fdefault_persona\x18\x02 \x01(\tR\x0edefaultPersona\"\xd4\x01\n" +
+	"\rProjectMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\apicture\x18\x04 \x01(\tR\apicture\x12+\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x17.project.v1.ProjectRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\":\n" +
+	"\x19ListProjectMembersRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\x83\x01\n" +
+	"\x1aListProjectMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.project.v1.ProjectMemberR\amembers\x120\n" +
+	"\amy_role\x18\x02 \x01(\x0e2\x17.project.v1.ProjectRoleR\x06myRole\"{\n" +
+	"\x17AddProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12+\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x17.project.v1.ProjectRoleR\x04role\"M\n" +
+	"\x18AddProjectMemberResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.project.v1.ProjectMemberR\x06member\"\x81\x01\n" +
+	"\x1aUpdateProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12+\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x17.project.v1.ProjectRoleR\x04role\"P\n" +
+	"\x1bUpdateProjectMemberResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.project.v1.ProjectMemberR\x06member\"T\n" +
+	"\x1aRemoveProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1d\n" +
+	"\x1bRemoveProjectMemberResponse*u\n" +
+	"\vProjectRole\x12\x1c\n" +
+	"\x18PROJECT_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PROJECT_ROLE_OWNER\x10\x01\x12\x17\n" +
+	"\x13PROJECT_ROLE_EDITOR\x10\x02\x12\x17\n" +
+	"\x13PROJECT_ROLE_VIEWER\x10\x032\xda\x10\n" +
 	"\x0eProjectService\x12\x82\x01\n" +
 	"\rUpsertProject\x12 .project.v1.UpsertProjectRequest\x1a!.project.v1.UpsertProjectResponse\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/_pd/api/v1/projects/{project_id}\x12v\n" +
 	"\n" +
 	"GetProject\x12\x1d.project.v1.GetProjectRequest\x1a\x1e.project.v1.GetProjectResponse\")\x82\xd3\xe4\x93\x02#\x12!/_pd/api/v1/projects/{project_id}\x12\xa3\x01\n" +
-	"\x14RunProjectPaperScore\x12'.project.v1.RunProjectPaperScoreRequest\x1a(.project.v1.RunProjectPaperScoreResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/_pd/api/v1/projects/{project_id}/paper-score\x12\xc0\x01\n" +
+	"\x14RunProjectPaperScore\x12'.project.v1.RunProjectPaperScoreRequest\x1a(.project.v1.RunProjectPaperScoreResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/_pd/api/v1/projects/{project_id}/paper-score\x12\xa7\x01\n" +
+	"\x16ListProjectPaperScores\x12).project.v1.ListProjectPaperScoresRequest\x1a*.project.v1.ListProjectPaperScoresResponse\"6\x82\xd3\xe4\x93\x020\x12./_pd/api/v1/projects/{project_id}/paper-scores\x12\xc0\x01\n" +
 	"\x1bRunProjectPaperScoreComment\x12..project.v1.RunProjectPaperScoreCommentRequest\x1a/.project.v1.RunProjectPaperScoreCommentResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/_pd/api/v1/projects/{project_id}/paper-score-comment\x12\xb7\x01\n" +
 	"\x19RunProjectOverleafComment\x12,.project.v1.RunProjectOverleafCommentRequest\x1a-.project.v1.RunProjectOverleafCommentResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/_pd/api/v1/projects/{project_id}/overleaf-comment\x12\xa7\x01\n" +
 	"\x16GetProjectInstructions\x12).project.v1.GetProjectInstructionsRequest\x1a*.project.v1.GetProjectInstructionsResponse\"6\x82\xd3\xe4\x93\x020\x12./_pd/api/v1/projects/{project_id}/instructions\x12\xb3\x01\n" +
-	"\x19UpsertProjectInstructions\x12,.project.v1.UpsertProjectInstructionsRequest\x1a-.project.v1.UpsertProjectInstructionsResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./_pd/api/v1/projects/{project_id}/instructionsB\x97\x01\n" +
+	"\x19UpsertProjectInstructions\x12,.project.v1.UpsertProjectInstructionsRequest\x1a-.project.v1.UpsertProjectInstructionsResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./_pd/api/v1/projects/{project_id}/instructions\x12\x9f\x01\n" +
+	"\x14UpdateProjectPersona\x12'.project.v1.UpdateProjectPersonaRequest\x1a(.project.v1.UpdateProjectPersonaResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/_pd/api/v1/projects/{project_id}/persona\x12\x96\x01\n" +
+	"\x12ListProjectMembers\x12%.project.v1.ListProjectMembersRequest\x1a&.project.v1.ListProjectMembersResponse\"1\x82\xd3\xe4\x93\x02+\x12)/_pd/api/v1/projects/{project_id}/members\x12\x93\x01\n" +
+	"\x10AddProjectMember\x12#.project.v1.AddProjectMemberRequest\x1a$.project.v1.AddProjectMemberResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/_pd/api/v1/projects/{project_id}/members\x12\xa6\x01\n" +
+	"\x13UpdateProjectMember\x12&.project.v1.UpdateProjectMemberRequest\x1a'.project.v1.UpdateProjectMemberResponse\">\x82\xd3\xe4\x93\x028:\x01*23/_pd/api/v1/projects/{project_id}/members/{user_id}\x12\xa3\x01\n" +
+	"\x13RemoveProjectMember\x12&.project.v1.RemoveProjectMemberRequest\x1a'.project.v1.RemoveProjectMemberResponse\";\x82\xd3\xe4\x93\x025*3/_pd/api/v1/projects/{project_id}/members/{user_id}B\x97\x01\n" +
 	"\x0ecom.project.v1B\fProjectProtoP\x01Z.paperdebugger/pkg/gen/api/project/v1;projectv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Project.V1\xca\x02\n" +
 	"Project\\V1\xe2\x02\x16Project\\V1\\GPBMetadata\xea\x02\vProject::V1b\x06proto3"
@@ -1415,66 +2335,107 @@ func file_project_v1_project_proto_rawDescGZIP() []byte {
 	return file_project_v1_project_proto_rawDescData
 }
 
-var file_project_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_project_v1_project_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_project_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_project_v1_project_proto_goTypes = []any{
-	(*Project)(nil),                             // 0: project.v1.Project
-	(*ProjectDoc)(nil),                          // 1: project.v1.ProjectDoc
-	(*UpsertProjectRequest)(nil),                // 2: project.v1.UpsertProjectRequest
-	(*UpsertProjectResponse)(nil),               // 3: project.v1.UpsertProjectResponse
-	(*GetProjectRequest)(nil),                   // 4: project.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),                  // 5: project.v1.GetProjectResponse
-	(*RunProjectPaperScoreRequest)(nil),         // 6: project.v1.RunProjectPaperScoreRequest
-	(*RunProjectPaperScoreResponse)(nil),        // 7: project.v1.RunProjectPaperScoreResponse
-	(*RunProjectPaperScoreCommentRequest)(nil),  // 8: project.v1.RunProjectPaperScoreCommentRequest
-	(*RunProjectPaperScoreCommentResponse)(nil), // 9: project.v1.RunProjectPaperScoreCommentResponse
-	(*RunProjectOverleafCommentRequest)(nil),    // 10: project.v1.RunProjectOverleafCommentRequest
-	(*RunProjectOverleafCommentResponse)(nil),   // 11: project.v1.RunProjectOverleafCommentResponse
-	(*OverleafComment)(nil),                     // 12: project.v1.OverleafComment
-	(*PaperScoreCommentResult)(nil),             // 13: project.v1.PaperScoreCommentResult
-	(*PaperScoreCommentEntry)(nil),              // 14: project.v1.PaperScoreCommentEntry
-	(*PaperScoreResult)(nil),                    // 15: project.v1.PaperScoreResult
-	(*SuggestionList)(nil),                      // 16: project.v1.SuggestionList
-	(*GetProjectInstructionsRequest)(nil),       // 17: project.v1.GetProjectInstructionsRequest
-	(*GetProjectInstructionsResponse)(nil),      // 18: project.v1.GetProjectInstructionsResponse
-	(*UpsertProjectInstructionsRequest)(nil),    // 19: project.v1.UpsertProjectInstructionsRequest
-	(*UpsertProjectInstructionsResponse)(nil),   // 20: project.v1.UpsertProjectInstructionsResponse
-	nil,                           // 21: project.v1.PaperScoreResult.DetailsEntry
-	nil,                           // 22: project.v1.PaperScoreResult.SuggestionsEntry
-	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+	(ProjectRole)(0),                            // 0: project.v1.ProjectRole
+	(*Project)(nil),                             // 1: project.v1.Project
+	(*ProjectDoc)(nil),                          // 2: project.v1.ProjectDoc
+	(*UpsertProjectRequest)(nil),                // 3: project.v1.UpsertProjectRequest
+	(*UpsertProjectResponse)(nil),               // 4: project.v1.UpsertProjectResponse
+	(*GetProjectRequest)(nil),                   // 5: project.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),                  // 6: project.v1.GetProjectResponse
+	(*RunProjectPaperScoreRequest)(nil),         // 7: project.v1.RunProjectPaperScoreRequest
+	(*RunProjectPaperScoreResponse)(nil),        // 8: project.v1.RunProjectPaperScoreResponse
+	(*PaperScoreRecord)(nil),                    // 9: project.v1.PaperScoreRecord
+	(*ListProjectPaperScoresRequest)(nil),       // 10: project.v1.ListProjectPaperScoresRequest
+	(*ListProjectPaperScoresResponse)(nil),      // 11: project.v1.ListProjectPaperScoresResponse
+	(*RunProjectPaperScoreCommentRequest)(nil),  // 12: project.v1.RunProjectPaperScoreCommentRequest
+	(*RunProjectPaperScoreCommentResponse)(nil), // 13: project.v1.RunProjectPaperScoreCommentResponse
+	(*RunProjectOverleafCommentRequest)(nil),    // 14: project.v1.RunProjectOverleafCommentRequest
+	(*RunProjectOverleafCommentResponse)(nil),   // 15: project.v1.RunProjectOverleafCommentResponse
+	(*OverleafComment)(nil),                     // 16: project.v1.OverleafComment
+	(*PaperScoreCommentResult)(nil),             // 17: project.v1.PaperScoreCommentResult
+	(*PaperScoreCommentEntry)(nil),              // 18: project.v1.PaperScoreCommentEntry
+	(*PaperScoreResult)(nil),                    // 19: project.v1.PaperScoreResult
+	(*SuggestionList)(nil),                      // 20: project.v1.SuggestionList
+	(*GetProjectInstructionsRequest)(nil),       // 21: project.v1.GetProjectInstructionsRequest
+	(*GetProjectInstructionsResponse)(nil),      // 22: project.v1.GetProjectInstructionsResponse
+	(*UpsertProjectInstructionsRequest)(nil),    // 23: project.v1.UpsertProjectInstructionsRequest
+	(*UpsertProjectInstructionsResponse)(nil),   // 24: project.v1.UpsertProjectInstructionsResponse
+	(*UpdateProjectPersonaRequest)(nil),         // 25: project.v1.UpdateProjectPersonaRequest
+	(*UpdateProjectPersonaResponse)(nil),        // 26: project.v1.UpdateProjectPersonaResponse
+	(*ProjectMember)(nil),                       // 27: project.v1.ProjectMember
+	(*ListProjectMembersRequest)(nil),           // 28: project.v1.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),          // 29: project.v1.ListProjectMembersResponse
+	(*AddProjectMemberRequest)(nil),             // 30: project.v1.AddProjectMemberRequest
+	(*AddProjectMemberResponse)(nil),            // 31: project.v1.AddProjectMemberResponse
+	(*UpdateProjectMemberRequest)(nil),          // 32: project.v1.UpdateProjectMemberRequest
+	(*UpdateProjectMemberResponse)(nil),         // 33: project.v1.UpdateProjectMemberResponse
+	(*RemoveProjectMemberRequest)(nil),          // 34: project.v1.RemoveProjectMemberRequest
+	(*RemoveProjectMemberResponse)(nil),         // 35: project.v1.RemoveProjectMemberResponse
+	nil,                                         // 36: project.v1.PaperScoreResult.DetailsEntry
+	nil,                                         // 37: project.v1.PaperScoreResult.SuggestionsEntry
+	(*timestamppb.Timestamp)(nil),               // 38: google.protobuf.Timestamp
 }
 var file_project_v1_project_proto_depIdxs = []int32{
-	23, // 0: project.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	23, // 1: project.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: project.v1.Project.docs:type_name -> project.v1.ProjectDoc
-	1,  // 3: project.v1.UpsertProjectRequest.docs:type_name -> project.v1.ProjectDoc
-	0,  // 4: project.v1.UpsertProjectResponse.project:type_name -> project.v1.Project
-	0,  // 5: project.v1.GetProjectResponse.project:type_name -> project.v1.Project
-	15, // 6: project.v1.RunProjectPaperScoreResponse.paper_score:type_name -> project.v1.PaperScoreResult
-	13, // 7: project.v1.RunProjectPaperScoreCommentResponse.comments:type_name -> project.v1.PaperScoreCommentResult
-	12, // 8: project.v1.RunProjectOverleafCommentResponse.comments:type_name -> project.v1.OverleafComment
-	14, // 9: project.v1.PaperScoreCommentResult.results:type_name -> project.v1.PaperScoreCommentEntry
-	21, // 10: project.v1.PaperScoreResult.details:type_name -> project.v1.PaperScoreResult.DetailsEntry
-	22, // 11: project.v1.PaperScoreResult.suggestions:type_name -> project.v1.PaperScoreResult.SuggestionsEntry
-	16, // 12: project.v1.PaperScoreResult.SuggestionsEntry.value:type_name -> project.v1.SuggestionList
-	2,  // 13: project.v1.ProjectService.UpsertProject:input_type -> project.v1.UpsertProjectRequest
-	4,  // 14: project.v1.ProjectService.GetProject:input_type -> project.v1.GetProjectRequest
-	6,  // 15: project.v1.ProjectService.RunProjectPaperScore:input_type -> project.v1.RunProjectPaperScoreRequest
-	8,  // 16: project.v1.ProjectService.RunProjectPaperScoreComment:input_type -> project.v1.RunProjectPaperScoreCommentRequest
-	10, // 17: project.v1.ProjectService.RunProjectOverleafComment:input_type -> project.v1.RunProjectOverleafCommentRequest
-	17, // 18: project.v1.ProjectService.GetProjectInstructions:input_type -> project.v1.GetProjectInstructionsRequest
-	19, // 19: project.v1.ProjectService.UpsertProjectInstructions:input_type -> project.v1.UpsertProjectInstructionsRequest
-	3,  // 20: project.v1.ProjectService.UpsertProject:output_type -> project.v1.UpsertProjectResponse
-	5,  // 21: project.v1.ProjectService.GetProject:output_type -> project.v1.GetProjectResponse
-	7,  // 22: project.v1.ProjectService.RunProjectPaperScore:output_type -> project.v1.RunProjectPaperScoreResponse
-	9,  // 23: project.v1.ProjectService.RunProjectPaperScoreComment:output_type -> project.v1.RunProjectPaperScoreCommentResponse
-	11, // 24: project.v1.ProjectService.RunProjectOverleafComment:output_type -> project.v1.RunProjectOverleafCommentResponse
-	18, // 25: project.v1.ProjectService.GetProjectInstructions:output_type -> project.v1.GetProjectInstructionsResponse
-	20, // 26: project.v1.ProjectService.UpsertProjectInstructions:output_type -> project.v1.UpsertProjectInstructionsResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	38, // 0: project.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	38, // 1: project.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: project.v1.Project.docs:type_name -> project.v1.ProjectDoc
+	2,  // 3: project.v1.UpsertProjectRequest.docs:type_name -> project.v1.ProjectDoc
+	1,  // 4: project.v1.UpsertProjectResponse.project:type_name -> project.v1.Project
+	1,  // 5: project.v1.GetProjectResponse.project:type_name -> project.v1.Project
+	19, // 6: project.v1.RunProjectPaperScoreResponse.paper_score:type_name -> project.v1.PaperScoreResult
+	9,  // 7: project.v1.RunProjectPaperScoreResponse.record:type_name -> project.v1.PaperScoreRecord
+	38, // 8: project.v1.PaperScoreRecord.created_at:type_name -> google.protobuf.Timestamp
+	19, // 9: project.v1.PaperScoreRecord.paper_score:type_name -> project.v1.PaperScoreResult
+	9,  // 10: project.v1.ListProjectPaperScoresResponse.paper_scores:type_name -> project.v1.PaperScoreRecord
+	17, // 11: project.v1.RunProjectPaperScoreCommentResponse.comments:type_name -> project.v1.PaperScoreCommentResult
+	16, // 12: project.v1.RunProjectPaperScoreCommentResponse.overleaf_comments:type_name -> project.v1.OverleafComment
+	16, // 13: project.v1.RunProjectOverleafCommentResponse.comments:type_name -> project.v1.OverleafComment
+	18, // 14: project.v1.PaperScoreCommentResult.results:type_name -> project.v1.PaperScoreCommentEntry
+	36, // 15: project.v1.PaperScoreResult.details:type_name -> project.v1.PaperScoreResult.DetailsEntry
+	37, // 16: project.v1.PaperScoreResult.suggestions:type_name -> project.v1.PaperScoreResult.SuggestionsEntry
+	0,  // 17: project.v1.ProjectMember.role:type_name -> project.v1.ProjectRole
+	38, // 18: project.v1.ProjectMember.created_at:type_name -> google.protobuf.Timestamp
+	27, // 19: project.v1.ListProjectMembersResponse.members:type_name -> project.v1.ProjectMember
+	0,  // 20: project.v1.ListProjectMembersResponse.my_role:type_name -> project.v1.ProjectRole
+	0,  // 21: project.v1.AddProjectMemberRequest.role:type_name -> project.v1.ProjectRole
+	27, // 22: project.v1.AddProjectMemberResponse.member:type_name -> project.v1.ProjectMember
+	0,  // 23: project.v1.UpdateProjectMemberRequest.role:type_name -> project.v1.ProjectRole
+	27, // 24: project.v1.UpdateProjectMemberResponse.member:type_name -> project.v1.ProjectMember
+	20, // 25: project.v1.PaperScoreResult.SuggestionsEntry.value:type_name -> project.v1.SuggestionList
+	3,  // 26: project.v1.ProjectService.UpsertProject:input_type -> project.v1.UpsertProjectRequest
+	5,  // 27: project.v1.ProjectService.GetProject:input_type -> project.v1.GetProjectRequest
+	7,  // 28: project.v1.ProjectService.RunProjectPaperScore:input_type -> project.v1.RunProjectPaperScoreRequest
+	10, // 29: project.v1.ProjectService.ListProjectPaperScores:input_type -> project.v1.ListProjectPaperScoresRequest
+	12, // 30: project.v1.ProjectService.RunProjectPaperScoreComment:input_type -> project.v1.RunProjectPaperScoreCommentRequest
+	14, // 31: project.v1.ProjectService.RunProjectOverleafComment:input_type -> project.v1.RunProjectOverleafCommentRequest
+	21, // 32: project.v1.ProjectService.GetProjectInstructions:input_type -> project.v1.GetProjectInstructionsRequest
+	23, // 33: project.v1.ProjectService.UpsertProjectInstructions:input_type -> project.v1.UpsertProjectInstructionsRequest
+	25, // 34: project.v1.ProjectService.UpdateProjectPersona:input_type -> project.v1.UpdateProjectPersonaRequest
+	28, // 35: project.v1.ProjectService.ListProjectMembers:input_type -> project.v1.ListProjectMembersRequest
+	30, // 36: project.v1.ProjectService.AddProjectMember:input_type -> project.v1.AddProjectMemberRequest
+	32, // 37: project.v1.ProjectService.UpdateProjectMember:input_type -> project.v1.UpdateProjectMemberRequest
+	34, // 38: project.v1.ProjectService.RemoveProjectMember:input_type -> project.v1.RemoveProjectMemberRequest
+	4,  // 39: project.v1.ProjectService.UpsertProject:output_type -> project.v1.UpsertProjectResponse
+	6,  // 40: project.v1.ProjectService.GetProject:output_type -> project.v1.GetProjectResponse
+	8,  // 41: project.v1.ProjectService.RunProjectPaperScore:output_type -> project.v1.RunProjectPaperScoreResponse
+	11, // 42: project.v1.ProjectService.ListProjectPaperScores:output_type -> project.v1.ListProjectPaperScoresResponse
+	13, // 43: project.v1.ProjectService.RunProjectPaperScoreComment:output_type -> project.v1.RunProjectPaperScoreCommentResponse
+	15, // 44: project.v1.ProjectService.RunProjectOverleafComment:output_type -> project.v1.RunProjectOverleafCommentResponse
+	22, // 45: project.v1.ProjectService.GetProjectInstructions:output_type -> project.v1.GetProjectInstructionsResponse
+	24, // 46: project.v1.ProjectService.UpsertProjectInstructions:output_type -> project.v1.UpsertProjectInstructionsResponse
+	26, // 47: project.v1.ProjectService.UpdateProjectPersona:output_type -> project.v1.UpdateProjectPersonaResponse
+	29, // 48: project.v1.ProjectService.ListProjectMembers:output_type -> project.v1.ListProjectMembersResponse
+	31, // 49: project.v1.ProjectService.AddProjectMember:output_type -> project.v1.AddProjectMemberResponse
+	33, // 50: project.v1.ProjectService.UpdateProjectMember:output_type -> project.v1.UpdateProjectMemberResponse
+	35, // 51: project.v1.ProjectService.RemoveProjectMember:output_type -> project.v1.RemoveProjectMemberResponse
+	39, // [39:52] is the sub-list for method output_type
+	26, // [26:39] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_project_v1_project_proto_init() }
@@ -1487,13 +2448,14 @@ func file_project_v1_project_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_project_v1_project_proto_rawDesc), len(file_project_v1_project_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      1,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_project_v1_project_proto_goTypes,
 		DependencyIndexes: file_project_v1_project_proto_depIdxs,
+		EnumInfos:         file_project_v1_project_proto_enumTypes,
 		MessageInfos:      file_project_v1_project_proto_msgTypes,
 	}.Build()
 	File_project_v1_project_proto = out.File
@@ -22,10 +22,16 @@ const (
 	ProjectService_UpsertProject_FullMethodName               = "/project.v1.ProjectService/UpsertProject"
 	ProjectService_GetProject_FullMethodName                  = "/project.v1.ProjectService/GetProject"
 	ProjectService_RunProjectPaperScore_FullMethodName        = "/project.v1.ProjectService/RunProjectPaperScore"
+	ProjectService_ListProjectPaperScores_FullMethodName      = "/project.v1.ProjectService/ListProjectPaperScores"
 	ProjectService_RunProjectPaperScoreComment_FullMethodName = "/project.v1.ProjectService/RunProjectPaperScoreComment"
 	ProjectService_RunProjectOverleafComment_FullMethodName   = "/project.v1.ProjectService/RunProjectOverleafComment"
 	ProjectService_GetProjectInstructions_FullMethodName      = "/project.v1.ProjectService/GetProjectInstructions"
 	ProjectService_UpsertProjectInstructions_FullMethodName   = "/project.v1.ProjectService/UpsertProjectInstructions"
+	ProjectService_UpdateProjectPersona_FullMethodName        = "/project.v1.ProjectService/UpdateProjectPersona"
+	ProjectService_ListProjectMembers_FullMethodName          = "/project.v1.ProjectService/ListProjectMembers"
+	ProjectService_AddProjectMember_FullMethodName            = "/project.v1.ProjectService/AddProjectMember"
+	ProjectService_UpdateProjectMember_FullMethodName         = "/project.v1.ProjectService/UpdateProjectMember"
+	ProjectService_RemoveProjectMember_FullMethodName         = "/project.v1.ProjectService/RemoveProjectMember"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -35,10 +41,20 @@ type ProjectServiceClient interface {
 	UpsertProject(ctx context.Context, in *UpsertProjectRequest, opts ...grpc.CallOption) (*UpsertProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	RunProjectPaperScore(ctx context.Context, in *RunProjectPaperScoreRequest, opts ...grpc.CallOption) (*RunProjectPaperScoreResponse, error)
+	ListProjectPaperScores(ctx context.Context, in *ListProjectPaperScoresRequest, opts ...grpc.CallOption) (*ListProjectPaperScoresResponse, error)
 	RunProjectPaperScoreComment(ctx context.Context, in *RunProjectPaperScoreCommentRequest, opts ...grpc.CallOption) (*RunProjectPaperScoreCommentResponse, error)
 	RunProjectOverleafComment(ctx context.Context, in *RunProjectOverleafCommentRequest, opts ...grpc.CallOption) (*RunProjectOverleafCommentResponse, error)
 	GetProjectInstructions(ctx context.Context, in *GetProjectInstructionsRequest, opts ...grpc.CallOption) (*GetProjectInstructionsResponse, error)
 	UpsertProjectInstructions(ctx context.Context, in *UpsertProjectInstructionsRequest, opts ...grpc.CallOption) (*UpsertProjectInstructionsResponse, error)
+	// Sets the persona of the new conversations of the project that do not choose one.
+	UpdateProjectPersona(ctx context.Context, in *UpdateProjectPersonaRequest, opts ...grpc.CallOption) (*UpdateProjectPersonaResponse, error)
+	// Lists the members of the project, the coauthors who see the conversations shared in it.
+	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
+	// Adds a user to the project by email, only the owner adds members.
+	AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*AddProjectMemberResponse, error)
+	UpdateProjectMember(ctx context.Context, in *UpdateProjectMemberRequest, opts ...grpc.CallOption) (*UpdateProjectMemberResponse, error)
+	// Removes a member, the members can remove themselves to leave the project.
+	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
 }
 
 type projectServiceClient struct {
@@ -79,6 +95,16 @@ func (c *projectServiceClient) RunProjectPaperScore(ctx context.Context, in *Run
 	return out, nil
 }
 
+func (c *projectServiceClient) ListProjectPaperScores(ctx context.Context, in *ListProjectPaperScoresRequest, opts ...grpc.CallOption) (*ListProjectPaperScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectPaperScoresResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjectPaperScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) RunProjectPaperScoreComment(ctx context.Context, in *RunProjectPaperScoreCommentRequest, opts ...grpc.CallOption) (*RunProjectPaperScoreCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RunProjectPaperScoreCommentResponse)
@@ -119,6 +145,56 @@ func (c *projectServiceClient) UpsertProjectInstructions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *projectServiceClient) UpdateProjectPersona(ctx context.Context, in *UpdateProjectPersonaRequest, opts ...grpc.CallOption) (*UpdateProjectPersonaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProjectPersonaResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectPersona_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectMembersResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjectMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*AddProjectMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProjectMemberResponse)
+	err := c.cc.Invoke(ctx, ProjectService_AddProjectMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProjectMember(ctx context.Context, in *UpdateProjectMemberRequest, opts ...grpc.CallOption) (*UpdateProjectMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProjectMemberResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProjectMemberResponse)
+	err := c.cc.Invoke(ctx, ProjectService_RemoveProjectMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -126,10 +202,20 @@ type ProjectServiceServer interface {
 	UpsertProject(context.Context, *UpsertProjectRequest) (*UpsertProjectResponse, error)
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	RunProjectPaperScore(context.Context, *RunProjectPaperScoreRequest) (*RunProjectPaperScoreResponse, error)
+	ListProjectPaperScores(context.Context, *ListProjectPaperScoresRequest) (*ListProjectPaperScoresResponse, error)
 	RunProjectPaperScoreComment(context.Context, *RunProjectPaperScoreCommentRequest) (*RunProjectPaperScoreCommentResponse, error)
 	RunProjectOverleafComment(context.Context, *RunProjectOverleafCommentRequest) (*RunProjectOverleafCommentResponse, error)
 	GetProjectInstructions(context.Context, *GetProjectInstructionsRequest) (*GetProjectInstructionsResponse, error)
 	UpsertProjectInstructions(context.Context, *UpsertProjectInstructionsRequest) (*UpsertProjectInstructionsResponse, error)
+	// Sets the persona of the new conversations of the project that do not choose one.
+	UpdateProjectPersona(context.Context, *UpdateProjectPersonaRequest) (*UpdateProjectPersonaResponse, error)
+	// Lists the members of the project, the coauthors who see the conversations shared in it.
+	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
+	// Adds a user to the project by email, only the owner adds members.
+	AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error)
+	UpdateProjectMember(context.Context, *UpdateProjectMemberRequest) (*UpdateProjectMemberResponse, error)
+	// Removes a member, the members can remove themselves to leave the project.
+	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -149,6 +235,9 @@ func (UnimplementedProjectServiceServer) GetProject(context.Context, *GetProject
 func (UnimplementedProjectServiceServer) RunProjectPaperScore(context.Context, *RunProjectPaperScoreRequest) (*RunProjectPaperScoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunProjectPaperScore not implemented")
 }
+func (UnimplementedProjectServiceServer) ListProjectPaperScores(context.Context, *ListProjectPaperScoresRequest) (*ListProjectPaperScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectPaperScores not implemented")
+}
 func (UnimplementedProjectServiceServer) RunProjectPaperScoreComment(context.Context, *RunProjectPaperScoreCommentRequest) (*RunProjectPaperScoreCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunProjectPaperScoreComment not implemented")
 }
@@ -161,6 +250,21 @@ func (UnimplementedProjectServiceServer) GetProjectInstructions(context.Context,
 func (UnimplementedProjectServiceServer) UpsertProjectInstructions(context.Context, *UpsertProjectInstructionsRequest) (*UpsertProjectInstructionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertProjectInstructions not implemented")
 }
+func (UnimplementedProjectServiceServer) UpdateProjectPersona(context.Context, *UpdateProjectPersonaRequest) (*UpdateProjectPersonaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectPersona not implemented")
+}
+func (UnimplementedProjectServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
+}
+func (UnimplementedProjectServiceServer) AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectMember not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProjectMember(context.Context, *UpdateProjectMemberRequest) (*UpdateProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectMember not implemented")
+}
+func (UnimplementedProjectServiceServer) RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectMember not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -236,6 +340,24 @@ func _ProjectService_RunProjectPaperScore_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListProjectPaperScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectPaperScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectPaperScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjectPaperScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectPaperScores(ctx, req.(*ListProjectPaperScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_RunProjectPaperScoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunProjectPaperScoreCommentRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +430,96 @@ func _ProjectService_UpsertProjectInstructions_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_UpdateProjectPersona_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectPersonaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectPersona(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectPersona_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectPersona(ctx, req.(*UpdateProjectPersonaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjectMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectMembers(ctx, req.(*ListProjectMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_AddProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).AddProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_AddProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).AddProjectMember(ctx, req.(*AddProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectMember(ctx, req.(*UpdateProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RemoveProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RemoveProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_RemoveProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RemoveProjectMember(ctx, req.(*RemoveProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +539,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunProjectPaperScore",
 			Handler:    _ProjectService_RunProjectPaperScore_Handler,
 		},
+		{
+			MethodName: "ListProjectPaperScores",
+			Handler:    _ProjectService_ListProjectPaperScores_Handler,
+		},
 		{
 			MethodName: "RunProjectPaperScoreComment",
 			Handler:    _ProjectService_RunProjectPaperScoreComment_Handler,
@@ -343,6 +559,26 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertProjectInstructions",
 			Handler:    _ProjectService_UpsertProjectInstructions_Handler,
 		},
+		{
+			MethodName: "UpdateProjectPersona",
+			Handler:    _ProjectService_UpdateProjectPersona_Handler,
+		},
+		{
+			MethodName: "ListProjectMembers",
+			Handler:    _ProjectService_ListProjectMembers_Handler,
+		},
+		{
+			MethodName: "AddProjectMember",
+			Handler:    _ProjectService_AddProjectMember_Handler,
+		},
+		{
+			MethodName: "UpdateProjectMember",
+			Handler:    _ProjectService_UpdateProjectMember_Handler,
+		},
+		{
+			MethodName: "RemoveProjectMember",
+			Handler:    _ProjectService_RemoveProjectMember_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "project/v1/project.proto",
@@ -23,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PromptVariableType int32
+
+const (
+	PromptVariableType_PROMPT_VARIABLE_TYPE_UNSPECIFIED   PromptVariableType = 0
+	PromptVariableType_PROMPT_VARIABLE_TYPE_TEXT          PromptVariableType = 1
+	PromptVariableType_PROMPT_VARIABLE_TYPE_NUMBER        PromptVariableType = 2
+	PromptVariableType_PROMPT_VARIABLE_TYPE_CHOICE        PromptVariableType = 3 // one of the options
+	PromptVariableType_PROMPT_VARIABLE_TYPE_SELECTED_TEXT PromptVariableType = 4 // filled with the text selected in the editor
+)
+
+// Enum value maps for PromptVariableType.
+var (
+	PromptVariableType_name = map[int32]string{
+		0: "PROMPT_VARIABLE_TYPE_UNSPECIFIED",
+		1: "PROMPT_VARIABLE_TYPE_TEXT",
+		2: "PROMPT_VARIABLE_TYPE_NUMBER",
+		3: "PROMPT_VARIABLE_TYPE_CHOICE",
+		4: "PROMPT_VARIABLE_TYPE_SELECTED_TEXT",
+	}
+	PromptVariableType_value = map[string]int32{
+		"PROMPT_VARIABLE_TYPE_UNSPECIFIED":   0,
+		"PROMPT_VARIABLE_TYPE_TEXT":          1,
+		"PROMPT_VARIABLE_TYPE_NUMBER":        2,
+		"PROMPT_VARIABLE_TYPE_CHOICE":        3,
+		"PROMPT_VARIABLE_TYPE_SELECTED_TEXT": 4,
+	}
+)
+
+func (x PromptVariableType) Enum() *PromptVariableType {
+	p := new(PromptVariableType)
+	*p = x
+	return p
+}
+
+func (x PromptVariableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromptVariableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (PromptVariableType) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[0]
+}
+
+func (x PromptVariableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromptVariableType.Descriptor instead.
+func (PromptVariableType) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -172,13 +227,24 @@ func (x *GetUserResponse) GetUser() *User {
 }
 
 type Prompt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	IsUserPrompt  bool                   `protobuf:"varint,6,opt,name=is_user_prompt,json=isUserPrompt,proto3" json:"is_user_prompt,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content      string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	IsUserPrompt bool                   `protobuf:"varint,6,opt,name=is_user_prompt,json=isUserPrompt,proto3" json:"is_user_prompt,omitempty"`
+	// Set for the prompts of a team library, the editors of the organization change them.
+	OrganizationId   string `protobuf:"bytes,7,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string `protobuf:"bytes,8,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	// Set for the built-in prompts, the preferences refer to them by key.
+	Key      string   `protobuf:"bytes,9,opt,name=key,proto3" json:"key,omitempty"`
+	Category string   `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pinned   bool     `protobuf:"varint,12,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Hidden   bool     `protobuf:"varint,13,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// The placeholders {{name}} of the content, filled by RenderPrompt.
+	Variables     []*PromptVariable `protobuf:"bytes,14,rep,name=variables,proto3" json:"variables,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -255,26 +321,88 @@ func (x *Prompt) GetIsUserPrompt() bool {
 	return false
 }
 
-type ListPromptsRequest struct {
+func (x *Prompt) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Prompt) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *Prompt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Prompt) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Prompt) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Prompt) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Prompt) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Prompt) GetVariables() []*PromptVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type PromptVariable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // lowercase letters, digits and underscores
+	Type          PromptVariableType     `protobuf:"varint,2,opt,name=type,proto3,enum=user.v1.PromptVariableType" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"` // for PROMPT_VARIABLE_TYPE_CHOICE
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPromptsRequest) Reset() {
-	*x = ListPromptsRequest{}
+func (x *PromptVariable) Reset() {
+	*x = PromptVariable{}
 	mi := &file_user_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPromptsRequest) String() string {
+func (x *PromptVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromptsRequest) ProtoMessage() {}
+func (*PromptVariable) ProtoMessage() {}
 
-func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+func (x *PromptVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,32 +414,75 @@ func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
-func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromptVariable.ProtoReflect.Descriptor instead.
+func (*PromptVariable) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
-type ListPromptsResponse struct {
+func (x *PromptVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptVariable) GetType() PromptVariableType {
+	if x != nil {
+		return x.Type
+	}
+	return PromptVariableType_PROMPT_VARIABLE_TYPE_UNSPECIFIED
+}
+
+func (x *PromptVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptVariable) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *PromptVariable) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PromptVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type ListPromptsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`                                     // e.g. "zh-CN", the titles of the built-in prompts are localized
+	IncludeHidden bool                   `protobuf:"varint,2,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"` // include the built-in prompts hidden by the user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPromptsResponse) Reset() {
-	*x = ListPromptsResponse{}
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPromptsResponse) String() string {
+func (x *ListPromptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPromptsResponse) ProtoMessage() {}
+func (*ListPromptsRequest) ProtoMessage() {}
 
-func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,40 +494,48 @@ func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
-func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListPromptsResponse) GetPrompts() []*Prompt {
+func (x *ListPromptsRequest) GetLocale() string {
 	if x != nil {
-		return x.Prompts
+		return x.Locale
 	}
-	return nil
+	return ""
 }
 
-type CreatePromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+func (x *ListPromptsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type ListPromptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pinned built-in prompts, the prompts of the user, the team prompts, then the other
+	// built-in prompts.
+	Prompts       []*Prompt `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromptRequest) Reset() {
-	*x = CreatePromptRequest{}
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromptRequest) String() string {
+func (x *ListPromptsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromptRequest) ProtoMessage() {}
+func (*ListPromptsResponse) ProtoMessage() {}
 
-func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,46 +547,39 @@ func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
-func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreatePromptRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreatePromptRequest) GetContent() string {
+func (x *ListPromptsResponse) GetPrompts() []*Prompt {
 	if x != nil {
-		return x.Content
+		return x.Prompts
 	}
-	return ""
+	return nil
 }
 
-type CreatePromptResponse struct {
+type PromptKeys struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePromptResponse) Reset() {
-	*x = CreatePromptResponse{}
+func (x *PromptKeys) Reset() {
+	*x = PromptKeys{}
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePromptResponse) String() string {
+func (x *PromptKeys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePromptResponse) ProtoMessage() {}
+func (*PromptKeys) ProtoMessage() {}
 
-func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
+func (x *PromptKeys) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,41 +591,42 @@ func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
-func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromptKeys.ProtoReflect.Descriptor instead.
+func (*PromptKeys) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreatePromptResponse) GetPrompt() *Prompt {
+func (x *PromptKeys) GetKeys() []string {
 	if x != nil {
-		return x.Prompt
+		return x.Keys
 	}
 	return nil
 }
 
-type UpdatePromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+type UpdatePromptPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys of the built-in prompts hidden from the library. Left unset, the current list is kept.
+	HiddenPrompts *PromptKeys `protobuf:"bytes,1,opt,name=hidden_prompts,json=hiddenPrompts,proto3" json:"hidden_prompts,omitempty"`
+	// Keys of the built-in prompts listed first. Left unset, the current list is kept.
+	PinnedPrompts *PromptKeys `protobuf:"bytes,2,opt,name=pinned_prompts,json=pinnedPrompts,proto3" json:"pinned_prompts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePromptRequest) Reset() {
-	*x = UpdatePromptRequest{}
+func (x *UpdatePromptPreferencesRequest) Reset() {
+	*x = UpdatePromptPreferencesRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePromptRequest) String() string {
+func (x *UpdatePromptPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePromptRequest) ProtoMessage() {}
+func (*UpdatePromptPreferencesRequest) ProtoMessage() {}
 
-func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePromptPreferencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,53 +638,47 @@ func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePromptPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptPreferencesRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdatePromptRequest) GetPromptId() string {
-	if x != nil {
-		return x.PromptId
-	}
-	return ""
-}
-
-func (x *UpdatePromptRequest) GetTitle() string {
+func (x *UpdatePromptPreferencesRequest) GetHiddenPrompts() *PromptKeys {
 	if x != nil {
-		return x.Title
+		return x.HiddenPrompts
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePromptRequest) GetContent() string {
+func (x *UpdatePromptPreferencesRequest) GetPinnedPrompts() *PromptKeys {
 	if x != nil {
-		return x.Content
+		return x.PinnedPrompts
 	}
-	return ""
+	return nil
 }
 
-type UpdatePromptResponse struct {
+type UpdatePromptPreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	HiddenPrompts []string               `protobuf:"bytes,1,rep,name=hidden_prompts,json=hiddenPrompts,proto3" json:"hidden_prompts,omitempty"`
+	PinnedPrompts []string               `protobuf:"bytes,2,rep,name=pinned_prompts,json=pinnedPrompts,proto3" json:"pinned_prompts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePromptResponse) Reset() {
-	*x = UpdatePromptResponse{}
+func (x *UpdatePromptPreferencesResponse) Reset() {
+	*x = UpdatePromptPreferencesResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePromptResponse) String() string {
+func (x *UpdatePromptPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePromptResponse) ProtoMessage() {}
+func (*UpdatePromptPreferencesResponse) ProtoMessage() {}
 
-func (x *UpdatePromptResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdatePromptPreferencesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,39 +690,49 @@ func (x *UpdatePromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePromptResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePromptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePromptPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptPreferencesResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdatePromptResponse) GetPrompt() *Prompt {
+func (x *UpdatePromptPreferencesResponse) GetHiddenPrompts() []string {
 	if x != nil {
-		return x.Prompt
+		return x.HiddenPrompts
 	}
 	return nil
 }
 
-type DeletePromptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdatePromptPreferencesResponse) GetPinnedPrompts() []string {
+	if x != nil {
+		return x.PinnedPrompts
+	}
+	return nil
 }
 
-func (x *DeletePromptRequest) Reset() {
-	*x = DeletePromptRequest{}
+type CreatePromptRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	OrganizationId *string                `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // adds the prompt to the library of the organization
+	Variables      []*PromptVariable      `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePromptRequest) Reset() {
+	*x = CreatePromptRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePromptRequest) String() string {
+func (x *CreatePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePromptRequest) ProtoMessage() {}
+func (*CreatePromptRequest) ProtoMessage() {}
 
-func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,38 +744,60 @@ func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
-func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeletePromptRequest) GetPromptId() string {
+func (x *CreatePromptRequest) GetTitle() string {
 	if x != nil {
-		return x.PromptId
+		return x.Title
 	}
 	return ""
 }
 
-type DeletePromptResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreatePromptRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
 }
 
-func (x *DeletePromptResponse) Reset() {
-	*x = DeletePromptResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreatePromptRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
 }
 
-func (x *DeletePromptResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreatePromptRequest) GetVariables() []*PromptVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
 }
 
-func (*DeletePromptResponse) ProtoMessage() {}
+type CreatePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
+func (x *CreatePromptResponse) Reset() {
+	*x = CreatePromptResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptResponse) ProtoMessage() {}
+
+func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -610,36 +809,42 @@ func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePromptResponse.ProtoReflect.Descriptor instead.
-func (*DeletePromptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
-type Settings struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	ShowShortcutsAfterSelection  bool                   `protobuf:"varint,1,opt,name=show_shortcuts_after_selection,json=showShortcutsAfterSelection,proto3" json:"show_shortcuts_after_selection,omitempty"`
-	FullWidthPaperDebuggerButton bool                   `protobuf:"varint,2,opt,name=full_width_paper_debugger_button,json=fullWidthPaperDebuggerButton,proto3" json:"full_width_paper_debugger_button,omitempty"`
-	EnableCompletion             bool                   `protobuf:"varint,3,opt,name=enable_completion,json=enableCompletion,proto3" json:"enable_completion,omitempty"`
-	FullDocumentRag              bool                   `protobuf:"varint,4,opt,name=full_document_rag,json=fullDocumentRag,proto3" json:"full_document_rag,omitempty"`
-	ShowedOnboarding             bool                   `protobuf:"varint,5,opt,name=showed_onboarding,json=showedOnboarding,proto3" json:"showed_onboarding,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+func (x *CreatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
 }
 
-func (x *Settings) Reset() {
-	*x = Settings{}
+type UpdatePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Variables     []*PromptVariable      `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromptRequest) Reset() {
+	*x = UpdatePromptRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settings) String() string {
+func (x *UpdatePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Settings) ProtoMessage() {}
+func (*UpdatePromptRequest) ProtoMessage() {}
 
-func (x *Settings) ProtoReflect() protoreflect.Message {
+func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,66 +856,60 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
-func (*Settings) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Settings) GetShowShortcutsAfterSelection() bool {
-	if x != nil {
-		return x.ShowShortcutsAfterSelection
-	}
-	return false
-}
-
-func (x *Settings) GetFullWidthPaperDebuggerButton() bool {
+func (x *UpdatePromptRequest) GetPromptId() string {
 	if x != nil {
-		return x.FullWidthPaperDebuggerButton
+		return x.PromptId
 	}
-	return false
+	return ""
 }
 
-func (x *Settings) GetEnableCompletion() bool {
+func (x *UpdatePromptRequest) GetTitle() string {
 	if x != nil {
-		return x.EnableCompletion
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-func (x *Settings) GetFullDocumentRag() bool {
+func (x *UpdatePromptRequest) GetContent() string {
 	if x != nil {
-		return x.FullDocumentRag
+		return x.Content
 	}
-	return false
+	return ""
 }
 
-func (x *Settings) GetShowedOnboarding() bool {
+func (x *UpdatePromptRequest) GetVariables() []*PromptVariable {
 	if x != nil {
-		return x.ShowedOnboarding
+		return x.Variables
 	}
-	return false
+	return nil
 }
 
-type GetSettingsRequest struct {
+type UpdatePromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsRequest) Reset() {
-	*x = GetSettingsRequest{}
+func (x *UpdatePromptResponse) Reset() {
+	*x = UpdatePromptResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsRequest) String() string {
+func (x *UpdatePromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsRequest) ProtoMessage() {}
+func (*UpdatePromptResponse) ProtoMessage() {}
 
-func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePromptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,32 +921,40 @@ func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePromptResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
-type GetSettingsResponse struct {
+func (x *UpdatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type RenderPromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by variable name, the defaults fill the missing ones
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSettingsResponse) Reset() {
-	*x = GetSettingsResponse{}
+func (x *RenderPromptRequest) Reset() {
+	*x = RenderPromptRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettingsResponse) String() string {
+func (x *RenderPromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettingsResponse) ProtoMessage() {}
+func (*RenderPromptRequest) ProtoMessage() {}
 
-func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *RenderPromptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,39 +966,46 @@ func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderPromptRequest.ProtoReflect.Descriptor instead.
+func (*RenderPromptRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSettingsResponse) GetSettings() *Settings {
+func (x *RenderPromptRequest) GetPromptId() string {
 	if x != nil {
-		return x.Settings
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *RenderPromptRequest) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type UpdateSettingsRequest struct {
+type RenderPromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsRequest) Reset() {
-	*x = UpdateSettingsRequest{}
+func (x *RenderPromptResponse) Reset() {
+	*x = RenderPromptResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsRequest) String() string {
+func (x *RenderPromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsRequest) ProtoMessage() {}
+func (*RenderPromptResponse) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *RenderPromptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,39 +1017,43 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderPromptResponse.ProtoReflect.Descriptor instead.
+func (*RenderPromptResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateSettingsRequest) GetSettings() *Settings {
+func (x *RenderPromptResponse) GetContent() string {
 	if x != nil {
-		return x.Settings
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type UpdateSettingsResponse struct {
+type PromptStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Uses          int32                  `protobuf:"varint,2,opt,name=uses,proto3" json:"uses,omitempty"`                                      // messages sent from the prompt by the user
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"` // by the user
+	TotalUses     int32                  `protobuf:"varint,4,opt,name=total_uses,json=totalUses,proto3" json:"total_uses,omitempty"`           // by all the users
+	Users         int32                  `protobuf:"varint,5,opt,name=users,proto3" json:"users,omitempty"`                                    // who used the prompt
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettingsResponse) Reset() {
-	*x = UpdateSettingsResponse{}
+func (x *PromptStats) Reset() {
+	*x = PromptStats{}
 	mi := &file_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettingsResponse) String() string {
+func (x *PromptStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsResponse) ProtoMessage() {}
+func (*PromptStats) ProtoMessage() {}
 
-func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *PromptStats) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,38 +1065,66 @@ func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromptStats.ProtoReflect.Descriptor instead.
+func (*PromptStats) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateSettingsResponse) GetSettings() *Settings {
+func (x *PromptStats) GetPromptId() string {
 	if x != nil {
-		return x.Settings
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *PromptStats) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *PromptStats) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
 	}
 	return nil
 }
 
-type ResetSettingsRequest struct {
+func (x *PromptStats) GetTotalUses() int32 {
+	if x != nil {
+		return x.TotalUses
+	}
+	return 0
+}
+
+func (x *PromptStats) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+type GetPromptStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetSettingsRequest) Reset() {
-	*x = ResetSettingsRequest{}
+func (x *GetPromptStatsRequest) Reset() {
+	*x = GetPromptStatsRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetSettingsRequest) String() string {
+func (x *GetPromptStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSettingsRequest) ProtoMessage() {}
+func (*GetPromptStatsRequest) ProtoMessage() {}
 
-func (x *ResetSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPromptStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -890,32 +1136,32 @@ func (x *ResetSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSettingsRequest.ProtoReflect.Descriptor instead.
-func (*ResetSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPromptStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptStatsRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
-type ResetSettingsResponse struct {
+type GetPromptStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	Stats         []*PromptStats         `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"` // most used first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetSettingsResponse) Reset() {
-	*x = ResetSettingsResponse{}
+func (x *GetPromptStatsResponse) Reset() {
+	*x = GetPromptStatsResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetSettingsResponse) String() string {
+func (x *GetPromptStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSettingsResponse) ProtoMessage() {}
+func (*GetPromptStatsResponse) ProtoMessage() {}
 
-func (x *ResetSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPromptStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,38 +1173,39 @@ func (x *ResetSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSettingsResponse.ProtoReflect.Descriptor instead.
-func (*ResetSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPromptStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPromptStatsResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ResetSettingsResponse) GetSettings() *Settings {
+func (x *GetPromptStatsResponse) GetStats() []*PromptStats {
 	if x != nil {
-		return x.Settings
+		return x.Stats
 	}
 	return nil
 }
 
-type GetUserInstructionsRequest struct {
+type SharePromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInstructionsRequest) Reset() {
-	*x = GetUserInstructionsRequest{}
+func (x *SharePromptRequest) Reset() {
+	*x = SharePromptRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInstructionsRequest) String() string {
+func (x *SharePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInstructionsRequest) ProtoMessage() {}
+func (*SharePromptRequest) ProtoMessage() {}
 
-func (x *GetUserInstructionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SharePromptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,32 +1217,40 @@ func (x *GetUserInstructionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInstructionsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserInstructionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SharePromptRequest.ProtoReflect.Descriptor instead.
+func (*SharePromptRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
-type GetUserInstructionsResponse struct {
+func (x *SharePromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type SharePromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Imports       int32                  `protobuf:"varint,2,opt,name=imports,proto3" json:"imports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInstructionsResponse) Reset() {
-	*x = GetUserInstructionsResponse{}
+func (x *SharePromptResponse) Reset() {
+	*x = SharePromptResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInstructionsResponse) String() string {
+func (x *SharePromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInstructionsResponse) ProtoMessage() {}
+func (*SharePromptResponse) ProtoMessage() {}
 
-func (x *GetUserInstructionsResponse) ProtoReflect() protoreflect.Message {
+func (x *SharePromptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,39 +1262,46 @@ func (x *GetUserInstructionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInstructionsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserInstructionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SharePromptResponse.ProtoReflect.Descriptor instead.
+func (*SharePromptResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetUserInstructionsResponse) GetInstructions() string {
+func (x *SharePromptResponse) GetToken() string {
 	if x != nil {
-		return x.Instructions
+		return x.Token
 	}
 	return ""
 }
 
-type UpsertUserInstructionsRequest struct {
+func (x *SharePromptResponse) GetImports() int32 {
+	if x != nil {
+		return x.Imports
+	}
+	return 0
+}
+
+type RevokePromptShareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertUserInstructionsRequest) Reset() {
-	*x = UpsertUserInstructionsRequest{}
+func (x *RevokePromptShareRequest) Reset() {
+	*x = RevokePromptShareRequest{}
 	mi := &file_user_v1_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertUserInstructionsRequest) String() string {
+func (x *RevokePromptShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertUserInstructionsRequest) ProtoMessage() {}
+func (*RevokePromptShareRequest) ProtoMessage() {}
 
-func (x *UpsertUserInstructionsRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokePromptShareRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,39 +1313,38 @@ func (x *UpsertUserInstructionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertUserInstructionsRequest.ProtoReflect.Descriptor instead.
-func (*UpsertUserInstructionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokePromptShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokePromptShareRequest) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpsertUserInstructionsRequest) GetInstructions() string {
+func (x *RevokePromptShareRequest) GetPromptId() string {
 	if x != nil {
-		return x.Instructions
+		return x.PromptId
 	}
 	return ""
 }
 
-type UpsertUserInstructionsResponse struct {
+type RevokePromptShareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertUserInstructionsResponse) Reset() {
-	*x = UpsertUserInstructionsResponse{}
+func (x *RevokePromptShareResponse) Reset() {
+	*x = RevokePromptShareResponse{}
 	mi := &file_user_v1_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertUserInstructionsResponse) String() string {
+func (x *RevokePromptShareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertUserInstructionsResponse) ProtoMessage() {}
+func (*RevokePromptShareResponse) ProtoMessage() {}
 
-func (x *UpsertUserInstructionsResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokePromptShareResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_v1_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,18 +1356,1304 @@ func (x *UpsertUserInstructionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertUserInstructionsResponse.ProtoReflect.Descriptor instead.
-func (*UpsertUserInstructionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokePromptShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokePromptShareResponse) Descriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpsertUserInstructionsResponse) GetInstructions() string {
-	if x != nil {
-		return x.Instructions
-	}
-	return ""
-}
-
+type ImportSharedPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharedPromptRequest) Reset() {
+	*x = ImportSharedPromptRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedPromptRequest) ProtoMessage() {}
+
+func (x *ImportSharedPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedPromptRequest.ProtoReflect.Descriptor instead.
+func (*ImportSharedPromptRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportSharedPromptRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ImportSharedPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharedPromptResponse) Reset() {
+	*x = ImportSharedPromptResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedPromptResponse) ProtoMessage() {}
+
+func (x *ImportSharedPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedPromptResponse.ProtoReflect.Descriptor instead.
+func (*ImportSharedPromptResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportSharedPromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type DeletePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptRequest) Reset() {
+	*x = DeletePromptRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptRequest) ProtoMessage() {}
+
+func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeletePromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type DeletePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromptResponse) Reset() {
+	*x = DeletePromptResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromptResponse) ProtoMessage() {}
+
+func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromptResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromptResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+type DeletedPrompt struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Prompt    *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// When the prompt is deleted for good, unset if it is kept forever.
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedPrompt) Reset() {
+	*x = DeletedPrompt{}
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedPrompt) ProtoMessage() {}
+
+func (x *DeletedPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedPrompt.ProtoReflect.Descriptor instead.
+func (*DeletedPrompt) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeletedPrompt) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *DeletedPrompt) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedPrompt) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListDeletedPromptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPromptsRequest) Reset() {
+	*x = ListDeletedPromptsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPromptsRequest) ProtoMessage() {}
+
+func (x *ListDeletedPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+type ListDeletedPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*DeletedPrompt       `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPromptsResponse) Reset() {
+	*x = ListDeletedPromptsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPromptsResponse) ProtoMessage() {}
+
+func (x *ListDeletedPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDeletedPromptsResponse) GetPrompts() []*DeletedPrompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type RestorePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePromptRequest) Reset() {
+	*x = RestorePromptRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePromptRequest) ProtoMessage() {}
+
+func (x *RestorePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePromptRequest.ProtoReflect.Descriptor instead.
+func (*RestorePromptRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestorePromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type RestorePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePromptResponse) Reset() {
+	*x = RestorePromptResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePromptResponse) ProtoMessage() {}
+
+func (x *RestorePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePromptResponse.ProtoReflect.Descriptor instead.
+func (*RestorePromptResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestorePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type Settings struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	ShowShortcutsAfterSelection  bool                   `protobuf:"varint,1,opt,name=show_shortcuts_after_selection,json=showShortcutsAfterSelection,proto3" json:"show_shortcuts_after_selection,omitempty"`
+	FullWidthPaperDebuggerButton bool                   `protobuf:"varint,2,opt,name=full_width_paper_debugger_button,json=fullWidthPaperDebuggerButton,proto3" json:"full_width_paper_debugger_button,omitempty"`
+	EnableCompletion             bool                   `protobuf:"varint,3,opt,name=enable_completion,json=enableCompletion,proto3" json:"enable_completion,omitempty"`
+	FullDocumentRag              bool                   `protobuf:"varint,4,opt,name=full_document_rag,json=fullDocumentRag,proto3" json:"full_document_rag,omitempty"`
+	ShowedOnboarding             bool                   `protobuf:"varint,5,opt,name=showed_onboarding,json=showedOnboarding,proto3" json:"showed_onboarding,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Settings) GetShowShortcutsAfterSelection() bool {
+	if x != nil {
+		return x.ShowShortcutsAfterSelection
+	}
+	return false
+}
+
+func (x *Settings) GetFullWidthPaperDebuggerButton() bool {
+	if x != nil {
+		return x.FullWidthPaperDebuggerButton
+	}
+	return false
+}
+
+func (x *Settings) GetEnableCompletion() bool {
+	if x != nil {
+		return x.EnableCompletion
+	}
+	return false
+}
+
+func (x *Settings) GetFullDocumentRag() bool {
+	if x != nil {
+		return x.FullDocumentRag
+	}
+	return false
+}
+
+func (x *Settings) GetShowedOnboarding() bool {
+	if x != nil {
+		return x.ShowedOnboarding
+	}
+	return false
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSettingsResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateSettingsRequest) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsResponse) Reset() {
+	*x = UpdateSettingsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateSettingsResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ResetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSettingsRequest) Reset() {
+	*x = ResetSettingsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSettingsRequest) ProtoMessage() {}
+
+func (x *ResetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ResetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+}
+
+type ResetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSettingsResponse) Reset() {
+	*x = ResetSettingsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSettingsResponse) ProtoMessage() {}
+
+func (x *ResetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ResetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResetSettingsResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetUserInstructionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInstructionsRequest) Reset() {
+	*x = GetUserInstructionsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInstructionsRequest) ProtoMessage() {}
+
+func (x *GetUserInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+}
+
+type GetUserInstructionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInstructionsResponse) Reset() {
+	*x = GetUserInstructionsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInstructionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInstructionsResponse) ProtoMessage() {}
+
+func (x *GetUserInstructionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInstructionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserInstructionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserInstructionsResponse) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+type UpsertUserInstructionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUserInstructionsRequest) Reset() {
+	*x = UpsertUserInstructionsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUserInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserInstructionsRequest) ProtoMessage() {}
+
+func (x *UpsertUserInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*UpsertUserInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpsertUserInstructionsRequest) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+type UpsertUserInstructionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instructions  string                 `protobuf:"bytes,1,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUserInstructionsResponse) Reset() {
+	*x = UpsertUserInstructionsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUserInstructionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserInstructionsResponse) ProtoMessage() {}
+
+func (x *UpsertUserInstructionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserInstructionsResponse.ProtoReflect.Descriptor instead.
+func (*UpsertUserInstructionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpsertUserInstructionsResponse) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+type Tool struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled         bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"` // whether the assistant may use the tool, after applying user and project preferences
+	DisabledByUser  bool                   `protobuf:"varint,4,opt,name=disabled_by_user,json=disabledByUser,proto3" json:"disabled_by_user,omitempty"`
+	PinnedByProject bool                   `protobuf:"varint,5,opt,name=pinned_by_project,json=pinnedByProject,proto3" json:"pinned_by_project,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tool) Reset() {
+	*x = Tool{}
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tool) ProtoMessage() {}
+
+func (x *Tool) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
+func (*Tool) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Tool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tool) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Tool) GetDisabledByUser() bool {
+	if x != nil {
+		return x.DisabledByUser
+	}
+	return false
+}
+
+func (x *Tool) GetPinnedByProject() bool {
+	if x != nil {
+		return x.PinnedByProject
+	}
+	return false
+}
+
+type ToolNames struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToolNames) Reset() {
+	*x = ToolNames{}
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToolNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolNames) ProtoMessage() {}
+
+func (x *ToolNames) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolNames.ProtoReflect.Descriptor instead.
+func (*ToolNames) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ToolNames) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ListAvailableToolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     *string                `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"` // if set, the project's pinned tool set is applied as well
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableToolsRequest) Reset() {
+	*x = ListAvailableToolsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableToolsRequest) ProtoMessage() {}
+
+func (x *ListAvailableToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableToolsRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableToolsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAvailableToolsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+type ListAvailableToolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tools         []*Tool                `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableToolsResponse) Reset() {
+	*x = ListAvailableToolsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableToolsResponse) ProtoMessage() {}
+
+func (x *ListAvailableToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableToolsResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableToolsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListAvailableToolsResponse) GetTools() []*Tool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type UpdateToolPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tools the assistant must never use for this user. Left unset, the current list is kept.
+	DisabledTools *ToolNames `protobuf:"bytes,1,opt,name=disabled_tools,json=disabledTools,proto3" json:"disabled_tools,omitempty"`
+	ProjectId     *string    `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Requires project_id. Only these tools may be used in the project, an empty list removes the pin.
+	ProjectPinnedTools *ToolNames `protobuf:"bytes,3,opt,name=project_pinned_tools,json=projectPinnedTools,proto3" json:"project_pinned_tools,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateToolPreferencesRequest) Reset() {
+	*x = UpdateToolPreferencesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateToolPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToolPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateToolPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToolPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToolPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateToolPreferencesRequest) GetDisabledTools() *ToolNames {
+	if x != nil {
+		return x.DisabledTools
+	}
+	return nil
+}
+
+func (x *UpdateToolPreferencesRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateToolPreferencesRequest) GetProjectPinnedTools() *ToolNames {
+	if x != nil {
+		return x.ProjectPinnedTools
+	}
+	return nil
+}
+
+type UpdateToolPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tools         []*Tool                `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateToolPreferencesResponse) Reset() {
+	*x = UpdateToolPreferencesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateToolPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToolPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateToolPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToolPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateToolPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateToolPreferencesResponse) GetTools() []*Tool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The email of the user, to confirm the deletion.
+	ConfirmEmail  string `protobuf:"bytes,1,opt,name=confirm_email,json=confirmEmail,proto3" json:"confirm_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountRequest) GetConfirmEmail() string {
+	if x != nil {
+		return x.ConfirmEmail
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the account and its data are deleted for good.
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteAccountResponse) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
@@ -1119,7 +2666,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\apicture\x18\x04 \x01(\tR\apicture\"\x10\n" +
 	"\x0eGetUserRequest\"4\n" +
 	"\x0fGetUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xe4\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xe3\x03\n" +
 	"\x06Prompt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -1128,24 +2675,99 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x12$\n" +
-	"\x0eis_user_prompt\x18\x06 \x01(\bR\fisUserPrompt\"\x14\n" +
-	"\x12ListPromptsRequest\"@\n" +
+	"\x0eis_user_prompt\x18\x06 \x01(\bR\fisUserPrompt\x12'\n" +
+	"\x0forganization_id\x18\a \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\b \x01(\tR\x10organizationName\x12\x10\n" +
+	"\x03key\x18\t \x01(\tR\x03key\x12\x1a\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x16\n" +
+	"\x06pinned\x18\f \x01(\bR\x06pinned\x12\x16\n" +
+	"\x06hidden\x18\r \x01(\bR\x06hidden\x125\n" +
+	"\tvariables\x18\x0e \x03(\v2\x17.user.v1.PromptVariableR\tvariables\"\xd2\x01\n" +
+	"\x0ePromptVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.user.v1.PromptVariableTypeR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\"S\n" +
+	"\x12ListPromptsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12%\n" +
+	"\x0einclude_hidden\x18\x02 \x01(\bR\rincludeHidden\"@\n" +
 	"\x13ListPromptsResponse\x12)\n" +
-	"\aprompts\x18\x01 \x03(\v2\x0f.user.v1.PromptR\aprompts\"E\n" +
+	"\aprompts\x18\x01 \x03(\v2\x0f.user.v1.PromptR\aprompts\" \n" +
+	"\n" +
+	"PromptKeys\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\x98\x01\n" +
+	"\x1eUpdatePromptPreferencesRequest\x12:\n" +
+	"\x0ehidden_prompts\x18\x01 \x01(\v2\x13.user.v1.PromptKeysR\rhiddenPrompts\x12:\n" +
+	"\x0epinned_prompts\x18\x02 \x01(\v2\x13.user.v1.PromptKeysR\rpinnedPrompts\"o\n" +
+	"\x1fUpdatePromptPreferencesResponse\x12%\n" +
+	"\x0ehidden_prompts\x18\x01 \x03(\tR\rhiddenPrompts\x12%\n" +
+	"\x0epinned_prompts\x18\x02 \x03(\tR\rpinnedPrompts\"\xbe\x01\n" +
 	"\x13CreatePromptRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"?\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12,\n" +
+	"\x0forganization_id\x18\x03 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x125\n" +
+	"\tvariables\x18\x04 \x03(\v2\x17.user.v1.PromptVariableR\tvariablesB\x12\n" +
+	"\x10_organization_id\"?\n" +
 	"\x14CreatePromptResponse\x12'\n" +
-	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\"b\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\"\x99\x01\n" +
 	"\x13UpdatePromptRequest\x12\x1b\n" +
 	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"?\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x125\n" +
+	"\tvariables\x18\x04 \x03(\v2\x17.user.v1.PromptVariableR\tvariables\"?\n" +
 	"\x14UpdatePromptResponse\x12'\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\"\xaf\x01\n" +
+	"\x13RenderPromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12@\n" +
+	"\x06values\x18\x02 \x03(\v2(.user.v1.RenderPromptRequest.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x14RenderPromptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"\xc7\x01\n" +
+	"\vPromptStats\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x12\n" +
+	"\x04uses\x18\x02 \x01(\x05R\x04uses\x12A\n" +
+	"\flast_used_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastUsedAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"total_uses\x18\x04 \x01(\x05R\ttotalUses\x12\x14\n" +
+	"\x05users\x18\x05 \x01(\x05R\x05usersB\x0f\n" +
+	"\r_last_used_at\"\x17\n" +
+	"\x15GetPromptStatsRequest\"D\n" +
+	"\x16GetPromptStatsResponse\x12*\n" +
+	"\x05stats\x18\x01 \x03(\v2\x14.user.v1.PromptStatsR\x05stats\"1\n" +
+	"\x12SharePromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"E\n" +
+	"\x13SharePromptResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x18\n" +
+	"\aimports\x18\x02 \x01(\x05R\aimports\"7\n" +
+	"\x18RevokePromptShareRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"\x1b\n" +
+	"\x19RevokePromptShareResponse\"1\n" +
+	"\x19ImportSharedPromptRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"E\n" +
+	"\x1aImportSharedPromptResponse\x12'\n" +
 	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\"2\n" +
 	"\x13DeletePromptRequest\x12\x1b\n" +
 	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"\x16\n" +
-	"\x14DeletePromptResponse\"\x9d\x02\n" +
+	"\x14DeletePromptResponse\"\xaa\x01\n" +
+	"\rDeletedPrompt\x12'\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"\x1b\n" +
+	"\x19ListDeletedPromptsRequest\"N\n" +
+	"\x1aListDeletedPromptsResponse\x120\n" +
+	"\aprompts\x18\x01 \x03(\v2\x16.user.v1.DeletedPromptR\aprompts\"3\n" +
+	"\x14RestorePromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"@\n" +
+	"\x15RestorePromptResponse\x12'\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x0f.user.v1.PromptR\x06prompt\"\x9d\x02\n" +
 	"\bSettings\x12C\n" +
 	"\x1eshow_shortcuts_after_selection\x18\x01 \x01(\bR\x1bshowShortcutsAfterSelection\x12F\n" +
 	" full_width_paper_debugger_button\x18\x02 \x01(\bR\x1cfullWidthPaperDebuggerButton\x12+\n" +
@@ -1168,19 +2790,61 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x1dUpsertUserInstructionsRequest\x12\"\n" +
 	"\finstructions\x18\x01 \x01(\tR\finstructions\"D\n" +
 	"\x1eUpsertUserInstructionsResponse\x12\"\n" +
-	"\finstructions\x18\x01 \x01(\tR\finstructions2\x83\n" +
+	"\finstructions\x18\x01 \x01(\tR\finstructions\"\xac\x01\n" +
+	"\x04Tool\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12(\n" +
+	"\x10disabled_by_user\x18\x04 \x01(\bR\x0edisabledByUser\x12*\n" +
+	"\x11pinned_by_project\x18\x05 \x01(\bR\x0fpinnedByProject\"!\n" +
+	"\tToolNames\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"N\n" +
+	"\x19ListAvailableToolsRequest\x12\"\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01B\r\n" +
+	"\v_project_id\"A\n" +
+	"\x1aListAvailableToolsResponse\x12#\n" +
+	"\x05tools\x18\x01 \x03(\v2\r.user.v1.ToolR\x05tools\"\xd2\x01\n" +
+	"\x1cUpdateToolPreferencesRequest\x129\n" +
+	"\x0edisabled_tools\x18\x01 \x01(\v2\x12.user.v1.ToolNamesR\rdisabledTools\x12\"\n" +
 	"\n" +
+	"project_id\x18\x02 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12D\n" +
+	"\x14project_pinned_tools\x18\x03 \x01(\v2\x12.user.v1.ToolNamesR\x12projectPinnedToolsB\r\n" +
+	"\v_project_id\"D\n" +
+	"\x1dUpdateToolPreferencesResponse\x12#\n" +
+	"\x05tools\x18\x01 \x03(\v2\r.user.v1.ToolR\x05tools\";\n" +
+	"\x14DeleteAccountRequest\x12#\n" +
+	"\rconfirm_email\x18\x01 \x01(\tR\fconfirmEmail\"N\n" +
+	"\x15DeleteAccountResponse\x125\n" +
+	"\bpurge_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt*\xc3\x01\n" +
+	"\x12PromptVariableType\x12$\n" +
+	" PROMPT_VARIABLE_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19PROMPT_VARIABLE_TYPE_TEXT\x10\x01\x12\x1f\n" +
+	"\x1bPROMPT_VARIABLE_TYPE_NUMBER\x10\x02\x12\x1f\n" +
+	"\x1bPROMPT_VARIABLE_TYPE_CHOICE\x10\x03\x12&\n" +
+	"\"PROMPT_VARIABLE_TYPE_SELECTED_TEXT\x10\x042\xa2\x16\n" +
 	"\vUserService\x12]\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/_pd/api/v1/users/@self\x12q\n" +
-	"\vListPrompts\x12\x1b.user.v1.ListPromptsRequest\x1a\x1c.user.v1.ListPromptsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/_pd/api/v1/users/@self/prompts\x12w\n" +
+	"\vListPrompts\x12\x1b.user.v1.ListPromptsRequest\x1a\x1c.user.v1.ListPromptsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/_pd/api/v1/users/@self/prompts\x12\xa3\x01\n" +
+	"\x17UpdatePromptPreferences\x12'.user.v1.UpdatePromptPreferencesRequest\x1a(.user.v1.UpdatePromptPreferencesResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\x1a*/_pd/api/v1/users/@self/prompt-preferences\x12w\n" +
 	"\fCreatePrompt\x12\x1c.user.v1.CreatePromptRequest\x1a\x1d.user.v1.CreatePromptResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/_pd/api/v1/users/@self/prompts\x12\x83\x01\n" +
 	"\fUpdatePrompt\x12\x1c.user.v1.UpdatePromptRequest\x1a\x1d.user.v1.UpdatePromptResponse\"6\x82\xd3\xe4\x93\x020:\x01*\x1a+/_pd/api/v1/users/@self/prompts/{prompt_id}\x12\x8e\x01\n" +
 	"\x13GetUserInstructions\x12#.user.v1.GetUserInstructionsRequest\x1a$.user.v1.GetUserInstructionsResponse\",\x82\xd3\xe4\x93\x02&\x12$/_pd/api/v1/users/@self/instructions\x12\x9a\x01\n" +
 	"\x16UpsertUserInstructions\x12&.user.v1.UpsertUserInstructionsRequest\x1a'.user.v1.UpsertUserInstructionsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/_pd/api/v1/users/@self/instructions\x12\x80\x01\n" +
-	"\fDeletePrompt\x12\x1c.user.v1.DeletePromptRequest\x1a\x1d.user.v1.DeletePromptResponse\"3\x82\xd3\xe4\x93\x02-*+/_pd/api/v1/users/@self/prompts/{prompt_id}\x12r\n" +
+	"\fDeletePrompt\x12\x1c.user.v1.DeletePromptRequest\x1a\x1d.user.v1.DeletePromptResponse\"3\x82\xd3\xe4\x93\x02-*+/_pd/api/v1/users/@self/prompts/{prompt_id}\x12\x8e\x01\n" +
+	"\x12ListDeletedPrompts\x12\".user.v1.ListDeletedPromptsRequest\x1a#.user.v1.ListDeletedPromptsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/_pd/api/v1/users/@self/deleted-prompts\x12\x8e\x01\n" +
+	"\rRestorePrompt\x12\x1d.user.v1.RestorePromptRequest\x1a\x1e.user.v1.RestorePromptResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/_pd/api/v1/users/@self/prompts/{prompt_id}/restore\x12\x8a\x01\n" +
+	"\fRenderPrompt\x12\x1c.user.v1.RenderPromptRequest\x1a\x1d.user.v1.RenderPromptResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/_pd/api/v1/users/@self/prompts/{prompt_id}/render\x12\x7f\n" +
+	"\x0eGetPromptStats\x12\x1e.user.v1.GetPromptStatsRequest\x1a\x1f.user.v1.GetPromptStatsResponse\",\x82\xd3\xe4\x93\x02&\x12$/_pd/api/v1/users/@self/prompt-stats\x12\x86\x01\n" +
+	"\vSharePrompt\x12\x1b.user.v1.SharePromptRequest\x1a\x1c.user.v1.SharePromptResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/_pd/api/v1/users/@self/prompts/{prompt_id}/share\x12\x95\x01\n" +
+	"\x11RevokePromptShare\x12!.user.v1.RevokePromptShareRequest\x1a\".user.v1.RevokePromptShareResponse\"9\x82\xd3\xe4\x93\x023*1/_pd/api/v1/users/@self/prompts/{prompt_id}/share\x12\x90\x01\n" +
+	"\x12ImportSharedPrompt\x12\".user.v1.ImportSharedPromptRequest\x1a#.user.v1.ImportSharedPromptResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/_pd/api/v1/users/@self/prompts/import\x12r\n" +
 	"\vGetSettings\x12\x1b.user.v1.GetSettingsRequest\x1a\x1c.user.v1.GetSettingsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /_pd/api/v1/users/@self/settings\x12~\n" +
 	"\x0eUpdateSettings\x12\x1e.user.v1.UpdateSettingsRequest\x1a\x1f.user.v1.UpdateSettingsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /_pd/api/v1/users/@self/settings\x12~\n" +
-	"\rResetSettings\x12\x1d.user.v1.ResetSettingsRequest\x1a\x1e.user.v1.ResetSettingsResponse\".\x82\xd3\xe4\x93\x02(\"&/_pd/api/v1/users/@self/settings/resetB\x7f\n" +
+	"\rResetSettings\x12\x1d.user.v1.ResetSettingsRequest\x1a\x1e.user.v1.ResetSettingsResponse\".\x82\xd3\xe4\x93\x02(\"&/_pd/api/v1/users/@self/settings/reset\x12\x84\x01\n" +
+	"\x12ListAvailableTools\x12\".user.v1.ListAvailableToolsRequest\x1a#.user.v1.ListAvailableToolsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/_pd/api/v1/users/@self/tools\x12\x90\x01\n" +
+	"\x15UpdateToolPreferences\x12%.user.v1.UpdateToolPreferencesRequest\x1a&.user.v1.UpdateToolPreferencesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/_pd/api/v1/users/@self/tools\x12y\n" +
+	"\rDeleteAccount\x12\x1d.user.v1.DeleteAccountRequest\x1a\x1e.user.v1.DeleteAccountResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/_pd/api/v1/users/@self/deleteB\x7f\n" +
 	"\vcom.user.v1B\tUserProtoP\x01Z(paperdebugger/pkg/gen/api/user/v1;userv1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
@@ -1195,69 +2859,142 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_user_v1_user_proto_goTypes = []any{
-	(*User)(nil),                           // 0: user.v1.User
-	(*GetUserRequest)(nil),                 // 1: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),                // 2: user.v1.GetUserResponse
-	(*Prompt)(nil),                         // 3: user.v1.Prompt
-	(*ListPromptsRequest)(nil),             // 4: user.v1.ListPromptsRequest
-	(*ListPromptsResponse)(nil),            // 5: user.v1.ListPromptsResponse
-	(*CreatePromptRequest)(nil),            // 6: user.v1.CreatePromptRequest
-	(*CreatePromptResponse)(nil),           // 7: user.v1.CreatePromptResponse
-	(*UpdatePromptRequest)(nil),            // 8: user.v1.UpdatePromptRequest
-	(*UpdatePromptResponse)(nil),           // 9: user.v1.UpdatePromptResponse
-	(*DeletePromptRequest)(nil),            // 10: user.v1.DeletePromptRequest
-	(*DeletePromptResponse)(nil),           // 11: user.v1.DeletePromptResponse
-	(*Settings)(nil),                       // 12: user.v1.Settings
-	(*GetSettingsRequest)(nil),             // 13: user.v1.GetSettingsRequest
-	(*GetSettingsResponse)(nil),            // 14: user.v1.GetSettingsResponse
-	(*UpdateSettingsRequest)(nil),          // 15: user.v1.UpdateSettingsRequest
-	(*UpdateSettingsResponse)(nil),         // 16: user.v1.UpdateSettingsResponse
-	(*ResetSettingsRequest)(nil),           // 17: user.v1.ResetSettingsRequest
-	(*ResetSettingsResponse)(nil),          // 18: user.v1.ResetSettingsResponse
-	(*GetUserInstructionsRequest)(nil),     // 19: user.v1.GetUserInstructionsRequest
-	(*GetUserInstructionsResponse)(nil),    // 20: user.v1.GetUserInstructionsResponse
-	(*UpsertUserInstructionsRequest)(nil),  // 21: user.v1.UpsertUserInstructionsRequest
-	(*UpsertUserInstructionsResponse)(nil), // 22: user.v1.UpsertUserInstructionsResponse
-	(*timestamppb.Timestamp)(nil),          // 23: google.protobuf.Timestamp
+	(PromptVariableType)(0),                 // 0: user.v1.PromptVariableType
+	(*User)(nil),                            // 1: user.v1.User
+	(*GetUserRequest)(nil),                  // 2: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 3: user.v1.GetUserResponse
+	(*Prompt)(nil),                          // 4: user.v1.Prompt
+	(*PromptVariable)(nil),                  // 5: user.v1.PromptVariable
+	(*ListPromptsRequest)(nil),              // 6: user.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),             // 7: user.v1.ListPromptsResponse
+	(*PromptKeys)(nil),                      // 8: user.v1.PromptKeys
+	(*UpdatePromptPreferencesRequest)(nil),  // 9: user.v1.UpdatePromptPreferencesRequest
+	(*UpdatePromptPreferencesResponse)(nil), // 10: user.v1.UpdatePromptPreferencesResponse
+	(*CreatePromptRequest)(nil),             // 11: user.v1.CreatePromptRequest
+	(*CreatePromptResponse)(nil),            // 12: user.v1.CreatePromptResponse
+	(*UpdatePromptRequest)(nil),             // 13: user.v1.UpdatePromptRequest
+	(*UpdatePromptResponse)(nil),            // 14: user.v1.UpdatePromptResponse
+	(*RenderPromptRequest)(nil),             // 15: user.v1.RenderPromptRequest
+	(*RenderPromptResponse)(nil),            // 16: user.v1.RenderPromptResponse
+	(*PromptStats)(nil),                     // 17: user.v1.PromptStats
+	(*GetPromptStatsRequest)(nil),           // 18: user.v1.GetPromptStatsRequest
+	(*GetPromptStatsResponse)(nil),          // 19: user.v1.GetPromptStatsResponse
+	(*SharePromptRequest)(nil),              // 20: user.v1.SharePromptRequest
+	(*SharePromptResponse)(nil),             // 21: user.v1.SharePromptResponse
+	(*RevokePromptShareRequest)(nil),        // 22: user.v1.RevokePromptShareRequest
+	(*RevokePromptShareResponse)(nil),       // 23: user.v1.RevokePromptShareResponse
+	(*ImportSharedPromptRequest)(nil),       // 24: user.v1.ImportSharedPromptRequest
+	(*ImportSharedPromptResponse)(nil),      // 25: user.v1.ImportSharedPromptResponse
+	(*DeletePromptRequest)(nil),             // 26: user.v1.DeletePromptRequest
+	(*DeletePromptResponse)(nil),            // 27: user.v1.DeletePromptResponse
+	(*DeletedPrompt)(nil),                   // 28: user.v1.DeletedPrompt
+	(*ListDeletedPromptsRequest)(nil),       // 29: user.v1.ListDeletedPromptsRequest
+	(*ListDeletedPromptsResponse)(nil),      // 30: user.v1.ListDeletedPromptsResponse
+	(*RestorePromptRequest)(nil),            // 31: user.v1.RestorePromptRequest
+	(*RestorePromptResponse)(nil),           // 32: user.v1.RestorePromptResponse
+	(*Settings)(nil),                        // 33: user.v1.Settings
+	(*GetSettingsRequest)(nil),              // 34: user.v1.GetSettingsRequest
+	(*GetSettingsResponse)(nil),             // 35: user.v1.GetSettingsResponse
+	(*UpdateSettingsRequest)(nil),           // 36: user.v1.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),          // 37: user.v1.UpdateSettingsResponse
+	(*ResetSettingsRequest)(nil),            // 38: user.v1.ResetSettingsRequest
+	(*ResetSettingsResponse)(nil),           // 39: user.v1.ResetSettingsResponse
+	(*GetUserInstructionsRequest)(nil),      // 40: user.v1.GetUserInstructionsRequest
+	(*GetUserInstructionsResponse)(nil),     // 41: user.v1.GetUserInstructionsResponse
+	(*UpsertUserInstructionsRequest)(nil),   // 42: user.v1.UpsertUserInstructionsRequest
+	(*UpsertUserInstructionsResponse)(nil),  // 43: user.v1.UpsertUserInstructionsResponse
+	(*Tool)(nil),                            // 44: user.v1.Tool
+	(*ToolNames)(nil),                       // 45: user.v1.ToolNames
+	(*ListAvailableToolsRequest)(nil),       // 46: user.v1.ListAvailableToolsRequest
+	(*ListAvailableToolsResponse)(nil),      // 47: user.v1.ListAvailableToolsResponse
+	(*UpdateToolPreferencesRequest)(nil),    // 48: user.v1.UpdateToolPreferencesRequest
+	(*UpdateToolPreferencesResponse)(nil),   // 49: user.v1.UpdateToolPreferencesResponse
+	(*DeleteAccountRequest)(nil),            // 50: user.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 51: user.v1.DeleteAccountResponse
+	nil,                                     // 52: user.v1.RenderPromptRequest.ValuesEntry
+	(*timestamppb.Timestamp)(nil),           // 53: google.protobuf.Timestamp
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	0,  // 0: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	23, // 1: user.v1.Prompt.created_at:type_name -> google.protobuf.Timestamp
-	23, // 2: user.v1.Prompt.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 3: user.v1.ListPromptsResponse.prompts:type_name -> user.v1.Prompt
-	3,  // 4: user.v1.CreatePromptResponse.prompt:type_name -> user.v1.Prompt
-	3,  // 5: user.v1.UpdatePromptResponse.prompt:type_name -> user.v1.Prompt
-	12, // 6: user.v1.GetSettingsResponse.settings:type_name -> user.v1.Settings
-	12, // 7: user.v1.UpdateSettingsRequest.settings:type_name -> user.v1.Settings
-	12, // 8: user.v1.UpdateSettingsResponse.settings:type_name -> user.v1.Settings
-	12, // 9: user.v1.ResetSettingsResponse.settings:type_name -> user.v1.Settings
-	1,  // 10: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	4,  // 11: user.v1.UserService.ListPrompts:input_type -> user.v1.ListPromptsRequest
-	6,  // 12: user.v1.UserService.CreatePrompt:input_type -> user.v1.CreatePromptRequest
-	8,  // 13: user.v1.UserService.UpdatePrompt:input_type -> user.v1.UpdatePromptRequest
-	19, // 14: user.v1.UserService.GetUserInstructions:input_type -> user.v1.GetUserInstructionsRequest
-	21, // 15: user.v1.UserService.UpsertUserInstructions:input_type -> user.v1.UpsertUserInstructionsRequest
-	10, // 16: user.v1.UserService.DeletePrompt:input_type -> user.v1.DeletePromptRequest
-	13, // 17: user.v1.UserService.GetSettings:input_type -> user.v1.GetSettingsRequest
-	15, // 18: user.v1.UserService.UpdateSettings:input_type -> user.v1.UpdateSettingsRequest
-	17, // 19: user.v1.UserService.ResetSettings:input_type -> user.v1.ResetSettingsRequest
-	2,  // 20: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	5,  // 21: user.v1.UserService.ListPrompts:output_type -> user.v1.ListPromptsResponse
-	7,  // 22: user.v1.UserService.CreatePrompt:output_type -> user.v1.CreatePromptResponse
-	9,  // 23: user.v1.UserService.UpdatePrompt:output_type -> user.v1.UpdatePromptResponse
-	20, // 24: user.v1.UserService.GetUserInstructions:output_type -> user.v1.GetUserInstructionsResponse
-	22, // 25: user.v1.UserService.UpsertUserInstructions:output_type -> user.v1.UpsertUserInstructionsResponse
-	11, // 26: user.v1.UserService.DeletePrompt:output_type -> user.v1.DeletePromptResponse
-	14, // 27: user.v1.UserService.GetSettings:output_type -> user.v1.GetSettingsResponse
-	16, // 28: user.v1.UserService.UpdateSettings:output_type -> user.v1.UpdateSettingsResponse
-	18, // 29: user.v1.UserService.ResetSettings:output_type -> user.v1.ResetSettingsResponse
-	20, // [20:30] is the sub-list for method output_type
-	10, // [10:20] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: user.v1.GetUserResponse.user:type_name -> user.v1.User
+	53, // 1: user.v1.Prompt.created_at:type_name -> google.protobuf.Timestamp
+	53, // 2: user.v1.Prompt.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: user.v1.Prompt.variables:type_name -> user.v1.PromptVariable
+	0,  // 4: user.v1.PromptVariable.type:type_name -> user.v1.PromptVariableType
+	4,  // 5: user.v1.ListPromptsResponse.prompts:type_name -> user.v1.Prompt
+	8,  // 6: user.v1.UpdatePromptPreferencesRequest.hidden_prompts:type_name -> user.v1.PromptKeys
+	8,  // 7: user.v1.UpdatePromptPreferencesRequest.pinned_prompts:type_name -> user.v1.PromptKeys
+	5,  // 8: user.v1.CreatePromptRequest.variables:type_name -> user.v1.PromptVariable
+	4,  // 9: user.v1.CreatePromptResponse.prompt:type_name -> user.v1.Prompt
+	5,  // 10: user.v1.UpdatePromptRequest.variables:type_name -> user.v1.PromptVariable
+	4,  // 11: user.v1.UpdatePromptResponse.prompt:type_name -> user.v1.Prompt
+	52, // 12: user.v1.RenderPromptRequest.values:type_name -> user.v1.RenderPromptRequest.ValuesEntry
+	53, // 13: user.v1.PromptStats.last_used_at:type_name -> google.protobuf.Timestamp
+	17, // 14: user.v1.GetPromptStatsResponse.stats:type_name -> user.v1.PromptStats
+	4,  // 15: user.v1.ImportSharedPromptResponse.prompt:type_name -> user.v1.Prompt
+	4,  // 16: user.v1.DeletedPrompt.prompt:type_name -> user.v1.Prompt
+	53, // 17: user.v1.DeletedPrompt.deleted_at:type_name -> google.protobuf.Timestamp
+	53, // 18: user.v1.DeletedPrompt.purge_at:type_name -> google.protobuf.Timestamp
+	28, // 19: user.v1.ListDeletedPromptsResponse.prompts:type_name -> user.v1.DeletedPrompt
+	4,  // 20: user.v1.RestorePromptResponse.prompt:type_name -> user.v1.Prompt
+	33, // 21: user.v1.GetSettingsResponse.settings:type_name -> user.v1.Settings
+	33, // 22: user.v1.UpdateSettingsRequest.settings:type_name -> user.v1.Settings
+	33, // 23: user.v1.UpdateSettingsResponse.settings:type_name -> user.v1.Settings
+	33, // 24: user.v1.ResetSettingsResponse.settings:type_name -> user.v1.Settings
+	44, // 25: user.v1.ListAvailableToolsResponse.tools:type_name -> user.v1.Tool
+	45, // 26: user.v1.UpdateToolPreferencesRequest.disabled_tools:type_name -> user.v1.ToolNames
+	45, // 27: user.v1.UpdateToolPreferencesRequest.project_pinned_tools:type_name -> user.v1.ToolNames
+	44, // 28: user.v1.UpdateToolPreferencesResponse.tools:type_name -> user.v1.Tool
+	53, // 29: user.v1.DeleteAccountResponse.purge_at:type_name -> google.protobuf.Timestamp
+	2,  // 30: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	6,  // 31: user.v1.UserService.ListPrompts:input_type -> user.v1.ListPromptsRequest
+	9,  // 32: user.v1.UserService.UpdatePromptPreferences:input_type -> user.v1.UpdatePromptPreferencesRequest
+	11, // 33: user.v1.UserService.CreatePrompt:input_type -> user.v1.CreatePromptRequest
+	13, // 34: user.v1.UserService.UpdatePrompt:input_type -> user.v1.UpdatePromptRequest
+	40, // 35: user.v1.UserService.GetUserInstructions:input_type -> user.v1.GetUserInstructionsRequest
+	42, // 36: user.v1.UserService.UpsertUserInstructions:input_type -> user.v1.UpsertUserInstructionsRequest
+	26, // 37: user.v1.UserService.DeletePrompt:input_type -> user.v1.DeletePromptRequest
+	29, // 38: user.v1.UserService.ListDeletedPrompts:input_type -> user.v1.ListDeletedPromptsRequest
+	31, // 39: user.v1.UserService.RestorePrompt:input_type -> user.v1.RestorePromptRequest
+	15, // 40: user.v1.UserService.RenderPrompt:input_type -> user.v1.RenderPromptRequest
+	18, // 41: user.v1.UserService.GetPromptStats:input_type -> user.v1.GetPromptStatsRequest
+	20, // 42: user.v1.UserService.SharePrompt:input_type -> user.v1.SharePromptRequest
+	22, // 43: user.v1.UserService.RevokePromptShare:input_type -> user.v1.RevokePromptShareRequest
+	24, // 44: user.v1.UserService.ImportSharedPrompt:input_type -> user.v1.ImportSharedPromptRequest
+	34, // 45: user.v1.UserService.GetSettings:input_type -> user.v1.GetSettingsRequest
+	36, // 46: user.v1.UserService.UpdateSettings:input_type -> user.v1.UpdateSettingsRequest
+	38, // 47: user.v1.UserService.ResetSettings:input_type -> user.v1.ResetSettingsRequest
+	46, // 48: user.v1.UserService.ListAvailableTools:input_type -> user.v1.ListAvailableToolsRequest
+	48, // 49: user.v1.UserService.UpdateToolPreferences:input_type -> user.v1.UpdateToolPreferencesRequest
+	50, // 50: user.v1.UserService.DeleteAccount:input_type -> user.v1.DeleteAccountRequest
+	3,  // 51: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	7,  // 52: user.v1.UserService.ListPrompts:output_type -> user.v1.ListPromptsResponse
+	10, // 53: user.v1.UserService.UpdatePromptPreferences:output_type -> user.v1.UpdatePromptPreferencesResponse
+	12, // 54: user.v1.UserService.CreatePrompt:output_type -> user.v1.CreatePromptResponse
+	14, // 55: user.v1.UserService.UpdatePrompt:output_type -> user.v1.UpdatePromptResponse
+	41, // 56: user.v1.UserService.GetUserInstructions:output_type -> user.v1.GetUserInstructionsResponse
+	43, // 57: user.v1.UserService.UpsertUserInstructions:output_type -> user.v1.UpsertUserInstructionsResponse
+	27, // 58: user.v1.UserService.DeletePrompt:output_type -> user.v1.DeletePromptResponse
+	30, // 59: user.v1.UserService.ListDeletedPrompts:output_type -> user.v1.ListDeletedPromptsResponse
+	32, // 60: user.v1.UserService.RestorePrompt:output_type -> user.v1.RestorePromptResponse
+	16, // 61: user.v1.UserService.RenderPrompt:output_type -> user.v1.RenderPromptResponse
+	19, // 62: user.v1.UserService.GetPromptStats:output_type -> user.v1.GetPromptStatsResponse
+	21, // 63: user.v1.UserService.SharePrompt:output_type -> user.v1.SharePromptResponse
+	23, // 64: user.v1.UserService.RevokePromptShare:output_type -> user.v1.RevokePromptShareResponse
+	25, // 65: user.v1.UserService.ImportSharedPrompt:output_type -> user.v1.ImportSharedPromptResponse
+	35, // 66: user.v1.UserService.GetSettings:output_type -> user.v1.GetSettingsResponse
+	37, // 67: user.v1.UserService.UpdateSettings:output_type -> user.v1.UpdateSettingsResponse
+	39, // 68: user.v1.UserService.ResetSettings:output_type -> user.v1.ResetSettingsResponse
+	47, // 69: user.v1.UserService.ListAvailableTools:output_type -> user.v1.ListAvailableToolsResponse
+	49, // 70: user.v1.UserService.UpdateToolPreferences:output_type -> user.v1.UpdateToolPreferencesResponse
+	51, // 71: user.v1.UserService.DeleteAccount:output_type -> user.v1.DeleteAccountResponse
+	51, // [51:72] is the sub-list for method output_type
+	30, // [30:51] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -1265,18 +3002,23 @@ func file_user_v1_user_proto_init() {
 	if File_user_v1_user_proto != nil {
 		return
 	}
+	file_user_v1_user_proto_msgTypes[10].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[16].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[45].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      1,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_v1_user_proto_goTypes,
 		DependencyIndexes: file_user_v1_user_proto_depIdxs,
+		EnumInfos:         file_user_v1_user_proto_enumTypes,
 		MessageInfos:      file_user_v1_user_proto_msgTypes,
 	}.Build()
 	File_user_v1_user_proto = out.File
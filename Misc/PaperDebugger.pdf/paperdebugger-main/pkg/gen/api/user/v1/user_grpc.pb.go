@@ -19,16 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUser_FullMethodName                = "/user.v1.UserService/GetUser"
-	UserService_ListPrompts_FullMethodName            = "/user.v1.UserService/ListPrompts"
-	UserService_CreatePrompt_FullMethodName           = "/user.v1.UserService/CreatePrompt"
-	UserService_UpdatePrompt_FullMethodName           = "/user.v1.UserService/UpdatePrompt"
-	UserService_GetUserInstructions_FullMethodName    = "/user.v1.UserService/GetUserInstructions"
-	UserService_UpsertUserInstructions_FullMethodName = "/user.v1.UserService/UpsertUserInstructions"
-	UserService_DeletePrompt_FullMethodName           = "/user.v1.UserService/DeletePrompt"
-	UserService_GetSettings_FullMethodName            = "/user.v1.UserService/GetSettings"
-	UserService_UpdateSettings_FullMethodName         = "/user.v1.UserService/UpdateSettings"
-	UserService_ResetSettings_FullMethodName          = "/user.v1.UserService/ResetSettings"
+	UserService_GetUser_FullMethodName                 = "/user.v1.UserService/GetUser"
+	UserService_ListPrompts_FullMethodName             = "/user.v1.UserService/ListPrompts"
+	UserService_UpdatePromptPreferences_FullMethodName = "/user.v1.UserService/UpdatePromptPreferences"
+	UserService_CreatePrompt_FullMethodName            = "/user.v1.UserService/CreatePrompt"
+	UserService_UpdatePrompt_FullMethodName            = "/user.v1.UserService/UpdatePrompt"
+	UserService_GetUserInstructions_FullMethodName     = "/user.v1.UserService/GetUserInstructions"
+	UserService_UpsertUserInstructions_FullMethodName  = "/user.v1.UserService/UpsertUserInstructions"
+	UserService_DeletePrompt_FullMethodName            = "/user.v1.UserService/DeletePrompt"
+	UserService_ListDeletedPrompts_FullMethodName      = "/user.v1.UserService/ListDeletedPrompts"
+	UserService_RestorePrompt_FullMethodName           = "/user.v1.UserService/RestorePrompt"
+	UserService_RenderPrompt_FullMethodName            = "/user.v1.UserService/RenderPrompt"
+	UserService_GetPromptStats_FullMethodName          = "/user.v1.UserService/GetPromptStats"
+	UserService_SharePrompt_FullMethodName             = "/user.v1.UserService/SharePrompt"
+	UserService_RevokePromptShare_FullMethodName       = "/user.v1.UserService/RevokePromptShare"
+	UserService_ImportSharedPrompt_FullMethodName      = "/user.v1.UserService/ImportSharedPrompt"
+	UserService_GetSettings_FullMethodName             = "/user.v1.UserService/GetSettings"
+	UserService_UpdateSettings_FullMethodName          = "/user.v1.UserService/UpdateSettings"
+	UserService_ResetSettings_FullMethodName           = "/user.v1.UserService/ResetSettings"
+	UserService_ListAvailableTools_FullMethodName      = "/user.v1.UserService/ListAvailableTools"
+	UserService_UpdateToolPreferences_FullMethodName   = "/user.v1.UserService/UpdateToolPreferences"
+	UserService_DeleteAccount_FullMethodName           = "/user.v1.UserService/DeleteAccount"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -37,14 +48,35 @@ const (
 type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error)
+	// Hides or pins built-in prompts for the user.
+	UpdatePromptPreferences(ctx context.Context, in *UpdatePromptPreferencesRequest, opts ...grpc.CallOption) (*UpdatePromptPreferencesResponse, error)
 	CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error)
 	UpdatePrompt(ctx context.Context, in *UpdatePromptRequest, opts ...grpc.CallOption) (*UpdatePromptResponse, error)
 	GetUserInstructions(ctx context.Context, in *GetUserInstructionsRequest, opts ...grpc.CallOption) (*GetUserInstructionsResponse, error)
 	UpsertUserInstructions(ctx context.Context, in *UpsertUserInstructionsRequest, opts ...grpc.CallOption) (*UpsertUserInstructionsResponse, error)
 	DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error)
+	// Lists the deleted prompts, they can be restored until they are deleted for good.
+	ListDeletedPrompts(ctx context.Context, in *ListDeletedPromptsRequest, opts ...grpc.CallOption) (*ListDeletedPromptsResponse, error)
+	RestorePrompt(ctx context.Context, in *RestorePromptRequest, opts ...grpc.CallOption) (*RestorePromptResponse, error)
+	// Fills the variables of a prompt template. Prompts without variables are returned as they are.
+	RenderPrompt(ctx context.Context, in *RenderPromptRequest, opts ...grpc.CallOption) (*RenderPromptResponse, error)
+	// Returns how often the prompts of the library were used: by the user, and by all the users for
+	// the team and built-in prompts. The prompts never used are left out.
+	GetPromptStats(ctx context.Context, in *GetPromptStatsRequest, opts ...grpc.CallOption) (*GetPromptStatsResponse, error)
+	// Returns the share token of a prompt the user can edit, creating it on the first call. Anyone
+	// with the token can import a copy of the prompt until the share is revoked.
+	SharePrompt(ctx context.Context, in *SharePromptRequest, opts ...grpc.CallOption) (*SharePromptResponse, error)
+	RevokePromptShare(ctx context.Context, in *RevokePromptShareRequest, opts ...grpc.CallOption) (*RevokePromptShareResponse, error)
+	// Copies a shared prompt to the prompts of the user.
+	ImportSharedPrompt(ctx context.Context, in *ImportSharedPromptRequest, opts ...grpc.CallOption) (*ImportSharedPromptResponse, error)
 	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
 	ResetSettings(ctx context.Context, in *ResetSettingsRequest, opts ...grpc.CallOption) (*ResetSettingsResponse, error)
+	ListAvailableTools(ctx context.Context, in *ListAvailableToolsRequest, opts ...grpc.CallOption) (*ListAvailableToolsResponse, error)
+	UpdateToolPreferences(ctx context.Context, in *UpdateToolPreferencesRequest, opts ...grpc.CallOption) (*UpdateToolPreferencesResponse, error)
+	// Logs the user out everywhere and deletes the account with all its data after a grace period.
+	// Logging in again during the grace period cancels the deletion.
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
 }
 
 type userServiceClient struct {
@@ -75,6 +107,16 @@ func (c *userServiceClient) ListPrompts(ctx context.Context, in *ListPromptsRequ
 	return out, nil
 }
 
+func (c *userServiceClient) UpdatePromptPreferences(ctx context.Context, in *UpdatePromptPreferencesRequest, opts ...grpc.CallOption) (*UpdatePromptPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromptPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePromptPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePromptResponse)
@@ -125,6 +167,76 @@ func (c *userServiceClient) DeletePrompt(ctx context.Context, in *DeletePromptRe
 	return out, nil
 }
 
+func (c *userServiceClient) ListDeletedPrompts(ctx context.Context, in *ListDeletedPromptsRequest, opts ...grpc.CallOption) (*ListDeletedPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedPromptsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListDeletedPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RestorePrompt(ctx context.Context, in *RestorePromptRequest, opts ...grpc.CallOption) (*RestorePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePromptResponse)
+	err := c.cc.Invoke(ctx, UserService_RestorePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RenderPrompt(ctx context.Context, in *RenderPromptRequest, opts ...grpc.CallOption) (*RenderPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderPromptResponse)
+	err := c.cc.Invoke(ctx, UserService_RenderPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPromptStats(ctx context.Context, in *GetPromptStatsRequest, opts ...grpc.CallOption) (*GetPromptStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromptStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPromptStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SharePrompt(ctx context.Context, in *SharePromptRequest, opts ...grpc.CallOption) (*SharePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharePromptResponse)
+	err := c.cc.Invoke(ctx, UserService_SharePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokePromptShare(ctx context.Context, in *RevokePromptShareRequest, opts ...grpc.CallOption) (*RevokePromptShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokePromptShareResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokePromptShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportSharedPrompt(ctx context.Context, in *ImportSharedPromptRequest, opts ...grpc.CallOption) (*ImportSharedPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSharedPromptResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportSharedPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSettingsResponse)
@@ -155,20 +267,71 @@ func (c *userServiceClient) ResetSettings(ctx context.Context, in *ResetSettings
 	return out, nil
 }
 
+func (c *userServiceClient) ListAvailableTools(ctx context.Context, in *ListAvailableToolsRequest, opts ...grpc.CallOption) (*ListAvailableToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAvailableToolsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAvailableTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateToolPreferences(ctx context.Context, in *UpdateToolPreferencesRequest, opts ...grpc.CallOption) (*UpdateToolPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateToolPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateToolPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error)
+	// Hides or pins built-in prompts for the user.
+	UpdatePromptPreferences(context.Context, *UpdatePromptPreferencesRequest) (*UpdatePromptPreferencesResponse, error)
 	CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error)
 	UpdatePrompt(context.Context, *UpdatePromptRequest) (*UpdatePromptResponse, error)
 	GetUserInstructions(context.Context, *GetUserInstructionsRequest) (*GetUserInstructionsResponse, error)
 	UpsertUserInstructions(context.Context, *UpsertUserInstructionsRequest) (*UpsertUserInstructionsResponse, error)
 	DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error)
+	// Lists the deleted prompts, they can be restored until they are deleted for good.
+	ListDeletedPrompts(context.Context, *ListDeletedPromptsRequest) (*ListDeletedPromptsResponse, error)
+	RestorePrompt(context.Context, *RestorePromptRequest) (*RestorePromptResponse, error)
+	// Fills the variables of a prompt template. Prompts without variables are returned as they are.
+	RenderPrompt(context.Context, *RenderPromptRequest) (*RenderPromptResponse, error)
+	// Returns how often the prompts of the library were used: by the user, and by all the users for
+	// the team and built-in prompts. The prompts never used are left out.
+	GetPromptStats(context.Context, *GetPromptStatsRequest) (*GetPromptStatsResponse, error)
+	// Returns the share token of a prompt the user can edit, creating it on the first call. Anyone
+	// with the token can import a copy of the prompt until the share is revoked.
+	SharePrompt(context.Context, *SharePromptRequest) (*SharePromptResponse, error)
+	RevokePromptShare(context.Context, *RevokePromptShareRequest) (*RevokePromptShareResponse, error)
+	// Copies a shared prompt to the prompts of the user.
+	ImportSharedPrompt(context.Context, *ImportSharedPromptRequest) (*ImportSharedPromptResponse, error)
 	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
 	ResetSettings(context.Context, *ResetSettingsRequest) (*ResetSettingsResponse, error)
+	ListAvailableTools(context.Context, *ListAvailableToolsRequest) (*ListAvailableToolsResponse, error)
+	UpdateToolPreferences(context.Context, *UpdateToolPreferencesRequest) (*UpdateToolPreferencesResponse, error)
+	// Logs the user out everywhere and deletes the account with all its data after a grace period.
+	// Logging in again during the grace period cancels the deletion.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -185,6 +348,9 @@ func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedUserServiceServer) ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPrompts not implemented")
 }
+func (UnimplementedUserServiceServer) UpdatePromptPreferences(context.Context, *UpdatePromptPreferencesRequest) (*UpdatePromptPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromptPreferences not implemented")
+}
 func (UnimplementedUserServiceServer) CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePrompt not implemented")
 }
@@ -200,6 +366,27 @@ func (UnimplementedUserServiceServer) UpsertUserInstructions(context.Context, *U
 func (UnimplementedUserServiceServer) DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePrompt not implemented")
 }
+func (UnimplementedUserServiceServer) ListDeletedPrompts(context.Context, *ListDeletedPromptsRequest) (*ListDeletedPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPrompts not implemented")
+}
+func (UnimplementedUserServiceServer) RestorePrompt(context.Context, *RestorePromptRequest) (*RestorePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePrompt not implemented")
+}
+func (UnimplementedUserServiceServer) RenderPrompt(context.Context, *RenderPromptRequest) (*RenderPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPrompt not implemented")
+}
+func (UnimplementedUserServiceServer) GetPromptStats(context.Context, *GetPromptStatsRequest) (*GetPromptStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptStats not implemented")
+}
+func (UnimplementedUserServiceServer) SharePrompt(context.Context, *SharePromptRequest) (*SharePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePrompt not implemented")
+}
+func (UnimplementedUserServiceServer) RevokePromptShare(context.Context, *RevokePromptShareRequest) (*RevokePromptShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePromptShare not implemented")
+}
+func (UnimplementedUserServiceServer) ImportSharedPrompt(context.Context, *ImportSharedPromptRequest) (*ImportSharedPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSharedPrompt not implemented")
+}
 func (UnimplementedUserServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
 }
@@ -209,6 +396,15 @@ func (UnimplementedUserServiceServer) UpdateSettings(context.Context, *UpdateSet
 func (UnimplementedUserServiceServer) ResetSettings(context.Context, *ResetSettingsRequest) (*ResetSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetSettings not implemented")
 }
+func (UnimplementedUserServiceServer) ListAvailableTools(context.Context, *ListAvailableToolsRequest) (*ListAvailableToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableTools not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateToolPreferences(context.Context, *UpdateToolPreferencesRequest) (*UpdateToolPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateToolPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -266,6 +462,24 @@ func _UserService_ListPrompts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdatePromptPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromptPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePromptPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePromptPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePromptPreferences(ctx, req.(*UpdatePromptPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePromptRequest)
 	if err := dec(in); err != nil {
@@ -356,6 +570,132 @@ func _UserService_DeletePrompt_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListDeletedPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListDeletedPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListDeletedPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListDeletedPrompts(ctx, req.(*ListDeletedPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RestorePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestorePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestorePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestorePrompt(ctx, req.(*RestorePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RenderPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RenderPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RenderPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RenderPrompt(ctx, req.(*RenderPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPromptStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPromptStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPromptStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPromptStats(ctx, req.(*GetPromptStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SharePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SharePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SharePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SharePrompt(ctx, req.(*SharePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokePromptShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePromptShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokePromptShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokePromptShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokePromptShare(ctx, req.(*RevokePromptShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportSharedPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSharedPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportSharedPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportSharedPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportSharedPrompt(ctx, req.(*ImportSharedPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSettingsRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +750,60 @@ func _UserService_ResetSettings_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListAvailableTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAvailableTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAvailableTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAvailableTools(ctx, req.(*ListAvailableToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateToolPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateToolPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateToolPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateToolPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateToolPreferences(ctx, req.(*UpdateToolPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -425,6 +819,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPrompts",
 			Handler:    _UserService_ListPrompts_Handler,
 		},
+		{
+			MethodName: "UpdatePromptPreferences",
+			Handler:    _UserService_UpdatePromptPreferences_Handler,
+		},
 		{
 			MethodName: "CreatePrompt",
 			Handler:    _UserService_CreatePrompt_Handler,
@@ -445,6 +843,34 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePrompt",
 			Handler:    _UserService_DeletePrompt_Handler,
 		},
+		{
+			MethodName: "ListDeletedPrompts",
+			Handler:    _UserService_ListDeletedPrompts_Handler,
+		},
+		{
+			MethodName: "RestorePrompt",
+			Handler:    _UserService_RestorePrompt_Handler,
+		},
+		{
+			MethodName: "RenderPrompt",
+			Handler:    _UserService_RenderPrompt_Handler,
+		},
+		{
+			MethodName: "GetPromptStats",
+			Handler:    _UserService_GetPromptStats_Handler,
+		},
+		{
+			MethodName: "SharePrompt",
+			Handler:    _UserService_SharePrompt_Handler,
+		},
+		{
+			MethodName: "RevokePromptShare",
+			Handler:    _UserService_RevokePromptShare_Handler,
+		},
+		{
+			MethodName: "ImportSharedPrompt",
+			Handler:    _UserService_ImportSharedPrompt_Handler,
+		},
 		{
 			MethodName: "GetSettings",
 			Handler:    _UserService_GetSettings_Handler,
@@ -457,6 +883,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetSettings",
 			Handler:    _UserService_ResetSettings_Handler,
 		},
+		{
+			MethodName: "ListAvailableTools",
+			Handler:    _UserService_ListAvailableTools_Handler,
+		},
+		{
+			MethodName: "UpdateToolPreferences",
+			Handler:    _UserService_UpdateToolPreferences_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _UserService_DeleteAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
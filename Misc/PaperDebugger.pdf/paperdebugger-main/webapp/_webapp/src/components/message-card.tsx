@@ -5,7 +5,10 @@ import { MessageEntry, MessageEntryStatus } from "../stores/conversation/types";
 import { AssistantMessageContainer } from "./message-entry-container/assistant";
 import { UserMessageContainer } from "./message-entry-container/user";
 import { ToolCallPrepareMessageContainer } from "./message-entry-container/toolcall-prepare";
+import { ToolCallApprovalMessageContainer } from "./message-entry-container/toolcall-approval";
 import { UnknownEntryMessageContainer } from "./message-entry-container/unknown-entry";
+import { RevisionMessageContainer } from "./message-entry-container/revision";
+import { TextEditsMessageContainer } from "./message-entry-container/text-edits";
 
 // Constants
 export const STYLES = {
@@ -69,6 +72,30 @@ export const MessageCard = memo(({ messageEntry, prevAttachment, animated }: Mes
       );
     }
 
+    if (messageEntry.revision !== undefined) {
+      return (
+        <RevisionMessageContainer
+          revision={messageEntry.revision}
+          stale={messageEntry.status === MessageEntryStatus.STALE}
+          preparing={messageEntry.status === MessageEntryStatus.PREPARING}
+        />
+      );
+    }
+
+    if (messageEntry.textEdits !== undefined) {
+      return <TextEditsMessageContainer textEdits={messageEntry.textEdits} />;
+    }
+
+    if (messageEntry.toolCallApprovalRequired !== undefined) {
+      return (
+        <ToolCallApprovalMessageContainer
+          toolCallId={messageEntry.toolCallApprovalRequired.toolCallId}
+          functionName={messageEntry.toolCallApprovalRequired.name}
+          args={messageEntry.toolCallApprovalRequired.args}
+        />
+      );
+    }
+
     if (messageEntry.toolCallPrepareArguments !== undefined) {
       return (
         <ToolCallPrepareMessageContainer
@@ -81,6 +108,8 @@ export const MessageCard = memo(({ messageEntry, prevAttachment, animated }: Mes
     if (messageEntry.user !== undefined) {
       return (
         <UserMessageContainer
+          messageId={messageEntry.messageId}
+          siblingIds={messageEntry.siblingIds ?? []}
           content={messageEntry.user?.content ?? ""}
           attachment={messageEntry.user?.selectedText ?? ""}
           stale={messageEntry.status === MessageEntryStatus.STALE}
@@ -5,6 +5,11 @@ import { getProjectId } from "../../libs/helpers";
 import MarkdownComponent from "../markdown";
 import { useAuthStore } from "../../stores/auth-store";
 import { Icon } from "@iconify/react/dist/iconify.js";
+import { useQueryClient } from "@tanstack/react-query";
+import { useForkConversationMutation } from "../../query";
+import { queryKeys } from "../../query/keys";
+import { useConversationStore } from "../../stores/conversation/conversation-store";
+import { errorToast } from "../../libs/toasts";
 
 // Helper functions
 const preprocessMessage = (message: string): string | undefined => {
@@ -34,6 +39,19 @@ export const AssistantMessageContainer = ({
   const { user } = useAuthStore();
   const projectId = getProjectId();
   const [copySuccess, setCopySuccess] = useState(false);
+  const queryClient = useQueryClient();
+  const { currentConversation, setCurrentConversation } = useConversationStore();
+  const forkConversationMutation = useForkConversationMutation({
+    onSuccess: (response) => {
+      if (response.conversation) {
+        setCurrentConversation(response.conversation);
+      }
+      queryClient.invalidateQueries({ queryKey: queryKeys.conversations.listConversations(projectId).queryKey });
+    },
+    onError: () => {
+      errorToast("Failed to fork conversation");
+    },
+  });
 
   const handleCopy = useCallback(() => {
     if (processedMessage) {
@@ -80,6 +98,17 @@ export const AssistantMessageContainer = ({
           <Tooltip content="Copy" placement="bottom" size="sm">
             <Icon icon={copySuccess ? "tabler:copy-check" : "tabler:copy"} className="icon" onClick={handleCopy} />
           </Tooltip>
+          {!preparing && (
+            <Tooltip content="Fork from here" placement="bottom" size="sm">
+              <Icon
+                icon="tabler:git-fork"
+                className="icon"
+                onClick={() =>
+                  forkConversationMutation.mutate({ conversationId: currentConversation.id, upToMessageId: messageId })
+                }
+              />
+            </Tooltip>
+          )}
         </div>
       </div>
     </div>
@@ -0,0 +1,35 @@
+import { MessageTypeRevision } from "../../pkg/gen/apiclient/chat/v1/chat_pb";
+import { TextPatches } from "../text-patches";
+import { LoadingIndicator } from "../loading-indicator";
+
+type RevisionMessageContainerProps = {
+  revision: MessageTypeRevision;
+  stale: boolean;
+  preparing: boolean;
+};
+
+// The assistant reply in the revision response mode, the revised text can be compared with the
+// original one and inserted in place of the selection.
+export const RevisionMessageContainer = ({ revision, stale, preparing }: RevisionMessageContainerProps) => {
+  if (preparing) {
+    return (
+      <div className="chat-message-entry">
+        <div className="indicator">
+          <LoadingIndicator text="Revising ..." />
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="chat-message-entry noselect">
+      <div className="message-box-assistant rnd-cancel">
+        <div className="canselect">
+          <TextPatches attachment={revision.original}>{revision.revised}</TextPatches>
+          {revision.rationale && <p className="!text-sm !text-default-600">{revision.rationale}</p>}
+        </div>
+        {stale && <div className="message-box-stale-description">This message is stale.</div>}
+      </div>
+    </div>
+  );
+};
@@ -0,0 +1,95 @@
+import { Button } from "@heroui/react";
+import { useState } from "react";
+import { fromJson } from "@bufbuild/protobuf";
+import {
+  ApplyEditRequestSchema,
+  MessageTypeTextEdits,
+  TextEdit,
+  TextEditStatus,
+} from "../../pkg/gen/apiclient/chat/v1/chat_pb";
+import { applyEdit } from "../../query/api";
+import { getCodeMirrorView } from "../../libs/helpers";
+import { useConversationStore } from "../../stores/conversation/conversation-store";
+
+type TextEditsMessageContainerProps = {
+  textEdits: MessageTypeTextEdits;
+};
+
+// applyToEditor replaces the edited range in the open document, if it still holds the original text. The
+// offsets are indices of the document string.
+const applyToEditor = (edit: TextEdit): boolean => {
+  const view = getCodeMirrorView();
+  if (!view) {
+    return false;
+  }
+  const content = view.state.doc.toString();
+  const from = edit.startOffset;
+  const to = from + edit.original.length;
+  if (content.slice(from, to) !== edit.original) {
+    return false;
+  }
+  view.dispatch({ changes: { from, to, insert: edit.replacement } });
+  return true;
+};
+
+// The edits of a project document turning the selected text into its revision, each one can be
+// applied to the open document or rejected.
+export const TextEditsMessageContainer = ({ textEdits }: TextEditsMessageContainerProps) => {
+  const { currentConversation } = useConversationStore();
+  const [edits, setEdits] = useState<TextEdit[]>(textEdits.edits);
+  const [errorMessage, setErrorMessage] = useState("");
+
+  const resolve = async (edit: TextEdit, accepted: boolean) => {
+    if (accepted && !applyToEditor(edit)) {
+      setErrorMessage(`Open ${textEdits.docPath} to apply this edit, it may have changed since.`);
+      return;
+    }
+    setErrorMessage("");
+    const response = await applyEdit(
+      fromJson(ApplyEditRequestSchema, {
+        conversationId: currentConversation.id,
+        editId: edit.editId,
+        accepted: accepted,
+      }),
+    );
+    const resolved = response.edit;
+    if (resolved) {
+      setEdits((prev) => prev.map((e) => (e.editId === resolved.editId ? resolved : e)));
+    }
+  };
+
+  return (
+    <div className="chat-message-entry noselect">
+      <div className="message-box-assistant rnd-cancel">
+        <div className="!text-sm !text-default-600 !mb-2">
+          {edits.length} edit{edits.length === 1 ? "" : "s"} in <code>{textEdits.docPath}</code>
+        </div>
+        <div className="!flex !flex-col !gap-2">
+          {edits.map((edit) => (
+            <div key={edit.editId} className="!text-sm !bg-default-100 !rounded-md !py-2 !px-3 !flex !flex-col !gap-1">
+              <pre className="!whitespace-pre-wrap canselect">
+                {edit.original && <del className="!text-danger-600">{edit.original}</del>}
+                {edit.replacement && <ins className="!text-success-600">{edit.replacement}</ins>}
+              </pre>
+              {edit.status === TextEditStatus.UNSPECIFIED ? (
+                <div className="!flex !flex-row !gap-2">
+                  <Button size="sm" color="primary" onPress={() => resolve(edit, true)}>
+                    Apply
+                  </Button>
+                  <Button size="sm" variant="flat" onPress={() => resolve(edit, false)}>
+                    Reject
+                  </Button>
+                </div>
+              ) : (
+                <span className="!text-tiny !text-default-500">
+                  {edit.status === TextEditStatus.ACCEPTED ? "Applied" : "Rejected"}
+                </span>
+              )}
+            </div>
+          ))}
+        </div>
+        {errorMessage && <div className="!text-tiny !text-danger-600 !mt-2">{errorMessage}</div>}
+      </div>
+    </div>
+  );
+};
@@ -0,0 +1,49 @@
+import { Button } from "@heroui/react";
+import { useState } from "react";
+import { useResolveToolCall } from "../../hooks/useResolveToolCall";
+
+type ToolCallApprovalMessageContainerProps = {
+  toolCallId: string;
+  functionName: string;
+  args: string;
+};
+
+// The tool sends the manuscript outside of PaperDebugger, it only runs once the user approved it.
+export const ToolCallApprovalMessageContainer = ({
+  toolCallId,
+  functionName,
+  args,
+}: ToolCallApprovalMessageContainerProps) => {
+  const { resolveToolCall } = useResolveToolCall();
+  const [resolving, setResolving] = useState(false);
+
+  const resolve = async (approved: boolean) => {
+    setResolving(true);
+    try {
+      await resolveToolCall(toolCallId, approved);
+    } finally {
+      setResolving(false);
+    }
+  };
+
+  return (
+    <div className="chat-message-entry">
+      <div className="indicator">
+        <div className="!text-sm !w-full !bg-default-100 !rounded-md !py-2 !px-3 !mb-4 !flex !flex-col !gap-2">
+          <span>
+            <code>{functionName}</code> wants to send your manuscript to an external service.
+          </span>
+          <pre className="!text-tiny !text-default-500 !whitespace-pre-wrap">{args}</pre>
+          <div className="!flex !flex-row !gap-2">
+            <Button size="sm" color="primary" isDisabled={resolving} onPress={() => resolve(true)}>
+              Approve
+            </Button>
+            <Button size="sm" variant="flat" isDisabled={resolving} onPress={() => resolve(false)}>
+              Deny
+            </Button>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+};
@@ -0,0 +1,34 @@
+import { cn } from "@heroui/react";
+import { LoadingIndicator } from "../../loading-indicator";
+import { useStreamingMessageStore } from "../../../stores/streaming-message-store";
+import { useConversationStore } from "../../../stores/conversation/conversation-store";
+import { useWatchToolJobs } from "../../../hooks/useWatchToolJobs";
+
+// The result of a tool call that went on in the background, see ToolRegistry.runJob
+const JOB_HANDLE = /"job_id":"([0-9a-f]+)","status":"running"/;
+
+export const isToolJobHandle = (message: string) => JOB_HANDLE.test(message ?? "");
+
+type ToolJobCardProps = {
+  messageId: string;
+  functionName: string;
+  animated: boolean;
+};
+
+export const ToolJobCard = ({ messageId, functionName, animated }: ToolJobCardProps) => {
+  const progress = useStreamingMessageStore((s) => s.toolCallProgress[messageId]);
+  const { currentConversation } = useConversationStore();
+  // while a turn is streamed, its stream carries the progress
+  const streaming = useStreamingMessageStore((s) => s.streamingMessage.parts.length > 0);
+  useWatchToolJobs(streaming ? "" : currentConversation.id);
+
+  const percent = progress?.progress !== undefined ? ` (${Math.round(progress.progress * 100)}%)` : "";
+  return (
+    <div className={cn("tool-card", { animated: animated })}>
+      <div className="flex items-center justify-between">
+        <h3 className="tool-card-title tool-card-jsonrpc">{functionName}</h3>
+      </div>
+      <LoadingIndicator text={`${progress?.message || "Running in the background"}${percent} ...`} />
+    </div>
+  );
+};
@@ -5,6 +5,7 @@ import { GreetingCard } from "./greeting";
 import { ErrorToolCard } from "./error";
 import { AlwaysExceptionCard } from "./always-exception";
 import { JsonRpc } from "./jsonrpc";
+import { isToolJobHandle, ToolJobCard } from "./tool-job";
 import { parseJsonRpcResult, UNKNOWN_JSONRPC_RESULT } from "./utils/common";
 
 type ToolsProps = {
@@ -35,6 +36,10 @@ export default function Tools({ messageId, functionName, message, error, prepari
     return <ErrorToolCard functionName={functionName} errorMessage={error} animated={animated} />;
   }
 
+  if (isToolJobHandle(message)) {
+    return <ToolJobCard messageId={messageId} functionName={functionName} animated={animated} />;
+  }
+
   const jsonRpcResult = parseJsonRpcResult(message);
 
   if (functionName === "paper_score") {
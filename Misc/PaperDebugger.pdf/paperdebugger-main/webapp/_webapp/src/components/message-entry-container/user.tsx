@@ -1,21 +1,116 @@
-import { cn } from "@heroui/react";
+import { Button, cn, Tooltip } from "@heroui/react";
+import { Icon } from "@iconify/react/dist/iconify.js";
+import { useState } from "react";
 import { AttachmentPopover } from "./attachment-popover";
+import { useBranchConversation } from "../../hooks/useBranchConversation";
+import { useConversationStore } from "../../stores/conversation/conversation-store";
 // import MarkdownComponent from "../markdown";
 
 export const UserMessageContainer = ({
+  messageId,
+  siblingIds,
   content,
   attachment,
   stale,
 }: {
+  messageId: string;
+  siblingIds: string[]; // the versions of the message, empty until it is saved
   content: string;
   attachment: string;
   stale: boolean;
 }) => {
+  const { editMessage, regenerateMessage, switchBranch } = useBranchConversation();
+  const { isStreaming, setIsStreaming } = useConversationStore();
+  const [editing, setEditing] = useState(false);
+  const [draft, setDraft] = useState(content);
+
+  const run = async (action: () => Promise<void>) => {
+    setIsStreaming(true);
+    try {
+      await action();
+    } finally {
+      setIsStreaming(false);
+    }
+  };
+
   const staleComponent = stale && (
     <div className="message-box-stale-description">
       Connection error. <br /> Please reload this conversation.
     </div>
   );
+
+  if (editing) {
+    return (
+      <div className="chat-message-entry">
+        <div className="message-box-user rnd-cancel !w-full !flex !flex-col !gap-2">
+          <textarea
+            onMouseDown={(e) => e.stopPropagation()}
+            className="!w-full !bg-transparent !border-none !resize-none focus:!outline-none"
+            rows={3}
+            value={draft}
+            onChange={(e) => setDraft(e.target.value)}
+          />
+          <div className="!flex !flex-row !gap-2 !justify-end">
+            <Button size="sm" variant="flat" onPress={() => setEditing(false)}>
+              Cancel
+            </Button>
+            <Button
+              size="sm"
+              color="primary"
+              isDisabled={!draft.trim() || isStreaming}
+              onPress={() => {
+                setEditing(false);
+                run(() => editMessage(messageId, draft, attachment));
+              }}
+            >
+              Send
+            </Button>
+          </div>
+        </div>
+      </div>
+    );
+  }
+
+  const version = siblingIds.indexOf(messageId);
+  const actions = siblingIds.length > 0 && !stale && (
+    <div className="actions rnd-cancel noselect !self-end">
+      {siblingIds.length > 1 && (
+        <span className="!flex !flex-row !items-center !text-tiny">
+          <Icon
+            icon="tabler:chevron-left"
+            className="icon"
+            onClick={() => version > 0 && !isStreaming && switchBranch(siblingIds[version - 1])}
+          />
+          {version + 1} / {siblingIds.length}
+          <Icon
+            icon="tabler:chevron-right"
+            className="icon"
+            onClick={() => version < siblingIds.length - 1 && !isStreaming && switchBranch(siblingIds[version + 1])}
+          />
+        </span>
+      )}
+      <Tooltip content="Edit" placement="bottom" size="sm">
+        <Icon
+          icon="tabler:edit"
+          className="icon"
+          onClick={() => {
+            if (!isStreaming) {
+              setDraft(content);
+              setEditing(true);
+            }
+          }}
+        />
+      </Tooltip>
+      <Tooltip content="Regenerate the answer" placement="bottom" size="sm">
+        <Icon
+          icon="tabler:refresh"
+          className="icon"
+          onClick={() => !isStreaming && run(() => regenerateMessage(messageId))}
+        />
+      </Tooltip>
+    </div>
+  );
+
   return (
     // Align right
     <div className="chat-message-entry">
@@ -26,6 +121,7 @@ export const UserMessageContainer = ({
         {attachment && <AttachmentPopover attachment={attachment} />}
         {staleComponent}
       </div>
+      {actions}
     </div>
   );
 };
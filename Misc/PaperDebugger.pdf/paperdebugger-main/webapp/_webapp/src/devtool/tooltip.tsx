@@ -2,13 +2,14 @@ import { useEffect, useState } from "react";
 
 import { useRef } from "react";
 import { useSelectionStore } from "../stores/selection-store";
+import { getEditorSelectionOffset } from "../libs/helpers";
 
 export const TooltipArea = ({ children }: { children: React.ReactNode }) => {
   const [showTooltip, setShowTooltip] = useState(false);
   const [tooltipPosition, setTooltipPosition] = useState<{ left: number; top: number } | null>(null);
 
   const tooltipRef = useRef<HTMLButtonElement>(null);
-  const { selectedText, setSelectedText, setSelectionRange } = useSelectionStore();
+  const { selectedText, setSelectedText, setSelectionRange, setSelectionOffset } = useSelectionStore();
 
   useEffect(() => {
     const handleSelectionChange = () => {
@@ -20,6 +21,7 @@ export const TooltipArea = ({ children }: { children: React.ReactNode }) => {
         if (text.trim().length > 0) {
           setSelectedText(text);
           setSelectionRange(range);
+          setSelectionOffset(getEditorSelectionOffset());
           setTooltipPosition({
             left: rect.left + rect.width / 2 + window.scrollX,
             top: rect.bottom + window.scrollY + 8, // 8px below selection
@@ -34,7 +36,7 @@ export const TooltipArea = ({ children }: { children: React.ReactNode }) => {
     return () => {
       document.removeEventListener("selectionchange", handleSelectionChange);
     };
-  }, [selectedText, setSelectedText, setSelectionRange]);
+  }, [selectedText, setSelectedText, setSelectionRange, setSelectionOffset]);
 
   useEffect(() => {
     const handleClick = (e: MouseEvent) => {
@@ -0,0 +1,203 @@
+import { useCallback } from "react";
+import { fromJson } from "@bufbuild/protobuf";
+import {
+  ConversationType,
+  CreateConversationMessageStreamResponse,
+  IncompleteIndicator,
+  MessageChunk,
+  MessageTypeUserSchema,
+  ResponseMode,
+  StreamError,
+  StreamFinalization,
+  StreamInitialization,
+  StreamPartBegin,
+  StreamPartEnd,
+  ToolCallProgress,
+} from "../pkg/gen/apiclient/chat/v1/chat_pb";
+import { editMessage, getConversation, regenerateMessage, switchBranch } from "../query/api";
+import { getProjectId } from "../libs/helpers";
+import { useConversationStore } from "../stores/conversation/conversation-store";
+import { useStreamingMessageStore } from "../stores/streaming-message-store";
+import { useSettingStore } from "../stores/setting-store";
+import { MessageEntryStatus } from "../stores/conversation/types";
+import { handleStreamInitialization } from "../stores/conversation/handlers/handleStreamInitialization";
+import { handleStreamPartBegin } from "../stores/conversation/handlers/handleStreamPartBegin";
+import { handleMessageChunk } from "../stores/conversation/handlers/handleMessageChunk";
+import { handleStreamPartEnd } from "../stores/conversation/handlers/handleStreamPartEnd";
+import { handleStreamFinalization } from "../stores/conversation/handlers/handleStreamFinalization";
+import { handleIncompleteIndicator } from "../stores/conversation/handlers/handleIncompleteIndicator";
+import { handleToolCallProgress } from "../stores/conversation/handlers/handleToolCallProgress";
+import { handleError } from "../stores/conversation/handlers/handleError";
+
+/**
+ * Custom React hook to branch the current conversation: edit a past user message, answer it again,
+ * or show another version of it.
+ *
+ * Editing and regenerating hide the messages from the branched user message on, then stream the new
+ * answer as in useSendMessageStream. The conversation is reloaded at the end to get the ids of the
+ * new messages and their versions.
+ *
+ * @returns {Object} An object containing the editMessage (messageId, message, selectedText),
+ * regenerateMessage (messageId) and switchBranch (messageId) functions.
+ */
+export function useBranchConversation() {
+  const { currentConversation, setCurrentConversation, updateCurrentConversation } = useConversationStore();
+  const { resetStreamingMessage, updateStreamingMessage, resetIncompleteIndicator } = useStreamingMessageStore();
+  const { conversationMode, structuredRevisions } = useSettingStore();
+
+  const onMessage = useCallback(
+    (response: CreateConversationMessageStreamResponse) => {
+      switch (response.responsePayload.case) {
+        case "streamInitialization":
+          // the conversation list is unchanged
+          handleStreamInitialization(response.responsePayload.value as StreamInitialization, () => {});
+          break;
+        case "streamPartBegin":
+          handleStreamPartBegin(response.responsePayload.value as StreamPartBegin, updateStreamingMessage);
+          break;
+        case "messageChunk":
+          handleMessageChunk(response.responsePayload.value as MessageChunk, updateStreamingMessage);
+          break;
+        case "streamPartEnd":
+          handleStreamPartEnd(response.responsePayload.value as StreamPartEnd, updateStreamingMessage);
+          break;
+        case "streamFinalization":
+          handleStreamFinalization(response.responsePayload.value as StreamFinalization);
+          break;
+        case "streamError":
+          handleError(new Error((response.responsePayload.value as StreamError).errorMessage));
+          break;
+        case "incompleteIndicator":
+          handleIncompleteIndicator(response.responsePayload.value as IncompleteIndicator);
+          break;
+        case "toolCallProgress":
+          handleToolCallProgress(response.responsePayload.value as ToolCallProgress);
+          break;
+        default: {
+          if (response.responsePayload.value !== undefined) {
+            const _typeCheck: never = response.responsePayload;
+            throw new Error("Unexpected response payload: " + _typeCheck);
+            // DO NOT delete above line, it is used to check that all cases are handled.
+          }
+          break;
+        }
+      }
+    },
+    [updateStreamingMessage],
+  );
+
+  // branch hides the messages from messageId on and shows the new version of the user message while streaming.
+  const branch = useCallback(
+    async (messageId: string, message: string, selectedText: string, stream: () => Promise<void>) => {
+      const conversationId = currentConversation.id;
+      resetStreamingMessage();
+      resetIncompleteIndicator();
+      updateCurrentConversation((prev) => {
+        const index = prev.messages.findIndex((m) => m.messageId === messageId);
+        return { ...prev, messages: index < 0 ? prev.messages : prev.messages.slice(0, index) };
+      });
+      updateStreamingMessage((prev) => ({
+        ...prev,
+        parts: [
+          {
+            messageId: "dummy",
+            status: MessageEntryStatus.PREPARING,
+            user: fromJson(MessageTypeUserSchema, { content: message, selectedText: selectedText }),
+          },
+        ],
+        sequence: prev.sequence + 1,
+      }));
+
+      try {
+        await stream();
+        const response = await getConversation({ conversationId });
+        if (response.conversation && useConversationStore.getState().currentConversation.id === conversationId) {
+          setCurrentConversation(response.conversation);
+        }
+      } catch (e) {
+        handleError(e as Error);
+      }
+    },
+    [
+      currentConversation.id,
+      resetStreamingMessage,
+      resetIncompleteIndicator,
+      updateCurrentConversation,
+      updateStreamingMessage,
+      setCurrentConversation,
+    ],
+  );
+
+  const editUserMessage = useCallback(
+    async (messageId: string, message: string, selectedText: string) => {
+      message = message.trim();
+      if (!message) {
+        return;
+      }
+      await branch(messageId, message, selectedText, () =>
+        editMessage(
+          {
+            conversationId: currentConversation.id,
+            messageId,
+            projectId: getProjectId(),
+            userMessage: message,
+            userSelectedText: selectedText,
+            conversationType:
+              conversationMode === "debug" ? ConversationType.DEBUG : currentConversation.conversationType,
+            responseMode: structuredRevisions && selectedText ? ResponseMode.REVISION : ResponseMode.UNSPECIFIED,
+          },
+          onMessage,
+        ),
+      );
+    },
+    [
+      branch,
+      currentConversation.id,
+      currentConversation.conversationType,
+      conversationMode,
+      structuredRevisions,
+      onMessage,
+    ],
+  );
+
+  const regenerateUserMessage = useCallback(
+    async (messageId: string) => {
+      const user = currentConversation.messages.find((m) => m.messageId === messageId)?.payload?.messageType;
+      if (user?.case !== "user") {
+        return;
+      }
+      const selectedText = user.value.selectedText ?? "";
+      await branch(messageId, user.value.content, selectedText, () =>
+        regenerateMessage(
+          {
+            conversationId: currentConversation.id,
+            messageId,
+            responseMode: structuredRevisions && selectedText ? ResponseMode.REVISION : ResponseMode.UNSPECIFIED,
+          },
+          onMessage,
+        ),
+      );
+    },
+    [branch, currentConversation.id, currentConversation.messages, structuredRevisions, onMessage],
+  );
+
+  const showBranch = useCallback(
+    async (messageId: string) => {
+      try {
+        const response = await switchBranch({ conversationId: currentConversation.id, messageId });
+        if (response.conversation) {
+          setCurrentConversation(response.conversation);
+        }
+      } catch (e) {
+        handleError(e as Error);
+      }
+    },
+    [currentConversation.id, setCurrentConversation],
+  );
+
+  return {
+    editMessage: editUserMessage,
+    regenerateMessage: regenerateUserMessage,
+    switchBranch: showBranch,
+  };
+}
@@ -0,0 +1,91 @@
+import { useCallback } from "react";
+import {
+  CreateConversationMessageStreamResponse,
+  IncompleteIndicator,
+  MessageChunk,
+  StreamError,
+  StreamFinalization,
+  StreamPartBegin,
+  StreamPartEnd,
+  ToolCallProgress,
+} from "../pkg/gen/apiclient/chat/v1/chat_pb";
+import { approveToolCall, denyToolCall } from "../query/api";
+import { useConversationStore } from "../stores/conversation/conversation-store";
+import { useStreamingMessageStore } from "../stores/streaming-message-store";
+import { handleStreamPartBegin } from "../stores/conversation/handlers/handleStreamPartBegin";
+import { handleMessageChunk } from "../stores/conversation/handlers/handleMessageChunk";
+import { handleStreamPartEnd } from "../stores/conversation/handlers/handleStreamPartEnd";
+import { handleStreamFinalization } from "../stores/conversation/handlers/handleStreamFinalization";
+import { handleIncompleteIndicator } from "../stores/conversation/handlers/handleIncompleteIndicator";
+import { handleToolCallProgress } from "../stores/conversation/handlers/handleToolCallProgress";
+import { handleError } from "../stores/conversation/handlers/handleError";
+
+/**
+ * Custom React hook to approve or deny a tool call awaiting the user's approval.
+ *
+ * The backend runs (or refuses) the tool call and streams the rest of the turn,
+ * the events are handled as in useSendMessageStream.
+ *
+ * @returns {Object} An object containing the resolveToolCall function, it accepts (toolCallId: string, approved: boolean).
+ */
+export function useResolveToolCall() {
+  const { currentConversation } = useConversationStore();
+  const { resetStreamingMessage, updateStreamingMessage, resetIncompleteIndicator } = useStreamingMessageStore();
+
+  const resolveToolCall = useCallback(
+    async (toolCallId: string, approved: boolean) => {
+      resetStreamingMessage();
+      resetIncompleteIndicator();
+
+      const onMessage = (response: CreateConversationMessageStreamResponse) => {
+        switch (response.responsePayload.case) {
+          case "streamInitialization":
+            break;
+          case "streamPartBegin":
+            handleStreamPartBegin(response.responsePayload.value as StreamPartBegin, updateStreamingMessage);
+            break;
+          case "messageChunk":
+            handleMessageChunk(response.responsePayload.value as MessageChunk, updateStreamingMessage);
+            break;
+          case "streamPartEnd":
+            handleStreamPartEnd(response.responsePayload.value as StreamPartEnd, updateStreamingMessage);
+            break;
+          case "streamFinalization":
+            handleStreamFinalization(response.responsePayload.value as StreamFinalization);
+            break;
+          case "streamError":
+            handleError(new Error((response.responsePayload.value as StreamError).errorMessage));
+            break;
+          case "incompleteIndicator":
+            handleIncompleteIndicator(response.responsePayload.value as IncompleteIndicator);
+            break;
+          case "toolCallProgress":
+            handleToolCallProgress(response.responsePayload.value as ToolCallProgress);
+            break;
+          default: {
+            if (response.responsePayload.value !== undefined) {
+              const _typeCheck: never = response.responsePayload;
+              throw new Error("Unexpected response payload: " + _typeCheck);
+              // DO NOT delete above line, it is used to check that all cases are handled.
+            }
+            break;
+          }
+        }
+      };
+
+      const request = { conversationId: currentConversation.id, toolCallId };
+      try {
+        if (approved) {
+          await approveToolCall(request, onMessage);
+        } else {
+          await denyToolCall(request, onMessage);
+        }
+      } catch (e) {
+        handleError(e as Error);
+      }
+    },
+    [currentConversation.id, resetStreamingMessage, resetIncompleteIndicator, updateStreamingMessage],
+  );
+
+  return { resolveToolCall };
+}
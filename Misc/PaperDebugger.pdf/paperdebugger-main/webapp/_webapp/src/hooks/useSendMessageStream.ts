@@ -3,6 +3,7 @@ import {
   ConversationType,
   CreateConversationMessageStreamRequest,
   IncompleteIndicator,
+  ResponseMode,
   StreamFinalization,
 } from "../pkg/gen/apiclient/chat/v1/chat_pb";
 import { PlainMessage } from "../query/types";
@@ -23,6 +24,7 @@ import {
   StreamInitialization,
   StreamPartBegin,
   StreamPartEnd,
+  ToolCallProgress,
 } from "../pkg/gen/apiclient/chat/v1/chat_pb";
 import { MessageEntry, MessageEntryStatus } from "../stores/conversation/types";
 import { fromJson } from "@bufbuild/protobuf";
@@ -32,10 +34,12 @@ import { useSocketStore } from "../stores/socket-store";
 import { logError, logWarn } from "../libs/logger";
 import { handleError } from "../stores/conversation/handlers/handleError";
 import { handleIncompleteIndicator } from "../stores/conversation/handlers/handleIncompleteIndicator";
+import { handleToolCallProgress } from "../stores/conversation/handlers/handleToolCallProgress";
 import { useAuthStore } from "../stores/auth-store";
 import { useDevtoolStore } from "../stores/devtool-store";
 import { getCookies } from "../intermediate";
 import { useSettingStore } from "../stores/setting-store";
+import { useSelectionStore } from "../stores/selection-store";
 
 /**
  * Custom React hook to handle sending a message as a stream in a conversation.
@@ -50,7 +54,7 @@ import { useSettingStore } from "../stores/setting-store";
  *   await sendMessageStream(message, selectedText);
  *
  * @returns {Object} An object containing the sendMessageStream function.
- * @returns {Function} sendMessageStream - Function to send a message as a stream. Accepts (message: string, selectedText: string) and returns a Promise.
+ * @returns {Function} sendMessageStream - Function to send a message as a stream. Accepts (message: string, selectedText: string, promptId?: string), promptId being the prompt of the library the message was written from, and returns a Promise.
  */
 export function useSendMessageStream() {
   const { sync } = useSocketStore();
@@ -60,10 +64,10 @@ export function useSendMessageStream() {
   const { refetch: refetchConversationList } = useListConversationsQuery(getProjectId());
   const { resetStreamingMessage, updateStreamingMessage, resetIncompleteIndicator } = useStreamingMessageStore();
   const { alwaysSyncProject } = useDevtoolStore();
-  const { conversationMode } = useSettingStore();
+  const { conversationMode, structuredRevisions } = useSettingStore();
 
   const sendMessageStream = useCallback(
-    async (message: string, selectedText: string) => {
+    async (message: string, selectedText: string, promptId?: string) => {
       if (!message || !message.trim()) {
         logWarn("No message to send");
         return;
@@ -76,7 +80,12 @@ export function useSendMessageStream() {
         languageModel: currentConversation.languageModel,
         userMessage: message,
         userSelectedText: selectedText,
-        conversationType: conversationMode === "debug" ? ConversationType.DEBUG : ConversationType.UNSPECIFIED,
+        // tells the occurrences of the selected text apart for the edits of a revision
+        userSelectionOffset: selectedText ? (useSelectionStore.getState().selectionOffset ?? undefined) : undefined,
+        promptId: promptId || undefined,
+        conversationType: conversationMode === "debug" ? ConversationType.DEBUG : currentConversation.conversationType,
+        // a message about selected text is answered with a revision of it
+        responseMode: structuredRevisions && selectedText ? ResponseMode.REVISION : ResponseMode.UNSPECIFIED,
       };
 
       resetStreamingMessage(); // ensure no stale message in the streaming messages
@@ -88,6 +97,7 @@ export function useSendMessageStream() {
         user: fromJson(MessageTypeUserSchema, {
           content: message,
           selectedText: selectedText,
+          promptId: promptId || undefined,
         }),
       };
       updateStreamingMessage((prev) => ({
@@ -144,6 +154,9 @@ export function useSendMessageStream() {
               case "incompleteIndicator":
                 handleIncompleteIndicator(response.responsePayload.value as IncompleteIndicator);
                 break;
+              case "toolCallProgress":
+                handleToolCallProgress(response.responsePayload.value as ToolCallProgress);
+                break;
               default: {
                 if (response.responsePayload.value !== undefined) {
                   const _typeCheck: never = response.responsePayload;
@@ -187,6 +200,7 @@ export function useSendMessageStream() {
       user?.id,
       alwaysSyncProject,
       conversationMode,
+      structuredRevisions,
     ],
   );
 
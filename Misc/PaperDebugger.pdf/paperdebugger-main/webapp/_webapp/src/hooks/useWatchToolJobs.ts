@@ -0,0 +1,46 @@
+import { useEffect } from "react";
+import { CreateConversationMessageStreamResponse, ToolCallProgress } from "../pkg/gen/apiclient/chat/v1/chat_pb";
+import { getConversation, watchToolJobs } from "../query/api";
+import { useConversationStore } from "../stores/conversation/conversation-store";
+import { handleToolCallProgress } from "../stores/conversation/handlers/handleToolCallProgress";
+import { logError } from "../libs/logger";
+
+// conversations with a WatchToolJobs stream open, one stream serves all their jobs
+const watching = new Set<string>();
+
+/**
+ * Custom React hook following the tool calls of a conversation that run in the background,
+ * e.g. after the page was reloaded while a long tool call was running.
+ *
+ * Once the jobs are done, the conversation is reloaded to show their results.
+ *
+ * @param conversationId The conversation to watch, nothing is watched if it is empty.
+ */
+export function useWatchToolJobs(conversationId: string) {
+  useEffect(() => {
+    if (!conversationId || watching.has(conversationId)) {
+      return;
+    }
+    watching.add(conversationId);
+
+    const onMessage = (response: CreateConversationMessageStreamResponse) => {
+      if (response.responsePayload.case === "toolCallProgress") {
+        handleToolCallProgress(response.responsePayload.value as ToolCallProgress);
+      }
+    };
+
+    watchToolJobs({ conversationId }, onMessage)
+      .then(async () => {
+        const { currentConversation, setCurrentConversation } = useConversationStore.getState();
+        if (currentConversation.id !== conversationId) {
+          return;
+        }
+        const response = await getConversation({ conversationId });
+        if (response.conversation) {
+          setCurrentConversation(response.conversation);
+        }
+      })
+      .catch((e) => logError("Failed to watch tool jobs", e))
+      .finally(() => watching.delete(conversationId));
+  }, [conversationId]);
+}
@@ -77,20 +77,23 @@ class ApiClient {
     });
   }
 
-  private async requestWithRefresh(config: AxiosRequestConfig): Promise<JsonValue> {
+  private async withRefresh<T>(request: () => Promise<T>): Promise<T> {
     try {
-      const response = await this.axiosInstance(config);
-      return response.data;
+      return await request();
     } catch (error) {
       if (error instanceof AxiosError && error.response?.status === 401 && this.hasToken()) {
         await this.refresh();
-        const response = await this.axiosInstance(config);
-        return response.data;
+        return await request();
       }
       throw error;
     }
   }
 
+  private async requestWithRefresh(config: AxiosRequestConfig): Promise<JsonValue> {
+    const response = await this.withRefresh(() => this.axiosInstance<JsonValue>(config));
+    return response.data;
+  }
+
   private async requestWithErrorToast(config: AxiosRequestConfig, options?: RequestOptions): Promise<JsonValue> {
     try {
       return await this.requestWithRefresh(config);
@@ -162,17 +165,34 @@ class ApiClient {
     );
   }
 
+  // download fetches a file attachment, the filename is the one of the Content-Disposition header or
+  // else fallbackFilename.
+  async download(url: string, fallbackFilename: string): Promise<{ filename: string; blob: Blob }> {
+    const response = await this.withRefresh(() => this.axiosInstance.get<Blob>(url, { responseType: "blob" }));
+    const disposition = `${response.headers["content-disposition"] ?? ""}`;
+    const filename = /filename="([^"]+)"/.exec(disposition)?.[1] ?? fallbackFilename;
+    return { filename, blob: response.data };
+  }
+
   async postStream(
     url: string,
     data: any, // eslint-disable-line @typescript-eslint/no-explicit-any
   ): Promise<ReadableStream<Uint8Array>> {
+    return this.stream("POST", url, JSON.stringify(data));
+  }
+
+  async getStream(url: string): Promise<ReadableStream<Uint8Array>> {
+    return this.stream("GET", url);
+  }
+
+  private async stream(method: string, url: string, body?: string): Promise<ReadableStream<Uint8Array>> {
     const response = await fetch(this.axiosInstance.defaults.baseURL + url, {
-      method: "POST",
+      method,
       headers: {
         "Content-Type": "application/json",
         Authorization: `${this.axiosInstance.defaults.headers.common["Authorization"]}`,
       },
-      body: JSON.stringify(data),
+      body,
     });
 
     if (!response.ok) {
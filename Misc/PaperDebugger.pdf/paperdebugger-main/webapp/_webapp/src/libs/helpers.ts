@@ -45,6 +45,12 @@ export function getCodeMirrorView(): EditorView | null {
   return view;
 }
 
+// getEditorSelectionOffset returns where the selection of the open document starts, in UTF-16 code units.
+export function getEditorSelectionOffset(): number | null {
+  const selection = getCodeMirrorView()?.state.selection.main;
+  return selection && !selection.empty ? selection.from : null;
+}
+
 export function applyChanges(changes: string, range: Range): boolean {
   const newText = document.createTextNode(changes);
   range.deleteContents();
@@ -234,3 +240,12 @@ export function blobToBase64(blob: Blob): Promise<string> {
     reader.readAsDataURL(blob); // 读取为 DataURL 格式（包含 base64）
   });
 }
+
+export function downloadFile(filename: string, mimeType: string, content: BlobPart) {
+  const url = URL.createObjectURL(new Blob([content], { type: mimeType }));
+  const link = document.createElement("a");
+  link.href = url;
+  link.download = filename;
+  link.click();
+  URL.revokeObjectURL(url);
+}
@@ -6,7 +6,7 @@ import { OnboardingGuide } from "./components/onboarding-guide";
 import { ToolbarButton } from "./components/toolbar-button";
 import "./index.css";
 import googleAnalytics from "./libs/google-analytics";
-import { generateSHA1Hash, onElementAdded, onElementAppeared } from "./libs/helpers";
+import { generateSHA1Hash, getEditorSelectionOffset, onElementAdded, onElementAppeared } from "./libs/helpers";
 import { OverleafCodeMirror, completion, createSuggestionExtension } from "./libs/inline-suggestion";
 import { logInfo } from "./libs/logger";
 import apiclient, { getEndpointFromLocalStorage } from "./libs/apiclient";
@@ -27,10 +27,13 @@ export const Main = () => {
   const {
     lastSelectedText,
     lastSelectionRange,
+    lastSelectionOffset,
     setLastSelectedText,
     setLastSelectionRange,
+    setLastSelectionOffset,
     setSelectedText,
     setSelectionRange,
+    setSelectionOffset,
     clearOverleafSelection,
   } = useSelectionStore();
   const [menuElement, setMenuElement] = useState<Element | null>(null);
@@ -69,6 +72,7 @@ export const Main = () => {
       if (editor && editor.contains(selection?.anchorNode ?? null)) {
         setLastSelectedText(selection?.toString() ?? null);
         setLastSelectionRange(selection?.getRangeAt(0) ?? null);
+        setLastSelectionOffset(getEditorSelectionOffset());
         return;
       } else {
         return;
@@ -78,7 +82,7 @@ export const Main = () => {
     return () => {
       document.removeEventListener("selectionchange", handleSelectionChange);
     };
-  }, [setLastSelectedText, setLastSelectionRange]);
+  }, [setLastSelectedText, setLastSelectionRange, setLastSelectionOffset]);
 
   // Add effect to close context menu when clicking outside
 
@@ -86,9 +90,19 @@ export const Main = () => {
     setActiveTab("chat");
     setSelectedText(lastSelectedText);
     setSelectionRange(lastSelectionRange);
+    setSelectionOffset(lastSelectionOffset);
     setIsOpen(true);
     clearOverleafSelection();
-  }, [setSelectedText, setSelectionRange, setIsOpen, lastSelectedText, lastSelectionRange, clearOverleafSelection]);
+  }, [
+    setSelectedText,
+    setSelectionRange,
+    setSelectionOffset,
+    setIsOpen,
+    lastSelectedText,
+    lastSelectionRange,
+    lastSelectionOffset,
+    clearOverleafSelection,
+  ]);
 
   useEffect(() => {
     const handleKeyDown = (event: KeyboardEvent) => {
@@ -0,0 +1,983 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file admin/v1/admin.proto (package admin.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "@buf/googleapis_googleapis.bufbuild_es/google/api/annotations_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file admin/v1/admin.proto.
+ */
+export const file_admin_v1_admin: GenFile = /*@__PURE__*/
+  fileDesc("ChRhZG1pbi92MS9hZG1pbi5wcm90bxIIYWRtaW4udjEiFAoSUmVsb2FkVG9vbHNSZXF1ZXN0IjQKE1JlbG9hZFRvb2xzUmVzcG9uc2USDQoFdG9vbHMYASADKAkSDgoGZXJyb3JzGAIgAygJItICCghUb29sQ2FsbBIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhIKCnByb2plY3RfaWQYAyABKAkSFwoPY29udmVyc2F0aW9uX2lkGAQgASgJEhQKDHRvb2xfY2FsbF9pZBgFIAEoCRIMCgRuYW1lGAYgASgJEg4KBnN0YXR1cxgHIAEoCRIRCglhcmd1bWVudHMYCCABKAkSDgoGcmVzdWx0GAkgASgJEg0KBWVycm9yGAogASgJEhcKCmxhdGVuY3lfbXMYCyABKANIAIgBARIOCgZjYWNoZWQYDCABKAgSLgoKY3JlYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDQoLX2xhdGVuY3lfbXMi7QEKFExpc3RUb29sQ2FsbHNSZXF1ZXN0EhwKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCUgAiAEBEhcKCnByb2plY3RfaWQYAiABKAlIAYgBARIWCgl0b29sX25hbWUYAyABKAlIAogBARITCgZzdGF0dXMYBCABKAlIA4gBARINCgVsaW1pdBgFIAEoBRIXCgpwYWdlX3Rva2VuGAYgASgJSASIAQFCEgoQX2NvbnZlcnNhdGlvbl9pZEINCgtfcHJvamVjdF9pZEIMCgpfdG9vbF9uYW1lQgkKB19zdGF0dXNCDQoLX3BhZ2VfdG9rZW4icQoVTGlzdFRvb2xDYWxsc1Jlc3BvbnNlEiYKCnRvb2xfY2FsbHMYASADKAsyEi5hZG1pbi52MS5Ub29sQ2FsbBIcCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAlIAIgBAUISChBfbmV4dF9wYWdlX3Rva2VuIqEBChdHZXRUb29sQ2FsbFN0YXRzUmVxdWVzdBIXCgpwcm9qZWN0X2lkGAEgASgJSACIAQESFgoJdG9vbF9uYW1lGAIgASgJSAGIAQESLgoFc2luY2UYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQFCDQoLX3Byb2plY3RfaWRCDAoKX3Rvb2xfbmFtZUIICgZfc2luY2UiLgoOVG9vbEVycm9yQ291bnQSDQoFZXJyb3IYASABKAkSDQoFY291bnQYAiABKAUi9wEKDVRvb2xDYWxsU3RhdHMSDAoEbmFtZRgBIAEoCRINCgV0b3RhbBgCIAEoBRIRCglzdWNjZWVkZWQYAyABKAUSDgoGZmFpbGVkGAQgASgFEhEKCXRpbWVkX291dBgFIAEoBRIPCgdwZW5kaW5nGAYgASgFEg4KBmNhY2hlZBgHIAEoBRIUCgxzdWNjZXNzX3JhdGUYCCABKAESFgoOcDUwX2xhdGVuY3lfbXMYCSABKAMSFgoOcDk1X2xhdGVuY3lfbXMYCiABKAMSLAoKdG9wX2Vycm9ycxgLIAMoCzIYLmFkbWluLnYxLlRvb2xFcnJvckNvdW50IkIKGEdldFRvb2xDYWxsU3RhdHNSZXNwb25zZRImCgV0b29scxgBIAMoCzIXLmFkbWluLnYxLlRvb2xDYWxsU3RhdHMi+QIKDURlZmF1bHRQcm9tcHQSCgoCaWQYASABKAkSCwoDa2V5GAIgASgJEg8KB3ZlcnNpb24YAyABKAUSEAoIY2F0ZWdvcnkYBCABKAkSDAoEdGFncxgFIAMoCRINCgVvcmRlchgGIAEoBRINCgV0aXRsZRgHIAEoCRJGChBsb2NhbGl6ZWRfdGl0bGVzGAggAygLMiwuYWRtaW4udjEuRGVmYXVsdFByb21wdC5Mb2NhbGl6ZWRUaXRsZXNFbnRyeRIPCgdjb250ZW50GAkgASgJEg8KB2RlbGV0ZWQYCiABKAgSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaNgoUTG9jYWxpemVkVGl0bGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI0ChlMaXN0RGVmYXVsdFByb21wdHNSZXF1ZXN0EhcKD2luY2x1ZGVfZGVsZXRlZBgBIAEoCCJGChpMaXN0RGVmYXVsdFByb21wdHNSZXNwb25zZRIoCgdwcm9tcHRzGAEgAygLMhcuYWRtaW4udjEuRGVmYXVsdFByb21wdCKFAgoaQ3JlYXRlRGVmYXVsdFByb21wdFJlcXVlc3QSCwoDa2V5GAEgASgJEhAKCGNhdGVnb3J5GAIgASgJEgwKBHRhZ3MYAyADKAkSDQoFb3JkZXIYBCABKAUSDQoFdGl0bGUYBSABKAkSUwoQbG9jYWxpemVkX3RpdGxlcxgGIAMoCzI5LmFkbWluLnYxLkNyZWF0ZURlZmF1bHRQcm9tcHRSZXF1ZXN0LkxvY2FsaXplZFRpdGxlc0VudHJ5Eg8KB2NvbnRlbnQYByABKAkaNgoUTG9jYWxpemVkVGl0bGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChtDcmVhdGVEZWZhdWx0UHJvbXB0UmVzcG9uc2USJwoGcHJvbXB0GAEgASgLMhcuYWRtaW4udjEuRGVmYXVsdFByb21wdCIhChFEZWZhdWx0UHJvbXB0VGFncxIMCgR0YWdzGAEgAygJIncKD0xvY2FsaXplZFRpdGxlcxI1CgZ0aXRsZXMYASADKAsyJS5hZG1pbi52MS5Mb2NhbGl6ZWRUaXRsZXMuVGl0bGVzRW50cnkaLQoLVGl0bGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKiAgoaVXBkYXRlRGVmYXVsdFByb21wdFJlcXVlc3QSEQoJcHJvbXB0X2lkGAEgASgJEg8KB3ZlcnNpb24YAiABKAUSFQoIY2F0ZWdvcnkYAyABKAlIAIgBARIpCgR0YWdzGAQgASgLMhsuYWRtaW4udjEuRGVmYXVsdFByb21wdFRhZ3MSEgoFb3JkZXIYBSABKAVIAYgBARISCgV0aXRsZRgGIAEoCUgCiAEBEjMKEGxvY2FsaXplZF90aXRsZXMYByABKAsyGS5hZG1pbi52MS5Mb2NhbGl6ZWRUaXRsZXMSFAoHY29udGVudBgIIAEoCUgDiAEBQgsKCV9jYXRlZ29yeUIICgZfb3JkZXJCCAoGX3RpdGxlQgoKCF9jb250ZW50IkYKG1VwZGF0ZURlZmF1bHRQcm9tcHRSZXNwb25zZRInCgZwcm9tcHQYASABKAsyFy5hZG1pbi52MS5EZWZhdWx0UHJvbXB0Ii8KGkRlbGV0ZURlZmF1bHRQcm9tcHRSZXF1ZXN0EhEKCXByb21wdF9pZBgBIAEoCSIdChtEZWxldGVEZWZhdWx0UHJvbXB0UmVzcG9uc2UivAEKDFN5c3RlbVByb21wdBIPCgdwZXJzb25hGAEgASgJEiwKBnNvdXJjZRgCIAEoDjIcLmFkbWluLnYxLlN5c3RlbVByb21wdFNvdXJjZRIPCgdjb250ZW50GAMgASgJEhgKEGJ1aWx0X2luX2NvbnRlbnQYBCABKAkSMwoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBAUINCgtfdXBkYXRlZF9hdCIaChhMaXN0U3lzdGVtUHJvbXB0c1JlcXVlc3QiSwoZTGlzdFN5c3RlbVByb21wdHNSZXNwb25zZRIuCg5zeXN0ZW1fcHJvbXB0cxgBIAMoCzIWLmFkbWluLnYxLlN5c3RlbVByb21wdCJUChlVcGRhdGVTeXN0ZW1Qcm9tcHRSZXF1ZXN0Eg8KB3BlcnNvbmEYASABKAkSDwoHY29udGVudBgCIAEoCRIVCg12YWxpZGF0ZV9vbmx5GAMgASgIIlwKGlVwZGF0ZVN5c3RlbVByb21wdFJlc3BvbnNlEi0KDXN5c3RlbV9wcm9tcHQYASABKAsyFi5hZG1pbi52MS5TeXN0ZW1Qcm9tcHQSDwoHcHJldmlldxgCIAEoCSIrChhSZXNldFN5c3RlbVByb21wdFJlcXVlc3QSDwoHcGVyc29uYRgBIAEoCSJKChlSZXNldFN5c3RlbVByb21wdFJlc3BvbnNlEi0KDXN5c3RlbV9wcm9tcHQYASABKAsyFi5hZG1pbi52MS5TeXN0ZW1Qcm9tcHQqoQEKElN5c3RlbVByb21wdFNvdXJjZRIkCiBTWVNURU1fUFJPTVBUX1NPVVJDRV9VTlNQRUNJRklFRBAAEiEKHVNZU1RFTV9QUk9NUFRfU09VUkNFX0JVSUxUX0lOEAESHwobU1lTVEVNX1BST01QVF9TT1VSQ0VfQ09ORklHEAISIQodU1lTVEVNX1BST01QVF9TT1VSQ0VfREFUQUJBU0UQAzKVCwoMQWRtaW5TZXJ2aWNlEnUKC1JlbG9hZFRvb2xzEhwuYWRtaW4udjEuUmVsb2FkVG9vbHNSZXF1ZXN0Gh0uYWRtaW4udjEuUmVsb2FkVG9vbHNSZXNwb25zZSIpgtPkkwIjOgEqIh4vX3BkL2FwaS92MS9hZG1pbi90b29scy9yZWxvYWQSdgoNTGlzdFRvb2xDYWxscxIeLmFkbWluLnYxLkxpc3RUb29sQ2FsbHNSZXF1ZXN0Gh8uYWRtaW4udjEuTGlzdFRvb2xDYWxsc1Jlc3BvbnNlIiSC0+STAh4SHC9fcGQvYXBpL3YxL2FkbWluL3Rvb2wtY2FsbHMShQEKEEdldFRvb2xDYWxsU3RhdHMSIS5hZG1pbi52MS5HZXRUb29sQ2FsbFN0YXRzUmVxdWVzdBoiLmFkbWluLnYxLkdldFRvb2xDYWxsU3RhdHNSZXNwb25zZSIqgtPkkwIkEiIvX3BkL2FwaS92MS9hZG1pbi90b29sLWNhbGxzL3N0YXRzEooBChJMaXN0RGVmYXVsdFByb21wdHMSIy5hZG1pbi52MS5MaXN0RGVmYXVsdFByb21wdHNSZXF1ZXN0GiQuYWRtaW4udjEuTGlzdERlZmF1bHRQcm9tcHRzUmVzcG9uc2UiKYLT5JMCIxIhL19wZC9hcGkvdjEvYWRtaW4vZGVmYXVsdC1wcm9tcHRzEpABChNDcmVhdGVEZWZhdWx0UHJvbXB0EiQuYWRtaW4udjEuQ3JlYXRlRGVmYXVsdFByb21wdFJlcXVlc3QaJS5hZG1pbi52MS5DcmVhdGVEZWZhdWx0UHJvbXB0UmVzcG9uc2UiLILT5JMCJjoBKiIhL19wZC9hcGkvdjEvYWRtaW4vZGVmYXVsdC1wcm9tcHRzEpwBChNVcGRhdGVEZWZhdWx0UHJvbXB0EiQuYWRtaW4udjEuVXBkYXRlRGVmYXVsdFByb21wdFJlcXVlc3QaJS5hZG1pbi52MS5VcGRhdGVEZWZhdWx0UHJvbXB0UmVzcG9uc2UiOILT5JMCMjoBKjItL19wZC9hcGkvdjEvYWRtaW4vZGVmYXVsdC1wcm9tcHRzL3twcm9tcHRfaWR9EpkBChNEZWxldGVEZWZhdWx0UHJvbXB0EiQuYWRtaW4udjEuRGVsZXRlRGVmYXVsdFByb21wdFJlcXVlc3QaJS5hZG1pbi52MS5EZWxldGVEZWZhdWx0UHJvbXB0UmVzcG9uc2UiNYLT5JMCLyotL19wZC9hcGkvdjEvYWRtaW4vZGVmYXVsdC1wcm9tcHRzL3twcm9tcHRfaWR9EoYBChFMaXN0U3lzdGVtUHJvbXB0cxIiLmFkbWluLnYxLkxpc3RTeXN0ZW1Qcm9tcHRzUmVxdWVzdBojLmFkbWluLnYxLkxpc3RTeXN0ZW1Qcm9tcHRzUmVzcG9uc2UiKILT5JMCIhIgL19wZC9hcGkvdjEvYWRtaW4vc3lzdGVtLXByb21wdHMSlgEKElVwZGF0ZVN5c3RlbVByb21wdBIjLmFkbWluLnYxLlVwZGF0ZVN5c3RlbVByb21wdFJlcXVlc3QaJC5hZG1pbi52MS5VcGRhdGVTeXN0ZW1Qcm9tcHRSZXNwb25zZSI1gtPkkwIvOgEqGiovX3BkL2FwaS92MS9hZG1pbi9zeXN0ZW0tcHJvbXB0cy97cGVyc29uYX0SkAEKEVJlc2V0U3lzdGVtUHJvbXB0EiIuYWRtaW4udjEuUmVzZXRTeXN0ZW1Qcm9tcHRSZXF1ZXN0GiMuYWRtaW4udjEuUmVzZXRTeXN0ZW1Qcm9tcHRSZXNwb25zZSIygtPkkwIsKiovX3BkL2FwaS92MS9hZG1pbi9zeXN0ZW0tcHJvbXB0cy97cGVyc29uYX1ChwEKDGNvbS5hZG1pbi52MUIKQWRtaW5Qcm90b1ABWipwYXBlcmRlYnVnZ2VyL3BrZy9nZW4vYXBpL2FkbWluL3YxO2FkbWludjGiAgNBWFiqAghBZG1pbi5WMcoCCEFkbWluXFYx4gIUQWRtaW5cVjFcR1BCTWV0YWRhdGHqAglBZG1pbjo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_protobuf_timestamp]);
+
+/**
+ * leave it empty
+ *
+ * @generated from message admin.v1.ReloadToolsRequest
+ */
+export type ReloadToolsRequest = Message<"admin.v1.ReloadToolsRequest"> & {
+};
+
+/**
+ * Describes the message admin.v1.ReloadToolsRequest.
+ * Use `create(ReloadToolsRequestSchema)` to create a new message.
+ */
+export const ReloadToolsRequestSchema: GenMessage<ReloadToolsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 0);
+
+/**
+ * @generated from message admin.v1.ReloadToolsResponse
+ */
+export type ReloadToolsResponse = Message<"admin.v1.ReloadToolsResponse"> & {
+  /**
+   * names of the tools registered after the reload
+   *
+   * @generated from field: repeated string tools = 1;
+   */
+  tools: string[];
+
+  /**
+   * backends which failed to reload, the previous tools are kept
+   *
+   * @generated from field: repeated string errors = 2;
+   */
+  errors: string[];
+};
+
+/**
+ * Describes the message admin.v1.ReloadToolsResponse.
+ * Use `create(ReloadToolsResponseSchema)` to create a new message.
+ */
+export const ReloadToolsResponseSchema: GenMessage<ReloadToolsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 1);
+
+/**
+ * ToolCall is one invocation of a tool, as recorded by the tools which keep a record of their calls.
+ *
+ * @generated from message admin.v1.ToolCall
+ */
+export type ToolCall = Message<"admin.v1.ToolCall"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string conversation_id = 4;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string tool_call_id = 5;
+   */
+  toolCallId: string;
+
+  /**
+   * @generated from field: string name = 6;
+   */
+  name: string;
+
+  /**
+   * "pending", "success", "error" or "timeout"
+   *
+   * @generated from field: string status = 7;
+   */
+  status: string;
+
+  /**
+   * json string
+   *
+   * @generated from field: string arguments = 8;
+   */
+  arguments: string;
+
+  /**
+   * json string
+   *
+   * @generated from field: string result = 9;
+   */
+  result: string;
+
+  /**
+   * @generated from field: string error = 10;
+   */
+  error: string;
+
+  /**
+   * unset while pending, after a timeout, and for cached results
+   *
+   * @generated from field: optional int64 latency_ms = 11;
+   */
+  latencyMs?: bigint;
+
+  /**
+   * the result of an earlier call on the same content was reused
+   *
+   * @generated from field: bool cached = 12;
+   */
+  cached: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 13;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 14;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.v1.ToolCall.
+ * Use `create(ToolCallSchema)` to create a new message.
+ */
+export const ToolCallSchema: GenMessage<ToolCall> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 2);
+
+/**
+ * @generated from message admin.v1.ListToolCallsRequest
+ */
+export type ListToolCallsRequest = Message<"admin.v1.ListToolCallsRequest"> & {
+  /**
+   * @generated from field: optional string conversation_id = 1;
+   */
+  conversationId?: string;
+
+  /**
+   * @generated from field: optional string project_id = 2;
+   */
+  projectId?: string;
+
+  /**
+   * @generated from field: optional string tool_name = 3;
+   */
+  toolName?: string;
+
+  /**
+   * @generated from field: optional string status = 4;
+   */
+  status?: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+
+  /**
+   * next_page_token of the previous page
+   *
+   * @generated from field: optional string page_token = 6;
+   */
+  pageToken?: string;
+};
+
+/**
+ * Describes the message admin.v1.ListToolCallsRequest.
+ * Use `create(ListToolCallsRequestSchema)` to create a new message.
+ */
+export const ListToolCallsRequestSchema: GenMessage<ListToolCallsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 3);
+
+/**
+ * @generated from message admin.v1.ListToolCallsResponse
+ */
+export type ListToolCallsResponse = Message<"admin.v1.ListToolCallsResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated admin.v1.ToolCall tool_calls = 1;
+   */
+  toolCalls: ToolCall[];
+
+  /**
+   * unset on the last page
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+};
+
+/**
+ * Describes the message admin.v1.ListToolCallsResponse.
+ * Use `create(ListToolCallsResponseSchema)` to create a new message.
+ */
+export const ListToolCallsResponseSchema: GenMessage<ListToolCallsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 4);
+
+/**
+ * @generated from message admin.v1.GetToolCallStatsRequest
+ */
+export type GetToolCallStatsRequest = Message<"admin.v1.GetToolCallStatsRequest"> & {
+  /**
+   * @generated from field: optional string project_id = 1;
+   */
+  projectId?: string;
+
+  /**
+   * @generated from field: optional string tool_name = 2;
+   */
+  toolName?: string;
+
+  /**
+   * defaults to 7 days ago
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+};
+
+/**
+ * Describes the message admin.v1.GetToolCallStatsRequest.
+ * Use `create(GetToolCallStatsRequestSchema)` to create a new message.
+ */
+export const GetToolCallStatsRequestSchema: GenMessage<GetToolCallStatsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 5);
+
+/**
+ * @generated from message admin.v1.ToolErrorCount
+ */
+export type ToolErrorCount = Message<"admin.v1.ToolErrorCount"> & {
+  /**
+   * @generated from field: string error = 1;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message admin.v1.ToolErrorCount.
+ * Use `create(ToolErrorCountSchema)` to create a new message.
+ */
+export const ToolErrorCountSchema: GenMessage<ToolErrorCount> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 6);
+
+/**
+ * @generated from message admin.v1.ToolCallStats
+ */
+export type ToolCallStats = Message<"admin.v1.ToolCallStats"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+
+  /**
+   * @generated from field: int32 succeeded = 3;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: int32 failed = 4;
+   */
+  failed: number;
+
+  /**
+   * @generated from field: int32 timed_out = 5;
+   */
+  timedOut: number;
+
+  /**
+   * @generated from field: int32 pending = 6;
+   */
+  pending: number;
+
+  /**
+   * included in succeeded
+   *
+   * @generated from field: int32 cached = 7;
+   */
+  cached: number;
+
+  /**
+   * succeeded / (succeeded + failed + timed_out)
+   *
+   * @generated from field: double success_rate = 8;
+   */
+  successRate: number;
+
+  /**
+   * @generated from field: int64 p50_latency_ms = 9;
+   */
+  p50LatencyMs: bigint;
+
+  /**
+   * @generated from field: int64 p95_latency_ms = 10;
+   */
+  p95LatencyMs: bigint;
+
+  /**
+   * most frequent first
+   *
+   * @generated from field: repeated admin.v1.ToolErrorCount top_errors = 11;
+   */
+  topErrors: ToolErrorCount[];
+};
+
+/**
+ * Describes the message admin.v1.ToolCallStats.
+ * Use `create(ToolCallStatsSchema)` to create a new message.
+ */
+export const ToolCallStatsSchema: GenMessage<ToolCallStats> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 7);
+
+/**
+ * @generated from message admin.v1.GetToolCallStatsResponse
+ */
+export type GetToolCallStatsResponse = Message<"admin.v1.GetToolCallStatsResponse"> & {
+  /**
+   * lowest success rate first
+   *
+   * @generated from field: repeated admin.v1.ToolCallStats tools = 1;
+   */
+  tools: ToolCallStats[];
+};
+
+/**
+ * Describes the message admin.v1.GetToolCallStatsResponse.
+ * Use `create(GetToolCallStatsResponseSchema)` to create a new message.
+ */
+export const GetToolCallStatsResponseSchema: GenMessage<GetToolCallStatsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 8);
+
+/**
+ * DefaultPrompt is a built-in prompt of the catalog shown to every user.
+ *
+ * @generated from message admin.v1.DefaultPrompt
+ */
+export type DefaultPrompt = Message<"admin.v1.DefaultPrompt"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * stable identifier, does not change
+   *
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * incremented on every change
+   *
+   * @generated from field: int32 version = 3;
+   */
+  version: number;
+
+  /**
+   * @generated from field: string category = 4;
+   */
+  category: string;
+
+  /**
+   * @generated from field: repeated string tags = 5;
+   */
+  tags: string[];
+
+  /**
+   * lower first
+   *
+   * @generated from field: int32 order = 6;
+   */
+  order: number;
+
+  /**
+   * @generated from field: string title = 7;
+   */
+  title: string;
+
+  /**
+   * by language tag, e.g. "zh" or "zh-TW"
+   *
+   * @generated from field: map<string, string> localized_titles = 8;
+   */
+  localizedTitles: { [key: string]: string };
+
+  /**
+   * @generated from field: string content = 9;
+   */
+  content: string;
+
+  /**
+   * @generated from field: bool deleted = 10;
+   */
+  deleted: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.v1.DefaultPrompt.
+ * Use `create(DefaultPromptSchema)` to create a new message.
+ */
+export const DefaultPromptSchema: GenMessage<DefaultPrompt> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 9);
+
+/**
+ * @generated from message admin.v1.ListDefaultPromptsRequest
+ */
+export type ListDefaultPromptsRequest = Message<"admin.v1.ListDefaultPromptsRequest"> & {
+  /**
+   * @generated from field: bool include_deleted = 1;
+   */
+  includeDeleted: boolean;
+};
+
+/**
+ * Describes the message admin.v1.ListDefaultPromptsRequest.
+ * Use `create(ListDefaultPromptsRequestSchema)` to create a new message.
+ */
+export const ListDefaultPromptsRequestSchema: GenMessage<ListDefaultPromptsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 10);
+
+/**
+ * @generated from message admin.v1.ListDefaultPromptsResponse
+ */
+export type ListDefaultPromptsResponse = Message<"admin.v1.ListDefaultPromptsResponse"> & {
+  /**
+   * by order, then title
+   *
+   * @generated from field: repeated admin.v1.DefaultPrompt prompts = 1;
+   */
+  prompts: DefaultPrompt[];
+};
+
+/**
+ * Describes the message admin.v1.ListDefaultPromptsResponse.
+ * Use `create(ListDefaultPromptsResponseSchema)` to create a new message.
+ */
+export const ListDefaultPromptsResponseSchema: GenMessage<ListDefaultPromptsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 11);
+
+/**
+ * @generated from message admin.v1.CreateDefaultPromptRequest
+ */
+export type CreateDefaultPromptRequest = Message<"admin.v1.CreateDefaultPromptRequest"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string category = 2;
+   */
+  category: string;
+
+  /**
+   * @generated from field: repeated string tags = 3;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: int32 order = 4;
+   */
+  order: number;
+
+  /**
+   * @generated from field: string title = 5;
+   */
+  title: string;
+
+  /**
+   * @generated from field: map<string, string> localized_titles = 6;
+   */
+  localizedTitles: { [key: string]: string };
+
+  /**
+   * @generated from field: string content = 7;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message admin.v1.CreateDefaultPromptRequest.
+ * Use `create(CreateDefaultPromptRequestSchema)` to create a new message.
+ */
+export const CreateDefaultPromptRequestSchema: GenMessage<CreateDefaultPromptRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 12);
+
+/**
+ * @generated from message admin.v1.CreateDefaultPromptResponse
+ */
+export type CreateDefaultPromptResponse = Message<"admin.v1.CreateDefaultPromptResponse"> & {
+  /**
+   * @generated from field: admin.v1.DefaultPrompt prompt = 1;
+   */
+  prompt?: DefaultPrompt;
+};
+
+/**
+ * Describes the message admin.v1.CreateDefaultPromptResponse.
+ * Use `create(CreateDefaultPromptResponseSchema)` to create a new message.
+ */
+export const CreateDefaultPromptResponseSchema: GenMessage<CreateDefaultPromptResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 13);
+
+/**
+ * @generated from message admin.v1.DefaultPromptTags
+ */
+export type DefaultPromptTags = Message<"admin.v1.DefaultPromptTags"> & {
+  /**
+   * @generated from field: repeated string tags = 1;
+   */
+  tags: string[];
+};
+
+/**
+ * Describes the message admin.v1.DefaultPromptTags.
+ * Use `create(DefaultPromptTagsSchema)` to create a new message.
+ */
+export const DefaultPromptTagsSchema: GenMessage<DefaultPromptTags> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 14);
+
+/**
+ * @generated from message admin.v1.LocalizedTitles
+ */
+export type LocalizedTitles = Message<"admin.v1.LocalizedTitles"> & {
+  /**
+   * @generated from field: map<string, string> titles = 1;
+   */
+  titles: { [key: string]: string };
+};
+
+/**
+ * Describes the message admin.v1.LocalizedTitles.
+ * Use `create(LocalizedTitlesSchema)` to create a new message.
+ */
+export const LocalizedTitlesSchema: GenMessage<LocalizedTitles> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 15);
+
+/**
+ * @generated from message admin.v1.UpdateDefaultPromptRequest
+ */
+export type UpdateDefaultPromptRequest = Message<"admin.v1.UpdateDefaultPromptRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+
+  /**
+   * The version read by the admin, the update fails if the prompt changed since.
+   *
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  /**
+   * @generated from field: optional string category = 3;
+   */
+  category?: string;
+
+  /**
+   * left unset, the tags are kept
+   *
+   * @generated from field: admin.v1.DefaultPromptTags tags = 4;
+   */
+  tags?: DefaultPromptTags;
+
+  /**
+   * @generated from field: optional int32 order = 5;
+   */
+  order?: number;
+
+  /**
+   * @generated from field: optional string title = 6;
+   */
+  title?: string;
+
+  /**
+   * left unset, the localized titles are kept
+   *
+   * @generated from field: admin.v1.LocalizedTitles localized_titles = 7;
+   */
+  localizedTitles?: LocalizedTitles;
+
+  /**
+   * @generated from field: optional string content = 8;
+   */
+  content?: string;
+};
+
+/**
+ * Describes the message admin.v1.UpdateDefaultPromptRequest.
+ * Use `create(UpdateDefaultPromptRequestSchema)` to create a new message.
+ */
+export const UpdateDefaultPromptRequestSchema: GenMessage<UpdateDefaultPromptRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 16);
+
+/**
+ * @generated from message admin.v1.UpdateDefaultPromptResponse
+ */
+export type UpdateDefaultPromptResponse = Message<"admin.v1.UpdateDefaultPromptResponse"> & {
+  /**
+   * @generated from field: admin.v1.DefaultPrompt prompt = 1;
+   */
+  prompt?: DefaultPrompt;
+};
+
+/**
+ * Describes the message admin.v1.UpdateDefaultPromptResponse.
+ * Use `create(UpdateDefaultPromptResponseSchema)` to create a new message.
+ */
+export const UpdateDefaultPromptResponseSchema: GenMessage<UpdateDefaultPromptResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 17);
+
+/**
+ * @generated from message admin.v1.DeleteDefaultPromptRequest
+ */
+export type DeleteDefaultPromptRequest = Message<"admin.v1.DeleteDefaultPromptRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+};
+
+/**
+ * Describes the message admin.v1.DeleteDefaultPromptRequest.
+ * Use `create(DeleteDefaultPromptRequestSchema)` to create a new message.
+ */
+export const DeleteDefaultPromptRequestSchema: GenMessage<DeleteDefaultPromptRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 18);
+
+/**
+ * @generated from message admin.v1.DeleteDefaultPromptResponse
+ */
+export type DeleteDefaultPromptResponse = Message<"admin.v1.DeleteDefaultPromptResponse"> & {
+};
+
+/**
+ * Describes the message admin.v1.DeleteDefaultPromptResponse.
+ * Use `create(DeleteDefaultPromptResponseSchema)` to create a new message.
+ */
+export const DeleteDefaultPromptResponseSchema: GenMessage<DeleteDefaultPromptResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 19);
+
+/**
+ * SystemPrompt is the text/template of the system prompt of a persona, see the README for the
+ * fields and the blocks it can use.
+ *
+ * @generated from message admin.v1.SystemPrompt
+ */
+export type SystemPrompt = Message<"admin.v1.SystemPrompt"> & {
+  /**
+   * e.g. "default", "debug" or "reviewer"
+   *
+   * @generated from field: string persona = 1;
+   */
+  persona: string;
+
+  /**
+   * @generated from field: admin.v1.SystemPromptSource source = 2;
+   */
+  source: SystemPromptSource;
+
+  /**
+   * the template in use
+   *
+   * @generated from field: string content = 3;
+   */
+  content: string;
+
+  /**
+   * @generated from field: string built_in_content = 4;
+   */
+  builtInContent: string;
+
+  /**
+   * of the override in the database
+   *
+   * @generated from field: optional google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message admin.v1.SystemPrompt.
+ * Use `create(SystemPromptSchema)` to create a new message.
+ */
+export const SystemPromptSchema: GenMessage<SystemPrompt> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 20);
+
+/**
+ * @generated from message admin.v1.ListSystemPromptsRequest
+ */
+export type ListSystemPromptsRequest = Message<"admin.v1.ListSystemPromptsRequest"> & {
+};
+
+/**
+ * Describes the message admin.v1.ListSystemPromptsRequest.
+ * Use `create(ListSystemPromptsRequestSchema)` to create a new message.
+ */
+export const ListSystemPromptsRequestSchema: GenMessage<ListSystemPromptsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 21);
+
+/**
+ * @generated from message admin.v1.ListSystemPromptsResponse
+ */
+export type ListSystemPromptsResponse = Message<"admin.v1.ListSystemPromptsResponse"> & {
+  /**
+   * @generated from field: repeated admin.v1.SystemPrompt system_prompts = 1;
+   */
+  systemPrompts: SystemPrompt[];
+};
+
+/**
+ * Describes the message admin.v1.ListSystemPromptsResponse.
+ * Use `create(ListSystemPromptsResponseSchema)` to create a new message.
+ */
+export const ListSystemPromptsResponseSchema: GenMessage<ListSystemPromptsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 22);
+
+/**
+ * @generated from message admin.v1.UpdateSystemPromptRequest
+ */
+export type UpdateSystemPromptRequest = Message<"admin.v1.UpdateSystemPromptRequest"> & {
+  /**
+   * @generated from field: string persona = 1;
+   */
+  persona: string;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * checks the template and renders the preview without saving it
+   *
+   * @generated from field: bool validate_only = 3;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message admin.v1.UpdateSystemPromptRequest.
+ * Use `create(UpdateSystemPromptRequestSchema)` to create a new message.
+ */
+export const UpdateSystemPromptRequestSchema: GenMessage<UpdateSystemPromptRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 23);
+
+/**
+ * @generated from message admin.v1.UpdateSystemPromptResponse
+ */
+export type UpdateSystemPromptResponse = Message<"admin.v1.UpdateSystemPromptResponse"> & {
+  /**
+   * @generated from field: admin.v1.SystemPrompt system_prompt = 1;
+   */
+  systemPrompt?: SystemPrompt;
+
+  /**
+   * the template rendered with sample instructions and paper content
+   *
+   * @generated from field: string preview = 2;
+   */
+  preview: string;
+};
+
+/**
+ * Describes the message admin.v1.UpdateSystemPromptResponse.
+ * Use `create(UpdateSystemPromptResponseSchema)` to create a new message.
+ */
+export const UpdateSystemPromptResponseSchema: GenMessage<UpdateSystemPromptResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 24);
+
+/**
+ * @generated from message admin.v1.ResetSystemPromptRequest
+ */
+export type ResetSystemPromptRequest = Message<"admin.v1.ResetSystemPromptRequest"> & {
+  /**
+   * @generated from field: string persona = 1;
+   */
+  persona: string;
+};
+
+/**
+ * Describes the message admin.v1.ResetSystemPromptRequest.
+ * Use `create(ResetSystemPromptRequestSchema)` to create a new message.
+ */
+export const ResetSystemPromptRequestSchema: GenMessage<ResetSystemPromptRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 25);
+
+/**
+ * @generated from message admin.v1.ResetSystemPromptResponse
+ */
+export type ResetSystemPromptResponse = Message<"admin.v1.ResetSystemPromptResponse"> & {
+  /**
+   * @generated from field: admin.v1.SystemPrompt system_prompt = 1;
+   */
+  systemPrompt?: SystemPrompt;
+};
+
+/**
+ * Describes the message admin.v1.ResetSystemPromptResponse.
+ * Use `create(ResetSystemPromptResponseSchema)` to create a new message.
+ */
+export const ResetSystemPromptResponseSchema: GenMessage<ResetSystemPromptResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 26);
+
+/**
+ * @generated from enum admin.v1.SystemPromptSource
+ */
+export enum SystemPromptSource {
+  /**
+   * @generated from enum value: SYSTEM_PROMPT_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SYSTEM_PROMPT_SOURCE_BUILT_IN = 1;
+   */
+  BUILT_IN = 1,
+
+  /**
+   * a file of PD_SYSTEM_PROMPT_DIR
+   *
+   * @generated from enum value: SYSTEM_PROMPT_SOURCE_CONFIG = 2;
+   */
+  CONFIG = 2,
+
+  /**
+   * set with UpdateSystemPrompt
+   *
+   * @generated from enum value: SYSTEM_PROMPT_SOURCE_DATABASE = 3;
+   */
+  DATABASE = 3,
+}
+
+/**
+ * Describes the enum admin.v1.SystemPromptSource.
+ */
+export const SystemPromptSourceSchema: GenEnum<SystemPromptSource> = /*@__PURE__*/
+  enumDesc(file_admin_v1_admin, 0);
+
+/**
+ * AdminService is only available to the users listed in PD_ADMIN_EMAILS.
+ *
+ * @generated from service admin.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * @generated from rpc admin.v1.AdminService.ReloadTools
+   */
+  reloadTools: {
+    methodKind: "unary";
+    input: typeof ReloadToolsRequestSchema;
+    output: typeof ReloadToolsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ListToolCalls
+   */
+  listToolCalls: {
+    methodKind: "unary";
+    input: typeof ListToolCallsRequestSchema;
+    output: typeof ListToolCallsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.GetToolCallStats
+   */
+  getToolCallStats: {
+    methodKind: "unary";
+    input: typeof GetToolCallStatsRequestSchema;
+    output: typeof GetToolCallStatsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ListDefaultPrompts
+   */
+  listDefaultPrompts: {
+    methodKind: "unary";
+    input: typeof ListDefaultPromptsRequestSchema;
+    output: typeof ListDefaultPromptsResponseSchema;
+  },
+  /**
+   * Adds a built-in prompt, or brings back a deleted one with the same key.
+   *
+   * @generated from rpc admin.v1.AdminService.CreateDefaultPrompt
+   */
+  createDefaultPrompt: {
+    methodKind: "unary";
+    input: typeof CreateDefaultPromptRequestSchema;
+    output: typeof CreateDefaultPromptResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.UpdateDefaultPrompt
+   */
+  updateDefaultPrompt: {
+    methodKind: "unary";
+    input: typeof UpdateDefaultPromptRequestSchema;
+    output: typeof UpdateDefaultPromptResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.DeleteDefaultPrompt
+   */
+  deleteDefaultPrompt: {
+    methodKind: "unary";
+    input: typeof DeleteDefaultPromptRequestSchema;
+    output: typeof DeleteDefaultPromptResponseSchema;
+  },
+  /**
+   * Lists the system prompts of the personas, with the template in use and the built-in one.
+   *
+   * @generated from rpc admin.v1.AdminService.ListSystemPrompts
+   */
+  listSystemPrompts: {
+    methodKind: "unary";
+    input: typeof ListSystemPromptsRequestSchema;
+    output: typeof ListSystemPromptsResponseSchema;
+  },
+  /**
+   * Overrides the system prompt template of a persona, it applies to the new conversations.
+   *
+   * @generated from rpc admin.v1.AdminService.UpdateSystemPrompt
+   */
+  updateSystemPrompt: {
+    methodKind: "unary";
+    input: typeof UpdateSystemPromptRequestSchema;
+    output: typeof UpdateSystemPromptResponseSchema;
+  },
+  /**
+   * Removes the override, the persona gets back the template of the configuration or the built-in one.
+   *
+   * @generated from rpc admin.v1.AdminService.ResetSystemPrompt
+   */
+  resetSystemPrompt: {
+    methodKind: "unary";
+    input: typeof ResetSystemPromptRequestSchema;
+    output: typeof ResetSystemPromptResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_admin_v1_admin, 0);
+
@@ -5,13 +5,15 @@
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_google_api_annotations } from "@buf/googleapis_googleapis.bufbuild_es/google/api/annotations_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message as Message$1 } from "@bufbuild/protobuf";
 
 /**
  * Describes the file chat/v1/chat.proto.
  */
 export const file_chat_v1_chat: GenFile = /*@__PURE__*/
-  fileDesc("ChJjaGF0L3YxL2NoYXQucHJvdG8SB2NoYXQudjEiUAoTTWVzc2FnZVR5cGVUb29sQ2FsbBIMCgRuYW1lGAEgASgJEgwKBGFyZ3MYAiABKAkSDgoGcmVzdWx0GAMgASgJEg0KBWVycm9yGAQgASgJIkEKI01lc3NhZ2VUeXBlVG9vbENhbGxQcmVwYXJlQXJndW1lbnRzEgwKBG5hbWUYASABKAkSDAoEYXJncxgCIAEoCSIkChFNZXNzYWdlVHlwZVN5c3RlbRIPCgdjb250ZW50GAEgASgJIicKFE1lc3NhZ2VUeXBlQXNzaXN0YW50Eg8KB2NvbnRlbnQYASABKAkiUAoPTWVzc2FnZVR5cGVVc2VyEg8KB2NvbnRlbnQYASABKAkSGgoNc2VsZWN0ZWRfdGV4dBgCIAEoCUgAiAEBQhAKDl9zZWxlY3RlZF90ZXh0IikKEk1lc3NhZ2VUeXBlVW5rbm93bhITCgtkZXNjcmlwdGlvbhgBIAEoCSLkAgoOTWVzc2FnZVBheWxvYWQSLAoGc3lzdGVtGAEgASgLMhouY2hhdC52MS5NZXNzYWdlVHlwZVN5c3RlbUgAEigKBHVzZXIYAiABKAsyGC5jaGF0LnYxLk1lc3NhZ2VUeXBlVXNlckgAEjIKCWFzc2lzdGFudBgDIAEoCzIdLmNoYXQudjEuTWVzc2FnZVR5cGVBc3Npc3RhbnRIABJTCht0b29sX2NhbGxfcHJlcGFyZV9hcmd1bWVudHMYBCABKAsyLC5jaGF0LnYxLk1lc3NhZ2VUeXBlVG9vbENhbGxQcmVwYXJlQXJndW1lbnRzSAASMQoJdG9vbF9jYWxsGAUgASgLMhwuY2hhdC52MS5NZXNzYWdlVHlwZVRvb2xDYWxsSAASLgoHdW5rbm93bhgGIAEoCzIbLmNoYXQudjEuTWVzc2FnZVR5cGVVbmtub3duSABCDgoMbWVzc2FnZV90eXBlIkcKB01lc3NhZ2USEgoKbWVzc2FnZV9pZBgBIAEoCRIoCgdwYXlsb2FkGAMgASgLMhcuY2hhdC52MS5NZXNzYWdlUGF5bG9hZCJ9CgxDb252ZXJzYXRpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAyABKAkSLgoObGFuZ3VhZ2VfbW9kZWwYAiABKA4yFi5jaGF0LnYxLkxhbmd1YWdlTW9kZWwSIgoIbWVzc2FnZXMYBCADKAsyEC5jaGF0LnYxLk1lc3NhZ2UiQgoYTGlzdENvbnZlcnNhdGlvbnNSZXF1ZXN0EhcKCnByb2plY3RfaWQYASABKAlIAIgBAUINCgtfcHJvamVjdF9pZCJJChlMaXN0Q29udmVyc2F0aW9uc1Jlc3BvbnNlEiwKDWNvbnZlcnNhdGlvbnMYASADKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbiIxChZHZXRDb252ZXJzYXRpb25SZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCSJGChdHZXRDb252ZXJzYXRpb25SZXNwb25zZRIrCgxjb252ZXJzYXRpb24YASABKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbiK3AgogQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIcCg9jb252ZXJzYXRpb25faWQYAiABKAlIAIgBARIuCg5sYW5ndWFnZV9tb2RlbBgDIAEoDjIWLmNoYXQudjEuTGFuZ3VhZ2VNb2RlbBIUCgx1c2VyX21lc3NhZ2UYBCABKAkSHwoSdXNlcl9zZWxlY3RlZF90ZXh0GAUgASgJSAGIAQESOQoRY29udmVyc2F0aW9uX3R5cGUYBiABKA4yGS5jaGF0LnYxLkNvbnZlcnNhdGlvblR5cGVIAogBAUISChBfY29udmVyc2F0aW9uX2lkQhUKE191c2VyX3NlbGVjdGVkX3RleHRCFAoSX2NvbnZlcnNhdGlvbl90eXBlIlAKIUNyZWF0ZUNvbnZlcnNhdGlvbk1lc3NhZ2VSZXNwb25zZRIrCgxjb252ZXJzYXRpb24YASABKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbiJDChlVcGRhdGVDb252ZXJzYXRpb25SZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCSJJChpVcGRhdGVDb252ZXJzYXRpb25SZXNwb25zZRIrCgxjb252ZXJzYXRpb24YASABKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbiI0ChlEZWxldGVDb252ZXJzYXRpb25SZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCSIcChpEZWxldGVDb252ZXJzYXRpb25SZXNwb25zZSJfChRTdHJlYW1Jbml0aWFsaXphdGlvbhIXCg9jb252ZXJzYXRpb25faWQYASABKAkSLgoObGFuZ3VhZ2VfbW9kZWwYBSABKA4yFi5jaGF0LnYxLkxhbmd1YWdlTW9kZWwiTwoPU3RyZWFtUGFydEJlZ2luEhIKCm1lc3NhZ2VfaWQYASABKAkSKAoHcGF5bG9hZBgDIAEoCzIXLmNoYXQudjEuTWVzc2FnZVBheWxvYWQiMQoMTWVzc2FnZUNodW5rEhIKCm1lc3NhZ2VfaWQYASABKAkSDQoFZGVsdGEYAiABKAkiOgoTSW5jb21wbGV0ZUluZGljYXRvchIOCgZyZWFzb24YASABKAkSEwoLcmVzcG9uc2VfaWQYAiABKAkiTQoNU3RyZWFtUGFydEVuZBISCgptZXNzYWdlX2lkGAEgASgJEigKB3BheWxvYWQYAyABKAsyFy5jaGF0LnYxLk1lc3NhZ2VQYXlsb2FkIi0KElN0cmVhbUZpbmFsaXphdGlvbhIXCg9jb252ZXJzYXRpb25faWQYASABKAkiJAoLU3RyZWFtRXJyb3ISFQoNZXJyb3JfbWVzc2FnZRgBIAEoCSK9AgomQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVN0cmVhbVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIcCg9jb252ZXJzYXRpb25faWQYAiABKAlIAIgBARIuCg5sYW5ndWFnZV9tb2RlbBgDIAEoDjIWLmNoYXQudjEuTGFuZ3VhZ2VNb2RlbBIUCgx1c2VyX21lc3NhZ2UYBCABKAkSHwoSdXNlcl9zZWxlY3RlZF90ZXh0GAUgASgJSAGIAQESOQoRY29udmVyc2F0aW9uX3R5cGUYBiABKA4yGS5jaGF0LnYxLkNvbnZlcnNhdGlvblR5cGVIAogBAUISChBfY29udmVyc2F0aW9uX2lkQhUKE191c2VyX3NlbGVjdGVkX3RleHRCFAoSX2NvbnZlcnNhdGlvbl90eXBlIr8DCidDcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVzcG9uc2USPgoVc3RyZWFtX2luaXRpYWxpemF0aW9uGAEgASgLMh0uY2hhdC52MS5TdHJlYW1Jbml0aWFsaXphdGlvbkgAEjUKEXN0cmVhbV9wYXJ0X2JlZ2luGAIgASgLMhguY2hhdC52MS5TdHJlYW1QYXJ0QmVnaW5IABIuCg1tZXNzYWdlX2NodW5rGAMgASgLMhUuY2hhdC52MS5NZXNzYWdlQ2h1bmtIABI8ChRpbmNvbXBsZXRlX2luZGljYXRvchgEIAEoCzIcLmNoYXQudjEuSW5jb21wbGV0ZUluZGljYXRvckgAEjEKD3N0cmVhbV9wYXJ0X2VuZBgFIAEoCzIWLmNoYXQudjEuU3RyZWFtUGFydEVuZEgAEjoKE3N0cmVhbV9maW5hbGl6YXRpb24YBiABKAsyGy5jaGF0LnYxLlN0cmVhbUZpbmFsaXphdGlvbkgAEiwKDHN0cmVhbV9lcnJvchgHIAEoCzIULmNoYXQudjEuU3RyZWFtRXJyb3JIAEISChByZXNwb25zZV9wYXlsb2FkKoECCg1MYW5ndWFnZU1vZGVsEh4KGkxBTkdVQUdFX01PREVMX1VOU1BFQ0lGSUVEEAASHwobTEFOR1VBR0VfTU9ERUxfT1BFTkFJX0dQVDRPEAESJAogTEFOR1VBR0VfTU9ERUxfT1BFTkFJX0dQVDQxX01JTkkQAhIfChtMQU5HVUFHRV9NT0RFTF9PUEVOQUlfR1BUNDEQBBIeChpMQU5HVUFHRV9NT0RFTF9PUEVOQUlfR1BUNRAHEiMKH0xBTkdVQUdFX01PREVMX09QRU5BSV9HUFQ1X01JTkkQCBIjCh9MQU5HVUFHRV9NT0RFTF9PUEVOQUlfR1BUNV9OQU5PEAkqUgoQQ29udmVyc2F0aW9uVHlwZRIhCh1DT05WRVJTQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEhsKF0NPTlZFUlNBVElPTl9UWVBFX0RFQlVHEAEyzQcKC0NoYXRTZXJ2aWNlEoMBChFMaXN0Q29udmVyc2F0aW9ucxIhLmNoYXQudjEuTGlzdENvbnZlcnNhdGlvbnNSZXF1ZXN0GiIuY2hhdC52MS5MaXN0Q29udmVyc2F0aW9uc1Jlc3BvbnNlIieC0+STAiESHy9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMSjwEKD0dldENvbnZlcnNhdGlvbhIfLmNoYXQudjEuR2V0Q29udmVyc2F0aW9uUmVxdWVzdBogLmNoYXQudjEuR2V0Q29udmVyc2F0aW9uUmVzcG9uc2UiOYLT5JMCMxIxL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfRKnAQoZQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZRIpLmNoYXQudjEuQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVJlcXVlc3QaKi5jaGF0LnYxLkNyZWF0ZUNvbnZlcnNhdGlvbk1lc3NhZ2VSZXNwb25zZSIzgtPkkwItOgEqIigvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL21lc3NhZ2VzEsIBCh9DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtEi8uY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVxdWVzdBowLmNoYXQudjEuQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVN0cmVhbVJlc3BvbnNlIjqC0+STAjQ6ASoiLy9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMvbWVzc2FnZXMvc3RyZWFtMAESmwEKElVwZGF0ZUNvbnZlcnNhdGlvbhIiLmNoYXQudjEuVXBkYXRlQ29udmVyc2F0aW9uUmVxdWVzdBojLmNoYXQudjEuVXBkYXRlQ29udmVyc2F0aW9uUmVzcG9uc2UiPILT5JMCNjoBKjIxL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfRKYAQoSRGVsZXRlQ29udmVyc2F0aW9uEiIuY2hhdC52MS5EZWxldGVDb252ZXJzYXRpb25SZXF1ZXN0GiMuY2hhdC52MS5EZWxldGVDb252ZXJzYXRpb25SZXNwb25zZSI5gtPkkwIzKjEvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL3tjb252ZXJzYXRpb25faWR9Qn8KC2NvbS5jaGF0LnYxQglDaGF0UHJvdG9QAVoocGFwZXJkZWJ1Z2dlci9wa2cvZ2VuL2FwaS9jaGF0L3YxO2NoYXR2MaICA0NYWKoCB0NoYXQuVjHKAgdDaGF0XFYx4gITQ2hhdFxWMVxHUEJNZXRhZGF0YeoCCENoYXQ6OlYxYgZwcm90bzM", [file_google_api_annotations]);
+  fileDesc("ChJjaGF0L3YxL2NoYXQucHJvdG8SB2NoYXQudjEiUAoTTWVzc2FnZVR5cGVUb29sQ2FsbBIMCgRuYW1lGAEgASgJEgwKBGFyZ3MYAiABKAkSDgoGcmVzdWx0GAMgASgJEg0KBWVycm9yGAQgASgJIkEKI01lc3NhZ2VUeXBlVG9vbENhbGxQcmVwYXJlQXJndW1lbnRzEgwKBG5hbWUYASABKAkSDAoEYXJncxgCIAEoCSJXCiNNZXNzYWdlVHlwZVRvb2xDYWxsQXBwcm92YWxSZXF1aXJlZBIUCgx0b29sX2NhbGxfaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgRhcmdzGAMgASgJIiQKEU1lc3NhZ2VUeXBlU3lzdGVtEg8KB2NvbnRlbnQYASABKAkiJwoUTWVzc2FnZVR5cGVBc3Npc3RhbnQSDwoHY29udGVudBgBIAEoCSJ2Cg9NZXNzYWdlVHlwZVVzZXISDwoHY29udGVudBgBIAEoCRIaCg1zZWxlY3RlZF90ZXh0GAIgASgJSACIAQESFgoJcHJvbXB0X2lkGAMgASgJSAGIAQFCEAoOX3NlbGVjdGVkX3RleHRCDAoKX3Byb21wdF9pZCJLChNNZXNzYWdlVHlwZVJldmlzaW9uEhAKCG9yaWdpbmFsGAEgASgJEg8KB3JldmlzZWQYAiABKAkSEQoJcmF0aW9uYWxlGAMgASgJIroBCghUZXh0RWRpdBIPCgdlZGl0X2lkGAEgASgJEg4KBmRvY19pZBgCIAEoCRITCgtkb2NfdmVyc2lvbhgDIAEoBRIUCgxzdGFydF9vZmZzZXQYBCABKAUSEgoKZW5kX29mZnNldBgFIAEoBRITCgtyZXBsYWNlbWVudBgGIAEoCRIQCghvcmlnaW5hbBgHIAEoCRInCgZzdGF0dXMYCCABKA4yFy5jaGF0LnYxLlRleHRFZGl0U3RhdHVzIkoKFE1lc3NhZ2VUeXBlVGV4dEVkaXRzEhAKCGRvY19wYXRoGAEgASgJEiAKBWVkaXRzGAIgAygLMhEuY2hhdC52MS5UZXh0RWRpdCIpChJNZXNzYWdlVHlwZVVua25vd24SEwoLZGVzY3JpcHRpb24YASABKAkioAQKDk1lc3NhZ2VQYXlsb2FkEiwKBnN5c3RlbRgBIAEoCzIaLmNoYXQudjEuTWVzc2FnZVR5cGVTeXN0ZW1IABIoCgR1c2VyGAIgASgLMhguY2hhdC52MS5NZXNzYWdlVHlwZVVzZXJIABIyCglhc3Npc3RhbnQYAyABKAsyHS5jaGF0LnYxLk1lc3NhZ2VUeXBlQXNzaXN0YW50SAASUwobdG9vbF9jYWxsX3ByZXBhcmVfYXJndW1lbnRzGAQgASgLMiwuY2hhdC52MS5NZXNzYWdlVHlwZVRvb2xDYWxsUHJlcGFyZUFyZ3VtZW50c0gAEjEKCXRvb2xfY2FsbBgFIAEoCzIcLmNoYXQudjEuTWVzc2FnZVR5cGVUb29sQ2FsbEgAEi4KB3Vua25vd24YBiABKAsyGy5jaGF0LnYxLk1lc3NhZ2VUeXBlVW5rbm93bkgAElMKG3Rvb2xfY2FsbF9hcHByb3ZhbF9yZXF1aXJlZBgHIAEoCzIsLmNoYXQudjEuTWVzc2FnZVR5cGVUb29sQ2FsbEFwcHJvdmFsUmVxdWlyZWRIABIwCghyZXZpc2lvbhgIIAEoCzIcLmNoYXQudjEuTWVzc2FnZVR5cGVSZXZpc2lvbkgAEjMKCnRleHRfZWRpdHMYCSABKAsyHS5jaGF0LnYxLk1lc3NhZ2VUeXBlVGV4dEVkaXRzSABCDgoMbWVzc2FnZV90eXBlIlwKB01lc3NhZ2USEgoKbWVzc2FnZV9pZBgBIAEoCRIoCgdwYXlsb2FkGAMgASgLMhcuY2hhdC52MS5NZXNzYWdlUGF5bG9hZBITCgtzaWJsaW5nX2lkcxgEIAMoCSLDAQoMQ29udmVyc2F0aW9uEgoKAmlkGAEgASgJEg0KBXRpdGxlGAMgASgJEi4KDmxhbmd1YWdlX21vZGVsGAIgASgOMhYuY2hhdC52MS5MYW5ndWFnZU1vZGVsEiIKCG1lc3NhZ2VzGAQgAygLMhAuY2hhdC52MS5NZXNzYWdlEg4KBnNoYXJlZBgFIAEoCBI0ChFjb252ZXJzYXRpb25fdHlwZRgGIAEoDjIZLmNoYXQudjEuQ29udmVyc2F0aW9uVHlwZSJ9ChhMaXN0Q29udmVyc2F0aW9uc1JlcXVlc3QSFwoKcHJvamVjdF9pZBgBIAEoCUgAiAEBEhcKCnBhZ2VfdG9rZW4YAiABKAlIAYgBARIRCglwYWdlX3NpemUYAyABKAVCDQoLX3Byb2plY3RfaWRCDQoLX3BhZ2VfdG9rZW4iewoZTGlzdENvbnZlcnNhdGlvbnNSZXNwb25zZRIsCg1jb252ZXJzYXRpb25zGAEgAygLMhUuY2hhdC52MS5Db252ZXJzYXRpb24SHAoPbmV4dF9wYWdlX3Rva2VuGAIgASgJSACIAQFCEgoQX25leHRfcGFnZV90b2tlbiJ1Ch9MaXN0Q29udmVyc2F0aW9uTWVzc2FnZXNSZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCRIXCgpwYWdlX3Rva2VuGAIgASgJSACIAQESEQoJcGFnZV9zaXplGAMgASgFQg0KC19wYWdlX3Rva2VuIngKIExpc3RDb252ZXJzYXRpb25NZXNzYWdlc1Jlc3BvbnNlEiIKCG1lc3NhZ2VzGAEgAygLMhAuY2hhdC52MS5NZXNzYWdlEhwKD25leHRfcGFnZV90b2tlbhgCIAEoCUgAiAEBQhIKEF9uZXh0X3BhZ2VfdG9rZW4iwAIKGlNlYXJjaENvbnZlcnNhdGlvbnNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhcKCnByb2plY3RfaWQYAiABKAlIAIgBARIzCg5sYW5ndWFnZV9tb2RlbBgDIAEoDjIWLmNoYXQudjEuTGFuZ3VhZ2VNb2RlbEgBiAEBEjYKDXVwZGF0ZWRfYWZ0ZXIYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAKIAQESNwoOdXBkYXRlZF9iZWZvcmUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAOIAQESDQoFbGltaXQYBiABKAVCDQoLX3Byb2plY3RfaWRCEQoPX2xhbmd1YWdlX21vZGVsQhAKDl91cGRhdGVkX2FmdGVyQhEKD191cGRhdGVkX2JlZm9yZSInCglUZXh0UmFuZ2USDQoFc3RhcnQYASABKAUSCwoDZW5kGAIgASgFIlkKDVNlYXJjaFNuaXBwZXQSEgoKbWVzc2FnZV9pZBgBIAEoCRIMCgR0ZXh0GAIgASgJEiYKCmhpZ2hsaWdodHMYAyADKAsyEi5jaGF0LnYxLlRleHRSYW5nZSLEAQoYQ29udmVyc2F0aW9uU2VhcmNoUmVzdWx0EisKDGNvbnZlcnNhdGlvbhgBIAEoCzIVLmNoYXQudjEuQ29udmVyc2F0aW9uEhIKCnByb2plY3RfaWQYAiABKAkSLgoKdXBkYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFc2NvcmUYBCABKAESKAoIc25pcHBldHMYBSADKAsyFi5jaGF0LnYxLlNlYXJjaFNuaXBwZXQiUQobU2VhcmNoQ29udmVyc2F0aW9uc1Jlc3BvbnNlEjIKB3Jlc3VsdHMYASADKAsyIS5jaGF0LnYxLkNvbnZlcnNhdGlvblNlYXJjaFJlc3VsdCIxChZHZXRDb252ZXJzYXRpb25SZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCSJGChdHZXRDb252ZXJzYXRpb25SZXNwb25zZRIrCgxjb252ZXJzYXRpb24YASABKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbiLgAwogQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIcCg9jb252ZXJzYXRpb25faWQYAiABKAlIAIgBARIuCg5sYW5ndWFnZV9tb2RlbBgDIAEoDjIWLmNoYXQudjEuTGFuZ3VhZ2VNb2RlbBIUCgx1c2VyX21lc3NhZ2UYBCABKAkSHwoSdXNlcl9zZWxlY3RlZF90ZXh0GAUgASgJSAGIAQESOQoRY29udmVyc2F0aW9uX3R5cGUYBiABKA4yGS5jaGF0LnYxLkNvbnZlcnNhdGlvblR5cGVIAogBARIxCg1yZXNwb25zZV9tb2RlGAcgASgOMhUuY2hhdC52MS5SZXNwb25zZU1vZGVIA4gBARIWCglwcm9tcHRfaWQYCCABKAlIBIgBARIiChV1c2VyX3NlbGVjdGlvbl9vZmZzZXQYCSABKAVIBYgBAUISChBfY29udmVyc2F0aW9uX2lkQhUKE191c2VyX3NlbGVjdGVkX3RleHRCFAoSX2NvbnZlcnNhdGlvbl90eXBlQhAKDl9yZXNwb25zZV9tb2RlQgwKCl9wcm9tcHRfaWRCGAoWX3VzZXJfc2VsZWN0aW9uX29mZnNldCJQCiFDcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlUmVzcG9uc2USKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24iQwoZVXBkYXRlQ29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSDQoFdGl0bGUYAiABKAkiSQoaVXBkYXRlQ29udmVyc2F0aW9uUmVzcG9uc2USKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24iNAoZRGVsZXRlQ29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkiHAoaRGVsZXRlQ29udmVyc2F0aW9uUmVzcG9uc2UitAEKE0RlbGV0ZWRDb252ZXJzYXRpb24SKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24SEgoKcHJvamVjdF9pZBgCIAEoCRIuCgpkZWxldGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghwdXJnZV9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiSQofTGlzdERlbGV0ZWRDb252ZXJzYXRpb25zUmVxdWVzdBIXCgpwcm9qZWN0X2lkGAEgASgJSACIAQFCDQoLX3Byb2plY3RfaWQiVwogTGlzdERlbGV0ZWRDb252ZXJzYXRpb25zUmVzcG9uc2USMwoNY29udmVyc2F0aW9ucxgBIAMoCzIcLmNoYXQudjEuRGVsZXRlZENvbnZlcnNhdGlvbiI1ChpSZXN0b3JlQ29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkiSgobUmVzdG9yZUNvbnZlcnNhdGlvblJlc3BvbnNlEisKDGNvbnZlcnNhdGlvbhgBIAEoCzIVLmNoYXQudjEuQ29udmVyc2F0aW9uIkMKGFNoYXJlQ29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSDgoGc2hhcmVkGAIgASgIIkgKGVNoYXJlQ29udmVyc2F0aW9uUmVzcG9uc2USKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24irAEKElNoYXJlZENvbnZlcnNhdGlvbhIrCgxjb252ZXJzYXRpb24YASABKAsyFS5jaGF0LnYxLkNvbnZlcnNhdGlvbhIQCghvd25lcl9pZBgCIAEoCRISCgpvd25lcl9uYW1lGAMgASgJEhMKC293bmVyX2VtYWlsGAQgASgJEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkcKHkxpc3RTaGFyZWRDb252ZXJzYXRpb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoBSJVCh9MaXN0U2hhcmVkQ29udmVyc2F0aW9uc1Jlc3BvbnNlEjIKDWNvbnZlcnNhdGlvbnMYASADKAsyGy5jaGF0LnYxLlNoYXJlZENvbnZlcnNhdGlvbiJHChZBcHByb3ZlVG9vbENhbGxSZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCRIUCgx0b29sX2NhbGxfaWQYAiABKAkiZAoTRGVueVRvb2xDYWxsUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSFAoMdG9vbF9jYWxsX2lkGAIgASgJEhMKBnJlYXNvbhgDIAEoCUgAiAEBQgkKB19yZWFzb24iLwoUV2F0Y2hUb29sSm9ic1JlcXVlc3QSFwoPY29udmVyc2F0aW9uX2lkGAEgASgJIk4KEEFwcGx5RWRpdFJlcXVlc3QSFwoPY29udmVyc2F0aW9uX2lkGAEgASgJEg8KB2VkaXRfaWQYAiABKAkSEAoIYWNjZXB0ZWQYAyABKAgiNAoRQXBwbHlFZGl0UmVzcG9uc2USHwoEZWRpdBgBIAEoCzIRLmNoYXQudjEuVGV4dEVkaXQi9wIKEkVkaXRNZXNzYWdlUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSEgoKbWVzc2FnZV9pZBgCIAEoCRISCgpwcm9qZWN0X2lkGAMgASgJEhQKDHVzZXJfbWVzc2FnZRgEIAEoCRIfChJ1c2VyX3NlbGVjdGVkX3RleHQYBSABKAlIAIgBARI5ChFjb252ZXJzYXRpb25fdHlwZRgGIAEoDjIZLmNoYXQudjEuQ29udmVyc2F0aW9uVHlwZUgBiAEBEjEKDXJlc3BvbnNlX21vZGUYByABKA4yFS5jaGF0LnYxLlJlc3BvbnNlTW9kZUgCiAEBEiIKFXVzZXJfc2VsZWN0aW9uX29mZnNldBgIIAEoBUgDiAEBQhUKE191c2VyX3NlbGVjdGVkX3RleHRCFAoSX2NvbnZlcnNhdGlvbl90eXBlQhAKDl9yZXNwb25zZV9tb2RlQhgKFl91c2VyX3NlbGVjdGlvbl9vZmZzZXQijAEKGFJlZ2VuZXJhdGVNZXNzYWdlUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSEgoKbWVzc2FnZV9pZBgCIAEoCRIxCg1yZXNwb25zZV9tb2RlGAMgASgOMhUuY2hhdC52MS5SZXNwb25zZU1vZGVIAIgBAUIQCg5fcmVzcG9uc2VfbW9kZSJCChNTd2l0Y2hCcmFuY2hSZXF1ZXN0EhcKD2NvbnZlcnNhdGlvbl9pZBgBIAEoCRISCgptZXNzYWdlX2lkGAIgASgJIkMKFFN3aXRjaEJyYW5jaFJlc3BvbnNlEisKDGNvbnZlcnNhdGlvbhgBIAEoCzIVLmNoYXQudjEuQ29udmVyc2F0aW9uIoIBChdGb3JrQ29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSGAoQdXBfdG9fbWVzc2FnZV9pZBgCIAEoCRIeChF0YXJnZXRfcHJvamVjdF9pZBgDIAEoCUgAiAEBQhQKEl90YXJnZXRfcHJvamVjdF9pZCJHChhGb3JrQ29udmVyc2F0aW9uUmVzcG9uc2USKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24iZwoZRXhwb3J0Q29udmVyc2F0aW9uUmVxdWVzdBIXCg9jb252ZXJzYXRpb25faWQYASABKAkSMQoGZm9ybWF0GAIgASgOMiEuY2hhdC52MS5Db252ZXJzYXRpb25FeHBvcnRGb3JtYXQiUgoaRXhwb3J0Q29udmVyc2F0aW9uUmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSEQoJbWltZV90eXBlGAIgASgJEg8KB2NvbnRlbnQYAyABKAkiQAoZSW1wb3J0Q29udmVyc2F0aW9uUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEg8KB2NvbnRlbnQYAiABKAkiSQoaSW1wb3J0Q29udmVyc2F0aW9uUmVzcG9uc2USKwoMY29udmVyc2F0aW9uGAEgASgLMhUuY2hhdC52MS5Db252ZXJzYXRpb24iXwoUU3RyZWFtSW5pdGlhbGl6YXRpb24SFwoPY29udmVyc2F0aW9uX2lkGAEgASgJEi4KDmxhbmd1YWdlX21vZGVsGAUgASgOMhYuY2hhdC52MS5MYW5ndWFnZU1vZGVsIk8KD1N0cmVhbVBhcnRCZWdpbhISCgptZXNzYWdlX2lkGAEgASgJEigKB3BheWxvYWQYAyABKAsyFy5jaGF0LnYxLk1lc3NhZ2VQYXlsb2FkIjEKDE1lc3NhZ2VDaHVuaxISCgptZXNzYWdlX2lkGAEgASgJEg0KBWRlbHRhGAIgASgJIjoKE0luY29tcGxldGVJbmRpY2F0b3ISDgoGcmVhc29uGAEgASgJEhMKC3Jlc3BvbnNlX2lkGAIgASgJIk0KDVN0cmVhbVBhcnRFbmQSEgoKbWVzc2FnZV9pZBgBIAEoCRIoCgdwYXlsb2FkGAMgASgLMhcuY2hhdC52MS5NZXNzYWdlUGF5bG9hZCItChJTdHJlYW1GaW5hbGl6YXRpb24SFwoPY29udmVyc2F0aW9uX2lkGAEgASgJIiQKC1N0cmVhbUVycm9yEhUKDWVycm9yX21lc3NhZ2UYASABKAkioQEKEFRvb2xDYWxsUHJvZ3Jlc3MSEgoKbWVzc2FnZV9pZBgBIAEoCRIOCgZqb2JfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRImCgZzdGF0dXMYBCABKA4yFi5jaGF0LnYxLlRvb2xKb2JTdGF0dXMSFQoIcHJvZ3Jlc3MYBSABKAFIAIgBARIPCgdtZXNzYWdlGAYgASgJQgsKCV9wcm9ncmVzcyLmAwomQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVN0cmVhbVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIcCg9jb252ZXJzYXRpb25faWQYAiABKAlIAIgBARIuCg5sYW5ndWFnZV9tb2RlbBgDIAEoDjIWLmNoYXQudjEuTGFuZ3VhZ2VNb2RlbBIUCgx1c2VyX21lc3NhZ2UYBCABKAkSHwoSdXNlcl9zZWxlY3RlZF90ZXh0GAUgASgJSAGIAQESOQoRY29udmVyc2F0aW9uX3R5cGUYBiABKA4yGS5jaGF0LnYxLkNvbnZlcnNhdGlvblR5cGVIAogBARIxCg1yZXNwb25zZV9tb2RlGAcgASgOMhUuY2hhdC52MS5SZXNwb25zZU1vZGVIA4gBARIWCglwcm9tcHRfaWQYCCABKAlIBIgBARIiChV1c2VyX3NlbGVjdGlvbl9vZmZzZXQYCSABKAVIBYgBAUISChBfY29udmVyc2F0aW9uX2lkQhUKE191c2VyX3NlbGVjdGVkX3RleHRCFAoSX2NvbnZlcnNhdGlvbl90eXBlQhAKDl9yZXNwb25zZV9tb2RlQgwKCl9wcm9tcHRfaWRCGAoWX3VzZXJfc2VsZWN0aW9uX29mZnNldCL4AwonQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVN0cmVhbVJlc3BvbnNlEj4KFXN0cmVhbV9pbml0aWFsaXphdGlvbhgBIAEoCzIdLmNoYXQudjEuU3RyZWFtSW5pdGlhbGl6YXRpb25IABI1ChFzdHJlYW1fcGFydF9iZWdpbhgCIAEoCzIYLmNoYXQudjEuU3RyZWFtUGFydEJlZ2luSAASLgoNbWVzc2FnZV9jaHVuaxgDIAEoCzIVLmNoYXQudjEuTWVzc2FnZUNodW5rSAASPAoUaW5jb21wbGV0ZV9pbmRpY2F0b3IYBCABKAsyHC5jaGF0LnYxLkluY29tcGxldGVJbmRpY2F0b3JIABIxCg9zdHJlYW1fcGFydF9lbmQYBSABKAsyFi5jaGF0LnYxLlN0cmVhbVBhcnRFbmRIABI6ChNzdHJlYW1fZmluYWxpemF0aW9uGAYgASgLMhsuY2hhdC52MS5TdHJlYW1GaW5hbGl6YXRpb25IABIsCgxzdHJlYW1fZXJyb3IYByABKAsyFC5jaGF0LnYxLlN0cmVhbUVycm9ySAASNwoSdG9vbF9jYWxsX3Byb2dyZXNzGAggASgLMhkuY2hhdC52MS5Ub29sQ2FsbFByb2dyZXNzSABCEgoQcmVzcG9uc2VfcGF5bG9hZCqBAgoNTGFuZ3VhZ2VNb2RlbBIeChpMQU5HVUFHRV9NT0RFTF9VTlNQRUNJRklFRBAAEh8KG0xBTkdVQUdFX01PREVMX09QRU5BSV9HUFQ0TxABEiQKIExBTkdVQUdFX01PREVMX09QRU5BSV9HUFQ0MV9NSU5JEAISHwobTEFOR1VBR0VfTU9ERUxfT1BFTkFJX0dQVDQxEAQSHgoaTEFOR1VBR0VfTU9ERUxfT1BFTkFJX0dQVDUQBxIjCh9MQU5HVUFHRV9NT0RFTF9PUEVOQUlfR1BUNV9NSU5JEAgSIwofTEFOR1VBR0VfTU9ERUxfT1BFTkFJX0dQVDVfTkFOTxAJKnAKDlRleHRFZGl0U3RhdHVzEiAKHFRFWFRfRURJVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlURVhUX0VESVRfU1RBVFVTX0FDQ0VQVEVEEAESHQoZVEVYVF9FRElUX1NUQVRVU19SRUpFQ1RFRBACKrsBChhDb252ZXJzYXRpb25FeHBvcnRGb3JtYXQSKgomQ09OVkVSU0FUSU9OX0VYUE9SVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIjCh9DT05WRVJTQVRJT05fRVhQT1JUX0ZPUk1BVF9KU09OEAESJwojQ09OVkVSU0FUSU9OX0VYUE9SVF9GT1JNQVRfTUFSS0RPV04QAhIlCiFDT05WRVJTQVRJT05fRVhQT1JUX0ZPUk1BVF9PUEVOQUkQAyqkAQoNVG9vbEpvYlN0YXR1cxIfChtUT09MX0pPQl9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZUT09MX0pPQl9TVEFUVVNfUVVFVUVEEAESGwoXVE9PTF9KT0JfU1RBVFVTX1JVTk5JTkcQAhIdChlUT09MX0pPQl9TVEFUVVNfU1VDQ0VFREVEEAMSGgoWVE9PTF9KT0JfU1RBVFVTX0ZBSUxFRBAEKt4BChBDb252ZXJzYXRpb25UeXBlEiEKHUNPTlZFUlNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASGwoXQ09OVkVSU0FUSU9OX1RZUEVfREVCVUcQARIeChpDT05WRVJTQVRJT05fVFlQRV9SRVZJRVdFUhAEEiAKHENPTlZFUlNBVElPTl9UWVBFX1RSQU5TTEFUT1IQBRIhCh1DT05WRVJTQVRJT05fVFlQRV9QUk9PRlJFQURFUhAGEiUKIUNPTlZFUlNBVElPTl9UWVBFX1JFQlVUVEFMX1dSSVRFUhAHKkkKDFJlc3BvbnNlTW9kZRIdChlSRVNQT05TRV9NT0RFX1VOU1BFQ0lGSUVEEAASGgoWUkVTUE9OU0VfTU9ERV9SRVZJU0lPThABMuwcCgtDaGF0U2VydmljZRKDAQoRTGlzdENvbnZlcnNhdGlvbnMSIS5jaGF0LnYxLkxpc3RDb252ZXJzYXRpb25zUmVxdWVzdBoiLmNoYXQudjEuTGlzdENvbnZlcnNhdGlvbnNSZXNwb25zZSIngtPkkwIhEh8vX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zEpMBChNTZWFyY2hDb252ZXJzYXRpb25zEiMuY2hhdC52MS5TZWFyY2hDb252ZXJzYXRpb25zUmVxdWVzdBokLmNoYXQudjEuU2VhcmNoQ29udmVyc2F0aW9uc1Jlc3BvbnNlIjGC0+STAis6ASoiJi9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMvc2VhcmNoErMBChhMaXN0Q29udmVyc2F0aW9uTWVzc2FnZXMSKC5jaGF0LnYxLkxpc3RDb252ZXJzYXRpb25NZXNzYWdlc1JlcXVlc3QaKS5jaGF0LnYxLkxpc3RDb252ZXJzYXRpb25NZXNzYWdlc1Jlc3BvbnNlIkKC0+STAjwSOi9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMve2NvbnZlcnNhdGlvbl9pZH0vbWVzc2FnZXMSjwEKD0dldENvbnZlcnNhdGlvbhIfLmNoYXQudjEuR2V0Q29udmVyc2F0aW9uUmVxdWVzdBogLmNoYXQudjEuR2V0Q29udmVyc2F0aW9uUmVzcG9uc2UiOYLT5JMCMxIxL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfRKnAQoZQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZRIpLmNoYXQudjEuQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVJlcXVlc3QaKi5jaGF0LnYxLkNyZWF0ZUNvbnZlcnNhdGlvbk1lc3NhZ2VSZXNwb25zZSIzgtPkkwItOgEqIigvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL21lc3NhZ2VzEsIBCh9DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtEi8uY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVxdWVzdBowLmNoYXQudjEuQ3JlYXRlQ29udmVyc2F0aW9uTWVzc2FnZVN0cmVhbVJlc3BvbnNlIjqC0+STAjQ6ASoiLy9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMvbWVzc2FnZXMvc3RyZWFtMAESmwEKElVwZGF0ZUNvbnZlcnNhdGlvbhIiLmNoYXQudjEuVXBkYXRlQ29udmVyc2F0aW9uUmVxdWVzdBojLmNoYXQudjEuVXBkYXRlQ29udmVyc2F0aW9uUmVzcG9uc2UiPILT5JMCNjoBKjIxL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfRKYAQoSRGVsZXRlQ29udmVyc2F0aW9uEiIuY2hhdC52MS5EZWxldGVDb252ZXJzYXRpb25SZXF1ZXN0GiMuY2hhdC52MS5EZWxldGVDb252ZXJzYXRpb25SZXNwb25zZSI5gtPkkwIzKjEvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL3tjb252ZXJzYXRpb25faWR9EqABChhMaXN0RGVsZXRlZENvbnZlcnNhdGlvbnMSKC5jaGF0LnYxLkxpc3REZWxldGVkQ29udmVyc2F0aW9uc1JlcXVlc3QaKS5jaGF0LnYxLkxpc3REZWxldGVkQ29udmVyc2F0aW9uc1Jlc3BvbnNlIi+C0+STAikSJy9fcGQvYXBpL3YxL2NoYXRzL2RlbGV0ZWQtY29udmVyc2F0aW9ucxKmAQoTUmVzdG9yZUNvbnZlcnNhdGlvbhIjLmNoYXQudjEuUmVzdG9yZUNvbnZlcnNhdGlvblJlcXVlc3QaJC5jaGF0LnYxLlJlc3RvcmVDb252ZXJzYXRpb25SZXNwb25zZSJEgtPkkwI+OgEqIjkvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL3tjb252ZXJzYXRpb25faWR9L3Jlc3RvcmUSngEKEVNoYXJlQ29udmVyc2F0aW9uEiEuY2hhdC52MS5TaGFyZUNvbnZlcnNhdGlvblJlcXVlc3QaIi5jaGF0LnYxLlNoYXJlQ29udmVyc2F0aW9uUmVzcG9uc2UiQoLT5JMCPDoBKiI3L19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS9zaGFyZRKcAQoXTGlzdFNoYXJlZENvbnZlcnNhdGlvbnMSJy5jaGF0LnYxLkxpc3RTaGFyZWRDb252ZXJzYXRpb25zUmVxdWVzdBooLmNoYXQudjEuTGlzdFNoYXJlZENvbnZlcnNhdGlvbnNSZXNwb25zZSIugtPkkwIoEiYvX3BkL2FwaS92MS9jaGF0cy9zaGFyZWQtY29udmVyc2F0aW9ucxLGAQoPQXBwcm92ZVRvb2xDYWxsEh8uY2hhdC52MS5BcHByb3ZlVG9vbENhbGxSZXF1ZXN0GjAuY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVzcG9uc2UiXoLT5JMCWDoBKiJTL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS90b29sLWNhbGxzL3t0b29sX2NhbGxfaWR9L2FwcHJvdmUwARK9AQoMRGVueVRvb2xDYWxsEhwuY2hhdC52MS5EZW55VG9vbENhbGxSZXF1ZXN0GjAuY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVzcG9uc2UiW4LT5JMCVToBKiJQL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS90b29sLWNhbGxzL3t0b29sX2NhbGxfaWR9L2RlbnkwARKnAQoNV2F0Y2hUb29sSm9icxIdLmNoYXQudjEuV2F0Y2hUb29sSm9ic1JlcXVlc3QaMC5jaGF0LnYxLkNyZWF0ZUNvbnZlcnNhdGlvbk1lc3NhZ2VTdHJlYW1SZXNwb25zZSJDgtPkkwI9EjsvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL3tjb252ZXJzYXRpb25faWR9L3Rvb2wtam9iczABEpABCglBcHBseUVkaXQSGS5jaGF0LnYxLkFwcGx5RWRpdFJlcXVlc3QaGi5jaGF0LnYxLkFwcGx5RWRpdFJlc3BvbnNlIkyC0+STAkY6ASoiQS9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMve2NvbnZlcnNhdGlvbl9pZH0vZWRpdHMve2VkaXRfaWR9ErcBCgtFZGl0TWVzc2FnZRIbLmNoYXQudjEuRWRpdE1lc3NhZ2VSZXF1ZXN0GjAuY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVzcG9uc2UiV4LT5JMCUToBKiJML19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS9tZXNzYWdlcy97bWVzc2FnZV9pZH0vZWRpdDABEskBChFSZWdlbmVyYXRlTWVzc2FnZRIhLmNoYXQudjEuUmVnZW5lcmF0ZU1lc3NhZ2VSZXF1ZXN0GjAuY2hhdC52MS5DcmVhdGVDb252ZXJzYXRpb25NZXNzYWdlU3RyZWFtUmVzcG9uc2UiXYLT5JMCVzoBKiJSL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS9tZXNzYWdlcy97bWVzc2FnZV9pZH0vcmVnZW5lcmF0ZTABEqYBCgxTd2l0Y2hCcmFuY2gSHC5jaGF0LnYxLlN3aXRjaEJyYW5jaFJlcXVlc3QaHS5jaGF0LnYxLlN3aXRjaEJyYW5jaFJlc3BvbnNlIlmC0+STAlM6ASoiTi9fcGQvYXBpL3YxL2NoYXRzL2NvbnZlcnNhdGlvbnMve2NvbnZlcnNhdGlvbl9pZH0vbWVzc2FnZXMve21lc3NhZ2VfaWR9L3N3aXRjaBKaAQoQRm9ya0NvbnZlcnNhdGlvbhIgLmNoYXQudjEuRm9ya0NvbnZlcnNhdGlvblJlcXVlc3QaIS5jaGF0LnYxLkZvcmtDb252ZXJzYXRpb25SZXNwb25zZSJBgtPkkwI7OgEqIjYvX3BkL2FwaS92MS9jaGF0cy9jb252ZXJzYXRpb25zL3tjb252ZXJzYXRpb25faWR9L2ZvcmsSnwEKEkV4cG9ydENvbnZlcnNhdGlvbhIiLmNoYXQudjEuRXhwb3J0Q29udmVyc2F0aW9uUmVxdWVzdBojLmNoYXQudjEuRXhwb3J0Q29udmVyc2F0aW9uUmVzcG9uc2UiQILT5JMCOhI4L19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy97Y29udmVyc2F0aW9uX2lkfS9leHBvcnQSkAEKEkltcG9ydENvbnZlcnNhdGlvbhIiLmNoYXQudjEuSW1wb3J0Q29udmVyc2F0aW9uUmVxdWVzdBojLmNoYXQudjEuSW1wb3J0Q29udmVyc2F0aW9uUmVzcG9uc2UiMYLT5JMCKzoBKiImL19wZC9hcGkvdjEvY2hhdHMvY29udmVyc2F0aW9ucy9pbXBvcnRCfwoLY29tLmNoYXQudjFCCUNoYXRQcm90b1ABWihwYXBlcmRlYnVnZ2VyL3BrZy9nZW4vYXBpL2NoYXQvdjE7Y2hhdHYxogIDQ1hYqgIHQ2hhdC5WMcoCB0NoYXRcVjHiAhNDaGF0XFYxXEdQQk1ldGFkYXRh6gIIQ2hhdDo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message chat.v1.MessageTypeToolCall
@@ -75,6 +77,38 @@ export type MessageTypeToolCallPrepareArguments = Message$1<"chat.v1.MessageType
 export const MessageTypeToolCallPrepareArgumentsSchema: GenMessage<MessageTypeToolCallPrepareArguments> = /*@__PURE__*/
   messageDesc(file_chat_v1_chat, 1);
 
+/**
+ * The tool sends data outside of PaperDebugger, it only runs after the user
+ * approved it with ApproveToolCall.
+ *
+ * @generated from message chat.v1.MessageTypeToolCallApprovalRequired
+ */
+export type MessageTypeToolCallApprovalRequired = Message$1<"chat.v1.MessageTypeToolCallApprovalRequired"> & {
+  /**
+   * @generated from field: string tool_call_id = 1;
+   */
+  toolCallId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Json string
+   *
+   * @generated from field: string args = 3;
+   */
+  args: string;
+};
+
+/**
+ * Describes the message chat.v1.MessageTypeToolCallApprovalRequired.
+ * Use `create(MessageTypeToolCallApprovalRequiredSchema)` to create a new message.
+ */
+export const MessageTypeToolCallApprovalRequiredSchema: GenMessage<MessageTypeToolCallApprovalRequired> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 2);
+
 /**
  * @generated from message chat.v1.MessageTypeSystem
  */
@@ -90,7 +124,7 @@ export type MessageTypeSystem = Message$1<"chat.v1.MessageTypeSystem"> & {
  * Use `create(MessageTypeSystemSchema)` to create a new message.
  */
 export const MessageTypeSystemSchema: GenMessage<MessageTypeSystem> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 2);
+  messageDesc(file_chat_v1_chat, 3);
 
 /**
  * @generated from message chat.v1.MessageTypeAssistant
@@ -107,7 +141,7 @@ export type MessageTypeAssistant = Message$1<"chat.v1.MessageTypeAssistant"> & {
  * Use `create(MessageTypeAssistantSchema)` to create a new message.
  */
 export const MessageTypeAssistantSchema: GenMessage<MessageTypeAssistant> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 3);
+  messageDesc(file_chat_v1_chat, 4);
 
 /**
  * @generated from message chat.v1.MessageTypeUser
@@ -122,6 +156,13 @@ export type MessageTypeUser = Message$1<"chat.v1.MessageTypeUser"> & {
    * @generated from field: optional string selected_text = 2;
    */
   selectedText?: string;
+
+  /**
+   * the prompt of the library the message was written from
+   *
+   * @generated from field: optional string prompt_id = 3;
+   */
+  promptId?: string;
 };
 
 /**
@@ -129,7 +170,122 @@ export type MessageTypeUser = Message$1<"chat.v1.MessageTypeUser"> & {
  * Use `create(MessageTypeUserSchema)` to create a new message.
  */
 export const MessageTypeUserSchema: GenMessage<MessageTypeUser> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 4);
+  messageDesc(file_chat_v1_chat, 5);
+
+/**
+ * A revision of the text selected by the user, replied in RESPONSE_MODE_REVISION.
+ *
+ * @generated from message chat.v1.MessageTypeRevision
+ */
+export type MessageTypeRevision = Message$1<"chat.v1.MessageTypeRevision"> & {
+  /**
+   * @generated from field: string original = 1;
+   */
+  original: string;
+
+  /**
+   * @generated from field: string revised = 2;
+   */
+  revised: string;
+
+  /**
+   * Why the text was revised this way
+   *
+   * @generated from field: string rationale = 3;
+   */
+  rationale: string;
+};
+
+/**
+ * Describes the message chat.v1.MessageTypeRevision.
+ * Use `create(MessageTypeRevisionSchema)` to create a new message.
+ */
+export const MessageTypeRevisionSchema: GenMessage<MessageTypeRevision> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 6);
+
+/**
+ * A change of a project document. The offsets count the UTF-16 code units of the document
+ * content (its lines joined with "\n") at doc_version, like the JavaScript string indices.
+ *
+ * @generated from message chat.v1.TextEdit
+ */
+export type TextEdit = Message$1<"chat.v1.TextEdit"> & {
+  /**
+   * @generated from field: string edit_id = 1;
+   */
+  editId: string;
+
+  /**
+   * @generated from field: string doc_id = 2;
+   */
+  docId: string;
+
+  /**
+   * @generated from field: int32 doc_version = 3;
+   */
+  docVersion: number;
+
+  /**
+   * @generated from field: int32 start_offset = 4;
+   */
+  startOffset: number;
+
+  /**
+   * exclusive
+   *
+   * @generated from field: int32 end_offset = 5;
+   */
+  endOffset: number;
+
+  /**
+   * @generated from field: string replacement = 6;
+   */
+  replacement: string;
+
+  /**
+   * the text between start_offset and end_offset
+   *
+   * @generated from field: string original = 7;
+   */
+  original: string;
+
+  /**
+   * @generated from field: chat.v1.TextEditStatus status = 8;
+   */
+  status: TextEditStatus;
+};
+
+/**
+ * Describes the message chat.v1.TextEdit.
+ * Use `create(TextEditSchema)` to create a new message.
+ */
+export const TextEditSchema: GenMessage<TextEdit> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 7);
+
+/**
+ * The edits of a project document turning the selected text into its revision,
+ * sent after the MessageTypeRevision when the selected text was found in the project.
+ *
+ * @generated from message chat.v1.MessageTypeTextEdits
+ */
+export type MessageTypeTextEdits = Message$1<"chat.v1.MessageTypeTextEdits"> & {
+  /**
+   * @generated from field: string doc_path = 1;
+   */
+  docPath: string;
+
+  /**
+   * @generated from field: repeated chat.v1.TextEdit edits = 2;
+   */
+  edits: TextEdit[];
+};
+
+/**
+ * Describes the message chat.v1.MessageTypeTextEdits.
+ * Use `create(MessageTypeTextEditsSchema)` to create a new message.
+ */
+export const MessageTypeTextEditsSchema: GenMessage<MessageTypeTextEdits> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 8);
 
 /**
  * @generated from message chat.v1.MessageTypeUnknown
@@ -146,7 +302,7 @@ export type MessageTypeUnknown = Message$1<"chat.v1.MessageTypeUnknown"> & {
  * Use `create(MessageTypeUnknownSchema)` to create a new message.
  */
 export const MessageTypeUnknownSchema: GenMessage<MessageTypeUnknown> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 5);
+  messageDesc(file_chat_v1_chat, 9);
 
 /**
  * @generated from message chat.v1.MessagePayload
@@ -191,6 +347,24 @@ export type MessagePayload = Message$1<"chat.v1.MessagePayload"> & {
      */
     value: MessageTypeUnknown;
     case: "unknown";
+  } | {
+    /**
+     * @generated from field: chat.v1.MessageTypeToolCallApprovalRequired tool_call_approval_required = 7;
+     */
+    value: MessageTypeToolCallApprovalRequired;
+    case: "toolCallApprovalRequired";
+  } | {
+    /**
+     * @generated from field: chat.v1.MessageTypeRevision revision = 8;
+     */
+    value: MessageTypeRevision;
+    case: "revision";
+  } | {
+    /**
+     * @generated from field: chat.v1.MessageTypeTextEdits text_edits = 9;
+     */
+    value: MessageTypeTextEdits;
+    case: "textEdits";
   } | { case: undefined; value?: undefined };
 };
 
@@ -199,7 +373,7 @@ export type MessagePayload = Message$1<"chat.v1.MessagePayload"> & {
  * Use `create(MessagePayloadSchema)` to create a new message.
  */
 export const MessagePayloadSchema: GenMessage<MessagePayload> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 6);
+  messageDesc(file_chat_v1_chat, 10);
 
 /**
  * @generated from message chat.v1.Message
@@ -214,6 +388,14 @@ export type Message = Message$1<"chat.v1.Message"> & {
    * @generated from field: chat.v1.MessagePayload payload = 3;
    */
   payload?: MessagePayload;
+
+  /**
+   * Only set on user messages: the versions of the message, i.e. the user messages that follow the
+   * same message in the conversation tree, oldest first. It includes this message.
+   *
+   * @generated from field: repeated string sibling_ids = 4;
+   */
+  siblingIds: string[];
 };
 
 /**
@@ -221,7 +403,7 @@ export type Message = Message$1<"chat.v1.Message"> & {
  * Use `create(MessageSchema)` to create a new message.
  */
 export const MessageSchema: GenMessage<Message> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 7);
+  messageDesc(file_chat_v1_chat, 11);
 
 /**
  * @generated from message chat.v1.Conversation
@@ -248,6 +430,20 @@ export type Conversation = Message$1<"chat.v1.Conversation"> & {
    * @generated from field: repeated chat.v1.Message messages = 4;
    */
   messages: Message[];
+
+  /**
+   * the members of the project can read it
+   *
+   * @generated from field: bool shared = 5;
+   */
+  shared: boolean;
+
+  /**
+   * the persona of the system prompt, chosen when the conversation was created
+   *
+   * @generated from field: chat.v1.ConversationType conversation_type = 6;
+   */
+  conversationType: ConversationType;
 };
 
 /**
@@ -255,7 +451,7 @@ export type Conversation = Message$1<"chat.v1.Conversation"> & {
  * Use `create(ConversationSchema)` to create a new message.
  */
 export const ConversationSchema: GenMessage<Conversation> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 8);
+  messageDesc(file_chat_v1_chat, 12);
 
 /**
  * @generated from message chat.v1.ListConversationsRequest
@@ -265,6 +461,20 @@ export type ListConversationsRequest = Message$1<"chat.v1.ListConversationsReque
    * @generated from field: optional string project_id = 1;
    */
   projectId?: string;
+
+  /**
+   * next_page_token of the previous page
+   *
+   * @generated from field: optional string page_token = 2;
+   */
+  pageToken?: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
 };
 
 /**
@@ -272,7 +482,7 @@ export type ListConversationsRequest = Message$1<"chat.v1.ListConversationsReque
  * Use `create(ListConversationsRequestSchema)` to create a new message.
  */
 export const ListConversationsRequestSchema: GenMessage<ListConversationsRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 9);
+  messageDesc(file_chat_v1_chat, 13);
 
 /**
  * @generated from message chat.v1.ListConversationsResponse
@@ -284,6 +494,13 @@ export type ListConversationsResponse = Message$1<"chat.v1.ListConversationsResp
    * @generated from field: repeated chat.v1.Conversation conversations = 1;
    */
   conversations: Conversation[];
+
+  /**
+   * unset on the last page
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
 };
 
 /**
@@ -291,174 +508,1028 @@ export type ListConversationsResponse = Message$1<"chat.v1.ListConversationsResp
  * Use `create(ListConversationsResponseSchema)` to create a new message.
  */
 export const ListConversationsResponseSchema: GenMessage<ListConversationsResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 10);
+  messageDesc(file_chat_v1_chat, 14);
 
 /**
- * @generated from message chat.v1.GetConversationRequest
+ * @generated from message chat.v1.ListConversationMessagesRequest
  */
-export type GetConversationRequest = Message$1<"chat.v1.GetConversationRequest"> & {
+export type ListConversationMessagesRequest = Message$1<"chat.v1.ListConversationMessagesRequest"> & {
   /**
    * @generated from field: string conversation_id = 1;
    */
   conversationId: string;
+
+  /**
+   * next_page_token of the previous page
+   *
+   * @generated from field: optional string page_token = 2;
+   */
+  pageToken?: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
 };
 
 /**
- * Describes the message chat.v1.GetConversationRequest.
- * Use `create(GetConversationRequestSchema)` to create a new message.
+ * Describes the message chat.v1.ListConversationMessagesRequest.
+ * Use `create(ListConversationMessagesRequestSchema)` to create a new message.
  */
-export const GetConversationRequestSchema: GenMessage<GetConversationRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 11);
+export const ListConversationMessagesRequestSchema: GenMessage<ListConversationMessagesRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 15);
 
 /**
- * @generated from message chat.v1.GetConversationResponse
+ * @generated from message chat.v1.ListConversationMessagesResponse
  */
-export type GetConversationResponse = Message$1<"chat.v1.GetConversationResponse"> & {
+export type ListConversationMessagesResponse = Message$1<"chat.v1.ListConversationMessagesResponse"> & {
   /**
-   * @generated from field: chat.v1.Conversation conversation = 1;
+   * The messages of the shown branch, in order. The first page holds the last messages, the next
+   * pages the ones before.
+   *
+   * @generated from field: repeated chat.v1.Message messages = 1;
    */
-  conversation?: Conversation;
+  messages: Message[];
+
+  /**
+   * unset on the page with the first message
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
 };
 
 /**
- * Describes the message chat.v1.GetConversationResponse.
- * Use `create(GetConversationResponseSchema)` to create a new message.
+ * Describes the message chat.v1.ListConversationMessagesResponse.
+ * Use `create(ListConversationMessagesResponseSchema)` to create a new message.
  */
-export const GetConversationResponseSchema: GenMessage<GetConversationResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 12);
+export const ListConversationMessagesResponseSchema: GenMessage<ListConversationMessagesResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 16);
 
 /**
- * @generated from message chat.v1.CreateConversationMessageRequest
+ * @generated from message chat.v1.SearchConversationsRequest
  */
-export type CreateConversationMessageRequest = Message$1<"chat.v1.CreateConversationMessageRequest"> & {
+export type SearchConversationsRequest = Message$1<"chat.v1.SearchConversationsRequest"> & {
   /**
-   * @generated from field: string project_id = 1;
+   * MongoDB text search syntax: words, "exact phrases" and -excluded words. If empty, the
+   * conversations matching the filters are returned, most recently updated first.
+   *
+   * @generated from field: string query = 1;
    */
-  projectId: string;
+  query: string;
 
   /**
-   * If conversation_id is not provided,
-   * a new conversation will be created and the id will be returned.
-   *
-   * @generated from field: optional string conversation_id = 2;
+   * @generated from field: optional string project_id = 2;
    */
-  conversationId?: string;
+  projectId?: string;
 
   /**
-   * @generated from field: chat.v1.LanguageModel language_model = 3;
+   * @generated from field: optional chat.v1.LanguageModel language_model = 3;
    */
-  languageModel: LanguageModel;
+  languageModel?: LanguageModel;
 
   /**
-   * @generated from field: string user_message = 4;
+   * @generated from field: optional google.protobuf.Timestamp updated_after = 4;
    */
-  userMessage: string;
+  updatedAfter?: Timestamp;
 
   /**
-   * @generated from field: optional string user_selected_text = 5;
+   * @generated from field: optional google.protobuf.Timestamp updated_before = 5;
    */
-  userSelectedText?: string;
+  updatedBefore?: Timestamp;
 
   /**
-   * @generated from field: optional chat.v1.ConversationType conversation_type = 6;
+   * defaults to 20, at most 50
+   *
+   * @generated from field: int32 limit = 6;
    */
-  conversationType?: ConversationType;
+  limit: number;
 };
 
 /**
- * Describes the message chat.v1.CreateConversationMessageRequest.
- * Use `create(CreateConversationMessageRequestSchema)` to create a new message.
+ * Describes the message chat.v1.SearchConversationsRequest.
+ * Use `create(SearchConversationsRequestSchema)` to create a new message.
  */
-export const CreateConversationMessageRequestSchema: GenMessage<CreateConversationMessageRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 13);
+export const SearchConversationsRequestSchema: GenMessage<SearchConversationsRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 17);
 
 /**
- * @generated from message chat.v1.CreateConversationMessageResponse
+ * A range of runes of a text.
+ *
+ * @generated from message chat.v1.TextRange
  */
-export type CreateConversationMessageResponse = Message$1<"chat.v1.CreateConversationMessageResponse"> & {
+export type TextRange = Message$1<"chat.v1.TextRange"> & {
   /**
-   * @generated from field: chat.v1.Conversation conversation = 1;
+   * @generated from field: int32 start = 1;
    */
-  conversation?: Conversation;
+  start: number;
+
+  /**
+   * @generated from field: int32 end = 2;
+   */
+  end: number;
 };
 
 /**
- * Describes the message chat.v1.CreateConversationMessageResponse.
- * Use `create(CreateConversationMessageResponseSchema)` to create a new message.
+ * Describes the message chat.v1.TextRange.
+ * Use `create(TextRangeSchema)` to create a new message.
  */
-export const CreateConversationMessageResponseSchema: GenMessage<CreateConversationMessageResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 14);
+export const TextRangeSchema: GenMessage<TextRange> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 18);
 
 /**
- * @generated from message chat.v1.UpdateConversationRequest
+ * @generated from message chat.v1.SearchSnippet
  */
-export type UpdateConversationRequest = Message$1<"chat.v1.UpdateConversationRequest"> & {
+export type SearchSnippet = Message$1<"chat.v1.SearchSnippet"> & {
   /**
-   * @generated from field: string conversation_id = 1;
+   * empty for the title
+   *
+   * @generated from field: string message_id = 1;
    */
-  conversationId: string;
+  messageId: string;
 
   /**
-   * @generated from field: string title = 2;
+   * the matching part of the title or message
+   *
+   * @generated from field: string text = 2;
    */
-  title: string;
+  text: string;
+
+  /**
+   * the matching words in text
+   *
+   * @generated from field: repeated chat.v1.TextRange highlights = 3;
+   */
+  highlights: TextRange[];
 };
 
 /**
- * Describes the message chat.v1.UpdateConversationRequest.
- * Use `create(UpdateConversationRequestSchema)` to create a new message.
+ * Describes the message chat.v1.SearchSnippet.
+ * Use `create(SearchSnippetSchema)` to create a new message.
  */
-export const UpdateConversationRequestSchema: GenMessage<UpdateConversationRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 15);
+export const SearchSnippetSchema: GenMessage<SearchSnippet> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 19);
 
 /**
- * @generated from message chat.v1.UpdateConversationResponse
+ * @generated from message chat.v1.ConversationSearchResult
  */
-export type UpdateConversationResponse = Message$1<"chat.v1.UpdateConversationResponse"> & {
+export type ConversationSearchResult = Message$1<"chat.v1.ConversationSearchResult"> & {
   /**
+   * without messages
+   *
    * @generated from field: chat.v1.Conversation conversation = 1;
    */
   conversation?: Conversation;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 3;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * the text search relevance, 0 without query
+   *
+   * @generated from field: double score = 4;
+   */
+  score: number;
+
+  /**
+   * the title and the first matching messages
+   *
+   * @generated from field: repeated chat.v1.SearchSnippet snippets = 5;
+   */
+  snippets: SearchSnippet[];
 };
 
 /**
- * Describes the message chat.v1.UpdateConversationResponse.
- * Use `create(UpdateConversationResponseSchema)` to create a new message.
+ * Describes the message chat.v1.ConversationSearchResult.
+ * Use `create(ConversationSearchResultSchema)` to create a new message.
  */
-export const UpdateConversationResponseSchema: GenMessage<UpdateConversationResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 16);
+export const ConversationSearchResultSchema: GenMessage<ConversationSearchResult> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 20);
 
 /**
- * @generated from message chat.v1.DeleteConversationRequest
+ * @generated from message chat.v1.SearchConversationsResponse
+ */
+export type SearchConversationsResponse = Message$1<"chat.v1.SearchConversationsResponse"> & {
+  /**
+   * best matches first
+   *
+   * @generated from field: repeated chat.v1.ConversationSearchResult results = 1;
+   */
+  results: ConversationSearchResult[];
+};
+
+/**
+ * Describes the message chat.v1.SearchConversationsResponse.
+ * Use `create(SearchConversationsResponseSchema)` to create a new message.
+ */
+export const SearchConversationsResponseSchema: GenMessage<SearchConversationsResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 21);
+
+/**
+ * @generated from message chat.v1.GetConversationRequest
+ */
+export type GetConversationRequest = Message$1<"chat.v1.GetConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message chat.v1.GetConversationRequest.
+ * Use `create(GetConversationRequestSchema)` to create a new message.
+ */
+export const GetConversationRequestSchema: GenMessage<GetConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 22);
+
+/**
+ * @generated from message chat.v1.GetConversationResponse
+ */
+export type GetConversationResponse = Message$1<"chat.v1.GetConversationResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.GetConversationResponse.
+ * Use `create(GetConversationResponseSchema)` to create a new message.
+ */
+export const GetConversationResponseSchema: GenMessage<GetConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 23);
+
+/**
+ * @generated from message chat.v1.CreateConversationMessageRequest
+ */
+export type CreateConversationMessageRequest = Message$1<"chat.v1.CreateConversationMessageRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * If conversation_id is not provided,
+   * a new conversation will be created and the id will be returned.
+   *
+   * @generated from field: optional string conversation_id = 2;
+   */
+  conversationId?: string;
+
+  /**
+   * @generated from field: chat.v1.LanguageModel language_model = 3;
+   */
+  languageModel: LanguageModel;
+
+  /**
+   * @generated from field: string user_message = 4;
+   */
+  userMessage: string;
+
+  /**
+   * @generated from field: optional string user_selected_text = 5;
+   */
+  userSelectedText?: string;
+
+  /**
+   * @generated from field: optional chat.v1.ConversationType conversation_type = 6;
+   */
+  conversationType?: ConversationType;
+
+  /**
+   * @generated from field: optional chat.v1.ResponseMode response_mode = 7;
+   */
+  responseMode?: ResponseMode;
+
+  /**
+   * The prompt of the library the message was rendered from, recorded with the message.
+   *
+   * @generated from field: optional string prompt_id = 8;
+   */
+  promptId?: string;
+
+  /**
+   * The UTF-16 offset of the selected text in the document open in the editor, which tells its
+   * occurrences apart when it appears several times in the project.
+   *
+   * @generated from field: optional int32 user_selection_offset = 9;
+   */
+  userSelectionOffset?: number;
+};
+
+/**
+ * Describes the message chat.v1.CreateConversationMessageRequest.
+ * Use `create(CreateConversationMessageRequestSchema)` to create a new message.
+ */
+export const CreateConversationMessageRequestSchema: GenMessage<CreateConversationMessageRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 24);
+
+/**
+ * @generated from message chat.v1.CreateConversationMessageResponse
+ */
+export type CreateConversationMessageResponse = Message$1<"chat.v1.CreateConversationMessageResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.CreateConversationMessageResponse.
+ * Use `create(CreateConversationMessageResponseSchema)` to create a new message.
+ */
+export const CreateConversationMessageResponseSchema: GenMessage<CreateConversationMessageResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 25);
+
+/**
+ * @generated from message chat.v1.UpdateConversationRequest
+ */
+export type UpdateConversationRequest = Message$1<"chat.v1.UpdateConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+};
+
+/**
+ * Describes the message chat.v1.UpdateConversationRequest.
+ * Use `create(UpdateConversationRequestSchema)` to create a new message.
+ */
+export const UpdateConversationRequestSchema: GenMessage<UpdateConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 26);
+
+/**
+ * @generated from message chat.v1.UpdateConversationResponse
+ */
+export type UpdateConversationResponse = Message$1<"chat.v1.UpdateConversationResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.UpdateConversationResponse.
+ * Use `create(UpdateConversationResponseSchema)` to create a new message.
+ */
+export const UpdateConversationResponseSchema: GenMessage<UpdateConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 27);
+
+/**
+ * @generated from message chat.v1.DeleteConversationRequest
+ */
+export type DeleteConversationRequest = Message$1<"chat.v1.DeleteConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message chat.v1.DeleteConversationRequest.
+ * Use `create(DeleteConversationRequestSchema)` to create a new message.
+ */
+export const DeleteConversationRequestSchema: GenMessage<DeleteConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 28);
+
+/**
+ * explicitly empty
+ *
+ * @generated from message chat.v1.DeleteConversationResponse
+ */
+export type DeleteConversationResponse = Message$1<"chat.v1.DeleteConversationResponse"> & {
+};
+
+/**
+ * Describes the message chat.v1.DeleteConversationResponse.
+ * Use `create(DeleteConversationResponseSchema)` to create a new message.
+ */
+export const DeleteConversationResponseSchema: GenMessage<DeleteConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 29);
+
+/**
+ * @generated from message chat.v1.DeletedConversation
+ */
+export type DeletedConversation = Message$1<"chat.v1.DeletedConversation"> & {
+  /**
+   * without messages
+   *
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deleted_at = 3;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * When the conversation is deleted for good, unset if it is kept forever.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 4;
+   */
+  purgeAt?: Timestamp;
+};
+
+/**
+ * Describes the message chat.v1.DeletedConversation.
+ * Use `create(DeletedConversationSchema)` to create a new message.
+ */
+export const DeletedConversationSchema: GenMessage<DeletedConversation> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 30);
+
+/**
+ * @generated from message chat.v1.ListDeletedConversationsRequest
+ */
+export type ListDeletedConversationsRequest = Message$1<"chat.v1.ListDeletedConversationsRequest"> & {
+  /**
+   * all projects if unset
+   *
+   * @generated from field: optional string project_id = 1;
+   */
+  projectId?: string;
+};
+
+/**
+ * Describes the message chat.v1.ListDeletedConversationsRequest.
+ * Use `create(ListDeletedConversationsRequestSchema)` to create a new message.
+ */
+export const ListDeletedConversationsRequestSchema: GenMessage<ListDeletedConversationsRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 31);
+
+/**
+ * @generated from message chat.v1.ListDeletedConversationsResponse
+ */
+export type ListDeletedConversationsResponse = Message$1<"chat.v1.ListDeletedConversationsResponse"> & {
+  /**
+   * @generated from field: repeated chat.v1.DeletedConversation conversations = 1;
+   */
+  conversations: DeletedConversation[];
+};
+
+/**
+ * Describes the message chat.v1.ListDeletedConversationsResponse.
+ * Use `create(ListDeletedConversationsResponseSchema)` to create a new message.
+ */
+export const ListDeletedConversationsResponseSchema: GenMessage<ListDeletedConversationsResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 32);
+
+/**
+ * @generated from message chat.v1.RestoreConversationRequest
+ */
+export type RestoreConversationRequest = Message$1<"chat.v1.RestoreConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message chat.v1.RestoreConversationRequest.
+ * Use `create(RestoreConversationRequestSchema)` to create a new message.
+ */
+export const RestoreConversationRequestSchema: GenMessage<RestoreConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 33);
+
+/**
+ * @generated from message chat.v1.RestoreConversationResponse
+ */
+export type RestoreConversationResponse = Message$1<"chat.v1.RestoreConversationResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.RestoreConversationResponse.
+ * Use `create(RestoreConversationResponseSchema)` to create a new message.
+ */
+export const RestoreConversationResponseSchema: GenMessage<RestoreConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 34);
+
+/**
+ * @generated from message chat.v1.ShareConversationRequest
+ */
+export type ShareConversationRequest = Message$1<"chat.v1.ShareConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: bool shared = 2;
+   */
+  shared: boolean;
+};
+
+/**
+ * Describes the message chat.v1.ShareConversationRequest.
+ * Use `create(ShareConversationRequestSchema)` to create a new message.
+ */
+export const ShareConversationRequestSchema: GenMessage<ShareConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 35);
+
+/**
+ * @generated from message chat.v1.ShareConversationResponse
+ */
+export type ShareConversationResponse = Message$1<"chat.v1.ShareConversationResponse"> & {
+  /**
+   * without messages
+   *
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.ShareConversationResponse.
+ * Use `create(ShareConversationResponseSchema)` to create a new message.
+ */
+export const ShareConversationResponseSchema: GenMessage<ShareConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 36);
+
+/**
+ * @generated from message chat.v1.SharedConversation
+ */
+export type SharedConversation = Message$1<"chat.v1.SharedConversation"> & {
+  /**
+   * without messages
+   *
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+
+  /**
+   * @generated from field: string owner_id = 2;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string owner_name = 3;
+   */
+  ownerName: string;
+
+  /**
+   * @generated from field: string owner_email = 4;
+   */
+  ownerEmail: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message chat.v1.SharedConversation.
+ * Use `create(SharedConversationSchema)` to create a new message.
+ */
+export const SharedConversationSchema: GenMessage<SharedConversation> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 37);
+
+/**
+ * @generated from message chat.v1.ListSharedConversationsRequest
+ */
+export type ListSharedConversationsRequest = Message$1<"chat.v1.ListSharedConversationsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message chat.v1.ListSharedConversationsRequest.
+ * Use `create(ListSharedConversationsRequestSchema)` to create a new message.
+ */
+export const ListSharedConversationsRequestSchema: GenMessage<ListSharedConversationsRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 38);
+
+/**
+ * @generated from message chat.v1.ListSharedConversationsResponse
+ */
+export type ListSharedConversationsResponse = Message$1<"chat.v1.ListSharedConversationsResponse"> & {
+  /**
+   * most recently updated first
+   *
+   * @generated from field: repeated chat.v1.SharedConversation conversations = 1;
+   */
+  conversations: SharedConversation[];
+};
+
+/**
+ * Describes the message chat.v1.ListSharedConversationsResponse.
+ * Use `create(ListSharedConversationsResponseSchema)` to create a new message.
+ */
+export const ListSharedConversationsResponseSchema: GenMessage<ListSharedConversationsResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 39);
+
+/**
+ * @generated from message chat.v1.ApproveToolCallRequest
+ */
+export type ApproveToolCallRequest = Message$1<"chat.v1.ApproveToolCallRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string tool_call_id = 2;
+   */
+  toolCallId: string;
+};
+
+/**
+ * Describes the message chat.v1.ApproveToolCallRequest.
+ * Use `create(ApproveToolCallRequestSchema)` to create a new message.
+ */
+export const ApproveToolCallRequestSchema: GenMessage<ApproveToolCallRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 40);
+
+/**
+ * @generated from message chat.v1.DenyToolCallRequest
+ */
+export type DenyToolCallRequest = Message$1<"chat.v1.DenyToolCallRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string tool_call_id = 2;
+   */
+  toolCallId: string;
+
+  /**
+   * told to the model
+   *
+   * @generated from field: optional string reason = 3;
+   */
+  reason?: string;
+};
+
+/**
+ * Describes the message chat.v1.DenyToolCallRequest.
+ * Use `create(DenyToolCallRequestSchema)` to create a new message.
+ */
+export const DenyToolCallRequestSchema: GenMessage<DenyToolCallRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 41);
+
+/**
+ * @generated from message chat.v1.WatchToolJobsRequest
+ */
+export type WatchToolJobsRequest = Message$1<"chat.v1.WatchToolJobsRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message chat.v1.WatchToolJobsRequest.
+ * Use `create(WatchToolJobsRequestSchema)` to create a new message.
+ */
+export const WatchToolJobsRequestSchema: GenMessage<WatchToolJobsRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 42);
+
+/**
+ * @generated from message chat.v1.ApplyEditRequest
+ */
+export type ApplyEditRequest = Message$1<"chat.v1.ApplyEditRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: string edit_id = 2;
+   */
+  editId: string;
+
+  /**
+   * false if the user rejected the edit
+   *
+   * @generated from field: bool accepted = 3;
+   */
+  accepted: boolean;
+};
+
+/**
+ * Describes the message chat.v1.ApplyEditRequest.
+ * Use `create(ApplyEditRequestSchema)` to create a new message.
+ */
+export const ApplyEditRequestSchema: GenMessage<ApplyEditRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 43);
+
+/**
+ * @generated from message chat.v1.ApplyEditResponse
+ */
+export type ApplyEditResponse = Message$1<"chat.v1.ApplyEditResponse"> & {
+  /**
+   * @generated from field: chat.v1.TextEdit edit = 1;
+   */
+  edit?: TextEdit;
+};
+
+/**
+ * Describes the message chat.v1.ApplyEditResponse.
+ * Use `create(ApplyEditResponseSchema)` to create a new message.
+ */
+export const ApplyEditResponseSchema: GenMessage<ApplyEditResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 44);
+
+/**
+ * @generated from message chat.v1.EditMessageRequest
+ */
+export type EditMessageRequest = Message$1<"chat.v1.EditMessageRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * a user message of the shown branch
+   *
+   * @generated from field: string message_id = 2;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_message = 4;
+   */
+  userMessage: string;
+
+  /**
+   * @generated from field: optional string user_selected_text = 5;
+   */
+  userSelectedText?: string;
+
+  /**
+   * @generated from field: optional chat.v1.ConversationType conversation_type = 6;
+   */
+  conversationType?: ConversationType;
+
+  /**
+   * @generated from field: optional chat.v1.ResponseMode response_mode = 7;
+   */
+  responseMode?: ResponseMode;
+
+  /**
+   * The UTF-16 offset of the selected text in the document open in the editor, which tells its
+   * occurrences apart when it appears several times in the project.
+   *
+   * @generated from field: optional int32 user_selection_offset = 8;
+   */
+  userSelectionOffset?: number;
+};
+
+/**
+ * Describes the message chat.v1.EditMessageRequest.
+ * Use `create(EditMessageRequestSchema)` to create a new message.
+ */
+export const EditMessageRequestSchema: GenMessage<EditMessageRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 45);
+
+/**
+ * @generated from message chat.v1.RegenerateMessageRequest
+ */
+export type RegenerateMessageRequest = Message$1<"chat.v1.RegenerateMessageRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * a user message of the shown branch
+   *
+   * @generated from field: string message_id = 2;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: optional chat.v1.ResponseMode response_mode = 3;
+   */
+  responseMode?: ResponseMode;
+};
+
+/**
+ * Describes the message chat.v1.RegenerateMessageRequest.
+ * Use `create(RegenerateMessageRequestSchema)` to create a new message.
+ */
+export const RegenerateMessageRequestSchema: GenMessage<RegenerateMessageRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 46);
+
+/**
+ * @generated from message chat.v1.SwitchBranchRequest
+ */
+export type SwitchBranchRequest = Message$1<"chat.v1.SwitchBranchRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * a user message of any branch
+   *
+   * @generated from field: string message_id = 2;
+   */
+  messageId: string;
+};
+
+/**
+ * Describes the message chat.v1.SwitchBranchRequest.
+ * Use `create(SwitchBranchRequestSchema)` to create a new message.
+ */
+export const SwitchBranchRequestSchema: GenMessage<SwitchBranchRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 47);
+
+/**
+ * @generated from message chat.v1.SwitchBranchResponse
+ */
+export type SwitchBranchResponse = Message$1<"chat.v1.SwitchBranchResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.SwitchBranchResponse.
+ * Use `create(SwitchBranchResponseSchema)` to create a new message.
+ */
+export const SwitchBranchResponseSchema: GenMessage<SwitchBranchResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 48);
+
+/**
+ * @generated from message chat.v1.ForkConversationRequest
+ */
+export type ForkConversationRequest = Message$1<"chat.v1.ForkConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * The fork ends with the turn of this message, i.e. with the answer to its user message. All
+   * messages are copied if it is empty.
+   *
+   * @generated from field: string up_to_message_id = 2;
+   */
+  upToMessageId: string;
+
+  /**
+   * The fork is grounded on the current content of this project instead of the content the
+   * conversation started with.
+   *
+   * @generated from field: optional string target_project_id = 3;
+   */
+  targetProjectId?: string;
+};
+
+/**
+ * Describes the message chat.v1.ForkConversationRequest.
+ * Use `create(ForkConversationRequestSchema)` to create a new message.
+ */
+export const ForkConversationRequestSchema: GenMessage<ForkConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 49);
+
+/**
+ * @generated from message chat.v1.ForkConversationResponse
+ */
+export type ForkConversationResponse = Message$1<"chat.v1.ForkConversationResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
+};
+
+/**
+ * Describes the message chat.v1.ForkConversationResponse.
+ * Use `create(ForkConversationResponseSchema)` to create a new message.
+ */
+export const ForkConversationResponseSchema: GenMessage<ForkConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 50);
+
+/**
+ * @generated from message chat.v1.ExportConversationRequest
+ */
+export type ExportConversationRequest = Message$1<"chat.v1.ExportConversationRequest"> & {
+  /**
+   * @generated from field: string conversation_id = 1;
+   */
+  conversationId: string;
+
+  /**
+   * @generated from field: chat.v1.ConversationExportFormat format = 2;
+   */
+  format: ConversationExportFormat;
+};
+
+/**
+ * Describes the message chat.v1.ExportConversationRequest.
+ * Use `create(ExportConversationRequestSchema)` to create a new message.
+ */
+export const ExportConversationRequestSchema: GenMessage<ExportConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 51);
+
+/**
+ * @generated from message chat.v1.ExportConversationResponse
+ */
+export type ExportConversationResponse = Message$1<"chat.v1.ExportConversationResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message chat.v1.ExportConversationResponse.
+ * Use `create(ExportConversationResponseSchema)` to create a new message.
+ */
+export const ExportConversationResponseSchema: GenMessage<ExportConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 52);
+
+/**
+ * @generated from message chat.v1.ImportConversationRequest
  */
-export type DeleteConversationRequest = Message$1<"chat.v1.DeleteConversationRequest"> & {
+export type ImportConversationRequest = Message$1<"chat.v1.ImportConversationRequest"> & {
   /**
-   * @generated from field: string conversation_id = 1;
+   * @generated from field: string project_id = 1;
    */
-  conversationId: string;
+  projectId: string;
+
+  /**
+   * The content of a JSON export.
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
 };
 
 /**
- * Describes the message chat.v1.DeleteConversationRequest.
- * Use `create(DeleteConversationRequestSchema)` to create a new message.
+ * Describes the message chat.v1.ImportConversationRequest.
+ * Use `create(ImportConversationRequestSchema)` to create a new message.
  */
-export const DeleteConversationRequestSchema: GenMessage<DeleteConversationRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 17);
+export const ImportConversationRequestSchema: GenMessage<ImportConversationRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 53);
 
 /**
- * explicitly empty
- *
- * @generated from message chat.v1.DeleteConversationResponse
+ * @generated from message chat.v1.ImportConversationResponse
  */
-export type DeleteConversationResponse = Message$1<"chat.v1.DeleteConversationResponse"> & {
+export type ImportConversationResponse = Message$1<"chat.v1.ImportConversationResponse"> & {
+  /**
+   * @generated from field: chat.v1.Conversation conversation = 1;
+   */
+  conversation?: Conversation;
 };
 
 /**
- * Describes the message chat.v1.DeleteConversationResponse.
- * Use `create(DeleteConversationResponseSchema)` to create a new message.
+ * Describes the message chat.v1.ImportConversationResponse.
+ * Use `create(ImportConversationResponseSchema)` to create a new message.
  */
-export const DeleteConversationResponseSchema: GenMessage<DeleteConversationResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 18);
+export const ImportConversationResponseSchema: GenMessage<ImportConversationResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 54);
 
 /**
  * Information sent once at the beginning of a new conversation stream
@@ -482,7 +1553,7 @@ export type StreamInitialization = Message$1<"chat.v1.StreamInitialization"> & {
  * Use `create(StreamInitializationSchema)` to create a new message.
  */
 export const StreamInitializationSchema: GenMessage<StreamInitialization> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 19);
+  messageDesc(file_chat_v1_chat, 55);
 
 /**
  * Designed as StreamPartBegin and StreamPartEnd to
@@ -510,7 +1581,7 @@ export type StreamPartBegin = Message$1<"chat.v1.StreamPartBegin"> & {
  * Use `create(StreamPartBeginSchema)` to create a new message.
  */
 export const StreamPartBeginSchema: GenMessage<StreamPartBegin> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 20);
+  messageDesc(file_chat_v1_chat, 56);
 
 /**
  * Note: After the StreamPartBegin of tool_call, there can be no MessageChunk,
@@ -539,7 +1610,7 @@ export type MessageChunk = Message$1<"chat.v1.MessageChunk"> & {
  * Use `create(MessageChunkSchema)` to create a new message.
  */
 export const MessageChunkSchema: GenMessage<MessageChunk> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 21);
+  messageDesc(file_chat_v1_chat, 57);
 
 /**
  * @generated from message chat.v1.IncompleteIndicator
@@ -561,7 +1632,7 @@ export type IncompleteIndicator = Message$1<"chat.v1.IncompleteIndicator"> & {
  * Use `create(IncompleteIndicatorSchema)` to create a new message.
  */
 export const IncompleteIndicatorSchema: GenMessage<IncompleteIndicator> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 22);
+  messageDesc(file_chat_v1_chat, 58);
 
 /**
  * @generated from message chat.v1.StreamPartEnd
@@ -583,7 +1654,7 @@ export type StreamPartEnd = Message$1<"chat.v1.StreamPartEnd"> & {
  * Use `create(StreamPartEndSchema)` to create a new message.
  */
 export const StreamPartEndSchema: GenMessage<StreamPartEnd> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 23);
+  messageDesc(file_chat_v1_chat, 59);
 
 /**
  * Sent when the current AI response is fully streamed
@@ -607,7 +1678,7 @@ export type StreamFinalization = Message$1<"chat.v1.StreamFinalization"> & {
  * Use `create(StreamFinalizationSchema)` to create a new message.
  */
 export const StreamFinalizationSchema: GenMessage<StreamFinalization> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 24);
+  messageDesc(file_chat_v1_chat, 60);
 
 /**
  * @generated from message chat.v1.StreamError
@@ -624,7 +1695,56 @@ export type StreamError = Message$1<"chat.v1.StreamError"> & {
  * Use `create(StreamErrorSchema)` to create a new message.
  */
 export const StreamErrorSchema: GenMessage<StreamError> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 25);
+  messageDesc(file_chat_v1_chat, 61);
+
+/**
+ * Progress of a tool call running as a background job.
+ * Once the job succeeded or failed, its result is in the tool_call message (see GetConversation).
+ *
+ * @generated from message chat.v1.ToolCallProgress
+ */
+export type ToolCallProgress = Message$1<"chat.v1.ToolCallProgress"> & {
+  /**
+   * The id of the tool_call message
+   *
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string job_id = 2;
+   */
+  jobId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: chat.v1.ToolJobStatus status = 4;
+   */
+  status: ToolJobStatus;
+
+  /**
+   * Between 0 and 1, unset if the tool does not know
+   *
+   * @generated from field: optional double progress = 5;
+   */
+  progress?: number;
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message chat.v1.ToolCallProgress.
+ * Use `create(ToolCallProgressSchema)` to create a new message.
+ */
+export const ToolCallProgressSchema: GenMessage<ToolCallProgress> = /*@__PURE__*/
+  messageDesc(file_chat_v1_chat, 62);
 
 /**
  * This message should be the same as CreateConversationMessageRequest
@@ -663,6 +1783,26 @@ export type CreateConversationMessageStreamRequest = Message$1<"chat.v1.CreateCo
    * @generated from field: optional chat.v1.ConversationType conversation_type = 6;
    */
   conversationType?: ConversationType;
+
+  /**
+   * @generated from field: optional chat.v1.ResponseMode response_mode = 7;
+   */
+  responseMode?: ResponseMode;
+
+  /**
+   * The prompt of the library the message was rendered from, recorded with the message.
+   *
+   * @generated from field: optional string prompt_id = 8;
+   */
+  promptId?: string;
+
+  /**
+   * The UTF-16 offset of the selected text in the document open in the editor, which tells its
+   * occurrences apart when it appears several times in the project.
+   *
+   * @generated from field: optional int32 user_selection_offset = 9;
+   */
+  userSelectionOffset?: number;
 };
 
 /**
@@ -670,7 +1810,7 @@ export type CreateConversationMessageStreamRequest = Message$1<"chat.v1.CreateCo
  * Use `create(CreateConversationMessageStreamRequestSchema)` to create a new message.
  */
 export const CreateConversationMessageStreamRequestSchema: GenMessage<CreateConversationMessageStreamRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 26);
+  messageDesc(file_chat_v1_chat, 63);
 
 /**
  * Response for streaming a message within an existing conversation
@@ -723,6 +1863,12 @@ export type CreateConversationMessageStreamResponse = Message$1<"chat.v1.CreateC
      */
     value: StreamError;
     case: "streamError";
+  } | {
+    /**
+     * @generated from field: chat.v1.ToolCallProgress tool_call_progress = 8;
+     */
+    value: ToolCallProgress;
+    case: "toolCallProgress";
   } | { case: undefined; value?: undefined };
 };
 
@@ -731,7 +1877,7 @@ export type CreateConversationMessageStreamResponse = Message$1<"chat.v1.CreateC
  * Use `create(CreateConversationMessageStreamResponseSchema)` to create a new message.
  */
 export const CreateConversationMessageStreamResponseSchema: GenMessage<CreateConversationMessageStreamResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_chat, 27);
+  messageDesc(file_chat_v1_chat, 64);
 
 /**
  * @generated from enum chat.v1.LanguageModel
@@ -779,6 +1925,109 @@ export enum LanguageModel {
 export const LanguageModelSchema: GenEnum<LanguageModel> = /*@__PURE__*/
   enumDesc(file_chat_v1_chat, 0);
 
+/**
+ * @generated from enum chat.v1.TextEditStatus
+ */
+export enum TextEditStatus {
+  /**
+   * proposed, not applied yet
+   *
+   * @generated from enum value: TEXT_EDIT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TEXT_EDIT_STATUS_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * @generated from enum value: TEXT_EDIT_STATUS_REJECTED = 2;
+   */
+  REJECTED = 2,
+}
+
+/**
+ * Describes the enum chat.v1.TextEditStatus.
+ */
+export const TextEditStatusSchema: GenEnum<TextEditStatus> = /*@__PURE__*/
+  enumDesc(file_chat_v1_chat, 1);
+
+/**
+ * @generated from enum chat.v1.ConversationExportFormat
+ */
+export enum ConversationExportFormat {
+  /**
+   * same as JSON
+   *
+   * @generated from enum value: CONVERSATION_EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Both chat histories and all branches, the format ImportConversation accepts.
+   *
+   * @generated from enum value: CONVERSATION_EXPORT_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * The shown branch, tool calls are collapsible blocks.
+   *
+   * @generated from enum value: CONVERSATION_EXPORT_FORMAT_MARKDOWN = 2;
+   */
+  MARKDOWN = 2,
+
+  /**
+   * The model and the input items of the OpenAI Responses API.
+   *
+   * @generated from enum value: CONVERSATION_EXPORT_FORMAT_OPENAI = 3;
+   */
+  OPENAI = 3,
+}
+
+/**
+ * Describes the enum chat.v1.ConversationExportFormat.
+ */
+export const ConversationExportFormatSchema: GenEnum<ConversationExportFormat> = /*@__PURE__*/
+  enumDesc(file_chat_v1_chat, 2);
+
+/**
+ * @generated from enum chat.v1.ToolJobStatus
+ */
+export enum ToolJobStatus {
+  /**
+   * @generated from enum value: TOOL_JOB_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TOOL_JOB_STATUS_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * @generated from enum value: TOOL_JOB_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: TOOL_JOB_STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * @generated from enum value: TOOL_JOB_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum chat.v1.ToolJobStatus.
+ */
+export const ToolJobStatusSchema: GenEnum<ToolJobStatus> = /*@__PURE__*/
+  enumDesc(file_chat_v1_chat, 3);
+
 /**
  * @generated from enum chat.v1.ConversationType
  */
@@ -794,13 +2043,66 @@ export enum ConversationType {
    * @generated from enum value: CONVERSATION_TYPE_DEBUG = 1;
    */
   DEBUG = 1,
+
+  /**
+   * CONVERSATION_TYPE_NO_SYSTEM_MESSAGE_INJECTION = 2;
+   * CONVERSATION_TYPE_NO_USER_MESSAGE_INJECTION = 3;
+   * The personas, each one has its own system prompt. They apply when the conversation is
+   * created, a new conversation left unspecified gets the default persona of the project.
+   *
+   * @generated from enum value: CONVERSATION_TYPE_REVIEWER = 4;
+   */
+  REVIEWER = 4,
+
+  /**
+   * @generated from enum value: CONVERSATION_TYPE_TRANSLATOR = 5;
+   */
+  TRANSLATOR = 5,
+
+  /**
+   * @generated from enum value: CONVERSATION_TYPE_PROOFREADER = 6;
+   */
+  PROOFREADER = 6,
+
+  /**
+   * @generated from enum value: CONVERSATION_TYPE_REBUTTAL_WRITER = 7;
+   */
+  REBUTTAL_WRITER = 7,
 }
 
 /**
  * Describes the enum chat.v1.ConversationType.
  */
 export const ConversationTypeSchema: GenEnum<ConversationType> = /*@__PURE__*/
-  enumDesc(file_chat_v1_chat, 1);
+  enumDesc(file_chat_v1_chat, 4);
+
+/**
+ * How the assistant replies to a message.
+ *
+ * @generated from enum chat.v1.ResponseMode
+ */
+export enum ResponseMode {
+  /**
+   * free text
+   *
+   * @generated from enum value: RESPONSE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The assistant replies with structured output, a revision of the user selected text,
+   * streamed as a MessageTypeRevision instead of message chunks.
+   *
+   * @generated from enum value: RESPONSE_MODE_REVISION = 1;
+   */
+  REVISION = 1,
+}
+
+/**
+ * Describes the enum chat.v1.ResponseMode.
+ */
+export const ResponseModeSchema: GenEnum<ResponseMode> = /*@__PURE__*/
+  enumDesc(file_chat_v1_chat, 5);
 
 /**
  * @generated from service chat.v1.ChatService
@@ -814,6 +2116,26 @@ export const ChatService: GenService<{
     input: typeof ListConversationsRequestSchema;
     output: typeof ListConversationsResponseSchema;
   },
+  /**
+   * Finds the conversations of the user whose title or messages match a full-text query.
+   *
+   * @generated from rpc chat.v1.ChatService.SearchConversations
+   */
+  searchConversations: {
+    methodKind: "unary";
+    input: typeof SearchConversationsRequestSchema;
+    output: typeof SearchConversationsResponseSchema;
+  },
+  /**
+   * Returns the messages of a conversation page by page, from the last one back.
+   *
+   * @generated from rpc chat.v1.ChatService.ListConversationMessages
+   */
+  listConversationMessages: {
+    methodKind: "unary";
+    input: typeof ListConversationMessagesRequestSchema;
+    output: typeof ListConversationMessagesResponseSchema;
+  },
   /**
    * @generated from rpc chat.v1.ChatService.GetConversation
    */
@@ -854,6 +2176,148 @@ export const ChatService: GenService<{
     input: typeof DeleteConversationRequestSchema;
     output: typeof DeleteConversationResponseSchema;
   },
+  /**
+   * Lists the deleted conversations, they can be restored until they are deleted for good.
+   *
+   * @generated from rpc chat.v1.ChatService.ListDeletedConversations
+   */
+  listDeletedConversations: {
+    methodKind: "unary";
+    input: typeof ListDeletedConversationsRequestSchema;
+    output: typeof ListDeletedConversationsResponseSchema;
+  },
+  /**
+   * @generated from rpc chat.v1.ChatService.RestoreConversation
+   */
+  restoreConversation: {
+    methodKind: "unary";
+    input: typeof RestoreConversationRequestSchema;
+    output: typeof RestoreConversationResponseSchema;
+  },
+  /**
+   * Shares a conversation with the members of its project, or stops sharing it.
+   *
+   * @generated from rpc chat.v1.ChatService.ShareConversation
+   */
+  shareConversation: {
+    methodKind: "unary";
+    input: typeof ShareConversationRequestSchema;
+    output: typeof ShareConversationResponseSchema;
+  },
+  /**
+   * Lists the conversations the other members shared in the project, they can be read and forked.
+   *
+   * @generated from rpc chat.v1.ChatService.ListSharedConversations
+   */
+  listSharedConversations: {
+    methodKind: "unary";
+    input: typeof ListSharedConversationsRequestSchema;
+    output: typeof ListSharedConversationsResponseSchema;
+  },
+  /**
+   * Runs a tool call that is awaiting approval and resumes the conversation.
+   *
+   * @generated from rpc chat.v1.ChatService.ApproveToolCall
+   */
+  approveToolCall: {
+    methodKind: "server_streaming";
+    input: typeof ApproveToolCallRequestSchema;
+    output: typeof CreateConversationMessageStreamResponseSchema;
+  },
+  /**
+   * Refuses a tool call that is awaiting approval, the model is told that the user denied it.
+   *
+   * @generated from rpc chat.v1.ChatService.DenyToolCall
+   */
+  denyToolCall: {
+    methodKind: "server_streaming";
+    input: typeof DenyToolCallRequestSchema;
+    output: typeof CreateConversationMessageStreamResponseSchema;
+  },
+  /**
+   * Streams the progress of the background tool jobs of a conversation, until all of them are done
+   * and their results were added to the conversation.
+   *
+   * @generated from rpc chat.v1.ChatService.WatchToolJobs
+   */
+  watchToolJobs: {
+    methodKind: "server_streaming";
+    input: typeof WatchToolJobsRequestSchema;
+    output: typeof CreateConversationMessageStreamResponseSchema;
+  },
+  /**
+   * Records that the user applied (accepted) or rejected a proposed text edit.
+   *
+   * @generated from rpc chat.v1.ChatService.ApplyEdit
+   */
+  applyEdit: {
+    methodKind: "unary";
+    input: typeof ApplyEditRequestSchema;
+    output: typeof ApplyEditResponseSchema;
+  },
+  /**
+   * Answers a new version of a past user message, the messages that followed the old version stay
+   * on their own branch of the conversation.
+   *
+   * @generated from rpc chat.v1.ChatService.EditMessage
+   */
+  editMessage: {
+    methodKind: "server_streaming";
+    input: typeof EditMessageRequestSchema;
+    output: typeof CreateConversationMessageStreamResponseSchema;
+  },
+  /**
+   * Answers a past user message again, on a new branch of the conversation.
+   *
+   * @generated from rpc chat.v1.ChatService.RegenerateMessage
+   */
+  regenerateMessage: {
+    methodKind: "server_streaming";
+    input: typeof RegenerateMessageRequestSchema;
+    output: typeof CreateConversationMessageStreamResponseSchema;
+  },
+  /**
+   * Shows the branch of a user message, with the messages last shown after it.
+   *
+   * @generated from rpc chat.v1.ChatService.SwitchBranch
+   */
+  switchBranch: {
+    methodKind: "unary";
+    input: typeof SwitchBranchRequestSchema;
+    output: typeof SwitchBranchResponseSchema;
+  },
+  /**
+   * Copies the shown branch of a conversation up to a message into a new conversation, optionally
+   * in another project.
+   *
+   * @generated from rpc chat.v1.ChatService.ForkConversation
+   */
+  forkConversation: {
+    methodKind: "unary";
+    input: typeof ForkConversationRequestSchema;
+    output: typeof ForkConversationResponseSchema;
+  },
+  /**
+   * Exports a conversation as Markdown, as a lossless JSON file that ImportConversation restores,
+   * or as the input of the OpenAI Responses API.
+   *
+   * @generated from rpc chat.v1.ChatService.ExportConversation
+   */
+  exportConversation: {
+    methodKind: "unary";
+    input: typeof ExportConversationRequestSchema;
+    output: typeof ExportConversationResponseSchema;
+  },
+  /**
+   * Restores a conversation from its JSON export into a project.
+   *
+   * @generated from rpc chat.v1.ChatService.ImportConversation
+   */
+  importConversation: {
+    methodKind: "unary";
+    input: typeof ImportConversationRequestSchema;
+    output: typeof ImportConversationResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_chat_v1_chat, 0);
 
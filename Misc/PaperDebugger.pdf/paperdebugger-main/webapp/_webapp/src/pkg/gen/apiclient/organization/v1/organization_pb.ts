@@ -0,0 +1,598 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file organization/v1/organization.proto (package organization.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "@buf/googleapis_googleapis.bufbuild_es/google/api/annotations_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file organization/v1/organization.proto.
+ */
+export const file_organization_v1_organization: GenFile = /*@__PURE__*/
+  fileDesc("CiJvcmdhbml6YXRpb24vdjEvb3JnYW5pemF0aW9uLnByb3RvEg9vcmdhbml6YXRpb24udjEi0gEKDE9yZ2FuaXphdGlvbhIKCgJpZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRuYW1lGAQgASgJEhQKDGluc3RydWN0aW9ucxgFIAEoCRIyCgdteV9yb2xlGAYgASgOMiEub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvblJvbGUixQEKEk9yZ2FuaXphdGlvbk1lbWJlchIPCgd1c2VyX2lkGAEgASgJEg0KBWVtYWlsGAIgASgJEgwKBG5hbWUYAyABKAkSDwoHcGljdHVyZRgEIAEoCRIvCgRyb2xlGAUgASgOMiEub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvblJvbGUSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHcGVuZGluZxgHIAEoCCIaChhMaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QihQEKGUxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USNAoNb3JnYW5pemF0aW9ucxgBIAMoCzIdLm9yZ2FuaXphdGlvbi52MS5Pcmdhbml6YXRpb24SMgoLaW52aXRhdGlvbnMYAiADKAsyHS5vcmdhbml6YXRpb24udjEuT3JnYW5pemF0aW9uIikKGUNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCSJRChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRIzCgxvcmdhbml6YXRpb24YASABKAsyHS5vcmdhbml6YXRpb24udjEuT3JnYW5pemF0aW9uInwKGVVwZGF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIZCgxpbnN0cnVjdGlvbnMYAyABKAlIAYgBAUIHCgVfbmFtZUIPCg1faW5zdHJ1Y3Rpb25zIlEKGlVwZGF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEjMKDG9yZ2FuaXphdGlvbhgBIAEoCzIdLm9yZ2FuaXphdGlvbi52MS5Pcmdhbml6YXRpb24iNAoZRGVsZXRlT3JnYW5pemF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiHAoaRGVsZXRlT3JnYW5pemF0aW9uUmVzcG9uc2UiOQoeTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCSJXCh9MaXN0T3JnYW5pemF0aW9uTWVtYmVyc1Jlc3BvbnNlEjQKB21lbWJlcnMYASADKAsyIy5vcmdhbml6YXRpb24udjEuT3JnYW5pemF0aW9uTWVtYmVyIncKHEFkZE9yZ2FuaXphdGlvbk1lbWJlclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg0KBWVtYWlsGAIgASgJEi8KBHJvbGUYAyABKA4yIS5vcmdhbml6YXRpb24udjEuT3JnYW5pemF0aW9uUm9sZSJUCh1BZGRPcmdhbml6YXRpb25NZW1iZXJSZXNwb25zZRIzCgZtZW1iZXIYASABKAsyIy5vcmdhbml6YXRpb24udjEuT3JnYW5pemF0aW9uTWVtYmVyInwKH1VwZGF0ZU9yZ2FuaXphdGlvbk1lbWJlclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSLwoEcm9sZRgDIAEoDjIhLm9yZ2FuaXphdGlvbi52MS5Pcmdhbml6YXRpb25Sb2xlIlcKIFVwZGF0ZU9yZ2FuaXphdGlvbk1lbWJlclJlc3BvbnNlEjMKBm1lbWJlchgBIAEoCzIjLm9yZ2FuaXphdGlvbi52MS5Pcmdhbml6YXRpb25NZW1iZXIiSwofUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIiCiBSZW1vdmVPcmdhbml6YXRpb25NZW1iZXJSZXNwb25zZSI+CiNBY2NlcHRPcmdhbml6YXRpb25JbnZpdGF0aW9uUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiWwokQWNjZXB0T3JnYW5pemF0aW9uSW52aXRhdGlvblJlc3BvbnNlEjMKDG9yZ2FuaXphdGlvbhgBIAEoCzIdLm9yZ2FuaXphdGlvbi52MS5Pcmdhbml6YXRpb24qjgEKEE9yZ2FuaXphdGlvblJvbGUSIQodT1JHQU5JWkFUSU9OX1JPTEVfVU5TUEVDSUZJRUQQABIbChdPUkdBTklaQVRJT05fUk9MRV9PV05FUhABEhwKGE9SR0FOSVpBVElPTl9ST0xFX0VESVRPUhACEhwKGE9SR0FOSVpBVElPTl9ST0xFX1ZJRVdFUhADMt8MChNPcmdhbml6YXRpb25TZXJ2aWNlEo0BChFMaXN0T3JnYW5pemF0aW9ucxIpLm9yZ2FuaXphdGlvbi52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaKi5vcmdhbml6YXRpb24udjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZSIhgtPkkwIbEhkvX3BkL2FwaS92MS9vcmdhbml6YXRpb25zEpMBChJDcmVhdGVPcmdhbml6YXRpb24SKi5vcmdhbml6YXRpb24udjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBorLm9yZ2FuaXphdGlvbi52MS5DcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZSIkgtPkkwIeOgEqIhkvX3BkL2FwaS92MS9vcmdhbml6YXRpb25zEqUBChJVcGRhdGVPcmdhbml6YXRpb24SKi5vcmdhbml6YXRpb24udjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBorLm9yZ2FuaXphdGlvbi52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZSI2gtPkkwIwOgEqMisvX3BkL2FwaS92MS9vcmdhbml6YXRpb25zL3tvcmdhbml6YXRpb25faWR9EqIBChJEZWxldGVPcmdhbml6YXRpb24SKi5vcmdhbml6YXRpb24udjEuRGVsZXRlT3JnYW5pemF0aW9uUmVxdWVzdBorLm9yZ2FuaXphdGlvbi52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZSIzgtPkkwItKisvX3BkL2FwaS92MS9vcmdhbml6YXRpb25zL3tvcmdhbml6YXRpb25faWR9ErkBChdMaXN0T3JnYW5pemF0aW9uTWVtYmVycxIvLm9yZ2FuaXphdGlvbi52MS5MaXN0T3JnYW5pemF0aW9uTWVtYmVyc1JlcXVlc3QaMC5vcmdhbml6YXRpb24udjEuTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXNwb25zZSI7gtPkkwI1EjMvX3BkL2FwaS92MS9vcmdhbml6YXRpb25zL3tvcmdhbml6YXRpb25faWR9L21lbWJlcnMStgEKFUFkZE9yZ2FuaXphdGlvbk1lbWJlchItLm9yZ2FuaXphdGlvbi52MS5BZGRPcmdhbml6YXRpb25NZW1iZXJSZXF1ZXN0Gi4ub3JnYW5pemF0aW9uLnYxLkFkZE9yZ2FuaXphdGlvbk1lbWJlclJlc3BvbnNlIj6C0+STAjg6ASoiMy9fcGQvYXBpL3YxL29yZ2FuaXphdGlvbnMve29yZ2FuaXphdGlvbl9pZH0vbWVtYmVycxLJAQoYVXBkYXRlT3JnYW5pemF0aW9uTWVtYmVyEjAub3JnYW5pemF0aW9uLnYxLlVwZGF0ZU9yZ2FuaXphdGlvbk1lbWJlclJlcXVlc3QaMS5vcmdhbml6YXRpb24udjEuVXBkYXRlT3JnYW5pemF0aW9uTWVtYmVyUmVzcG9uc2UiSILT5JMCQjoBKjI9L19wZC9hcGkvdjEvb3JnYW5pemF0aW9ucy97b3JnYW5pemF0aW9uX2lkfS9tZW1iZXJzL3t1c2VyX2lkfRLGAQoYUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyEjAub3JnYW5pemF0aW9uLnYxLlJlbW92ZU9yZ2FuaXphdGlvbk1lbWJlclJlcXVlc3QaMS5vcmdhbml6YXRpb24udjEuUmVtb3ZlT3JnYW5pemF0aW9uTWVtYmVyUmVzcG9uc2UiRYLT5JMCPyo9L19wZC9hcGkvdjEvb3JnYW5pemF0aW9ucy97b3JnYW5pemF0aW9uX2lkfS9tZW1iZXJzL3t1c2VyX2lkfRLKAQocQWNjZXB0T3JnYW5pemF0aW9uSW52aXRhdGlvbhI0Lm9yZ2FuaXphdGlvbi52MS5BY2NlcHRPcmdhbml6YXRpb25JbnZpdGF0aW9uUmVxdWVzdBo1Lm9yZ2FuaXphdGlvbi52MS5BY2NlcHRPcmdhbml6YXRpb25JbnZpdGF0aW9uUmVzcG9uc2UiPYLT5JMCNzoBKiIyL19wZC9hcGkvdjEvb3JnYW5pemF0aW9ucy97b3JnYW5pemF0aW9uX2lkfS9hY2NlcHRCvwEKE2NvbS5vcmdhbml6YXRpb24udjFCEU9yZ2FuaXphdGlvblByb3RvUAFaOHBhcGVyZGVidWdnZXIvcGtnL2dlbi9hcGkvb3JnYW5pemF0aW9uL3YxO29yZ2FuaXphdGlvbnYxogIDT1hYqgIPT3JnYW5pemF0aW9uLlYxygIPT3JnYW5pemF0aW9uXFYx4gIbT3JnYW5pemF0aW9uXFYxXEdQQk1ldGFkYXRh6gIQT3JnYW5pemF0aW9uOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message organization.v1.Organization
+ */
+export type Organization = Message<"organization.v1.Organization"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 3;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * Applied to the conversations of the members, before the project and user instructions.
+   *
+   * @generated from field: string instructions = 5;
+   */
+  instructions: string;
+
+  /**
+   * @generated from field: organization.v1.OrganizationRole my_role = 6;
+   */
+  myRole: OrganizationRole;
+};
+
+/**
+ * Describes the message organization.v1.Organization.
+ * Use `create(OrganizationSchema)` to create a new message.
+ */
+export const OrganizationSchema: GenMessage<Organization> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 0);
+
+/**
+ * @generated from message organization.v1.OrganizationMember
+ */
+export type OrganizationMember = Message<"organization.v1.OrganizationMember"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * empty if the account was deleted
+   *
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string picture = 4;
+   */
+  picture: string;
+
+  /**
+   * @generated from field: organization.v1.OrganizationRole role = 5;
+   */
+  role: OrganizationRole;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * invited, until the user accepts
+   *
+   * @generated from field: bool pending = 7;
+   */
+  pending: boolean;
+};
+
+/**
+ * Describes the message organization.v1.OrganizationMember.
+ * Use `create(OrganizationMemberSchema)` to create a new message.
+ */
+export const OrganizationMemberSchema: GenMessage<OrganizationMember> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 1);
+
+/**
+ * @generated from message organization.v1.ListOrganizationsRequest
+ */
+export type ListOrganizationsRequest = Message<"organization.v1.ListOrganizationsRequest"> & {
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationsRequest.
+ * Use `create(ListOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListOrganizationsRequestSchema: GenMessage<ListOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 2);
+
+/**
+ * @generated from message organization.v1.ListOrganizationsResponse
+ */
+export type ListOrganizationsResponse = Message<"organization.v1.ListOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated organization.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+
+  /**
+   * The organizations the user is invited to, my_role is the role they are invited as.
+   *
+   * @generated from field: repeated organization.v1.Organization invitations = 2;
+   */
+  invitations: Organization[];
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationsResponse.
+ * Use `create(ListOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListOrganizationsResponseSchema: GenMessage<ListOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 3);
+
+/**
+ * @generated from message organization.v1.CreateOrganizationRequest
+ */
+export type CreateOrganizationRequest = Message<"organization.v1.CreateOrganizationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message organization.v1.CreateOrganizationRequest.
+ * Use `create(CreateOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateOrganizationRequestSchema: GenMessage<CreateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 4);
+
+/**
+ * @generated from message organization.v1.CreateOrganizationResponse
+ */
+export type CreateOrganizationResponse = Message<"organization.v1.CreateOrganizationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.CreateOrganizationResponse.
+ * Use `create(CreateOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateOrganizationResponseSchema: GenMessage<CreateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 5);
+
+/**
+ * @generated from message organization.v1.UpdateOrganizationRequest
+ */
+export type UpdateOrganizationRequest = Message<"organization.v1.UpdateOrganizationRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * unchanged if unset
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * unchanged if unset
+   *
+   * @generated from field: optional string instructions = 3;
+   */
+  instructions?: string;
+};
+
+/**
+ * Describes the message organization.v1.UpdateOrganizationRequest.
+ * Use `create(UpdateOrganizationRequestSchema)` to create a new message.
+ */
+export const UpdateOrganizationRequestSchema: GenMessage<UpdateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 6);
+
+/**
+ * @generated from message organization.v1.UpdateOrganizationResponse
+ */
+export type UpdateOrganizationResponse = Message<"organization.v1.UpdateOrganizationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.UpdateOrganizationResponse.
+ * Use `create(UpdateOrganizationResponseSchema)` to create a new message.
+ */
+export const UpdateOrganizationResponseSchema: GenMessage<UpdateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 7);
+
+/**
+ * @generated from message organization.v1.DeleteOrganizationRequest
+ */
+export type DeleteOrganizationRequest = Message<"organization.v1.DeleteOrganizationRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message organization.v1.DeleteOrganizationRequest.
+ * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
+ */
+export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 8);
+
+/**
+ * @generated from message organization.v1.DeleteOrganizationResponse
+ */
+export type DeleteOrganizationResponse = Message<"organization.v1.DeleteOrganizationResponse"> & {
+};
+
+/**
+ * Describes the message organization.v1.DeleteOrganizationResponse.
+ * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
+ */
+export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 9);
+
+/**
+ * @generated from message organization.v1.ListOrganizationMembersRequest
+ */
+export type ListOrganizationMembersRequest = Message<"organization.v1.ListOrganizationMembersRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationMembersRequest.
+ * Use `create(ListOrganizationMembersRequestSchema)` to create a new message.
+ */
+export const ListOrganizationMembersRequestSchema: GenMessage<ListOrganizationMembersRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 10);
+
+/**
+ * @generated from message organization.v1.ListOrganizationMembersResponse
+ */
+export type ListOrganizationMembersResponse = Message<"organization.v1.ListOrganizationMembersResponse"> & {
+  /**
+   * the owner first
+   *
+   * @generated from field: repeated organization.v1.OrganizationMember members = 1;
+   */
+  members: OrganizationMember[];
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationMembersResponse.
+ * Use `create(ListOrganizationMembersResponseSchema)` to create a new message.
+ */
+export const ListOrganizationMembersResponseSchema: GenMessage<ListOrganizationMembersResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 11);
+
+/**
+ * @generated from message organization.v1.AddOrganizationMemberRequest
+ */
+export type AddOrganizationMemberRequest = Message<"organization.v1.AddOrganizationMemberRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * the user must have signed in to PaperDebugger once
+   *
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * editor or viewer
+   *
+   * @generated from field: organization.v1.OrganizationRole role = 3;
+   */
+  role: OrganizationRole;
+};
+
+/**
+ * Describes the message organization.v1.AddOrganizationMemberRequest.
+ * Use `create(AddOrganizationMemberRequestSchema)` to create a new message.
+ */
+export const AddOrganizationMemberRequestSchema: GenMessage<AddOrganizationMemberRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 12);
+
+/**
+ * @generated from message organization.v1.AddOrganizationMemberResponse
+ */
+export type AddOrganizationMemberResponse = Message<"organization.v1.AddOrganizationMemberResponse"> & {
+  /**
+   * @generated from field: organization.v1.OrganizationMember member = 1;
+   */
+  member?: OrganizationMember;
+};
+
+/**
+ * Describes the message organization.v1.AddOrganizationMemberResponse.
+ * Use `create(AddOrganizationMemberResponseSchema)` to create a new message.
+ */
+export const AddOrganizationMemberResponseSchema: GenMessage<AddOrganizationMemberResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 13);
+
+/**
+ * @generated from message organization.v1.UpdateOrganizationMemberRequest
+ */
+export type UpdateOrganizationMemberRequest = Message<"organization.v1.UpdateOrganizationMemberRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * editor or viewer
+   *
+   * @generated from field: organization.v1.OrganizationRole role = 3;
+   */
+  role: OrganizationRole;
+};
+
+/**
+ * Describes the message organization.v1.UpdateOrganizationMemberRequest.
+ * Use `create(UpdateOrganizationMemberRequestSchema)` to create a new message.
+ */
+export const UpdateOrganizationMemberRequestSchema: GenMessage<UpdateOrganizationMemberRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 14);
+
+/**
+ * @generated from message organization.v1.UpdateOrganizationMemberResponse
+ */
+export type UpdateOrganizationMemberResponse = Message<"organization.v1.UpdateOrganizationMemberResponse"> & {
+  /**
+   * @generated from field: organization.v1.OrganizationMember member = 1;
+   */
+  member?: OrganizationMember;
+};
+
+/**
+ * Describes the message organization.v1.UpdateOrganizationMemberResponse.
+ * Use `create(UpdateOrganizationMemberResponseSchema)` to create a new message.
+ */
+export const UpdateOrganizationMemberResponseSchema: GenMessage<UpdateOrganizationMemberResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 15);
+
+/**
+ * @generated from message organization.v1.RemoveOrganizationMemberRequest
+ */
+export type RemoveOrganizationMemberRequest = Message<"organization.v1.RemoveOrganizationMemberRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message organization.v1.RemoveOrganizationMemberRequest.
+ * Use `create(RemoveOrganizationMemberRequestSchema)` to create a new message.
+ */
+export const RemoveOrganizationMemberRequestSchema: GenMessage<RemoveOrganizationMemberRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 16);
+
+/**
+ * @generated from message organization.v1.RemoveOrganizationMemberResponse
+ */
+export type RemoveOrganizationMemberResponse = Message<"organization.v1.RemoveOrganizationMemberResponse"> & {
+};
+
+/**
+ * Describes the message organization.v1.RemoveOrganizationMemberResponse.
+ * Use `create(RemoveOrganizationMemberResponseSchema)` to create a new message.
+ */
+export const RemoveOrganizationMemberResponseSchema: GenMessage<RemoveOrganizationMemberResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 17);
+
+/**
+ * @generated from message organization.v1.AcceptOrganizationInvitationRequest
+ */
+export type AcceptOrganizationInvitationRequest = Message<"organization.v1.AcceptOrganizationInvitationRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message organization.v1.AcceptOrganizationInvitationRequest.
+ * Use `create(AcceptOrganizationInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptOrganizationInvitationRequestSchema: GenMessage<AcceptOrganizationInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 18);
+
+/**
+ * @generated from message organization.v1.AcceptOrganizationInvitationResponse
+ */
+export type AcceptOrganizationInvitationResponse = Message<"organization.v1.AcceptOrganizationInvitationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.AcceptOrganizationInvitationResponse.
+ * Use `create(AcceptOrganizationInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptOrganizationInvitationResponseSchema: GenMessage<AcceptOrganizationInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 19);
+
+/**
+ * @generated from enum organization.v1.OrganizationRole
+ */
+export enum OrganizationRole {
+  /**
+   * not a member
+   *
+   * @generated from enum value: ORGANIZATION_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * manages the members
+   *
+   * @generated from enum value: ORGANIZATION_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * manages the prompts and instructions of the team
+   *
+   * @generated from enum value: ORGANIZATION_ROLE_EDITOR = 2;
+   */
+  EDITOR = 2,
+
+  /**
+   * uses them
+   *
+   * @generated from enum value: ORGANIZATION_ROLE_VIEWER = 3;
+   */
+  VIEWER = 3,
+}
+
+/**
+ * Describes the enum organization.v1.OrganizationRole.
+ */
+export const OrganizationRoleSchema: GenEnum<OrganizationRole> = /*@__PURE__*/
+  enumDesc(file_organization_v1_organization, 0);
+
+/**
+ * Organizations are teams, e.g. labs, whose members share a prompt library and default
+ * instructions.
+ *
+ * @generated from service organization.v1.OrganizationService
+ */
+export const OrganizationService: GenService<{
+  /**
+   * Lists the organizations of the user and the ones they are invited to.
+   *
+   * @generated from rpc organization.v1.OrganizationService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * Creates an organization owned by the user.
+   *
+   * @generated from rpc organization.v1.OrganizationService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * Renames an organization or sets its instructions, the owner and the editors can update it.
+   *
+   * @generated from rpc organization.v1.OrganizationService.UpdateOrganization
+   */
+  updateOrganization: {
+    methodKind: "unary";
+    input: typeof UpdateOrganizationRequestSchema;
+    output: typeof UpdateOrganizationResponseSchema;
+  },
+  /**
+   * Deletes an organization with its members and its prompts, only the owner deletes it.
+   *
+   * @generated from rpc organization.v1.OrganizationService.DeleteOrganization
+   */
+  deleteOrganization: {
+    methodKind: "unary";
+    input: typeof DeleteOrganizationRequestSchema;
+    output: typeof DeleteOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.ListOrganizationMembers
+   */
+  listOrganizationMembers: {
+    methodKind: "unary";
+    input: typeof ListOrganizationMembersRequestSchema;
+    output: typeof ListOrganizationMembersResponseSchema;
+  },
+  /**
+   * Invites a user to the organization by email, only the owner invites members. The user becomes
+   * a member, and the instructions of the organization apply to their conversations, once they
+   * accept with AcceptOrganizationInvitation. They decline with RemoveOrganizationMember.
+   *
+   * @generated from rpc organization.v1.OrganizationService.AddOrganizationMember
+   */
+  addOrganizationMember: {
+    methodKind: "unary";
+    input: typeof AddOrganizationMemberRequestSchema;
+    output: typeof AddOrganizationMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.UpdateOrganizationMember
+   */
+  updateOrganizationMember: {
+    methodKind: "unary";
+    input: typeof UpdateOrganizationMemberRequestSchema;
+    output: typeof UpdateOrganizationMemberResponseSchema;
+  },
+  /**
+   * Removes a member or an invitation, the users can remove themselves to leave the organization
+   * or decline the invitation.
+   *
+   * @generated from rpc organization.v1.OrganizationService.RemoveOrganizationMember
+   */
+  removeOrganizationMember: {
+    methodKind: "unary";
+    input: typeof RemoveOrganizationMemberRequestSchema;
+    output: typeof RemoveOrganizationMemberResponseSchema;
+  },
+  /**
+   * Accepts the invitation of the user to the organization.
+   *
+   * @generated from rpc organization.v1.OrganizationService.AcceptOrganizationInvitation
+   */
+  acceptOrganizationInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptOrganizationInvitationRequestSchema;
+    output: typeof AcceptOrganizationInvitationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_organization_v1_organization, 0);
+
@@ -2,8 +2,8 @@
 // @generated from file project/v1/project.proto (package project.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_google_api_annotations } from "@buf/googleapis_googleapis.bufbuild_es/google/api/annotations_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file project/v1/project.proto.
  */
 export const file_project_v1_project: GenFile = /*@__PURE__*/
-  fileDesc("Chhwcm9qZWN0L3YxL3Byb2plY3QucHJvdG8SCnByb2plY3QudjEivgEKB1Byb2plY3QSCgoCaWQYASABKAkSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEbmFtZRgEIAEoCRITCgtyb290X2RvY19pZBgFIAEoCRIkCgRkb2NzGAYgAygLMhYucHJvamVjdC52MS5Qcm9qZWN0RG9jIkoKClByb2plY3REb2MSCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoBRIQCghmaWxlcGF0aBgDIAEoCRINCgVsaW5lcxgEIAMoCSJzChRVcHNlcnRQcm9qZWN0UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLcm9vdF9kb2NfaWQYAyABKAkSJAoEZG9jcxgEIAMoCzIWLnByb2plY3QudjEuUHJvamVjdERvYyI9ChVVcHNlcnRQcm9qZWN0UmVzcG9uc2USJAoHcHJvamVjdBgBIAEoCzITLnByb2plY3QudjEuUHJvamVjdCInChFHZXRQcm9qZWN0UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJIjoKEkdldFByb2plY3RSZXNwb25zZRIkCgdwcm9qZWN0GAEgASgLMhMucHJvamVjdC52MS5Qcm9qZWN0IkoKG1J1blByb2plY3RQYXBlclNjb3JlUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhcKD2NvbnZlcnNhdGlvbl9pZBgCIAEoCSJlChxSdW5Qcm9qZWN0UGFwZXJTY29yZVJlc3BvbnNlEhIKCnByb2plY3RfaWQYASABKAkSMQoLcGFwZXJfc2NvcmUYAiABKAsyHC5wcm9qZWN0LnYxLlBhcGVyU2NvcmVSZXN1bHQiUQoiUnVuUHJvamVjdFBhcGVyU2NvcmVDb21tZW50UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhcKD2NvbnZlcnNhdGlvbl9pZBgCIAEoCSJwCiNSdW5Qcm9qZWN0UGFwZXJTY29yZUNvbW1lbnRSZXNwb25zZRISCgpwcm9qZWN0X2lkGAEgASgJEjUKCGNvbW1lbnRzGAIgAygLMiMucHJvamVjdC52MS5QYXBlclNjb3JlQ29tbWVudFJlc3VsdCKBAQogUnVuUHJvamVjdE92ZXJsZWFmQ29tbWVudFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIPCgdzZWN0aW9uGAIgASgJEhMKC2FuY2hvcl90ZXh0GAMgASgJEg8KB2NvbW1lbnQYBCABKAkSEgoKaW1wb3J0YW5jZRgFIAEoCSJmCiFSdW5Qcm9qZWN0T3ZlcmxlYWZDb21tZW50UmVzcG9uc2USEgoKcHJvamVjdF9pZBgBIAEoCRItCghjb21tZW50cxgCIAMoCzIbLnByb2plY3QudjEuT3ZlcmxlYWZDb21tZW50IuQBCg9PdmVybGVhZkNvbW1lbnQSEgoKY29tbWVudF9pZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg4KBmRvY19pZBgDIAEoCRITCgtkb2NfdmVyc2lvbhgEIAEoBRIQCghkb2Nfc2hhMRgFIAEoCRIWCg5xdW90ZV9wb3NpdGlvbhgGIAEoBRISCgpxdW90ZV90ZXh0GAcgASgJEg8KB2NvbW1lbnQYCCABKAkSEgoKaW1wb3J0YW5jZRgJIAEoCRIQCghkb2NfcGF0aBgKIAEoCRIPCgdzZWN0aW9uGAsgASgJIk4KF1BhcGVyU2NvcmVDb21tZW50UmVzdWx0EjMKB3Jlc3VsdHMYASADKAsyIi5wcm9qZWN0LnYxLlBhcGVyU2NvcmVDb21tZW50RW50cnkiYwoWUGFwZXJTY29yZUNvbW1lbnRFbnRyeRIPCgdzZWN0aW9uGAEgASgJEhIKCmFuY2hvclRleHQYAiABKAkSEAoId2Vha25lc3MYAyABKAkSEgoKaW1wb3J0YW5jZRgEIAEoCSK1AgoQUGFwZXJTY29yZVJlc3VsdBINCgVzY29yZRgBIAEoAhISCgpwZXJjZW50aWxlGAIgASgCEjoKB2RldGFpbHMYAyADKAsyKS5wcm9qZWN0LnYxLlBhcGVyU2NvcmVSZXN1bHQuRGV0YWlsc0VudHJ5EkIKC3N1Z2dlc3Rpb25zGAQgAygLMi0ucHJvamVjdC52MS5QYXBlclNjb3JlUmVzdWx0LlN1Z2dlc3Rpb25zRW50cnkaLgoMRGV0YWlsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaTgoQU3VnZ2VzdGlvbnNFbnRyeRILCgNrZXkYASABKAkSKQoFdmFsdWUYAiABKAsyGi5wcm9qZWN0LnYxLlN1Z2dlc3Rpb25MaXN0OgI4ASIlCg5TdWdnZXN0aW9uTGlzdBITCgtzdWdnZXN0aW9ucxgBIAMoCSIzCh1HZXRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJIkoKHkdldFByb2plY3RJbnN0cnVjdGlvbnNSZXNwb25zZRISCgpwcm9qZWN0X2lkGAEgASgJEhQKDGluc3RydWN0aW9ucxgCIAEoCSJMCiBVcHNlcnRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhQKDGluc3RydWN0aW9ucxgCIAEoCSJNCiFVcHNlcnRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVzcG9uc2USEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxpbnN0cnVjdGlvbnMYAiABKAkykAkKDlByb2plY3RTZXJ2aWNlEoIBCg1VcHNlcnRQcm9qZWN0EiAucHJvamVjdC52MS5VcHNlcnRQcm9qZWN0UmVxdWVzdBohLnByb2plY3QudjEuVXBzZXJ0UHJvamVjdFJlc3BvbnNlIiyC0+STAiY6ASoaIS9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfRJ2CgpHZXRQcm9qZWN0Eh0ucHJvamVjdC52MS5HZXRQcm9qZWN0UmVxdWVzdBoeLnByb2plY3QudjEuR2V0UHJvamVjdFJlc3BvbnNlIimC0+STAiMSIS9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfRKjAQoUUnVuUHJvamVjdFBhcGVyU2NvcmUSJy5wcm9qZWN0LnYxLlJ1blByb2plY3RQYXBlclNjb3JlUmVxdWVzdBooLnByb2plY3QudjEuUnVuUHJvamVjdFBhcGVyU2NvcmVSZXNwb25zZSI4gtPkkwIyOgEqIi0vX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vcGFwZXItc2NvcmUSwAEKG1J1blByb2plY3RQYXBlclNjb3JlQ29tbWVudBIuLnByb2plY3QudjEuUnVuUHJvamVjdFBhcGVyU2NvcmVDb21tZW50UmVxdWVzdBovLnByb2plY3QudjEuUnVuUHJvamVjdFBhcGVyU2NvcmVDb21tZW50UmVzcG9uc2UiQILT5JMCOjoBKiI1L19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L3BhcGVyLXNjb3JlLWNvbW1lbnQStwEKGVJ1blByb2plY3RPdmVybGVhZkNvbW1lbnQSLC5wcm9qZWN0LnYxLlJ1blByb2plY3RPdmVybGVhZkNvbW1lbnRSZXF1ZXN0Gi0ucHJvamVjdC52MS5SdW5Qcm9qZWN0T3ZlcmxlYWZDb21tZW50UmVzcG9uc2UiPYLT5JMCNzoBKiIyL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L292ZXJsZWFmLWNvbW1lbnQSpwEKFkdldFByb2plY3RJbnN0cnVjdGlvbnMSKS5wcm9qZWN0LnYxLkdldFByb2plY3RJbnN0cnVjdGlvbnNSZXF1ZXN0GioucHJvamVjdC52MS5HZXRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVzcG9uc2UiNoLT5JMCMBIuL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L2luc3RydWN0aW9ucxKzAQoZVXBzZXJ0UHJvamVjdEluc3RydWN0aW9ucxIsLnByb2plY3QudjEuVXBzZXJ0UHJvamVjdEluc3RydWN0aW9uc1JlcXVlc3QaLS5wcm9qZWN0LnYxLlVwc2VydFByb2plY3RJbnN0cnVjdGlvbnNSZXNwb25zZSI5gtPkkwIzOgEqIi4vX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vaW5zdHJ1Y3Rpb25zQpcBCg5jb20ucHJvamVjdC52MUIMUHJvamVjdFByb3RvUAFaLnBhcGVyZGVidWdnZXIvcGtnL2dlbi9hcGkvcHJvamVjdC92MTtwcm9qZWN0djGiAgNQWFiqAgpQcm9qZWN0LlYxygIKUHJvamVjdFxWMeICFlByb2plY3RcVjFcR1BCTWV0YWRhdGHqAgtQcm9qZWN0OjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_protobuf_timestamp]);
+  fileDesc("Chhwcm9qZWN0L3YxL3Byb2plY3QucHJvdG8SCnByb2plY3QudjEi1wEKB1Byb2plY3QSCgoCaWQYASABKAkSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEbmFtZRgEIAEoCRITCgtyb290X2RvY19pZBgFIAEoCRIkCgRkb2NzGAYgAygLMhYucHJvamVjdC52MS5Qcm9qZWN0RG9jEhcKD2RlZmF1bHRfcGVyc29uYRgHIAEoCSJKCgpQcm9qZWN0RG9jEgoKAmlkGAEgASgJEg8KB3ZlcnNpb24YAiABKAUSEAoIZmlsZXBhdGgYAyABKAkSDQoFbGluZXMYBCADKAkicwoUVXBzZXJ0UHJvamVjdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC3Jvb3RfZG9jX2lkGAMgASgJEiQKBGRvY3MYBCADKAsyFi5wcm9qZWN0LnYxLlByb2plY3REb2MiPQoVVXBzZXJ0UHJvamVjdFJlc3BvbnNlEiQKB3Byb2plY3QYASABKAsyEy5wcm9qZWN0LnYxLlByb2plY3QiJwoRR2V0UHJvamVjdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSI6ChJHZXRQcm9qZWN0UmVzcG9uc2USJAoHcHJvamVjdBgBIAEoCzITLnByb2plY3QudjEuUHJvamVjdCJKChtSdW5Qcm9qZWN0UGFwZXJTY29yZVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIXCg9jb252ZXJzYXRpb25faWQYAiABKAkiowEKHFJ1blByb2plY3RQYXBlclNjb3JlUmVzcG9uc2USEgoKcHJvamVjdF9pZBgBIAEoCRIxCgtwYXBlcl9zY29yZRgCIAEoCzIcLnByb2plY3QudjEuUGFwZXJTY29yZVJlc3VsdBIsCgZyZWNvcmQYAyABKAsyHC5wcm9qZWN0LnYxLlBhcGVyU2NvcmVSZWNvcmQSDgoGcmV1c2VkGAQgASgIIsIBChBQYXBlclNjb3JlUmVjb3JkEgoKAmlkGAEgASgJEi4KCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKC3BhcGVyX3Njb3JlGAMgASgLMhwucHJvamVjdC52MS5QYXBlclNjb3JlUmVzdWx0EhAKCGNhdGVnb3J5GAQgASgJEhQKDGNvbnRlbnRfaGFzaBgFIAEoCRIXCg9jb252ZXJzYXRpb25faWQYBiABKAkiQgodTGlzdFByb2plY3RQYXBlclNjb3Jlc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRINCgVsaW1pdBgCIAEoBSJUCh5MaXN0UHJvamVjdFBhcGVyU2NvcmVzUmVzcG9uc2USMgoMcGFwZXJfc2NvcmVzGAEgAygLMhwucHJvamVjdC52MS5QYXBlclNjb3JlUmVjb3JkIlEKIlJ1blByb2plY3RQYXBlclNjb3JlQ29tbWVudFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIXCg9jb252ZXJzYXRpb25faWQYAiABKAkiqAEKI1J1blByb2plY3RQYXBlclNjb3JlQ29tbWVudFJlc3BvbnNlEhIKCnByb2plY3RfaWQYASABKAkSNQoIY29tbWVudHMYAiADKAsyIy5wcm9qZWN0LnYxLlBhcGVyU2NvcmVDb21tZW50UmVzdWx0EjYKEW92ZXJsZWFmX2NvbW1lbnRzGAMgAygLMhsucHJvamVjdC52MS5PdmVybGVhZkNvbW1lbnQigQEKIFJ1blByb2plY3RPdmVybGVhZkNvbW1lbnRSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDwoHc2VjdGlvbhgCIAEoCRITCgthbmNob3JfdGV4dBgDIAEoCRIPCgdjb21tZW50GAQgASgJEhIKCmltcG9ydGFuY2UYBSABKAkiZgohUnVuUHJvamVjdE92ZXJsZWFmQ29tbWVudFJlc3BvbnNlEhIKCnByb2plY3RfaWQYASABKAkSLQoIY29tbWVudHMYAiADKAsyGy5wcm9qZWN0LnYxLk92ZXJsZWFmQ29tbWVudCLkAQoPT3ZlcmxlYWZDb21tZW50EhIKCmNvbW1lbnRfaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRIOCgZkb2NfaWQYAyABKAkSEwoLZG9jX3ZlcnNpb24YBCABKAUSEAoIZG9jX3NoYTEYBSABKAkSFgoOcXVvdGVfcG9zaXRpb24YBiABKAUSEgoKcXVvdGVfdGV4dBgHIAEoCRIPCgdjb21tZW50GAggASgJEhIKCmltcG9ydGFuY2UYCSABKAkSEAoIZG9jX3BhdGgYCiABKAkSDwoHc2VjdGlvbhgLIAEoCSJOChdQYXBlclNjb3JlQ29tbWVudFJlc3VsdBIzCgdyZXN1bHRzGAEgAygLMiIucHJvamVjdC52MS5QYXBlclNjb3JlQ29tbWVudEVudHJ5ImMKFlBhcGVyU2NvcmVDb21tZW50RW50cnkSDwoHc2VjdGlvbhgBIAEoCRISCgphbmNob3JUZXh0GAIgASgJEhAKCHdlYWtuZXNzGAMgASgJEhIKCmltcG9ydGFuY2UYBCABKAkitQIKEFBhcGVyU2NvcmVSZXN1bHQSDQoFc2NvcmUYASABKAISEgoKcGVyY2VudGlsZRgCIAEoAhI6CgdkZXRhaWxzGAMgAygLMikucHJvamVjdC52MS5QYXBlclNjb3JlUmVzdWx0LkRldGFpbHNFbnRyeRJCCgtzdWdnZXN0aW9ucxgEIAMoCzItLnByb2plY3QudjEuUGFwZXJTY29yZVJlc3VsdC5TdWdnZXN0aW9uc0VudHJ5Gi4KDERldGFpbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGk4KEFN1Z2dlc3Rpb25zRW50cnkSCwoDa2V5GAEgASgJEikKBXZhbHVlGAIgASgLMhoucHJvamVjdC52MS5TdWdnZXN0aW9uTGlzdDoCOAEiJQoOU3VnZ2VzdGlvbkxpc3QSEwoLc3VnZ2VzdGlvbnMYASADKAkiMwodR2V0UHJvamVjdEluc3RydWN0aW9uc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSJKCh5HZXRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVzcG9uc2USEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxpbnN0cnVjdGlvbnMYAiABKAkiTAogVXBzZXJ0UHJvamVjdEluc3RydWN0aW9uc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIUCgxpbnN0cnVjdGlvbnMYAiABKAkiTQohVXBzZXJ0UHJvamVjdEluc3RydWN0aW9uc1Jlc3BvbnNlEhIKCnByb2plY3RfaWQYASABKAkSFAoMaW5zdHJ1Y3Rpb25zGAIgASgJIkoKG1VwZGF0ZVByb2plY3RQZXJzb25hUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhcKD2RlZmF1bHRfcGVyc29uYRgCIAEoCSJLChxVcGRhdGVQcm9qZWN0UGVyc29uYVJlc3BvbnNlEhIKCnByb2plY3RfaWQYASABKAkSFwoPZGVmYXVsdF9wZXJzb25hGAIgASgJIqUBCg1Qcm9qZWN0TWVtYmVyEg8KB3VzZXJfaWQYASABKAkSDQoFZW1haWwYAiABKAkSDAoEbmFtZRgDIAEoCRIPCgdwaWN0dXJlGAQgASgJEiUKBHJvbGUYBSABKA4yFy5wcm9qZWN0LnYxLlByb2plY3RSb2xlEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIi8KGUxpc3RQcm9qZWN0TWVtYmVyc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSJyChpMaXN0UHJvamVjdE1lbWJlcnNSZXNwb25zZRIqCgdtZW1iZXJzGAEgAygLMhkucHJvamVjdC52MS5Qcm9qZWN0TWVtYmVyEigKB215X3JvbGUYAiABKA4yFy5wcm9qZWN0LnYxLlByb2plY3RSb2xlImMKF0FkZFByb2plY3RNZW1iZXJSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDQoFZW1haWwYAiABKAkSJQoEcm9sZRgDIAEoDjIXLnByb2plY3QudjEuUHJvamVjdFJvbGUiRQoYQWRkUHJvamVjdE1lbWJlclJlc3BvbnNlEikKBm1lbWJlchgBIAEoCzIZLnByb2plY3QudjEuUHJvamVjdE1lbWJlciJoChpVcGRhdGVQcm9qZWN0TWVtYmVyUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSJQoEcm9sZRgDIAEoDjIXLnByb2plY3QudjEuUHJvamVjdFJvbGUiSAobVXBkYXRlUHJvamVjdE1lbWJlclJlc3BvbnNlEikKBm1lbWJlchgBIAEoCzIZLnByb2plY3QudjEuUHJvamVjdE1lbWJlciJBChpSZW1vdmVQcm9qZWN0TWVtYmVyUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkiHQobUmVtb3ZlUHJvamVjdE1lbWJlclJlc3BvbnNlKnUKC1Byb2plY3RSb2xlEhwKGFBST0pFQ1RfUk9MRV9VTlNQRUNJRklFRBAAEhYKElBST0pFQ1RfUk9MRV9PV05FUhABEhcKE1BST0pFQ1RfUk9MRV9FRElUT1IQAhIXChNQUk9KRUNUX1JPTEVfVklFV0VSEAMy2hAKDlByb2plY3RTZXJ2aWNlEoIBCg1VcHNlcnRQcm9qZWN0EiAucHJvamVjdC52MS5VcHNlcnRQcm9qZWN0UmVxdWVzdBohLnByb2plY3QudjEuVXBzZXJ0UHJvamVjdFJlc3BvbnNlIiyC0+STAiY6ASoaIS9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfRJ2CgpHZXRQcm9qZWN0Eh0ucHJvamVjdC52MS5HZXRQcm9qZWN0UmVxdWVzdBoeLnByb2plY3QudjEuR2V0UHJvamVjdFJlc3BvbnNlIimC0+STAiMSIS9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfRKjAQoUUnVuUHJvamVjdFBhcGVyU2NvcmUSJy5wcm9qZWN0LnYxLlJ1blByb2plY3RQYXBlclNjb3JlUmVxdWVzdBooLnByb2plY3QudjEuUnVuUHJvamVjdFBhcGVyU2NvcmVSZXNwb25zZSI4gtPkkwIyOgEqIi0vX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vcGFwZXItc2NvcmUSpwEKFkxpc3RQcm9qZWN0UGFwZXJTY29yZXMSKS5wcm9qZWN0LnYxLkxpc3RQcm9qZWN0UGFwZXJTY29yZXNSZXF1ZXN0GioucHJvamVjdC52MS5MaXN0UHJvamVjdFBhcGVyU2NvcmVzUmVzcG9uc2UiNoLT5JMCMBIuL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L3BhcGVyLXNjb3JlcxLAAQobUnVuUHJvamVjdFBhcGVyU2NvcmVDb21tZW50Ei4ucHJvamVjdC52MS5SdW5Qcm9qZWN0UGFwZXJTY29yZUNvbW1lbnRSZXF1ZXN0Gi8ucHJvamVjdC52MS5SdW5Qcm9qZWN0UGFwZXJTY29yZUNvbW1lbnRSZXNwb25zZSJAgtPkkwI6OgEqIjUvX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vcGFwZXItc2NvcmUtY29tbWVudBK3AQoZUnVuUHJvamVjdE92ZXJsZWFmQ29tbWVudBIsLnByb2plY3QudjEuUnVuUHJvamVjdE92ZXJsZWFmQ29tbWVudFJlcXVlc3QaLS5wcm9qZWN0LnYxLlJ1blByb2plY3RPdmVybGVhZkNvbW1lbnRSZXNwb25zZSI9gtPkkwI3OgEqIjIvX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vb3ZlcmxlYWYtY29tbWVudBKnAQoWR2V0UHJvamVjdEluc3RydWN0aW9ucxIpLnByb2plY3QudjEuR2V0UHJvamVjdEluc3RydWN0aW9uc1JlcXVlc3QaKi5wcm9qZWN0LnYxLkdldFByb2plY3RJbnN0cnVjdGlvbnNSZXNwb25zZSI2gtPkkwIwEi4vX3BkL2FwaS92MS9wcm9qZWN0cy97cHJvamVjdF9pZH0vaW5zdHJ1Y3Rpb25zErMBChlVcHNlcnRQcm9qZWN0SW5zdHJ1Y3Rpb25zEiwucHJvamVjdC52MS5VcHNlcnRQcm9qZWN0SW5zdHJ1Y3Rpb25zUmVxdWVzdBotLnByb2plY3QudjEuVXBzZXJ0UHJvamVjdEluc3RydWN0aW9uc1Jlc3BvbnNlIjmC0+STAjM6ASoiLi9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfS9pbnN0cnVjdGlvbnMSnwEKFFVwZGF0ZVByb2plY3RQZXJzb25hEicucHJvamVjdC52MS5VcGRhdGVQcm9qZWN0UGVyc29uYVJlcXVlc3QaKC5wcm9qZWN0LnYxLlVwZGF0ZVByb2plY3RQZXJzb25hUmVzcG9uc2UiNILT5JMCLjoBKhopL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L3BlcnNvbmESlgEKEkxpc3RQcm9qZWN0TWVtYmVycxIlLnByb2plY3QudjEuTGlzdFByb2plY3RNZW1iZXJzUmVxdWVzdBomLnByb2plY3QudjEuTGlzdFByb2plY3RNZW1iZXJzUmVzcG9uc2UiMYLT5JMCKxIpL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L21lbWJlcnMSkwEKEEFkZFByb2plY3RNZW1iZXISIy5wcm9qZWN0LnYxLkFkZFByb2plY3RNZW1iZXJSZXF1ZXN0GiQucHJvamVjdC52MS5BZGRQcm9qZWN0TWVtYmVyUmVzcG9uc2UiNILT5JMCLjoBKiIpL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L21lbWJlcnMSpgEKE1VwZGF0ZVByb2plY3RNZW1iZXISJi5wcm9qZWN0LnYxLlVwZGF0ZVByb2plY3RNZW1iZXJSZXF1ZXN0GicucHJvamVjdC52MS5VcGRhdGVQcm9qZWN0TWVtYmVyUmVzcG9uc2UiPoLT5JMCODoBKjIzL19wZC9hcGkvdjEvcHJvamVjdHMve3Byb2plY3RfaWR9L21lbWJlcnMve3VzZXJfaWR9EqMBChNSZW1vdmVQcm9qZWN0TWVtYmVyEiYucHJvamVjdC52MS5SZW1vdmVQcm9qZWN0TWVtYmVyUmVxdWVzdBonLnByb2plY3QudjEuUmVtb3ZlUHJvamVjdE1lbWJlclJlc3BvbnNlIjuC0+STAjUqMy9fcGQvYXBpL3YxL3Byb2plY3RzL3twcm9qZWN0X2lkfS9tZW1iZXJzL3t1c2VyX2lkfUKXAQoOY29tLnByb2plY3QudjFCDFByb2plY3RQcm90b1ABWi5wYXBlcmRlYnVnZ2VyL3BrZy9nZW4vYXBpL3Byb2plY3QvdjE7cHJvamVjdHYxogIDUFhYqgIKUHJvamVjdC5WMcoCClByb2plY3RcVjHiAhZQcm9qZWN0XFYxXEdQQk1ldGFkYXRh6gILUHJvamVjdDo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message project.v1.Project
@@ -48,6 +48,14 @@ export type Project = Message<"project.v1.Project"> & {
    * @generated from field: repeated project.v1.ProjectDoc docs = 6;
    */
   docs: ProjectDoc[];
+
+  /**
+   * The persona of the new conversations: "reviewer", "translator", "proofreader" or
+   * "rebuttal_writer", empty for the default one.
+   *
+   * @generated from field: string default_persona = 7;
+   */
+  defaultPersona: string;
 };
 
 /**
@@ -209,6 +217,18 @@ export type RunProjectPaperScoreResponse = Message<"project.v1.RunProjectPaperSc
    * @generated from field: project.v1.PaperScoreResult paper_score = 2;
    */
   paperScore?: PaperScoreResult;
+
+  /**
+   * @generated from field: project.v1.PaperScoreRecord record = 3;
+   */
+  record?: PaperScoreRecord;
+
+  /**
+   * the paper did not change since its last score, which is returned
+   *
+   * @generated from field: bool reused = 4;
+   */
+  reused: boolean;
 };
 
 /**
@@ -218,6 +238,97 @@ export type RunProjectPaperScoreResponse = Message<"project.v1.RunProjectPaperSc
 export const RunProjectPaperScoreResponseSchema: GenMessage<RunProjectPaperScoreResponse> = /*@__PURE__*/
   messageDesc(file_project_v1_project, 7);
 
+/**
+ * PaperScoreRecord is a score of the project, kept to follow the score across revisions.
+ *
+ * @generated from message project.v1.PaperScoreRecord
+ */
+export type PaperScoreRecord = Message<"project.v1.PaperScoreRecord"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: project.v1.PaperScoreResult paper_score = 3;
+   */
+  paperScore?: PaperScoreResult;
+
+  /**
+   * @generated from field: string category = 4;
+   */
+  category: string;
+
+  /**
+   * sha256 of the scored content, the same for unchanged revisions
+   *
+   * @generated from field: string content_hash = 5;
+   */
+  contentHash: string;
+
+  /**
+   * if it was requested from a conversation
+   *
+   * @generated from field: string conversation_id = 6;
+   */
+  conversationId: string;
+};
+
+/**
+ * Describes the message project.v1.PaperScoreRecord.
+ * Use `create(PaperScoreRecordSchema)` to create a new message.
+ */
+export const PaperScoreRecordSchema: GenMessage<PaperScoreRecord> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 8);
+
+/**
+ * @generated from message project.v1.ListProjectPaperScoresRequest
+ */
+export type ListProjectPaperScoresRequest = Message<"project.v1.ListProjectPaperScoresRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * defaults to 50, at most 200
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message project.v1.ListProjectPaperScoresRequest.
+ * Use `create(ListProjectPaperScoresRequestSchema)` to create a new message.
+ */
+export const ListProjectPaperScoresRequestSchema: GenMessage<ListProjectPaperScoresRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 9);
+
+/**
+ * @generated from message project.v1.ListProjectPaperScoresResponse
+ */
+export type ListProjectPaperScoresResponse = Message<"project.v1.ListProjectPaperScoresResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated project.v1.PaperScoreRecord paper_scores = 1;
+   */
+  paperScores: PaperScoreRecord[];
+};
+
+/**
+ * Describes the message project.v1.ListProjectPaperScoresResponse.
+ * Use `create(ListProjectPaperScoresResponseSchema)` to create a new message.
+ */
+export const ListProjectPaperScoresResponseSchema: GenMessage<ListProjectPaperScoresResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 10);
+
 /**
  * Paper score comment
  *
@@ -240,7 +351,7 @@ export type RunProjectPaperScoreCommentRequest = Message<"project.v1.RunProjectP
  * Use `create(RunProjectPaperScoreCommentRequestSchema)` to create a new message.
  */
 export const RunProjectPaperScoreCommentRequestSchema: GenMessage<RunProjectPaperScoreCommentRequest> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 8);
+  messageDesc(file_project_v1_project, 11);
 
 /**
  * @generated from message project.v1.RunProjectPaperScoreCommentResponse
@@ -255,6 +366,13 @@ export type RunProjectPaperScoreCommentResponse = Message<"project.v1.RunProject
    * @generated from field: repeated project.v1.PaperScoreCommentResult comments = 2;
    */
   comments: PaperScoreCommentResult[];
+
+  /**
+   * the comments anchored in the project documents
+   *
+   * @generated from field: repeated project.v1.OverleafComment overleaf_comments = 3;
+   */
+  overleafComments: OverleafComment[];
 };
 
 /**
@@ -262,7 +380,7 @@ export type RunProjectPaperScoreCommentResponse = Message<"project.v1.RunProject
  * Use `create(RunProjectPaperScoreCommentResponseSchema)` to create a new message.
  */
 export const RunProjectPaperScoreCommentResponseSchema: GenMessage<RunProjectPaperScoreCommentResponse> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 9);
+  messageDesc(file_project_v1_project, 12);
 
 /**
  * Overleaf comment
@@ -301,7 +419,7 @@ export type RunProjectOverleafCommentRequest = Message<"project.v1.RunProjectOve
  * Use `create(RunProjectOverleafCommentRequestSchema)` to create a new message.
  */
 export const RunProjectOverleafCommentRequestSchema: GenMessage<RunProjectOverleafCommentRequest> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 10);
+  messageDesc(file_project_v1_project, 13);
 
 /**
  * @generated from message project.v1.RunProjectOverleafCommentResponse
@@ -323,7 +441,7 @@ export type RunProjectOverleafCommentResponse = Message<"project.v1.RunProjectOv
  * Use `create(RunProjectOverleafCommentResponseSchema)` to create a new message.
  */
 export const RunProjectOverleafCommentResponseSchema: GenMessage<RunProjectOverleafCommentResponse> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 11);
+  messageDesc(file_project_v1_project, 14);
 
 /**
  * @generated from message project.v1.OverleafComment
@@ -392,7 +510,7 @@ export type OverleafComment = Message<"project.v1.OverleafComment"> & {
  * Use `create(OverleafCommentSchema)` to create a new message.
  */
 export const OverleafCommentSchema: GenMessage<OverleafComment> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 12);
+  messageDesc(file_project_v1_project, 15);
 
 /**
  * @generated from message project.v1.PaperScoreCommentResult
@@ -409,7 +527,7 @@ export type PaperScoreCommentResult = Message<"project.v1.PaperScoreCommentResul
  * Use `create(PaperScoreCommentResultSchema)` to create a new message.
  */
 export const PaperScoreCommentResultSchema: GenMessage<PaperScoreCommentResult> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 13);
+  messageDesc(file_project_v1_project, 16);
 
 /**
  * @generated from message project.v1.PaperScoreCommentEntry
@@ -445,7 +563,7 @@ export type PaperScoreCommentEntry = Message<"project.v1.PaperScoreCommentEntry"
  * Use `create(PaperScoreCommentEntrySchema)` to create a new message.
  */
 export const PaperScoreCommentEntrySchema: GenMessage<PaperScoreCommentEntry> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 14);
+  messageDesc(file_project_v1_project, 17);
 
 /**
  * @generated from message project.v1.PaperScoreResult
@@ -477,7 +595,7 @@ export type PaperScoreResult = Message<"project.v1.PaperScoreResult"> & {
  * Use `create(PaperScoreResultSchema)` to create a new message.
  */
 export const PaperScoreResultSchema: GenMessage<PaperScoreResult> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 15);
+  messageDesc(file_project_v1_project, 18);
 
 /**
  * @generated from message project.v1.SuggestionList
@@ -494,7 +612,7 @@ export type SuggestionList = Message<"project.v1.SuggestionList"> & {
  * Use `create(SuggestionListSchema)` to create a new message.
  */
 export const SuggestionListSchema: GenMessage<SuggestionList> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 16);
+  messageDesc(file_project_v1_project, 19);
 
 /**
  * Instructions
@@ -513,7 +631,7 @@ export type GetProjectInstructionsRequest = Message<"project.v1.GetProjectInstru
  * Use `create(GetProjectInstructionsRequestSchema)` to create a new message.
  */
 export const GetProjectInstructionsRequestSchema: GenMessage<GetProjectInstructionsRequest> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 17);
+  messageDesc(file_project_v1_project, 20);
 
 /**
  * @generated from message project.v1.GetProjectInstructionsResponse
@@ -535,7 +653,7 @@ export type GetProjectInstructionsResponse = Message<"project.v1.GetProjectInstr
  * Use `create(GetProjectInstructionsResponseSchema)` to create a new message.
  */
 export const GetProjectInstructionsResponseSchema: GenMessage<GetProjectInstructionsResponse> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 18);
+  messageDesc(file_project_v1_project, 21);
 
 /**
  * @generated from message project.v1.UpsertProjectInstructionsRequest
@@ -557,7 +675,7 @@ export type UpsertProjectInstructionsRequest = Message<"project.v1.UpsertProject
  * Use `create(UpsertProjectInstructionsRequestSchema)` to create a new message.
  */
 export const UpsertProjectInstructionsRequestSchema: GenMessage<UpsertProjectInstructionsRequest> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 19);
+  messageDesc(file_project_v1_project, 22);
 
 /**
  * @generated from message project.v1.UpsertProjectInstructionsResponse
@@ -579,7 +697,310 @@ export type UpsertProjectInstructionsResponse = Message<"project.v1.UpsertProjec
  * Use `create(UpsertProjectInstructionsResponseSchema)` to create a new message.
  */
 export const UpsertProjectInstructionsResponseSchema: GenMessage<UpsertProjectInstructionsResponse> = /*@__PURE__*/
-  messageDesc(file_project_v1_project, 20);
+  messageDesc(file_project_v1_project, 23);
+
+/**
+ * Persona
+ *
+ * @generated from message project.v1.UpdateProjectPersonaRequest
+ */
+export type UpdateProjectPersonaRequest = Message<"project.v1.UpdateProjectPersonaRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * empty for the default one
+   *
+   * @generated from field: string default_persona = 2;
+   */
+  defaultPersona: string;
+};
+
+/**
+ * Describes the message project.v1.UpdateProjectPersonaRequest.
+ * Use `create(UpdateProjectPersonaRequestSchema)` to create a new message.
+ */
+export const UpdateProjectPersonaRequestSchema: GenMessage<UpdateProjectPersonaRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 24);
+
+/**
+ * @generated from message project.v1.UpdateProjectPersonaResponse
+ */
+export type UpdateProjectPersonaResponse = Message<"project.v1.UpdateProjectPersonaResponse"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string default_persona = 2;
+   */
+  defaultPersona: string;
+};
+
+/**
+ * Describes the message project.v1.UpdateProjectPersonaResponse.
+ * Use `create(UpdateProjectPersonaResponseSchema)` to create a new message.
+ */
+export const UpdateProjectPersonaResponseSchema: GenMessage<UpdateProjectPersonaResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 25);
+
+/**
+ * @generated from message project.v1.ProjectMember
+ */
+export type ProjectMember = Message<"project.v1.ProjectMember"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * empty if the account was deleted
+   *
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string picture = 4;
+   */
+  picture: string;
+
+  /**
+   * @generated from field: project.v1.ProjectRole role = 5;
+   */
+  role: ProjectRole;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message project.v1.ProjectMember.
+ * Use `create(ProjectMemberSchema)` to create a new message.
+ */
+export const ProjectMemberSchema: GenMessage<ProjectMember> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 26);
+
+/**
+ * @generated from message project.v1.ListProjectMembersRequest
+ */
+export type ListProjectMembersRequest = Message<"project.v1.ListProjectMembersRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message project.v1.ListProjectMembersRequest.
+ * Use `create(ListProjectMembersRequestSchema)` to create a new message.
+ */
+export const ListProjectMembersRequestSchema: GenMessage<ListProjectMembersRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 27);
+
+/**
+ * @generated from message project.v1.ListProjectMembersResponse
+ */
+export type ListProjectMembersResponse = Message<"project.v1.ListProjectMembersResponse"> & {
+  /**
+   * the owner first
+   *
+   * @generated from field: repeated project.v1.ProjectMember members = 1;
+   */
+  members: ProjectMember[];
+
+  /**
+   * @generated from field: project.v1.ProjectRole my_role = 2;
+   */
+  myRole: ProjectRole;
+};
+
+/**
+ * Describes the message project.v1.ListProjectMembersResponse.
+ * Use `create(ListProjectMembersResponseSchema)` to create a new message.
+ */
+export const ListProjectMembersResponseSchema: GenMessage<ListProjectMembersResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 28);
+
+/**
+ * @generated from message project.v1.AddProjectMemberRequest
+ */
+export type AddProjectMemberRequest = Message<"project.v1.AddProjectMemberRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * the user must have signed in to PaperDebugger once
+   *
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * editor or viewer
+   *
+   * @generated from field: project.v1.ProjectRole role = 3;
+   */
+  role: ProjectRole;
+};
+
+/**
+ * Describes the message project.v1.AddProjectMemberRequest.
+ * Use `create(AddProjectMemberRequestSchema)` to create a new message.
+ */
+export const AddProjectMemberRequestSchema: GenMessage<AddProjectMemberRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 29);
+
+/**
+ * @generated from message project.v1.AddProjectMemberResponse
+ */
+export type AddProjectMemberResponse = Message<"project.v1.AddProjectMemberResponse"> & {
+  /**
+   * @generated from field: project.v1.ProjectMember member = 1;
+   */
+  member?: ProjectMember;
+};
+
+/**
+ * Describes the message project.v1.AddProjectMemberResponse.
+ * Use `create(AddProjectMemberResponseSchema)` to create a new message.
+ */
+export const AddProjectMemberResponseSchema: GenMessage<AddProjectMemberResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 30);
+
+/**
+ * @generated from message project.v1.UpdateProjectMemberRequest
+ */
+export type UpdateProjectMemberRequest = Message<"project.v1.UpdateProjectMemberRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * editor or viewer
+   *
+   * @generated from field: project.v1.ProjectRole role = 3;
+   */
+  role: ProjectRole;
+};
+
+/**
+ * Describes the message project.v1.UpdateProjectMemberRequest.
+ * Use `create(UpdateProjectMemberRequestSchema)` to create a new message.
+ */
+export const UpdateProjectMemberRequestSchema: GenMessage<UpdateProjectMemberRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 31);
+
+/**
+ * @generated from message project.v1.UpdateProjectMemberResponse
+ */
+export type UpdateProjectMemberResponse = Message<"project.v1.UpdateProjectMemberResponse"> & {
+  /**
+   * @generated from field: project.v1.ProjectMember member = 1;
+   */
+  member?: ProjectMember;
+};
+
+/**
+ * Describes the message project.v1.UpdateProjectMemberResponse.
+ * Use `create(UpdateProjectMemberResponseSchema)` to create a new message.
+ */
+export const UpdateProjectMemberResponseSchema: GenMessage<UpdateProjectMemberResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 32);
+
+/**
+ * @generated from message project.v1.RemoveProjectMemberRequest
+ */
+export type RemoveProjectMemberRequest = Message<"project.v1.RemoveProjectMemberRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message project.v1.RemoveProjectMemberRequest.
+ * Use `create(RemoveProjectMemberRequestSchema)` to create a new message.
+ */
+export const RemoveProjectMemberRequestSchema: GenMessage<RemoveProjectMemberRequest> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 33);
+
+/**
+ * @generated from message project.v1.RemoveProjectMemberResponse
+ */
+export type RemoveProjectMemberResponse = Message<"project.v1.RemoveProjectMemberResponse"> & {
+};
+
+/**
+ * Describes the message project.v1.RemoveProjectMemberResponse.
+ * Use `create(RemoveProjectMemberResponseSchema)` to create a new message.
+ */
+export const RemoveProjectMemberResponseSchema: GenMessage<RemoveProjectMemberResponse> = /*@__PURE__*/
+  messageDesc(file_project_v1_project, 34);
+
+/**
+ * Members
+ *
+ * @generated from enum project.v1.ProjectRole
+ */
+export enum ProjectRole {
+  /**
+   * not a member
+   *
+   * @generated from enum value: PROJECT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * manages the members
+   *
+   * @generated from enum value: PROJECT_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * shares conversations and accepts the comments of shared ones
+   *
+   * @generated from enum value: PROJECT_ROLE_EDITOR = 2;
+   */
+  EDITOR = 2,
+
+  /**
+   * reads the shared conversations
+   *
+   * @generated from enum value: PROJECT_ROLE_VIEWER = 3;
+   */
+  VIEWER = 3,
+}
+
+/**
+ * Describes the enum project.v1.ProjectRole.
+ */
+export const ProjectRoleSchema: GenEnum<ProjectRole> = /*@__PURE__*/
+  enumDesc(file_project_v1_project, 0);
 
 /**
  * @generated from service project.v1.ProjectService
@@ -609,6 +1030,14 @@ export const ProjectService: GenService<{
     input: typeof RunProjectPaperScoreRequestSchema;
     output: typeof RunProjectPaperScoreResponseSchema;
   },
+  /**
+   * @generated from rpc project.v1.ProjectService.ListProjectPaperScores
+   */
+  listProjectPaperScores: {
+    methodKind: "unary";
+    input: typeof ListProjectPaperScoresRequestSchema;
+    output: typeof ListProjectPaperScoresResponseSchema;
+  },
   /**
    * @generated from rpc project.v1.ProjectService.RunProjectPaperScoreComment
    */
@@ -641,6 +1070,54 @@ export const ProjectService: GenService<{
     input: typeof UpsertProjectInstructionsRequestSchema;
     output: typeof UpsertProjectInstructionsResponseSchema;
   },
+  /**
+   * Sets the persona of the new conversations of the project that do not choose one.
+   *
+   * @generated from rpc project.v1.ProjectService.UpdateProjectPersona
+   */
+  updateProjectPersona: {
+    methodKind: "unary";
+    input: typeof UpdateProjectPersonaRequestSchema;
+    output: typeof UpdateProjectPersonaResponseSchema;
+  },
+  /**
+   * Lists the members of the project, the coauthors who see the conversations shared in it.
+   *
+   * @generated from rpc project.v1.ProjectService.ListProjectMembers
+   */
+  listProjectMembers: {
+    methodKind: "unary";
+    input: typeof ListProjectMembersRequestSchema;
+    output: typeof ListProjectMembersResponseSchema;
+  },
+  /**
+   * Adds a user to the project by email, only the owner adds members.
+   *
+   * @generated from rpc project.v1.ProjectService.AddProjectMember
+   */
+  addProjectMember: {
+    methodKind: "unary";
+    input: typeof AddProjectMemberRequestSchema;
+    output: typeof AddProjectMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc project.v1.ProjectService.UpdateProjectMember
+   */
+  updateProjectMember: {
+    methodKind: "unary";
+    input: typeof UpdateProjectMemberRequestSchema;
+    output: typeof UpdateProjectMemberResponseSchema;
+  },
+  /**
+   * Removes a member, the members can remove themselves to leave the project.
+   *
+   * @generated from rpc project.v1.ProjectService.RemoveProjectMember
+   */
+  removeProjectMember: {
+    methodKind: "unary";
+    input: typeof RemoveProjectMemberRequestSchema;
+    output: typeof RemoveProjectMemberResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_project_v1_project, 0);
 
@@ -2,8 +2,8 @@
 // @generated from file user/v1/user.proto (package user.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_google_api_annotations } from "@buf/googleapis_googleapis.bufbuild_es/google/api/annotations_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file user/v1/user.proto.
  */
 export const file_user_v1_user: GenFile = /*@__PURE__*/
-  fileDesc("ChJ1c2VyL3YxL3VzZXIucHJvdG8SB3VzZXIudjEiQAoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIMCgRuYW1lGAMgASgJEg8KB3BpY3R1cmUYBCABKAkiEAoOR2V0VXNlclJlcXVlc3QiLgoPR2V0VXNlclJlc3BvbnNlEhsKBHVzZXIYASABKAsyDS51c2VyLnYxLlVzZXIirAEKBlByb21wdBIKCgJpZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgEIAEoCRIPCgdjb250ZW50GAUgASgJEhYKDmlzX3VzZXJfcHJvbXB0GAYgASgIIhQKEkxpc3RQcm9tcHRzUmVxdWVzdCI3ChNMaXN0UHJvbXB0c1Jlc3BvbnNlEiAKB3Byb21wdHMYASADKAsyDy51c2VyLnYxLlByb21wdCI1ChNDcmVhdGVQcm9tcHRSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEg8KB2NvbnRlbnQYAiABKAkiNwoUQ3JlYXRlUHJvbXB0UmVzcG9uc2USHwoGcHJvbXB0GAEgASgLMg8udXNlci52MS5Qcm9tcHQiSAoTVXBkYXRlUHJvbXB0UmVxdWVzdBIRCglwcm9tcHRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCSI3ChRVcGRhdGVQcm9tcHRSZXNwb25zZRIfCgZwcm9tcHQYASABKAsyDy51c2VyLnYxLlByb21wdCIoChNEZWxldGVQcm9tcHRSZXF1ZXN0EhEKCXByb21wdF9pZBgBIAEoCSIWChREZWxldGVQcm9tcHRSZXNwb25zZSKtAQoIU2V0dGluZ3MSJgoec2hvd19zaG9ydGN1dHNfYWZ0ZXJfc2VsZWN0aW9uGAEgASgIEigKIGZ1bGxfd2lkdGhfcGFwZXJfZGVidWdnZXJfYnV0dG9uGAIgASgIEhkKEWVuYWJsZV9jb21wbGV0aW9uGAMgASgIEhkKEWZ1bGxfZG9jdW1lbnRfcmFnGAQgASgIEhkKEXNob3dlZF9vbmJvYXJkaW5nGAUgASgIIhQKEkdldFNldHRpbmdzUmVxdWVzdCI6ChNHZXRTZXR0aW5nc1Jlc3BvbnNlEiMKCHNldHRpbmdzGAEgASgLMhEudXNlci52MS5TZXR0aW5ncyI8ChVVcGRhdGVTZXR0aW5nc1JlcXVlc3QSIwoIc2V0dGluZ3MYASABKAsyES51c2VyLnYxLlNldHRpbmdzIj0KFlVwZGF0ZVNldHRpbmdzUmVzcG9uc2USIwoIc2V0dGluZ3MYASABKAsyES51c2VyLnYxLlNldHRpbmdzIhYKFFJlc2V0U2V0dGluZ3NSZXF1ZXN0IjwKFVJlc2V0U2V0dGluZ3NSZXNwb25zZRIjCghzZXR0aW5ncxgBIAEoCzIRLnVzZXIudjEuU2V0dGluZ3MiHAoaR2V0VXNlckluc3RydWN0aW9uc1JlcXVlc3QiMwobR2V0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlEhQKDGluc3RydWN0aW9ucxgBIAEoCSI1Ch1VcHNlcnRVc2VySW5zdHJ1Y3Rpb25zUmVxdWVzdBIUCgxpbnN0cnVjdGlvbnMYASABKAkiNgoeVXBzZXJ0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlEhQKDGluc3RydWN0aW9ucxgBIAEoCTKDCgoLVXNlclNlcnZpY2USXQoHR2V0VXNlchIXLnVzZXIudjEuR2V0VXNlclJlcXVlc3QaGC51c2VyLnYxLkdldFVzZXJSZXNwb25zZSIfgtPkkwIZEhcvX3BkL2FwaS92MS91c2Vycy9Ac2VsZhJxCgtMaXN0UHJvbXB0cxIbLnVzZXIudjEuTGlzdFByb21wdHNSZXF1ZXN0GhwudXNlci52MS5MaXN0UHJvbXB0c1Jlc3BvbnNlIieC0+STAiESHy9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3Byb21wdHMSdwoMQ3JlYXRlUHJvbXB0EhwudXNlci52MS5DcmVhdGVQcm9tcHRSZXF1ZXN0Gh0udXNlci52MS5DcmVhdGVQcm9tcHRSZXNwb25zZSIqgtPkkwIkOgEqIh8vX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9wcm9tcHRzEoMBCgxVcGRhdGVQcm9tcHQSHC51c2VyLnYxLlVwZGF0ZVByb21wdFJlcXVlc3QaHS51c2VyLnYxLlVwZGF0ZVByb21wdFJlc3BvbnNlIjaC0+STAjA6ASoaKy9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3Byb21wdHMve3Byb21wdF9pZH0SjgEKE0dldFVzZXJJbnN0cnVjdGlvbnMSIy51c2VyLnYxLkdldFVzZXJJbnN0cnVjdGlvbnNSZXF1ZXN0GiQudXNlci52MS5HZXRVc2VySW5zdHJ1Y3Rpb25zUmVzcG9uc2UiLILT5JMCJhIkL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvaW5zdHJ1Y3Rpb25zEpoBChZVcHNlcnRVc2VySW5zdHJ1Y3Rpb25zEiYudXNlci52MS5VcHNlcnRVc2VySW5zdHJ1Y3Rpb25zUmVxdWVzdBonLnVzZXIudjEuVXBzZXJ0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlIi+C0+STAik6ASoiJC9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL2luc3RydWN0aW9ucxKAAQoMRGVsZXRlUHJvbXB0EhwudXNlci52MS5EZWxldGVQcm9tcHRSZXF1ZXN0Gh0udXNlci52MS5EZWxldGVQcm9tcHRSZXNwb25zZSIzgtPkkwItKisvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9wcm9tcHRzL3twcm9tcHRfaWR9EnIKC0dldFNldHRpbmdzEhsudXNlci52MS5HZXRTZXR0aW5nc1JlcXVlc3QaHC51c2VyLnYxLkdldFNldHRpbmdzUmVzcG9uc2UiKILT5JMCIhIgL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvc2V0dGluZ3MSfgoOVXBkYXRlU2V0dGluZ3MSHi51c2VyLnYxLlVwZGF0ZVNldHRpbmdzUmVxdWVzdBofLnVzZXIudjEuVXBkYXRlU2V0dGluZ3NSZXNwb25zZSIrgtPkkwIlOgEqGiAvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9zZXR0aW5ncxJ+Cg1SZXNldFNldHRpbmdzEh0udXNlci52MS5SZXNldFNldHRpbmdzUmVxdWVzdBoeLnVzZXIudjEuUmVzZXRTZXR0aW5nc1Jlc3BvbnNlIi6C0+STAigiJi9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3NldHRpbmdzL3Jlc2V0Qn8KC2NvbS51c2VyLnYxQglVc2VyUHJvdG9QAVoocGFwZXJkZWJ1Z2dlci9wa2cvZ2VuL2FwaS91c2VyL3YxO3VzZXJ2MaICA1VYWKoCB1VzZXIuVjHKAgdVc2VyXFYx4gITVXNlclxWMVxHUEJNZXRhZGF0YeoCCFVzZXI6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_protobuf_timestamp]);
+  fileDesc("ChJ1c2VyL3YxL3VzZXIucHJvdG8SB3VzZXIudjEiQAoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIMCgRuYW1lGAMgASgJEg8KB3BpY3R1cmUYBCABKAkiEAoOR2V0VXNlclJlcXVlc3QiLgoPR2V0VXNlclJlc3BvbnNlEhsKBHVzZXIYASABKAsyDS51c2VyLnYxLlVzZXIi2QIKBlByb21wdBIKCgJpZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgV0aXRsZRgEIAEoCRIPCgdjb250ZW50GAUgASgJEhYKDmlzX3VzZXJfcHJvbXB0GAYgASgIEhcKD29yZ2FuaXphdGlvbl9pZBgHIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgIIAEoCRILCgNrZXkYCSABKAkSEAoIY2F0ZWdvcnkYCiABKAkSDAoEdGFncxgLIAMoCRIOCgZwaW5uZWQYDCABKAgSDgoGaGlkZGVuGA0gASgIEioKCXZhcmlhYmxlcxgOIAMoCzIXLnVzZXIudjEuUHJvbXB0VmFyaWFibGUimAEKDlByb21wdFZhcmlhYmxlEgwKBG5hbWUYASABKAkSKQoEdHlwZRgCIAEoDjIbLnVzZXIudjEuUHJvbXB0VmFyaWFibGVUeXBlEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhUKDWRlZmF1bHRfdmFsdWUYBCABKAkSDwoHb3B0aW9ucxgFIAMoCRIQCghyZXF1aXJlZBgGIAEoCCI8ChJMaXN0UHJvbXB0c1JlcXVlc3QSDgoGbG9jYWxlGAEgASgJEhYKDmluY2x1ZGVfaGlkZGVuGAIgASgIIjcKE0xpc3RQcm9tcHRzUmVzcG9uc2USIAoHcHJvbXB0cxgBIAMoCzIPLnVzZXIudjEuUHJvbXB0IhoKClByb21wdEtleXMSDAoEa2V5cxgBIAMoCSJ6Ch5VcGRhdGVQcm9tcHRQcmVmZXJlbmNlc1JlcXVlc3QSKwoOaGlkZGVuX3Byb21wdHMYASABKAsyEy51c2VyLnYxLlByb21wdEtleXMSKwoOcGlubmVkX3Byb21wdHMYAiABKAsyEy51c2VyLnYxLlByb21wdEtleXMiUQofVXBkYXRlUHJvbXB0UHJlZmVyZW5jZXNSZXNwb25zZRIWCg5oaWRkZW5fcHJvbXB0cxgBIAMoCRIWCg5waW5uZWRfcHJvbXB0cxgCIAMoCSKTAQoTQ3JlYXRlUHJvbXB0UmVxdWVzdBINCgV0aXRsZRgBIAEoCRIPCgdjb250ZW50GAIgASgJEhwKD29yZ2FuaXphdGlvbl9pZBgDIAEoCUgAiAEBEioKCXZhcmlhYmxlcxgEIAMoCzIXLnVzZXIudjEuUHJvbXB0VmFyaWFibGVCEgoQX29yZ2FuaXphdGlvbl9pZCI3ChRDcmVhdGVQcm9tcHRSZXNwb25zZRIfCgZwcm9tcHQYASABKAsyDy51c2VyLnYxLlByb21wdCJ0ChNVcGRhdGVQcm9tcHRSZXF1ZXN0EhEKCXByb21wdF9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdjb250ZW50GAMgASgJEioKCXZhcmlhYmxlcxgEIAMoCzIXLnVzZXIudjEuUHJvbXB0VmFyaWFibGUiNwoUVXBkYXRlUHJvbXB0UmVzcG9uc2USHwoGcHJvbXB0GAEgASgLMg8udXNlci52MS5Qcm9tcHQikQEKE1JlbmRlclByb21wdFJlcXVlc3QSEQoJcHJvbXB0X2lkGAEgASgJEjgKBnZhbHVlcxgCIAMoCzIoLnVzZXIudjEuUmVuZGVyUHJvbXB0UmVxdWVzdC5WYWx1ZXNFbnRyeRotCgtWYWx1ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIicKFFJlbmRlclByb21wdFJlc3BvbnNlEg8KB2NvbnRlbnQYASABKAkimQEKC1Byb21wdFN0YXRzEhEKCXByb21wdF9pZBgBIAEoCRIMCgR1c2VzGAIgASgFEjUKDGxhc3RfdXNlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARISCgp0b3RhbF91c2VzGAQgASgFEg0KBXVzZXJzGAUgASgFQg8KDV9sYXN0X3VzZWRfYXQiFwoVR2V0UHJvbXB0U3RhdHNSZXF1ZXN0Ij0KFkdldFByb21wdFN0YXRzUmVzcG9uc2USIwoFc3RhdHMYASADKAsyFC51c2VyLnYxLlByb21wdFN0YXRzIicKElNoYXJlUHJvbXB0UmVxdWVzdBIRCglwcm9tcHRfaWQYASABKAkiNQoTU2hhcmVQcm9tcHRSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIPCgdpbXBvcnRzGAIgASgFIi0KGFJldm9rZVByb21wdFNoYXJlUmVxdWVzdBIRCglwcm9tcHRfaWQYASABKAkiGwoZUmV2b2tlUHJvbXB0U2hhcmVSZXNwb25zZSIqChlJbXBvcnRTaGFyZWRQcm9tcHRSZXF1ZXN0Eg0KBXRva2VuGAEgASgJIj0KGkltcG9ydFNoYXJlZFByb21wdFJlc3BvbnNlEh8KBnByb21wdBgBIAEoCzIPLnVzZXIudjEuUHJvbXB0IigKE0RlbGV0ZVByb21wdFJlcXVlc3QSEQoJcHJvbXB0X2lkGAEgASgJIhYKFERlbGV0ZVByb21wdFJlc3BvbnNlIo4BCg1EZWxldGVkUHJvbXB0Eh8KBnByb21wdBgBIAEoCzIPLnVzZXIudjEuUHJvbXB0Ei4KCmRlbGV0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCHB1cmdlX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIbChlMaXN0RGVsZXRlZFByb21wdHNSZXF1ZXN0IkUKGkxpc3REZWxldGVkUHJvbXB0c1Jlc3BvbnNlEicKB3Byb21wdHMYASADKAsyFi51c2VyLnYxLkRlbGV0ZWRQcm9tcHQiKQoUUmVzdG9yZVByb21wdFJlcXVlc3QSEQoJcHJvbXB0X2lkGAEgASgJIjgKFVJlc3RvcmVQcm9tcHRSZXNwb25zZRIfCgZwcm9tcHQYASABKAsyDy51c2VyLnYxLlByb21wdCKtAQoIU2V0dGluZ3MSJgoec2hvd19zaG9ydGN1dHNfYWZ0ZXJfc2VsZWN0aW9uGAEgASgIEigKIGZ1bGxfd2lkdGhfcGFwZXJfZGVidWdnZXJfYnV0dG9uGAIgASgIEhkKEWVuYWJsZV9jb21wbGV0aW9uGAMgASgIEhkKEWZ1bGxfZG9jdW1lbnRfcmFnGAQgASgIEhkKEXNob3dlZF9vbmJvYXJkaW5nGAUgASgIIhQKEkdldFNldHRpbmdzUmVxdWVzdCI6ChNHZXRTZXR0aW5nc1Jlc3BvbnNlEiMKCHNldHRpbmdzGAEgASgLMhEudXNlci52MS5TZXR0aW5ncyI8ChVVcGRhdGVTZXR0aW5nc1JlcXVlc3QSIwoIc2V0dGluZ3MYASABKAsyES51c2VyLnYxLlNldHRpbmdzIj0KFlVwZGF0ZVNldHRpbmdzUmVzcG9uc2USIwoIc2V0dGluZ3MYASABKAsyES51c2VyLnYxLlNldHRpbmdzIhYKFFJlc2V0U2V0dGluZ3NSZXF1ZXN0IjwKFVJlc2V0U2V0dGluZ3NSZXNwb25zZRIjCghzZXR0aW5ncxgBIAEoCzIRLnVzZXIudjEuU2V0dGluZ3MiHAoaR2V0VXNlckluc3RydWN0aW9uc1JlcXVlc3QiMwobR2V0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlEhQKDGluc3RydWN0aW9ucxgBIAEoCSI1Ch1VcHNlcnRVc2VySW5zdHJ1Y3Rpb25zUmVxdWVzdBIUCgxpbnN0cnVjdGlvbnMYASABKAkiNgoeVXBzZXJ0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlEhQKDGluc3RydWN0aW9ucxgBIAEoCSJvCgRUb29sEgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDwoHZW5hYmxlZBgDIAEoCBIYChBkaXNhYmxlZF9ieV91c2VyGAQgASgIEhkKEXBpbm5lZF9ieV9wcm9qZWN0GAUgASgIIhoKCVRvb2xOYW1lcxINCgVuYW1lcxgBIAMoCSJDChlMaXN0QXZhaWxhYmxlVG9vbHNSZXF1ZXN0EhcKCnByb2plY3RfaWQYASABKAlIAIgBAUINCgtfcHJvamVjdF9pZCI6ChpMaXN0QXZhaWxhYmxlVG9vbHNSZXNwb25zZRIcCgV0b29scxgBIAMoCzINLnVzZXIudjEuVG9vbCKkAQocVXBkYXRlVG9vbFByZWZlcmVuY2VzUmVxdWVzdBIqCg5kaXNhYmxlZF90b29scxgBIAEoCzISLnVzZXIudjEuVG9vbE5hbWVzEhcKCnByb2plY3RfaWQYAiABKAlIAIgBARIwChRwcm9qZWN0X3Bpbm5lZF90b29scxgDIAEoCzISLnVzZXIudjEuVG9vbE5hbWVzQg0KC19wcm9qZWN0X2lkIj0KHVVwZGF0ZVRvb2xQcmVmZXJlbmNlc1Jlc3BvbnNlEhwKBXRvb2xzGAEgAygLMg0udXNlci52MS5Ub29sIi0KFERlbGV0ZUFjY291bnRSZXF1ZXN0EhUKDWNvbmZpcm1fZW1haWwYASABKAkiRQoVRGVsZXRlQWNjb3VudFJlc3BvbnNlEiwKCHB1cmdlX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCrDAQoSUHJvbXB0VmFyaWFibGVUeXBlEiQKIFBST01QVF9WQVJJQUJMRV9UWVBFX1VOU1BFQ0lGSUVEEAASHQoZUFJPTVBUX1ZBUklBQkxFX1RZUEVfVEVYVBABEh8KG1BST01QVF9WQVJJQUJMRV9UWVBFX05VTUJFUhACEh8KG1BST01QVF9WQVJJQUJMRV9UWVBFX0NIT0lDRRADEiYKIlBST01QVF9WQVJJQUJMRV9UWVBFX1NFTEVDVEVEX1RFWFQQBDKiFgoLVXNlclNlcnZpY2USXQoHR2V0VXNlchIXLnVzZXIudjEuR2V0VXNlclJlcXVlc3QaGC51c2VyLnYxLkdldFVzZXJSZXNwb25zZSIfgtPkkwIZEhcvX3BkL2FwaS92MS91c2Vycy9Ac2VsZhJxCgtMaXN0UHJvbXB0cxIbLnVzZXIudjEuTGlzdFByb21wdHNSZXF1ZXN0GhwudXNlci52MS5MaXN0UHJvbXB0c1Jlc3BvbnNlIieC0+STAiESHy9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3Byb21wdHMSowEKF1VwZGF0ZVByb21wdFByZWZlcmVuY2VzEicudXNlci52MS5VcGRhdGVQcm9tcHRQcmVmZXJlbmNlc1JlcXVlc3QaKC51c2VyLnYxLlVwZGF0ZVByb21wdFByZWZlcmVuY2VzUmVzcG9uc2UiNYLT5JMCLzoBKhoqL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0LXByZWZlcmVuY2VzEncKDENyZWF0ZVByb21wdBIcLnVzZXIudjEuQ3JlYXRlUHJvbXB0UmVxdWVzdBodLnVzZXIudjEuQ3JlYXRlUHJvbXB0UmVzcG9uc2UiKoLT5JMCJDoBKiIfL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0cxKDAQoMVXBkYXRlUHJvbXB0EhwudXNlci52MS5VcGRhdGVQcm9tcHRSZXF1ZXN0Gh0udXNlci52MS5VcGRhdGVQcm9tcHRSZXNwb25zZSI2gtPkkwIwOgEqGisvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9wcm9tcHRzL3twcm9tcHRfaWR9Eo4BChNHZXRVc2VySW5zdHJ1Y3Rpb25zEiMudXNlci52MS5HZXRVc2VySW5zdHJ1Y3Rpb25zUmVxdWVzdBokLnVzZXIudjEuR2V0VXNlckluc3RydWN0aW9uc1Jlc3BvbnNlIiyC0+STAiYSJC9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL2luc3RydWN0aW9ucxKaAQoWVXBzZXJ0VXNlckluc3RydWN0aW9ucxImLnVzZXIudjEuVXBzZXJ0VXNlckluc3RydWN0aW9uc1JlcXVlc3QaJy51c2VyLnYxLlVwc2VydFVzZXJJbnN0cnVjdGlvbnNSZXNwb25zZSIvgtPkkwIpOgEqIiQvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9pbnN0cnVjdGlvbnMSgAEKDERlbGV0ZVByb21wdBIcLnVzZXIudjEuRGVsZXRlUHJvbXB0UmVxdWVzdBodLnVzZXIudjEuRGVsZXRlUHJvbXB0UmVzcG9uc2UiM4LT5JMCLSorL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0cy97cHJvbXB0X2lkfRKOAQoSTGlzdERlbGV0ZWRQcm9tcHRzEiIudXNlci52MS5MaXN0RGVsZXRlZFByb21wdHNSZXF1ZXN0GiMudXNlci52MS5MaXN0RGVsZXRlZFByb21wdHNSZXNwb25zZSIvgtPkkwIpEicvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9kZWxldGVkLXByb21wdHMSjgEKDVJlc3RvcmVQcm9tcHQSHS51c2VyLnYxLlJlc3RvcmVQcm9tcHRSZXF1ZXN0Gh4udXNlci52MS5SZXN0b3JlUHJvbXB0UmVzcG9uc2UiPoLT5JMCODoBKiIzL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0cy97cHJvbXB0X2lkfS9yZXN0b3JlEooBCgxSZW5kZXJQcm9tcHQSHC51c2VyLnYxLlJlbmRlclByb21wdFJlcXVlc3QaHS51c2VyLnYxLlJlbmRlclByb21wdFJlc3BvbnNlIj2C0+STAjc6ASoiMi9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3Byb21wdHMve3Byb21wdF9pZH0vcmVuZGVyEn8KDkdldFByb21wdFN0YXRzEh4udXNlci52MS5HZXRQcm9tcHRTdGF0c1JlcXVlc3QaHy51c2VyLnYxLkdldFByb21wdFN0YXRzUmVzcG9uc2UiLILT5JMCJhIkL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0LXN0YXRzEoYBCgtTaGFyZVByb21wdBIbLnVzZXIudjEuU2hhcmVQcm9tcHRSZXF1ZXN0GhwudXNlci52MS5TaGFyZVByb21wdFJlc3BvbnNlIjyC0+STAjY6ASoiMS9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3Byb21wdHMve3Byb21wdF9pZH0vc2hhcmUSlQEKEVJldm9rZVByb21wdFNoYXJlEiEudXNlci52MS5SZXZva2VQcm9tcHRTaGFyZVJlcXVlc3QaIi51c2VyLnYxLlJldm9rZVByb21wdFNoYXJlUmVzcG9uc2UiOYLT5JMCMyoxL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvcHJvbXB0cy97cHJvbXB0X2lkfS9zaGFyZRKQAQoSSW1wb3J0U2hhcmVkUHJvbXB0EiIudXNlci52MS5JbXBvcnRTaGFyZWRQcm9tcHRSZXF1ZXN0GiMudXNlci52MS5JbXBvcnRTaGFyZWRQcm9tcHRSZXNwb25zZSIxgtPkkwIrOgEqIiYvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9wcm9tcHRzL2ltcG9ydBJyCgtHZXRTZXR0aW5ncxIbLnVzZXIudjEuR2V0U2V0dGluZ3NSZXF1ZXN0GhwudXNlci52MS5HZXRTZXR0aW5nc1Jlc3BvbnNlIiiC0+STAiISIC9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL3NldHRpbmdzEn4KDlVwZGF0ZVNldHRpbmdzEh4udXNlci52MS5VcGRhdGVTZXR0aW5nc1JlcXVlc3QaHy51c2VyLnYxLlVwZGF0ZVNldHRpbmdzUmVzcG9uc2UiK4LT5JMCJToBKhogL19wZC9hcGkvdjEvdXNlcnMvQHNlbGYvc2V0dGluZ3MSfgoNUmVzZXRTZXR0aW5ncxIdLnVzZXIudjEuUmVzZXRTZXR0aW5nc1JlcXVlc3QaHi51c2VyLnYxLlJlc2V0U2V0dGluZ3NSZXNwb25zZSIugtPkkwIoIiYvX3BkL2FwaS92MS91c2Vycy9Ac2VsZi9zZXR0aW5ncy9yZXNldBKEAQoSTGlzdEF2YWlsYWJsZVRvb2xzEiIudXNlci52MS5MaXN0QXZhaWxhYmxlVG9vbHNSZXF1ZXN0GiMudXNlci52MS5MaXN0QXZhaWxhYmxlVG9vbHNSZXNwb25zZSIlgtPkkwIfEh0vX3BkL2FwaS92MS91c2Vycy9Ac2VsZi90b29scxKQAQoVVXBkYXRlVG9vbFByZWZlcmVuY2VzEiUudXNlci52MS5VcGRhdGVUb29sUHJlZmVyZW5jZXNSZXF1ZXN0GiYudXNlci52MS5VcGRhdGVUb29sUHJlZmVyZW5jZXNSZXNwb25zZSIogtPkkwIiOgEqGh0vX3BkL2FwaS92MS91c2Vycy9Ac2VsZi90b29scxJ5Cg1EZWxldGVBY2NvdW50Eh0udXNlci52MS5EZWxldGVBY2NvdW50UmVxdWVzdBoeLnVzZXIudjEuRGVsZXRlQWNjb3VudFJlc3BvbnNlIimC0+STAiM6ASoiHi9fcGQvYXBpL3YxL3VzZXJzL0BzZWxmL2RlbGV0ZUJ/Cgtjb20udXNlci52MUIJVXNlclByb3RvUAFaKHBhcGVyZGVidWdnZXIvcGtnL2dlbi9hcGkvdXNlci92MTt1c2VydjGiAgNVWFiqAgdVc2VyLlYxygIHVXNlclxWMeICE1VzZXJcVjFcR1BCTWV0YWRhdGHqAghVc2VyOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message user.v1.User
@@ -110,6 +110,52 @@ export type Prompt = Message<"user.v1.Prompt"> & {
    * @generated from field: bool is_user_prompt = 6;
    */
   isUserPrompt: boolean;
+
+  /**
+   * Set for the prompts of a team library, the editors of the organization change them.
+   *
+   * @generated from field: string organization_id = 7;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string organization_name = 8;
+   */
+  organizationName: string;
+
+  /**
+   * Set for the built-in prompts, the preferences refer to them by key.
+   *
+   * @generated from field: string key = 9;
+   */
+  key: string;
+
+  /**
+   * @generated from field: string category = 10;
+   */
+  category: string;
+
+  /**
+   * @generated from field: repeated string tags = 11;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: bool pinned = 12;
+   */
+  pinned: boolean;
+
+  /**
+   * @generated from field: bool hidden = 13;
+   */
+  hidden: boolean;
+
+  /**
+   * The placeholders {{name}} of the content, filled by RenderPrompt.
+   *
+   * @generated from field: repeated user.v1.PromptVariable variables = 14;
+   */
+  variables: PromptVariable[];
 };
 
 /**
@@ -119,10 +165,69 @@ export type Prompt = Message<"user.v1.Prompt"> & {
 export const PromptSchema: GenMessage<Prompt> = /*@__PURE__*/
   messageDesc(file_user_v1_user, 3);
 
+/**
+ * @generated from message user.v1.PromptVariable
+ */
+export type PromptVariable = Message<"user.v1.PromptVariable"> & {
+  /**
+   * lowercase letters, digits and underscores
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: user.v1.PromptVariableType type = 2;
+   */
+  type: PromptVariableType;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string default_value = 4;
+   */
+  defaultValue: string;
+
+  /**
+   * for PROMPT_VARIABLE_TYPE_CHOICE
+   *
+   * @generated from field: repeated string options = 5;
+   */
+  options: string[];
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required: boolean;
+};
+
+/**
+ * Describes the message user.v1.PromptVariable.
+ * Use `create(PromptVariableSchema)` to create a new message.
+ */
+export const PromptVariableSchema: GenMessage<PromptVariable> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 4);
+
 /**
  * @generated from message user.v1.ListPromptsRequest
  */
 export type ListPromptsRequest = Message<"user.v1.ListPromptsRequest"> & {
+  /**
+   * e.g. "zh-CN", the titles of the built-in prompts are localized
+   *
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+
+  /**
+   * include the built-in prompts hidden by the user
+   *
+   * @generated from field: bool include_hidden = 2;
+   */
+  includeHidden: boolean;
 };
 
 /**
@@ -130,13 +235,16 @@ export type ListPromptsRequest = Message<"user.v1.ListPromptsRequest"> & {
  * Use `create(ListPromptsRequestSchema)` to create a new message.
  */
 export const ListPromptsRequestSchema: GenMessage<ListPromptsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 4);
+  messageDesc(file_user_v1_user, 5);
 
 /**
  * @generated from message user.v1.ListPromptsResponse
  */
 export type ListPromptsResponse = Message<"user.v1.ListPromptsResponse"> & {
   /**
+   * The pinned built-in prompts, the prompts of the user, the team prompts, then the other
+   * built-in prompts.
+   *
    * @generated from field: repeated user.v1.Prompt prompts = 1;
    */
   prompts: Prompt[];
@@ -147,7 +255,72 @@ export type ListPromptsResponse = Message<"user.v1.ListPromptsResponse"> & {
  * Use `create(ListPromptsResponseSchema)` to create a new message.
  */
 export const ListPromptsResponseSchema: GenMessage<ListPromptsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 5);
+  messageDesc(file_user_v1_user, 6);
+
+/**
+ * @generated from message user.v1.PromptKeys
+ */
+export type PromptKeys = Message<"user.v1.PromptKeys"> & {
+  /**
+   * @generated from field: repeated string keys = 1;
+   */
+  keys: string[];
+};
+
+/**
+ * Describes the message user.v1.PromptKeys.
+ * Use `create(PromptKeysSchema)` to create a new message.
+ */
+export const PromptKeysSchema: GenMessage<PromptKeys> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 7);
+
+/**
+ * @generated from message user.v1.UpdatePromptPreferencesRequest
+ */
+export type UpdatePromptPreferencesRequest = Message<"user.v1.UpdatePromptPreferencesRequest"> & {
+  /**
+   * Keys of the built-in prompts hidden from the library. Left unset, the current list is kept.
+   *
+   * @generated from field: user.v1.PromptKeys hidden_prompts = 1;
+   */
+  hiddenPrompts?: PromptKeys;
+
+  /**
+   * Keys of the built-in prompts listed first. Left unset, the current list is kept.
+   *
+   * @generated from field: user.v1.PromptKeys pinned_prompts = 2;
+   */
+  pinnedPrompts?: PromptKeys;
+};
+
+/**
+ * Describes the message user.v1.UpdatePromptPreferencesRequest.
+ * Use `create(UpdatePromptPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdatePromptPreferencesRequestSchema: GenMessage<UpdatePromptPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 8);
+
+/**
+ * @generated from message user.v1.UpdatePromptPreferencesResponse
+ */
+export type UpdatePromptPreferencesResponse = Message<"user.v1.UpdatePromptPreferencesResponse"> & {
+  /**
+   * @generated from field: repeated string hidden_prompts = 1;
+   */
+  hiddenPrompts: string[];
+
+  /**
+   * @generated from field: repeated string pinned_prompts = 2;
+   */
+  pinnedPrompts: string[];
+};
+
+/**
+ * Describes the message user.v1.UpdatePromptPreferencesResponse.
+ * Use `create(UpdatePromptPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdatePromptPreferencesResponseSchema: GenMessage<UpdatePromptPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 9);
 
 /**
  * @generated from message user.v1.CreatePromptRequest
@@ -162,6 +335,18 @@ export type CreatePromptRequest = Message<"user.v1.CreatePromptRequest"> & {
    * @generated from field: string content = 2;
    */
   content: string;
+
+  /**
+   * adds the prompt to the library of the organization
+   *
+   * @generated from field: optional string organization_id = 3;
+   */
+  organizationId?: string;
+
+  /**
+   * @generated from field: repeated user.v1.PromptVariable variables = 4;
+   */
+  variables: PromptVariable[];
 };
 
 /**
@@ -169,7 +354,7 @@ export type CreatePromptRequest = Message<"user.v1.CreatePromptRequest"> & {
  * Use `create(CreatePromptRequestSchema)` to create a new message.
  */
 export const CreatePromptRequestSchema: GenMessage<CreatePromptRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 6);
+  messageDesc(file_user_v1_user, 10);
 
 /**
  * @generated from message user.v1.CreatePromptResponse
@@ -186,7 +371,7 @@ export type CreatePromptResponse = Message<"user.v1.CreatePromptResponse"> & {
  * Use `create(CreatePromptResponseSchema)` to create a new message.
  */
 export const CreatePromptResponseSchema: GenMessage<CreatePromptResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 7);
+  messageDesc(file_user_v1_user, 11);
 
 /**
  * @generated from message user.v1.UpdatePromptRequest
@@ -206,6 +391,11 @@ export type UpdatePromptRequest = Message<"user.v1.UpdatePromptRequest"> & {
    * @generated from field: string content = 3;
    */
   content: string;
+
+  /**
+   * @generated from field: repeated user.v1.PromptVariable variables = 4;
+   */
+  variables: PromptVariable[];
 };
 
 /**
@@ -213,7 +403,7 @@ export type UpdatePromptRequest = Message<"user.v1.UpdatePromptRequest"> & {
  * Use `create(UpdatePromptRequestSchema)` to create a new message.
  */
 export const UpdatePromptRequestSchema: GenMessage<UpdatePromptRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 8);
+  messageDesc(file_user_v1_user, 12);
 
 /**
  * @generated from message user.v1.UpdatePromptResponse
@@ -230,7 +420,228 @@ export type UpdatePromptResponse = Message<"user.v1.UpdatePromptResponse"> & {
  * Use `create(UpdatePromptResponseSchema)` to create a new message.
  */
 export const UpdatePromptResponseSchema: GenMessage<UpdatePromptResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 9);
+  messageDesc(file_user_v1_user, 13);
+
+/**
+ * @generated from message user.v1.RenderPromptRequest
+ */
+export type RenderPromptRequest = Message<"user.v1.RenderPromptRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+
+  /**
+   * by variable name, the defaults fill the missing ones
+   *
+   * @generated from field: map<string, string> values = 2;
+   */
+  values: { [key: string]: string };
+};
+
+/**
+ * Describes the message user.v1.RenderPromptRequest.
+ * Use `create(RenderPromptRequestSchema)` to create a new message.
+ */
+export const RenderPromptRequestSchema: GenMessage<RenderPromptRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 14);
+
+/**
+ * @generated from message user.v1.RenderPromptResponse
+ */
+export type RenderPromptResponse = Message<"user.v1.RenderPromptResponse"> & {
+  /**
+   * @generated from field: string content = 1;
+   */
+  content: string;
+};
+
+/**
+ * Describes the message user.v1.RenderPromptResponse.
+ * Use `create(RenderPromptResponseSchema)` to create a new message.
+ */
+export const RenderPromptResponseSchema: GenMessage<RenderPromptResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 15);
+
+/**
+ * @generated from message user.v1.PromptStats
+ */
+export type PromptStats = Message<"user.v1.PromptStats"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+
+  /**
+   * messages sent from the prompt by the user
+   *
+   * @generated from field: int32 uses = 2;
+   */
+  uses: number;
+
+  /**
+   * by the user
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_used_at = 3;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * by all the users
+   *
+   * @generated from field: int32 total_uses = 4;
+   */
+  totalUses: number;
+
+  /**
+   * who used the prompt
+   *
+   * @generated from field: int32 users = 5;
+   */
+  users: number;
+};
+
+/**
+ * Describes the message user.v1.PromptStats.
+ * Use `create(PromptStatsSchema)` to create a new message.
+ */
+export const PromptStatsSchema: GenMessage<PromptStats> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 16);
+
+/**
+ * @generated from message user.v1.GetPromptStatsRequest
+ */
+export type GetPromptStatsRequest = Message<"user.v1.GetPromptStatsRequest"> & {
+};
+
+/**
+ * Describes the message user.v1.GetPromptStatsRequest.
+ * Use `create(GetPromptStatsRequestSchema)` to create a new message.
+ */
+export const GetPromptStatsRequestSchema: GenMessage<GetPromptStatsRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 17);
+
+/**
+ * @generated from message user.v1.GetPromptStatsResponse
+ */
+export type GetPromptStatsResponse = Message<"user.v1.GetPromptStatsResponse"> & {
+  /**
+   * most used first
+   *
+   * @generated from field: repeated user.v1.PromptStats stats = 1;
+   */
+  stats: PromptStats[];
+};
+
+/**
+ * Describes the message user.v1.GetPromptStatsResponse.
+ * Use `create(GetPromptStatsResponseSchema)` to create a new message.
+ */
+export const GetPromptStatsResponseSchema: GenMessage<GetPromptStatsResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 18);
+
+/**
+ * @generated from message user.v1.SharePromptRequest
+ */
+export type SharePromptRequest = Message<"user.v1.SharePromptRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+};
+
+/**
+ * Describes the message user.v1.SharePromptRequest.
+ * Use `create(SharePromptRequestSchema)` to create a new message.
+ */
+export const SharePromptRequestSchema: GenMessage<SharePromptRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 19);
+
+/**
+ * @generated from message user.v1.SharePromptResponse
+ */
+export type SharePromptResponse = Message<"user.v1.SharePromptResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: int32 imports = 2;
+   */
+  imports: number;
+};
+
+/**
+ * Describes the message user.v1.SharePromptResponse.
+ * Use `create(SharePromptResponseSchema)` to create a new message.
+ */
+export const SharePromptResponseSchema: GenMessage<SharePromptResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 20);
+
+/**
+ * @generated from message user.v1.RevokePromptShareRequest
+ */
+export type RevokePromptShareRequest = Message<"user.v1.RevokePromptShareRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+};
+
+/**
+ * Describes the message user.v1.RevokePromptShareRequest.
+ * Use `create(RevokePromptShareRequestSchema)` to create a new message.
+ */
+export const RevokePromptShareRequestSchema: GenMessage<RevokePromptShareRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 21);
+
+/**
+ * @generated from message user.v1.RevokePromptShareResponse
+ */
+export type RevokePromptShareResponse = Message<"user.v1.RevokePromptShareResponse"> & {
+};
+
+/**
+ * Describes the message user.v1.RevokePromptShareResponse.
+ * Use `create(RevokePromptShareResponseSchema)` to create a new message.
+ */
+export const RevokePromptShareResponseSchema: GenMessage<RevokePromptShareResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 22);
+
+/**
+ * @generated from message user.v1.ImportSharedPromptRequest
+ */
+export type ImportSharedPromptRequest = Message<"user.v1.ImportSharedPromptRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message user.v1.ImportSharedPromptRequest.
+ * Use `create(ImportSharedPromptRequestSchema)` to create a new message.
+ */
+export const ImportSharedPromptRequestSchema: GenMessage<ImportSharedPromptRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 23);
+
+/**
+ * @generated from message user.v1.ImportSharedPromptResponse
+ */
+export type ImportSharedPromptResponse = Message<"user.v1.ImportSharedPromptResponse"> & {
+  /**
+   * @generated from field: user.v1.Prompt prompt = 1;
+   */
+  prompt?: Prompt;
+};
+
+/**
+ * Describes the message user.v1.ImportSharedPromptResponse.
+ * Use `create(ImportSharedPromptResponseSchema)` to create a new message.
+ */
+export const ImportSharedPromptResponseSchema: GenMessage<ImportSharedPromptResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 24);
 
 /**
  * @generated from message user.v1.DeletePromptRequest
@@ -247,7 +658,7 @@ export type DeletePromptRequest = Message<"user.v1.DeletePromptRequest"> & {
  * Use `create(DeletePromptRequestSchema)` to create a new message.
  */
 export const DeletePromptRequestSchema: GenMessage<DeletePromptRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 10);
+  messageDesc(file_user_v1_user, 25);
 
 /**
  * @generated from message user.v1.DeletePromptResponse
@@ -260,7 +671,100 @@ export type DeletePromptResponse = Message<"user.v1.DeletePromptResponse"> & {
  * Use `create(DeletePromptResponseSchema)` to create a new message.
  */
 export const DeletePromptResponseSchema: GenMessage<DeletePromptResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 11);
+  messageDesc(file_user_v1_user, 26);
+
+/**
+ * @generated from message user.v1.DeletedPrompt
+ */
+export type DeletedPrompt = Message<"user.v1.DeletedPrompt"> & {
+  /**
+   * @generated from field: user.v1.Prompt prompt = 1;
+   */
+  prompt?: Prompt;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deleted_at = 2;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * When the prompt is deleted for good, unset if it is kept forever.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 3;
+   */
+  purgeAt?: Timestamp;
+};
+
+/**
+ * Describes the message user.v1.DeletedPrompt.
+ * Use `create(DeletedPromptSchema)` to create a new message.
+ */
+export const DeletedPromptSchema: GenMessage<DeletedPrompt> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 27);
+
+/**
+ * @generated from message user.v1.ListDeletedPromptsRequest
+ */
+export type ListDeletedPromptsRequest = Message<"user.v1.ListDeletedPromptsRequest"> & {
+};
+
+/**
+ * Describes the message user.v1.ListDeletedPromptsRequest.
+ * Use `create(ListDeletedPromptsRequestSchema)` to create a new message.
+ */
+export const ListDeletedPromptsRequestSchema: GenMessage<ListDeletedPromptsRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 28);
+
+/**
+ * @generated from message user.v1.ListDeletedPromptsResponse
+ */
+export type ListDeletedPromptsResponse = Message<"user.v1.ListDeletedPromptsResponse"> & {
+  /**
+   * @generated from field: repeated user.v1.DeletedPrompt prompts = 1;
+   */
+  prompts: DeletedPrompt[];
+};
+
+/**
+ * Describes the message user.v1.ListDeletedPromptsResponse.
+ * Use `create(ListDeletedPromptsResponseSchema)` to create a new message.
+ */
+export const ListDeletedPromptsResponseSchema: GenMessage<ListDeletedPromptsResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 29);
+
+/**
+ * @generated from message user.v1.RestorePromptRequest
+ */
+export type RestorePromptRequest = Message<"user.v1.RestorePromptRequest"> & {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId: string;
+};
+
+/**
+ * Describes the message user.v1.RestorePromptRequest.
+ * Use `create(RestorePromptRequestSchema)` to create a new message.
+ */
+export const RestorePromptRequestSchema: GenMessage<RestorePromptRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 30);
+
+/**
+ * @generated from message user.v1.RestorePromptResponse
+ */
+export type RestorePromptResponse = Message<"user.v1.RestorePromptResponse"> & {
+  /**
+   * @generated from field: user.v1.Prompt prompt = 1;
+   */
+  prompt?: Prompt;
+};
+
+/**
+ * Describes the message user.v1.RestorePromptResponse.
+ * Use `create(RestorePromptResponseSchema)` to create a new message.
+ */
+export const RestorePromptResponseSchema: GenMessage<RestorePromptResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 31);
 
 /**
  * @generated from message user.v1.Settings
@@ -297,7 +801,7 @@ export type Settings = Message<"user.v1.Settings"> & {
  * Use `create(SettingsSchema)` to create a new message.
  */
 export const SettingsSchema: GenMessage<Settings> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 12);
+  messageDesc(file_user_v1_user, 32);
 
 /**
  * @generated from message user.v1.GetSettingsRequest
@@ -310,7 +814,7 @@ export type GetSettingsRequest = Message<"user.v1.GetSettingsRequest"> & {
  * Use `create(GetSettingsRequestSchema)` to create a new message.
  */
 export const GetSettingsRequestSchema: GenMessage<GetSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 13);
+  messageDesc(file_user_v1_user, 33);
 
 /**
  * @generated from message user.v1.GetSettingsResponse
@@ -327,7 +831,7 @@ export type GetSettingsResponse = Message<"user.v1.GetSettingsResponse"> & {
  * Use `create(GetSettingsResponseSchema)` to create a new message.
  */
 export const GetSettingsResponseSchema: GenMessage<GetSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 14);
+  messageDesc(file_user_v1_user, 34);
 
 /**
  * @generated from message user.v1.UpdateSettingsRequest
@@ -344,7 +848,7 @@ export type UpdateSettingsRequest = Message<"user.v1.UpdateSettingsRequest"> & {
  * Use `create(UpdateSettingsRequestSchema)` to create a new message.
  */
 export const UpdateSettingsRequestSchema: GenMessage<UpdateSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 15);
+  messageDesc(file_user_v1_user, 35);
 
 /**
  * @generated from message user.v1.UpdateSettingsResponse
@@ -361,7 +865,7 @@ export type UpdateSettingsResponse = Message<"user.v1.UpdateSettingsResponse"> &
  * Use `create(UpdateSettingsResponseSchema)` to create a new message.
  */
 export const UpdateSettingsResponseSchema: GenMessage<UpdateSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 16);
+  messageDesc(file_user_v1_user, 36);
 
 /**
  * @generated from message user.v1.ResetSettingsRequest
@@ -374,7 +878,7 @@ export type ResetSettingsRequest = Message<"user.v1.ResetSettingsRequest"> & {
  * Use `create(ResetSettingsRequestSchema)` to create a new message.
  */
 export const ResetSettingsRequestSchema: GenMessage<ResetSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 17);
+  messageDesc(file_user_v1_user, 37);
 
 /**
  * @generated from message user.v1.ResetSettingsResponse
@@ -391,7 +895,7 @@ export type ResetSettingsResponse = Message<"user.v1.ResetSettingsResponse"> & {
  * Use `create(ResetSettingsResponseSchema)` to create a new message.
  */
 export const ResetSettingsResponseSchema: GenMessage<ResetSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 18);
+  messageDesc(file_user_v1_user, 38);
 
 /**
  * @generated from message user.v1.GetUserInstructionsRequest
@@ -404,7 +908,7 @@ export type GetUserInstructionsRequest = Message<"user.v1.GetUserInstructionsReq
  * Use `create(GetUserInstructionsRequestSchema)` to create a new message.
  */
 export const GetUserInstructionsRequestSchema: GenMessage<GetUserInstructionsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 19);
+  messageDesc(file_user_v1_user, 39);
 
 /**
  * @generated from message user.v1.GetUserInstructionsResponse
@@ -421,7 +925,7 @@ export type GetUserInstructionsResponse = Message<"user.v1.GetUserInstructionsRe
  * Use `create(GetUserInstructionsResponseSchema)` to create a new message.
  */
 export const GetUserInstructionsResponseSchema: GenMessage<GetUserInstructionsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 20);
+  messageDesc(file_user_v1_user, 40);
 
 /**
  * @generated from message user.v1.UpsertUserInstructionsRequest
@@ -438,7 +942,7 @@ export type UpsertUserInstructionsRequest = Message<"user.v1.UpsertUserInstructi
  * Use `create(UpsertUserInstructionsRequestSchema)` to create a new message.
  */
 export const UpsertUserInstructionsRequestSchema: GenMessage<UpsertUserInstructionsRequest> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 21);
+  messageDesc(file_user_v1_user, 41);
 
 /**
  * @generated from message user.v1.UpsertUserInstructionsResponse
@@ -455,7 +959,225 @@ export type UpsertUserInstructionsResponse = Message<"user.v1.UpsertUserInstruct
  * Use `create(UpsertUserInstructionsResponseSchema)` to create a new message.
  */
 export const UpsertUserInstructionsResponseSchema: GenMessage<UpsertUserInstructionsResponse> = /*@__PURE__*/
-  messageDesc(file_user_v1_user, 22);
+  messageDesc(file_user_v1_user, 42);
+
+/**
+ * @generated from message user.v1.Tool
+ */
+export type Tool = Message<"user.v1.Tool"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * whether the assistant may use the tool, after applying user and project preferences
+   *
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: bool disabled_by_user = 4;
+   */
+  disabledByUser: boolean;
+
+  /**
+   * @generated from field: bool pinned_by_project = 5;
+   */
+  pinnedByProject: boolean;
+};
+
+/**
+ * Describes the message user.v1.Tool.
+ * Use `create(ToolSchema)` to create a new message.
+ */
+export const ToolSchema: GenMessage<Tool> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 43);
+
+/**
+ * @generated from message user.v1.ToolNames
+ */
+export type ToolNames = Message<"user.v1.ToolNames"> & {
+  /**
+   * @generated from field: repeated string names = 1;
+   */
+  names: string[];
+};
+
+/**
+ * Describes the message user.v1.ToolNames.
+ * Use `create(ToolNamesSchema)` to create a new message.
+ */
+export const ToolNamesSchema: GenMessage<ToolNames> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 44);
+
+/**
+ * @generated from message user.v1.ListAvailableToolsRequest
+ */
+export type ListAvailableToolsRequest = Message<"user.v1.ListAvailableToolsRequest"> & {
+  /**
+   * if set, the project's pinned tool set is applied as well
+   *
+   * @generated from field: optional string project_id = 1;
+   */
+  projectId?: string;
+};
+
+/**
+ * Describes the message user.v1.ListAvailableToolsRequest.
+ * Use `create(ListAvailableToolsRequestSchema)` to create a new message.
+ */
+export const ListAvailableToolsRequestSchema: GenMessage<ListAvailableToolsRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 45);
+
+/**
+ * @generated from message user.v1.ListAvailableToolsResponse
+ */
+export type ListAvailableToolsResponse = Message<"user.v1.ListAvailableToolsResponse"> & {
+  /**
+   * @generated from field: repeated user.v1.Tool tools = 1;
+   */
+  tools: Tool[];
+};
+
+/**
+ * Describes the message user.v1.ListAvailableToolsResponse.
+ * Use `create(ListAvailableToolsResponseSchema)` to create a new message.
+ */
+export const ListAvailableToolsResponseSchema: GenMessage<ListAvailableToolsResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 46);
+
+/**
+ * @generated from message user.v1.UpdateToolPreferencesRequest
+ */
+export type UpdateToolPreferencesRequest = Message<"user.v1.UpdateToolPreferencesRequest"> & {
+  /**
+   * Tools the assistant must never use for this user. Left unset, the current list is kept.
+   *
+   * @generated from field: user.v1.ToolNames disabled_tools = 1;
+   */
+  disabledTools?: ToolNames;
+
+  /**
+   * @generated from field: optional string project_id = 2;
+   */
+  projectId?: string;
+
+  /**
+   * Requires project_id. Only these tools may be used in the project, an empty list removes the pin.
+   *
+   * @generated from field: user.v1.ToolNames project_pinned_tools = 3;
+   */
+  projectPinnedTools?: ToolNames;
+};
+
+/**
+ * Describes the message user.v1.UpdateToolPreferencesRequest.
+ * Use `create(UpdateToolPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateToolPreferencesRequestSchema: GenMessage<UpdateToolPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 47);
+
+/**
+ * @generated from message user.v1.UpdateToolPreferencesResponse
+ */
+export type UpdateToolPreferencesResponse = Message<"user.v1.UpdateToolPreferencesResponse"> & {
+  /**
+   * @generated from field: repeated user.v1.Tool tools = 1;
+   */
+  tools: Tool[];
+};
+
+/**
+ * Describes the message user.v1.UpdateToolPreferencesResponse.
+ * Use `create(UpdateToolPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateToolPreferencesResponseSchema: GenMessage<UpdateToolPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 48);
+
+/**
+ * @generated from message user.v1.DeleteAccountRequest
+ */
+export type DeleteAccountRequest = Message<"user.v1.DeleteAccountRequest"> & {
+  /**
+   * The email of the user, to confirm the deletion.
+   *
+   * @generated from field: string confirm_email = 1;
+   */
+  confirmEmail: string;
+};
+
+/**
+ * Describes the message user.v1.DeleteAccountRequest.
+ * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ */
+export const DeleteAccountRequestSchema: GenMessage<DeleteAccountRequest> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 49);
+
+/**
+ * @generated from message user.v1.DeleteAccountResponse
+ */
+export type DeleteAccountResponse = Message<"user.v1.DeleteAccountResponse"> & {
+  /**
+   * When the account and its data are deleted for good.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 1;
+   */
+  purgeAt?: Timestamp;
+};
+
+/**
+ * Describes the message user.v1.DeleteAccountResponse.
+ * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ */
+export const DeleteAccountResponseSchema: GenMessage<DeleteAccountResponse> = /*@__PURE__*/
+  messageDesc(file_user_v1_user, 50);
+
+/**
+ * @generated from enum user.v1.PromptVariableType
+ */
+export enum PromptVariableType {
+  /**
+   * @generated from enum value: PROMPT_VARIABLE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROMPT_VARIABLE_TYPE_TEXT = 1;
+   */
+  TEXT = 1,
+
+  /**
+   * @generated from enum value: PROMPT_VARIABLE_TYPE_NUMBER = 2;
+   */
+  NUMBER = 2,
+
+  /**
+   * one of the options
+   *
+   * @generated from enum value: PROMPT_VARIABLE_TYPE_CHOICE = 3;
+   */
+  CHOICE = 3,
+
+  /**
+   * filled with the text selected in the editor
+   *
+   * @generated from enum value: PROMPT_VARIABLE_TYPE_SELECTED_TEXT = 4;
+   */
+  SELECTED_TEXT = 4,
+}
+
+/**
+ * Describes the enum user.v1.PromptVariableType.
+ */
+export const PromptVariableTypeSchema: GenEnum<PromptVariableType> = /*@__PURE__*/
+  enumDesc(file_user_v1_user, 0);
 
 /**
  * @generated from service user.v1.UserService
@@ -477,6 +1199,16 @@ export const UserService: GenService<{
     input: typeof ListPromptsRequestSchema;
     output: typeof ListPromptsResponseSchema;
   },
+  /**
+   * Hides or pins built-in prompts for the user.
+   *
+   * @generated from rpc user.v1.UserService.UpdatePromptPreferences
+   */
+  updatePromptPreferences: {
+    methodKind: "unary";
+    input: typeof UpdatePromptPreferencesRequestSchema;
+    output: typeof UpdatePromptPreferencesResponseSchema;
+  },
   /**
    * @generated from rpc user.v1.UserService.CreatePrompt
    */
@@ -517,6 +1249,74 @@ export const UserService: GenService<{
     input: typeof DeletePromptRequestSchema;
     output: typeof DeletePromptResponseSchema;
   },
+  /**
+   * Lists the deleted prompts, they can be restored until they are deleted for good.
+   *
+   * @generated from rpc user.v1.UserService.ListDeletedPrompts
+   */
+  listDeletedPrompts: {
+    methodKind: "unary";
+    input: typeof ListDeletedPromptsRequestSchema;
+    output: typeof ListDeletedPromptsResponseSchema;
+  },
+  /**
+   * @generated from rpc user.v1.UserService.RestorePrompt
+   */
+  restorePrompt: {
+    methodKind: "unary";
+    input: typeof RestorePromptRequestSchema;
+    output: typeof RestorePromptResponseSchema;
+  },
+  /**
+   * Fills the variables of a prompt template. Prompts without variables are returned as they are.
+   *
+   * @generated from rpc user.v1.UserService.RenderPrompt
+   */
+  renderPrompt: {
+    methodKind: "unary";
+    input: typeof RenderPromptRequestSchema;
+    output: typeof RenderPromptResponseSchema;
+  },
+  /**
+   * Returns how often the prompts of the library were used: by the user, and by all the users for
+   * the team and built-in prompts. The prompts never used are left out.
+   *
+   * @generated from rpc user.v1.UserService.GetPromptStats
+   */
+  getPromptStats: {
+    methodKind: "unary";
+    input: typeof GetPromptStatsRequestSchema;
+    output: typeof GetPromptStatsResponseSchema;
+  },
+  /**
+   * Returns the share token of a prompt the user can edit, creating it on the first call. Anyone
+   * with the token can import a copy of the prompt until the share is revoked.
+   *
+   * @generated from rpc user.v1.UserService.SharePrompt
+   */
+  sharePrompt: {
+    methodKind: "unary";
+    input: typeof SharePromptRequestSchema;
+    output: typeof SharePromptResponseSchema;
+  },
+  /**
+   * @generated from rpc user.v1.UserService.RevokePromptShare
+   */
+  revokePromptShare: {
+    methodKind: "unary";
+    input: typeof RevokePromptShareRequestSchema;
+    output: typeof RevokePromptShareResponseSchema;
+  },
+  /**
+   * Copies a shared prompt to the prompts of the user.
+   *
+   * @generated from rpc user.v1.UserService.ImportSharedPrompt
+   */
+  importSharedPrompt: {
+    methodKind: "unary";
+    input: typeof ImportSharedPromptRequestSchema;
+    output: typeof ImportSharedPromptResponseSchema;
+  },
   /**
    * @generated from rpc user.v1.UserService.GetSettings
    */
@@ -541,6 +1341,33 @@ export const UserService: GenService<{
     input: typeof ResetSettingsRequestSchema;
     output: typeof ResetSettingsResponseSchema;
   },
+  /**
+   * @generated from rpc user.v1.UserService.ListAvailableTools
+   */
+  listAvailableTools: {
+    methodKind: "unary";
+    input: typeof ListAvailableToolsRequestSchema;
+    output: typeof ListAvailableToolsResponseSchema;
+  },
+  /**
+   * @generated from rpc user.v1.UserService.UpdateToolPreferences
+   */
+  updateToolPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateToolPreferencesRequestSchema;
+    output: typeof UpdateToolPreferencesResponseSchema;
+  },
+  /**
+   * Logs the user out everywhere and deletes the account with all its data after a grace period.
+   * Logging in again during the grace period cancels the deletion.
+   *
+   * @generated from rpc user.v1.UserService.DeleteAccount
+   */
+  deleteAccount: {
+    methodKind: "unary";
+    input: typeof DeleteAccountRequestSchema;
+    output: typeof DeleteAccountResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_user_v1_user, 0);
 
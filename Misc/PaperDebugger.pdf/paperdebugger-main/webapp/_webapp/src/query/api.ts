@@ -10,16 +10,43 @@ import {
   RefreshTokenResponseSchema,
 } from "../pkg/gen/apiclient/auth/v1/auth_pb";
 import {
+  ApplyEditRequest,
+  ApplyEditResponseSchema,
+  ApproveToolCallRequest,
   CreateConversationMessageRequest,
   CreateConversationMessageResponseSchema,
   CreateConversationMessageStreamResponse,
   CreateConversationMessageStreamResponseSchema,
   DeleteConversationRequest,
   DeleteConversationResponseSchema,
+  DenyToolCallRequest,
+  EditMessageRequest,
+  ExportConversationRequest,
+  ExportConversationResponseSchema,
+  ForkConversationRequest,
+  ForkConversationResponseSchema,
+  RegenerateMessageRequest,
+  RestoreConversationRequest,
+  RestoreConversationResponseSchema,
+  ShareConversationRequest,
+  ShareConversationResponseSchema,
+  ListSharedConversationsRequest,
+  ListSharedConversationsResponseSchema,
+  SwitchBranchRequest,
+  SwitchBranchResponseSchema,
+  WatchToolJobsRequest,
   GetConversationRequest,
   GetConversationResponseSchema,
+  ImportConversationRequest,
+  ImportConversationResponseSchema,
+  ListConversationMessagesRequest,
+  ListDeletedConversationsRequest,
+  ListDeletedConversationsResponseSchema,
+  ListConversationMessagesResponseSchema,
   ListConversationsRequest,
   ListConversationsResponseSchema,
+  SearchConversationsRequest,
+  SearchConversationsResponseSchema,
   UpdateConversationRequest,
   UpdateConversationResponseSchema,
 } from "../pkg/gen/apiclient/chat/v1/chat_pb";
@@ -28,12 +55,26 @@ import {
   GetProjectResponseSchema,
   RunProjectPaperScoreRequest,
   RunProjectPaperScoreResponseSchema,
+  RunProjectPaperScoreCommentRequest,
+  RunProjectPaperScoreCommentResponseSchema,
+  ListProjectPaperScoresRequest,
+  ListProjectPaperScoresResponseSchema,
   UpsertProjectRequest,
   UpsertProjectResponseSchema,
   GetProjectInstructionsRequest,
   GetProjectInstructionsResponseSchema,
   UpsertProjectInstructionsRequest,
   UpsertProjectInstructionsResponseSchema,
+  UpdateProjectPersonaRequest,
+  UpdateProjectPersonaResponseSchema,
+  ListProjectMembersRequest,
+  ListProjectMembersResponseSchema,
+  AddProjectMemberRequest,
+  AddProjectMemberResponseSchema,
+  UpdateProjectMemberRequest,
+  UpdateProjectMemberResponseSchema,
+  RemoveProjectMemberRequest,
+  RemoveProjectMemberResponseSchema,
 } from "../pkg/gen/apiclient/project/v1/project_pb";
 import {
   GetSettingsResponseSchema,
@@ -41,20 +82,56 @@ import {
   ResetSettingsResponseSchema,
   UpdateSettingsRequest,
   UpdateSettingsResponseSchema,
+  ListPromptsRequest,
   ListPromptsResponseSchema,
+  UpdatePromptPreferencesRequest,
+  UpdatePromptPreferencesResponseSchema,
   CreatePromptRequest,
   CreatePromptResponseSchema,
   UpdatePromptRequest,
   UpdatePromptResponseSchema,
   DeletePromptRequest,
   DeletePromptResponseSchema,
+  RenderPromptRequest,
+  RenderPromptResponseSchema,
+  ListDeletedPromptsResponseSchema,
+  RestorePromptRequest,
+  RestorePromptResponseSchema,
+  GetPromptStatsResponseSchema,
+  SharePromptRequest,
+  SharePromptResponseSchema,
+  RevokePromptShareRequest,
+  RevokePromptShareResponseSchema,
+  ImportSharedPromptRequest,
+  ImportSharedPromptResponseSchema,
   GetSettingsResponse,
   GetUserResponse,
   GetUserInstructionsResponseSchema,
   UpsertUserInstructionsRequest,
   UpsertUserInstructionsResponseSchema,
   GetUserInstructionsRequest,
+  DeleteAccountRequest,
+  DeleteAccountResponseSchema,
 } from "../pkg/gen/apiclient/user/v1/user_pb";
+import {
+  ListOrganizationsResponseSchema,
+  CreateOrganizationRequest,
+  CreateOrganizationResponseSchema,
+  UpdateOrganizationRequest,
+  UpdateOrganizationResponseSchema,
+  DeleteOrganizationRequest,
+  DeleteOrganizationResponseSchema,
+  ListOrganizationMembersRequest,
+  ListOrganizationMembersResponseSchema,
+  AddOrganizationMemberRequest,
+  AddOrganizationMemberResponseSchema,
+  UpdateOrganizationMemberRequest,
+  UpdateOrganizationMemberResponseSchema,
+  RemoveOrganizationMemberRequest,
+  RemoveOrganizationMemberResponseSchema,
+  AcceptOrganizationInvitationRequest,
+  AcceptOrganizationInvitationResponseSchema,
+} from "../pkg/gen/apiclient/organization/v1/organization_pb";
 import { PlainMessage } from "./types";
 import { fromJson } from "@bufbuild/protobuf";
 import { processStream } from "./utils";
@@ -92,6 +169,16 @@ export const getUser = async (): Promise<PlainMessage<GetUserResponse>> => {
   return fromJson(GetUserResponseSchema, response);
 };
 
+// The export is a file download rather than an RPC, it can be larger than a single message.
+export const exportMyData = async () => {
+  return apiclient.download("/users/@self/export", "paperdebugger-data.zip");
+};
+
+export const deleteAccount = async (data: PlainMessage<DeleteAccountRequest>) => {
+  const response = await apiclient.post("/users/@self/delete", data);
+  return fromJson(DeleteAccountResponseSchema, response);
+};
+
 // New settings API endpoints
 export const getSettings = async (): Promise<PlainMessage<GetSettingsResponse>> => {
   if (!apiclient.hasToken()) {
@@ -118,6 +205,19 @@ export const listConversations = async (data: PlainMessage<ListConversationsRequ
   return fromJson(ListConversationsResponseSchema, response);
 };
 
+export const searchConversations = async (data: PlainMessage<SearchConversationsRequest>) => {
+  const response = await apiclient.post("/chats/conversations/search", data);
+  return fromJson(SearchConversationsResponseSchema, response);
+};
+
+export const listConversationMessages = async (data: PlainMessage<ListConversationMessagesRequest>) => {
+  const response = await apiclient.get(`/chats/conversations/${data.conversationId}/messages`, {
+    pageToken: data.pageToken,
+    pageSize: data.pageSize,
+  });
+  return fromJson(ListConversationMessagesResponseSchema, response);
+};
+
 export const getConversation = async (data: PlainMessage<GetConversationRequest>) => {
   const response = await apiclient.get(`/chats/conversations/${data.conversationId}`);
   return fromJson(GetConversationResponseSchema, response);
@@ -139,11 +239,113 @@ export const createConversationMessageStream = async (
   await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
 };
 
+export const approveToolCall = async (
+  data: PlainMessage<ApproveToolCallRequest>,
+  onMessage: (chunk: CreateConversationMessageStreamResponse) => void,
+) => {
+  const stream = await apiclient.postStream(
+    `/chats/conversations/${data.conversationId}/tool-calls/${data.toolCallId}/approve`,
+    data,
+  );
+  await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
+};
+
+export const denyToolCall = async (
+  data: PlainMessage<DenyToolCallRequest>,
+  onMessage: (chunk: CreateConversationMessageStreamResponse) => void,
+) => {
+  const stream = await apiclient.postStream(
+    `/chats/conversations/${data.conversationId}/tool-calls/${data.toolCallId}/deny`,
+    data,
+  );
+  await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
+};
+
+export const watchToolJobs = async (
+  data: PlainMessage<WatchToolJobsRequest>,
+  onMessage: (chunk: CreateConversationMessageStreamResponse) => void,
+) => {
+  const stream = await apiclient.getStream(`/chats/conversations/${data.conversationId}/tool-jobs`);
+  await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
+};
+
+export const applyEdit = async (data: PlainMessage<ApplyEditRequest>) => {
+  const response = await apiclient.post(`/chats/conversations/${data.conversationId}/edits/${data.editId}`, data);
+  return fromJson(ApplyEditResponseSchema, response);
+};
+
+export const editMessage = async (
+  data: PlainMessage<EditMessageRequest>,
+  onMessage: (chunk: CreateConversationMessageStreamResponse) => void,
+) => {
+  const stream = await apiclient.postStream(
+    `/chats/conversations/${data.conversationId}/messages/${data.messageId}/edit`,
+    data,
+  );
+  await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
+};
+
+export const regenerateMessage = async (
+  data: PlainMessage<RegenerateMessageRequest>,
+  onMessage: (chunk: CreateConversationMessageStreamResponse) => void,
+) => {
+  const stream = await apiclient.postStream(
+    `/chats/conversations/${data.conversationId}/messages/${data.messageId}/regenerate`,
+    data,
+  );
+  await processStream(stream, CreateConversationMessageStreamResponseSchema, onMessage);
+};
+
+export const switchBranch = async (data: PlainMessage<SwitchBranchRequest>) => {
+  const response = await apiclient.post(
+    `/chats/conversations/${data.conversationId}/messages/${data.messageId}/switch`,
+    data,
+  );
+  return fromJson(SwitchBranchResponseSchema, response);
+};
+
+export const forkConversation = async (data: PlainMessage<ForkConversationRequest>) => {
+  const response = await apiclient.post(`/chats/conversations/${data.conversationId}/fork`, data);
+  return fromJson(ForkConversationResponseSchema, response);
+};
+
+export const exportConversation = async (data: PlainMessage<ExportConversationRequest>) => {
+  const response = await apiclient.get(`/chats/conversations/${data.conversationId}/export`, {
+    format: data.format,
+  });
+  return fromJson(ExportConversationResponseSchema, response);
+};
+
+export const importConversation = async (data: PlainMessage<ImportConversationRequest>) => {
+  const response = await apiclient.post("/chats/conversations/import", data);
+  return fromJson(ImportConversationResponseSchema, response);
+};
+
 export const deleteConversation = async (data: PlainMessage<DeleteConversationRequest>) => {
   const response = await apiclient.delete(`/chats/conversations/${data.conversationId}`);
   return fromJson(DeleteConversationResponseSchema, response);
 };
 
+export const listDeletedConversations = async (data: PlainMessage<ListDeletedConversationsRequest>) => {
+  const response = await apiclient.get("/chats/deleted-conversations", { projectId: data.projectId });
+  return fromJson(ListDeletedConversationsResponseSchema, response);
+};
+
+export const restoreConversation = async (data: PlainMessage<RestoreConversationRequest>) => {
+  const response = await apiclient.post(`/chats/conversations/${data.conversationId}/restore`, data);
+  return fromJson(RestoreConversationResponseSchema, response);
+};
+
+export const shareConversation = async (data: PlainMessage<ShareConversationRequest>) => {
+  const response = await apiclient.post(`/chats/conversations/${data.conversationId}/share`, data);
+  return fromJson(ShareConversationResponseSchema, response);
+};
+
+export const listSharedConversations = async (data: PlainMessage<ListSharedConversationsRequest>) => {
+  const response = await apiclient.get("/chats/shared-conversations", { projectId: data.projectId });
+  return fromJson(ListSharedConversationsResponseSchema, response);
+};
+
 export const updateConversation = async (data: PlainMessage<UpdateConversationRequest>) => {
   const response = await apiclient.patch(`/chats/conversations/${data.conversationId}`, data);
   return fromJson(UpdateConversationResponseSchema, response);
@@ -161,16 +363,21 @@ export const upsertProject = async (data: PlainMessage<UpsertProjectRequest>) =>
   return fromJson(UpsertProjectResponseSchema, response);
 };
 
-export const listPrompts = async () => {
+export const listPrompts = async (data: PlainMessage<ListPromptsRequest>) => {
   if (!apiclient.hasToken()) {
     return fromJson(ListPromptsResponseSchema, { prompts: [] });
   }
-  const response = await apiclient.get("/users/@self/prompts", undefined, {
+  const response = await apiclient.get("/users/@self/prompts", data, {
     ignoreErrorToast: true,
   });
   return fromJson(ListPromptsResponseSchema, response);
 };
 
+export const updatePromptPreferences = async (data: PlainMessage<UpdatePromptPreferencesRequest>) => {
+  const response = await apiclient.put("/users/@self/prompt-preferences", data);
+  return fromJson(UpdatePromptPreferencesResponseSchema, response);
+};
+
 export const createPrompt = async (data: PlainMessage<CreatePromptRequest>) => {
   const response = await apiclient.post("/users/@self/prompts", data);
   return fromJson(CreatePromptResponseSchema, response);
@@ -186,6 +393,41 @@ export const deletePrompt = async (data: PlainMessage<DeletePromptRequest>) => {
   return fromJson(DeletePromptResponseSchema, response);
 };
 
+export const renderPrompt = async (data: PlainMessage<RenderPromptRequest>) => {
+  const response = await apiclient.post(`/users/@self/prompts/${data.promptId}/render`, data);
+  return fromJson(RenderPromptResponseSchema, response);
+};
+
+export const listDeletedPrompts = async () => {
+  const response = await apiclient.get("/users/@self/deleted-prompts");
+  return fromJson(ListDeletedPromptsResponseSchema, response);
+};
+
+export const restorePrompt = async (data: PlainMessage<RestorePromptRequest>) => {
+  const response = await apiclient.post(`/users/@self/prompts/${data.promptId}/restore`, data);
+  return fromJson(RestorePromptResponseSchema, response);
+};
+
+export const getPromptStats = async () => {
+  const response = await apiclient.get("/users/@self/prompt-stats");
+  return fromJson(GetPromptStatsResponseSchema, response);
+};
+
+export const sharePrompt = async (data: PlainMessage<SharePromptRequest>) => {
+  const response = await apiclient.post(`/users/@self/prompts/${data.promptId}/share`, data);
+  return fromJson(SharePromptResponseSchema, response);
+};
+
+export const revokePromptShare = async (data: PlainMessage<RevokePromptShareRequest>) => {
+  const response = await apiclient.delete(`/users/@self/prompts/${data.promptId}/share`);
+  return fromJson(RevokePromptShareResponseSchema, response);
+};
+
+export const importSharedPrompt = async (data: PlainMessage<ImportSharedPromptRequest>) => {
+  const response = await apiclient.post("/users/@self/prompts/import", data);
+  return fromJson(ImportSharedPromptResponseSchema, response);
+};
+
 export const getUserInstructions = async (data: PlainMessage<GetUserInstructionsRequest>) => {
   if (!apiclient.hasToken()) {
     throw new Error("No token");
@@ -204,12 +446,24 @@ export const upsertUserInstructions = async (data: PlainMessage<UpsertUserInstru
   return fromJson(UpsertUserInstructionsResponseSchema, response);
 };
 
-// Deprecated, use function call in LLMs instead. We do not need to call this function anymore.
 export const runProjectPaperScore = async (data: PlainMessage<RunProjectPaperScoreRequest>) => {
   const response = await apiclient.post(`/projects/${data.projectId}/paper-score`, data);
   return fromJson(RunProjectPaperScoreResponseSchema, response);
 };
 
+export const runProjectPaperScoreComment = async (data: PlainMessage<RunProjectPaperScoreCommentRequest>) => {
+  const response = await apiclient.post(`/projects/${data.projectId}/paper-score-comment`, data);
+  return fromJson(RunProjectPaperScoreCommentResponseSchema, response);
+};
+
+export const listProjectPaperScores = async (data: PlainMessage<ListProjectPaperScoresRequest>) => {
+  if (!apiclient.hasToken()) {
+    throw new Error("No token");
+  }
+  const response = await apiclient.get(`/projects/${data.projectId}/paper-scores`, { limit: data.limit });
+  return fromJson(ListProjectPaperScoresResponseSchema, response);
+};
+
 export const getProjectInstructions = async (data: PlainMessage<GetProjectInstructionsRequest>) => {
   if (!apiclient.hasToken()) {
     throw new Error("No token");
@@ -228,6 +482,85 @@ export const upsertProjectInstructions = async (data: PlainMessage<UpsertProject
   return fromJson(UpsertProjectInstructionsResponseSchema, response);
 };
 
+export const updateProjectPersona = async (data: PlainMessage<UpdateProjectPersonaRequest>) => {
+  const response = await apiclient.put(`/projects/${data.projectId}/persona`, data);
+  return fromJson(UpdateProjectPersonaResponseSchema, response);
+};
+
+export const listProjectMembers = async (data: PlainMessage<ListProjectMembersRequest>) => {
+  const response = await apiclient.get(`/projects/${data.projectId}/members`, undefined, {
+    ignoreErrorToast: true,
+  });
+  return fromJson(ListProjectMembersResponseSchema, response);
+};
+
+export const addProjectMember = async (data: PlainMessage<AddProjectMemberRequest>) => {
+  const response = await apiclient.post(`/projects/${data.projectId}/members`, data);
+  return fromJson(AddProjectMemberResponseSchema, response);
+};
+
+export const updateProjectMember = async (data: PlainMessage<UpdateProjectMemberRequest>) => {
+  const response = await apiclient.patch(`/projects/${data.projectId}/members/${data.userId}`, data);
+  return fromJson(UpdateProjectMemberResponseSchema, response);
+};
+
+export const removeProjectMember = async (data: PlainMessage<RemoveProjectMemberRequest>) => {
+  const response = await apiclient.delete(`/projects/${data.projectId}/members/${data.userId}`);
+  return fromJson(RemoveProjectMemberResponseSchema, response);
+};
+
+export const listOrganizations = async () => {
+  if (!apiclient.hasToken()) {
+    return fromJson(ListOrganizationsResponseSchema, { organizations: [] });
+  }
+  const response = await apiclient.get("/organizations", undefined, {
+    ignoreErrorToast: true,
+  });
+  return fromJson(ListOrganizationsResponseSchema, response);
+};
+
+export const createOrganization = async (data: PlainMessage<CreateOrganizationRequest>) => {
+  const response = await apiclient.post("/organizations", data);
+  return fromJson(CreateOrganizationResponseSchema, response);
+};
+
+export const updateOrganization = async (data: PlainMessage<UpdateOrganizationRequest>) => {
+  const response = await apiclient.patch(`/organizations/${data.organizationId}`, data);
+  return fromJson(UpdateOrganizationResponseSchema, response);
+};
+
+export const deleteOrganization = async (data: PlainMessage<DeleteOrganizationRequest>) => {
+  const response = await apiclient.delete(`/organizations/${data.organizationId}`);
+  return fromJson(DeleteOrganizationResponseSchema, response);
+};
+
+export const listOrganizationMembers = async (data: PlainMessage<ListOrganizationMembersRequest>) => {
+  const response = await apiclient.get(`/organizations/${data.organizationId}/members`, undefined, {
+    ignoreErrorToast: true,
+  });
+  return fromJson(ListOrganizationMembersResponseSchema, response);
+};
+
+export const addOrganizationMember = async (data: PlainMessage<AddOrganizationMemberRequest>) => {
+  const response = await apiclient.post(`/organizations/${data.organizationId}/members`, data);
+  return fromJson(AddOrganizationMemberResponseSchema, response);
+};
+
+export const updateOrganizationMember = async (data: PlainMessage<UpdateOrganizationMemberRequest>) => {
+  const response = await apiclient.patch(`/organizations/${data.organizationId}/members/${data.userId}`, data);
+  return fromJson(UpdateOrganizationMemberResponseSchema, response);
+};
+
+export const removeOrganizationMember = async (data: PlainMessage<RemoveOrganizationMemberRequest>) => {
+  const response = await apiclient.delete(`/organizations/${data.organizationId}/members/${data.userId}`);
+  return fromJson(RemoveOrganizationMemberResponseSchema, response);
+};
+
+export const acceptOrganizationInvitation = async (data: PlainMessage<AcceptOrganizationInvitationRequest>) => {
+  const response = await apiclient.post(`/organizations/${data.organizationId}/accept`, data);
+  return fromJson(AcceptOrganizationInvitationResponseSchema, response);
+};
+
 export const acceptComments = async (data: PlainMessage<CommentsAcceptedRequest>) => {
   const response = await apiclient.post(`/comments/accepted`, data);
   return fromJson(CommentsAcceptedResponseSchema, response);
@@ -1,9 +1,15 @@
-import { useMutation, useQuery } from "@tanstack/react-query";
+import { useInfiniteQuery, useMutation, useQuery } from "@tanstack/react-query";
 import {
   CreateConversationMessageResponse,
   DeleteConversationResponse,
+  ForkConversationResponse,
   GetConversationResponse,
   ListConversationsResponse,
+  ListDeletedConversationsResponse,
+  RestoreConversationResponse,
+  ShareConversationResponse,
+  ListSharedConversationsResponse,
+  SearchConversationsResponse,
   UpdateConversationResponse,
 } from "../pkg/gen/apiclient/chat/v1/chat_pb";
 import { UseMutationOptionsOverride, UseQueryOptionsOverride } from "./types";
@@ -12,22 +18,60 @@ import {
   createPrompt,
   deleteConversation,
   deletePrompt,
+  forkConversation,
   getConversation,
   getProject,
   listConversations,
+  listDeletedConversations,
+  restoreConversation,
+  shareConversation,
+  listSharedConversations,
+  listDeletedPrompts,
+  restorePrompt,
+  searchConversations,
   listPrompts,
+  updatePromptPreferences,
+  renderPrompt,
+  getPromptStats,
+  sharePrompt,
+  revokePromptShare,
+  importSharedPrompt,
   runProjectPaperScore,
+  runProjectPaperScoreComment,
+  listProjectPaperScores,
   updateConversation,
   updatePrompt,
   getUserInstructions,
   upsertUserInstructions,
   getProjectInstructions,
   upsertProjectInstructions,
+  updateProjectPersona,
+  listProjectMembers,
+  addProjectMember,
+  updateProjectMember,
+  removeProjectMember,
+  listOrganizations,
+  createOrganization,
+  updateOrganization,
+  deleteOrganization,
+  listOrganizationMembers,
+  addOrganizationMember,
+  updateOrganizationMember,
+  removeOrganizationMember,
+  acceptOrganizationInvitation,
 } from "./api";
 import {
   CreatePromptResponse,
   DeletePromptResponse,
   ListPromptsResponse,
+  UpdatePromptPreferencesResponse,
+  RenderPromptResponse,
+  ListDeletedPromptsResponse,
+  RestorePromptResponse,
+  GetPromptStatsResponse,
+  SharePromptResponse,
+  RevokePromptShareResponse,
+  ImportSharedPromptResponse,
   UpdatePromptResponse,
   GetUserInstructionsResponse,
   UpsertUserInstructionsResponse,
@@ -36,9 +80,27 @@ import { queryKeys } from "./keys";
 import {
   GetProjectResponse,
   RunProjectPaperScoreResponse,
+  RunProjectPaperScoreCommentResponse,
+  ListProjectPaperScoresResponse,
   GetProjectInstructionsResponse,
   UpsertProjectInstructionsResponse,
+  UpdateProjectPersonaResponse,
+  ListProjectMembersResponse,
+  AddProjectMemberResponse,
+  UpdateProjectMemberResponse,
+  RemoveProjectMemberResponse,
 } from "../pkg/gen/apiclient/project/v1/project_pb";
+import {
+  ListOrganizationsResponse,
+  CreateOrganizationResponse,
+  UpdateOrganizationResponse,
+  DeleteOrganizationResponse,
+  ListOrganizationMembersResponse,
+  AddOrganizationMemberResponse,
+  UpdateOrganizationMemberResponse,
+  RemoveOrganizationMemberResponse,
+  AcceptOrganizationInvitationResponse,
+} from "../pkg/gen/apiclient/organization/v1/organization_pb";
 import { useAuthStore } from "../stores/auth-store";
 
 // Deprecated
@@ -63,7 +125,23 @@ export const useGetProjectQuery = (projectId: string, opts?: UseQueryOptionsOver
 export const useListPromptsQuery = (opts?: UseQueryOptionsOverride<ListPromptsResponse>) => {
   return useQuery({
     queryKey: queryKeys.prompts.listPrompts().queryKey,
-    queryFn: listPrompts,
+    queryFn: () => listPrompts({ locale: navigator.language, includeHidden: false }),
+    ...opts,
+  });
+};
+
+export const useUpdatePromptPreferencesMutation = (
+  opts?: UseMutationOptionsOverride<UpdatePromptPreferencesResponse>,
+) => {
+  return useMutation({
+    mutationFn: updatePromptPreferences,
+    ...opts,
+  });
+};
+
+export const useRenderPromptMutation = (opts?: UseMutationOptionsOverride<RenderPromptResponse>) => {
+  return useMutation({
+    mutationFn: renderPrompt,
     ...opts,
   });
 };
@@ -89,16 +167,73 @@ export const useDeletePromptMutation = (opts?: UseMutationOptionsOverride<Delete
   });
 };
 
-export const useListConversationsQuery = (
-  projectId: string,
-  opts?: UseQueryOptionsOverride<ListConversationsResponse>,
-) => {
+export const useListDeletedPromptsQuery = (opts?: UseQueryOptionsOverride<ListDeletedPromptsResponse>) => {
+  return useQuery({
+    queryKey: queryKeys.prompts.listDeletedPrompts().queryKey,
+    queryFn: listDeletedPrompts,
+    ...opts,
+  });
+};
+
+export const useRestorePromptMutation = (opts?: UseMutationOptionsOverride<RestorePromptResponse>) => {
+  return useMutation({
+    mutationFn: restorePrompt,
+    ...opts,
+  });
+};
+
+export const useGetPromptStatsQuery = (opts?: UseQueryOptionsOverride<GetPromptStatsResponse>) => {
+  return useQuery({
+    queryKey: queryKeys.prompts.getPromptStats().queryKey,
+    queryFn: getPromptStats,
+    ...opts,
+  });
+};
+
+export const useSharePromptMutation = (opts?: UseMutationOptionsOverride<SharePromptResponse>) => {
+  return useMutation({
+    mutationFn: sharePrompt,
+    ...opts,
+  });
+};
+
+export const useRevokePromptShareMutation = (opts?: UseMutationOptionsOverride<RevokePromptShareResponse>) => {
+  return useMutation({
+    mutationFn: revokePromptShare,
+    ...opts,
+  });
+};
+
+export const useImportSharedPromptMutation = (opts?: UseMutationOptionsOverride<ImportSharedPromptResponse>) => {
+  return useMutation({
+    mutationFn: importSharedPrompt,
+    ...opts,
+  });
+};
+
+// The conversations are loaded page by page, most recently updated first, see fetchNextPage.
+export const useListConversationsQuery = (projectId: string) => {
   // 如果登录，才获取
   const { user } = useAuthStore();
-  return useQuery({
+  return useInfiniteQuery({
     queryKey: queryKeys.conversations.listConversations(projectId).queryKey,
-    queryFn: () => listConversations({ projectId }),
+    queryFn: ({ pageParam }) => listConversations({ projectId, pageToken: pageParam, pageSize: 0 }),
+    initialPageParam: undefined as string | undefined,
+    getNextPageParam: (lastPage: ListConversationsResponse) => lastPage.nextPageToken,
     enabled: !!user,
+  });
+};
+
+export const useSearchConversationsQuery = (
+  projectId: string,
+  query: string,
+  opts?: UseQueryOptionsOverride<SearchConversationsResponse>,
+) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.conversations.searchConversations(projectId, query).queryKey,
+    queryFn: () => searchConversations({ projectId, query, limit: 0 }),
+    enabled: !!user && !!query,
     ...opts,
   });
 };
@@ -110,6 +245,53 @@ export const useDeleteConversationMutation = (opts?: UseMutationOptionsOverride<
   });
 };
 
+export const useListDeletedConversationsQuery = (
+  projectId: string,
+  opts?: UseQueryOptionsOverride<ListDeletedConversationsResponse>,
+) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.conversations.listDeletedConversations(projectId).queryKey,
+    queryFn: () => listDeletedConversations({ projectId }),
+    enabled: !!user,
+    ...opts,
+  });
+};
+
+export const useRestoreConversationMutation = (opts?: UseMutationOptionsOverride<RestoreConversationResponse>) => {
+  return useMutation({
+    mutationFn: restoreConversation,
+    ...opts,
+  });
+};
+
+export const useShareConversationMutation = (opts?: UseMutationOptionsOverride<ShareConversationResponse>) => {
+  return useMutation({
+    mutationFn: shareConversation,
+    ...opts,
+  });
+};
+
+export const useListSharedConversationsQuery = (
+  projectId: string,
+  opts?: UseQueryOptionsOverride<ListSharedConversationsResponse>,
+) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.conversations.listSharedConversations(projectId).queryKey,
+    queryFn: () => listSharedConversations({ projectId, pageSize: 0 }),
+    enabled: !!user && !!projectId,
+    ...opts,
+  });
+};
+
+export const useForkConversationMutation = (opts?: UseMutationOptionsOverride<ForkConversationResponse>) => {
+  return useMutation({
+    mutationFn: forkConversation,
+    ...opts,
+  });
+};
+
 export const useRunProjectPaperScoreMutation = (opts?: UseMutationOptionsOverride<RunProjectPaperScoreResponse>) => {
   return useMutation({
     mutationFn: runProjectPaperScore,
@@ -117,6 +299,27 @@ export const useRunProjectPaperScoreMutation = (opts?: UseMutationOptionsOverrid
   });
 };
 
+export const useRunProjectPaperScoreCommentMutation = (
+  opts?: UseMutationOptionsOverride<RunProjectPaperScoreCommentResponse>,
+) => {
+  return useMutation({
+    mutationFn: runProjectPaperScoreComment,
+    ...opts,
+  });
+};
+
+export const useListProjectPaperScoresQuery = (
+  projectId: string,
+  opts?: UseQueryOptionsOverride<ListProjectPaperScoresResponse>,
+) => {
+  return useQuery({
+    queryKey: queryKeys.projects.listProjectPaperScores(projectId).queryKey,
+    queryFn: () => listProjectPaperScores({ projectId, limit: 0 }),
+    enabled: !!projectId,
+    ...opts,
+  });
+};
+
 export const useGetConversationQuery = (
   conversationId: string,
   opts?: UseQueryOptionsOverride<GetConversationResponse>,
@@ -185,3 +388,123 @@ export const useUpsertProjectInstructionsMutation = (
     ...opts,
   });
 };
+
+export const useUpdateProjectPersonaMutation = (opts?: UseMutationOptionsOverride<UpdateProjectPersonaResponse>) => {
+  return useMutation({
+    mutationFn: updateProjectPersona,
+    ...opts,
+  });
+};
+
+// Project Members
+export const useListProjectMembersQuery = (
+  projectId: string,
+  opts?: UseQueryOptionsOverride<ListProjectMembersResponse>,
+) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.projects.listProjectMembers(projectId).queryKey,
+    queryFn: () => listProjectMembers({ projectId }),
+    enabled: !!user && !!projectId,
+    ...opts,
+  });
+};
+
+export const useAddProjectMemberMutation = (opts?: UseMutationOptionsOverride<AddProjectMemberResponse>) => {
+  return useMutation({
+    mutationFn: addProjectMember,
+    ...opts,
+  });
+};
+
+export const useUpdateProjectMemberMutation = (opts?: UseMutationOptionsOverride<UpdateProjectMemberResponse>) => {
+  return useMutation({
+    mutationFn: updateProjectMember,
+    ...opts,
+  });
+};
+
+export const useRemoveProjectMemberMutation = (opts?: UseMutationOptionsOverride<RemoveProjectMemberResponse>) => {
+  return useMutation({
+    mutationFn: removeProjectMember,
+    ...opts,
+  });
+};
+
+export const useListOrganizationsQuery = (opts?: UseQueryOptionsOverride<ListOrganizationsResponse>) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.organizations.listOrganizations().queryKey,
+    queryFn: listOrganizations,
+    enabled: !!user,
+    ...opts,
+  });
+};
+
+export const useCreateOrganizationMutation = (opts?: UseMutationOptionsOverride<CreateOrganizationResponse>) => {
+  return useMutation({
+    mutationFn: createOrganization,
+    ...opts,
+  });
+};
+
+export const useUpdateOrganizationMutation = (opts?: UseMutationOptionsOverride<UpdateOrganizationResponse>) => {
+  return useMutation({
+    mutationFn: updateOrganization,
+    ...opts,
+  });
+};
+
+export const useDeleteOrganizationMutation = (opts?: UseMutationOptionsOverride<DeleteOrganizationResponse>) => {
+  return useMutation({
+    mutationFn: deleteOrganization,
+    ...opts,
+  });
+};
+
+export const useListOrganizationMembersQuery = (
+  organizationId: string,
+  opts?: UseQueryOptionsOverride<ListOrganizationMembersResponse>,
+) => {
+  const { user } = useAuthStore();
+  return useQuery({
+    queryKey: queryKeys.organizations.listOrganizationMembers(organizationId).queryKey,
+    queryFn: () => listOrganizationMembers({ organizationId }),
+    enabled: !!user && !!organizationId,
+    ...opts,
+  });
+};
+
+export const useAddOrganizationMemberMutation = (opts?: UseMutationOptionsOverride<AddOrganizationMemberResponse>) => {
+  return useMutation({
+    mutationFn: addOrganizationMember,
+    ...opts,
+  });
+};
+
+export const useUpdateOrganizationMemberMutation = (
+  opts?: UseMutationOptionsOverride<UpdateOrganizationMemberResponse>,
+) => {
+  return useMutation({
+    mutationFn: updateOrganizationMember,
+    ...opts,
+  });
+};
+
+export const useRemoveOrganizationMemberMutation = (
+  opts?: UseMutationOptionsOverride<RemoveOrganizationMemberResponse>,
+) => {
+  return useMutation({
+    mutationFn: removeOrganizationMember,
+    ...opts,
+  });
+};
+
+export const useAcceptOrganizationInvitationMutation = (
+  opts?: UseMutationOptionsOverride<AcceptOrganizationInvitationResponse>,
+) => {
+  return useMutation({
+    mutationFn: acceptOrganizationInvitation,
+    ...opts,
+  });
+};
@@ -7,10 +7,15 @@ export const queryKeys = createQueryKeyStore({
   },
   prompts: {
     listPrompts: () => ["users", "@self", "prompts"],
+    listDeletedPrompts: () => ["users", "@self", "deleted-prompts"],
+    getPromptStats: () => ["users", "@self", "prompt-stats"],
   },
   conversations: {
     listConversations: (projectId: string) => ["conversations", projectId],
     getConversation: (conversationId: string) => ["conversations", conversationId],
+    searchConversations: (projectId: string, query: string) => ["conversations", projectId, "search", query],
+    listDeletedConversations: (projectId: string) => ["conversations", projectId, "deleted"],
+    listSharedConversations: (projectId: string) => ["conversations", projectId, "shared"],
   },
   projects: {
     getProject: (projectId: string) => ["projects", projectId],
@@ -20,7 +25,13 @@ export const queryKeys = createQueryKeyStore({
       projectId,
       conversationId,
     ],
+    listProjectPaperScores: (projectId: string) => ["projects", projectId, "paper-scores"],
     getProjectInstructions: (projectId: string) => ["projects", projectId, "instructions"],
+    listProjectMembers: (projectId: string) => ["projects", projectId, "members"],
+  },
+  organizations: {
+    listOrganizations: () => ["organizations"],
+    listOrganizationMembers: (organizationId: string) => ["organizations", organizationId, "members"],
   },
   comments: {
     accepted: (projectId: string, conversationId: string, commentIds: string[]) => [
@@ -35,6 +35,10 @@ interface ConversationUiStore {
   prompt: string;
   setPrompt: (prompt: string) => void;
 
+  // the prompt of the library the input was written from, sent with the message
+  promptId: string;
+  setPromptId: (promptId: string) => void;
+
   showChatHistory: boolean;
   setShowChatHistory: (showChatHistory: boolean) => void;
 
@@ -81,6 +85,9 @@ export const useConversationUiStore = create<ConversationUiStore>((set) => ({
   prompt: "",
   setPrompt: (prompt: string) => set({ prompt }),
 
+  promptId: "",
+  setPromptId: (promptId: string) => set({ promptId }),
+
   showChatHistory: false,
   setShowChatHistory: (showChatHistory: boolean) => set({ showChatHistory: showChatHistory }),
 
@@ -1,4 +1,4 @@
-import { fromJson } from "@bufbuild/protobuf";
+import { create, fromJson } from "@bufbuild/protobuf";
 import { Conversation, LanguageModel, Message, MessageSchema } from "../../../pkg/gen/apiclient/chat/v1/chat_pb";
 import { MessageEntry, MessageEntryStatus } from "../types";
 import { useStreamingMessageStore } from "../../streaming-message-store";
@@ -15,6 +15,24 @@ export const convertMessageEntryToMessage = (messageEntry: MessageEntry): Messag
         },
       },
     });
+  } else if (messageEntry.revision) {
+    return fromJson(MessageSchema, {
+      messageId: messageEntry.messageId,
+      payload: {
+        revision: {
+          original: messageEntry.revision.original,
+          revised: messageEntry.revision.revised,
+          rationale: messageEntry.revision.rationale,
+        },
+      },
+    });
+  } else if (messageEntry.textEdits) {
+    return create(MessageSchema, {
+      messageId: messageEntry.messageId,
+      payload: {
+        messageType: { case: "textEdits", value: messageEntry.textEdits },
+      },
+    });
   } else if (messageEntry.toolCall) {
     return fromJson(MessageSchema, {
       messageId: messageEntry.messageId,
@@ -27,6 +45,17 @@ export const convertMessageEntryToMessage = (messageEntry: MessageEntry): Messag
         },
       },
     });
+  } else if (messageEntry.toolCallApprovalRequired) {
+    return fromJson(MessageSchema, {
+      messageId: messageEntry.messageId,
+      payload: {
+        toolCallApprovalRequired: {
+          toolCallId: messageEntry.toolCallApprovalRequired.toolCallId,
+          name: messageEntry.toolCallApprovalRequired.name,
+          args: messageEntry.toolCallApprovalRequired.args,
+        },
+      },
+    });
   } else if (messageEntry.user) {
     return fromJson(MessageSchema, {
       messageId: messageEntry.messageId,
@@ -60,7 +89,11 @@ export const flushStreamingMessageToConversation = (conversationId?: string, lan
       ...prev,
       id: conversationId ?? prev.id,
       languageModel: languageModel ?? prev.languageModel,
-      messages: [...prev.messages, ...flushMessages],
+      // a resolved tool call replaces its approval request, they share the same message id
+      messages: [
+        ...prev.messages.map((message) => flushMessages.find((m) => m.messageId === message.messageId) ?? message),
+        ...flushMessages.filter((m) => !prev.messages.some((message) => message.messageId === m.messageId)),
+      ],
     }));
   });
 
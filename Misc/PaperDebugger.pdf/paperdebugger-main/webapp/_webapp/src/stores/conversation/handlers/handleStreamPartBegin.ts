@@ -18,6 +18,16 @@ export function handleStreamPartBegin(
       parts: [...prev.parts, newMessageEntry],
       sequence: prev.sequence + 1,
     }));
+  } else if (role === "revision") {
+    const newMessageEntry: MessageEntry = {
+      messageId: partBegin.messageId,
+      status: MessageEntryStatus.PREPARING,
+      revision: partBegin.payload?.messageType.value,
+    };
+    updateStreamingMessage((prev) => ({
+      parts: [...prev.parts, newMessageEntry],
+      sequence: prev.sequence + 1,
+    }));
   } else if (role === "toolCallPrepareArguments") {
     const newMessageEntry: MessageEntry = {
       messageId: partBegin.messageId,
@@ -38,6 +48,10 @@ export function handleStreamPartBegin(
       parts: [...prev.parts, newMessageEntry],
       sequence: prev.sequence + 1,
     }));
+  } else if (role === "toolCallApprovalRequired") {
+    // not possible, sent as a part end
+  } else if (role === "textEdits") {
+    // not possible, sent as a part end
   } else if (role === "system") {
     // not possible
   } else if (role === "user") {
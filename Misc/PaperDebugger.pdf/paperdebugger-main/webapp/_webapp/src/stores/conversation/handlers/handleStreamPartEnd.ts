@@ -1,12 +1,15 @@
 import {
   MessageTypeAssistant,
+  MessageTypeRevision,
+  MessageTypeTextEdits,
   MessageTypeToolCall,
+  MessageTypeToolCallApprovalRequired,
   MessageTypeToolCallPrepareArguments,
   StreamPartEnd,
 } from "../../../pkg/gen/apiclient/chat/v1/chat_pb";
 import { StreamingMessage } from "../../streaming-message-store";
 import { logError } from "../../../libs/logger";
-import { MessageEntryStatus } from "../types";
+import { MessageEntry, MessageEntryStatus } from "../types";
 
 export function handleStreamPartEnd(
   partEnd: StreamPartEnd,
@@ -23,6 +26,28 @@ export function handleStreamPartEnd(
               ...part,
               status: MessageEntryStatus.FINALIZED,
               assistant: assistantMessage,
+              revision: undefined, // the model did not reply with a revision
+            };
+          }
+          return part;
+        });
+        return {
+          ...prev,
+          parts: newParts,
+          sequence: prev.sequence + 1,
+        };
+      });
+      break;
+    }
+    case "revision": {
+      updateStreamingMessage((prev) => {
+        const newParts = prev.parts.map((part) => {
+          if (part.messageId === partEnd.messageId) {
+            const revision = partEnd.payload?.messageType.value as MessageTypeRevision;
+            return {
+              ...part,
+              status: MessageEntryStatus.FINALIZED,
+              revision: revision,
             };
           }
           return part;
@@ -77,6 +102,34 @@ export function handleStreamPartEnd(
       });
       break;
     }
+    case "toolCallApprovalRequired": {
+      // the tool call waits for the user, there is no part begin
+      const newMessageEntry: MessageEntry = {
+        messageId: partEnd.messageId,
+        status: MessageEntryStatus.FINALIZED,
+        toolCallApprovalRequired: partEnd.payload?.messageType.value as MessageTypeToolCallApprovalRequired,
+      };
+      updateStreamingMessage((prev) => ({
+        ...prev,
+        parts: [...prev.parts, newMessageEntry],
+        sequence: prev.sequence + 1,
+      }));
+      break;
+    }
+    case "textEdits": {
+      // the edits follow the revision, there is no part begin
+      const newMessageEntry: MessageEntry = {
+        messageId: partEnd.messageId,
+        status: MessageEntryStatus.FINALIZED,
+        textEdits: partEnd.payload?.messageType.value as MessageTypeTextEdits,
+      };
+      updateStreamingMessage((prev) => ({
+        ...prev,
+        parts: [...prev.parts, newMessageEntry],
+        sequence: prev.sequence + 1,
+      }));
+      break;
+    }
     case "system": {
       break;
     }
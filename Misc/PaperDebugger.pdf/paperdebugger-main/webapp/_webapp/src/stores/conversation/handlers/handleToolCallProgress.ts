@@ -0,0 +1,16 @@
+import { ToolCallProgress, ToolJobStatus } from "../../../pkg/gen/apiclient/chat/v1/chat_pb";
+import { useStreamingMessageStore } from "../../streaming-message-store";
+
+// Tracks the tool calls running in the background. A finished job is forgotten,
+// its result is in the conversation (see GetConversation).
+export function handleToolCallProgress(progress: ToolCallProgress) {
+  useStreamingMessageStore.getState().updateToolCallProgress((prev) => {
+    const next = { ...prev };
+    if (progress.status === ToolJobStatus.SUCCEEDED || progress.status === ToolJobStatus.FAILED) {
+      delete next[progress.messageId];
+    } else {
+      next[progress.messageId] = progress;
+    }
+    return next;
+  });
+}
@@ -1,6 +1,9 @@
 import {
   MessageTypeAssistant,
+  MessageTypeRevision,
+  MessageTypeTextEdits,
   MessageTypeToolCall,
+  MessageTypeToolCallApprovalRequired,
   MessageTypeToolCallPrepareArguments,
   MessageTypeUnknown,
   MessageTypeUser,
@@ -19,7 +22,11 @@ export type MessageEntry = {
   // roles
   user?: MessageTypeUser;
   assistant?: MessageTypeAssistant;
+  revision?: MessageTypeRevision; // the assistant reply in the revision response mode
+  textEdits?: MessageTypeTextEdits; // the edits of the project turning the selected text into the revision
   toolCallPrepareArguments?: MessageTypeToolCallPrepareArguments;
   toolCall?: MessageTypeToolCall;
+  toolCallApprovalRequired?: MessageTypeToolCallApprovalRequired;
   unknown?: MessageTypeUnknown;
+  siblingIds?: string[]; // the versions of a user message, see Message.siblingIds
 };
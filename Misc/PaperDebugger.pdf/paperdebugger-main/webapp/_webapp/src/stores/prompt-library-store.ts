@@ -19,12 +19,13 @@ export const usePromptLibraryStore = create<PromptLibraryStore>((set, get) => ({
   isLoading: false,
   loadPrompts: async () => {
     set({ isLoading: true });
-    const response = await listPrompts();
+    // the hidden built-in prompts are kept to be shown again from the library
+    const response = await listPrompts({ locale: navigator.language, includeHidden: true });
     set({ prompts: response.prompts || [], isLoading: false });
   },
 
   searchPrompts: (query: string) => {
-    const prompts = get().prompts;
+    const prompts = get().prompts.filter((p) => !p.hidden);
     return prompts.filter((p) => p.title.toLowerCase().includes(query.toLowerCase()));
   },
 }));
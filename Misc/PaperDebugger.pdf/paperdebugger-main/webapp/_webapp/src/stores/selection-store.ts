@@ -6,8 +6,11 @@ import { EditorView } from "@codemirror/view";
 type CoreState = {
   selectedText: string | null;
   selectionRange: Range | null;
+  // the offset of the selection in the open document, in UTF-16 code units
+  selectionOffset: number | null;
   lastSelectedText: string | null;
   lastSelectionRange: Range | null;
+  lastSelectionOffset: number | null;
   overleafCm: OverleafCodeMirror | null;
 };
 
@@ -33,8 +36,16 @@ export const useSelectionStore = create<SelectionStore>((set) => ({
   setLastSelectionRange: (lastSelectionRange) => {
     set({ lastSelectionRange });
   },
+  selectionOffset: null,
+  setSelectionOffset: (selectionOffset) => {
+    set({ selectionOffset });
+  },
+  lastSelectionOffset: null,
+  setLastSelectionOffset: (lastSelectionOffset) => {
+    set({ lastSelectionOffset });
+  },
   clear: () => {
-    set({ selectedText: null, selectionRange: null });
+    set({ selectedText: null, selectionRange: null, selectionOffset: null });
   },
   clearOverleafSelection: () => {
     let cmContentElement = document.querySelector(".cm-content");
@@ -27,6 +27,9 @@ export interface SettingStore {
 
   hideAvatar: boolean;
   setHideAvatar: (enable: boolean) => void;
+
+  structuredRevisions: boolean;
+  setStructuredRevisions: (enable: boolean) => void;
 }
 
 const defaultSettings: PlainMessage<Settings> = {
@@ -150,4 +153,10 @@ export const useSettingStore = create<SettingStore>()((set, get) => ({
     localStorage.setItem("pd.ui.hideAvatar", enable.toString());
     set({ hideAvatar: enable });
   },
+
+  structuredRevisions: localStorage.getItem("pd.chat.structuredRevisions") === "true" || false,
+  setStructuredRevisions: (enable: boolean) => {
+    localStorage.setItem("pd.chat.structuredRevisions", enable.toString());
+    set({ structuredRevisions: enable });
+  },
 }));
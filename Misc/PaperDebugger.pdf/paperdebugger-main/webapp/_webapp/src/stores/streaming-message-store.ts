@@ -3,7 +3,7 @@
 import { create } from "zustand";
 import { MessageEntry } from "./conversation/types";
 import { flushSync } from "react-dom";
-import { IncompleteIndicator } from "../pkg/gen/apiclient/chat/v1/chat_pb";
+import { IncompleteIndicator, ToolCallProgress } from "../pkg/gen/apiclient/chat/v1/chat_pb";
 import { SetterResetterStore } from "./types";
 
 export type StreamingMessage = {
@@ -14,6 +14,7 @@ export type StreamingMessage = {
 type CoreState = {
   streamingMessage: StreamingMessage;
   incompleteIndicator: IncompleteIndicator | null;
+  toolCallProgress: Record<string, ToolCallProgress>; // by the message id of the tool call
 };
 
 type StreamingMessageState = SetterResetterStore<CoreState>;
@@ -47,4 +48,11 @@ export const useStreamingMessageStore = create<StreamingMessageState>((set) => (
       return { incompleteIndicator: newState };
     });
   },
+
+  toolCallProgress: {},
+  setToolCallProgress: (toolCallProgress) => set({ toolCallProgress }),
+  resetToolCallProgress: () => set({ toolCallProgress: {} }),
+  updateToolCallProgress: (updater) => {
+    set((state) => ({ toolCallProgress: updater(state.toolCallProgress) }));
+  },
 }));
@@ -1,14 +1,36 @@
+import { Select, SelectItem } from "@heroui/react";
 import { Logo } from "../../../components/logo";
+import { useConversationStore } from "../../../stores/conversation/conversation-store";
+import { conversationPersonas } from "../personas";
 
-export const EmptyView = () => (
-  <div className="pd-app-tab-content-body noselect" id="pd-chat-item-container-empty">
-    <div className="bg-gray-50 w-full h-full flex flex-col items-center justify-center text-default-400 dark:text-default-50">
-      <Logo className="bg-gray-100 rounded-full flex justify-center items-center" />
-      <p className="text-2xl font-semibold">Ask or Edit</p>
-      <div className="block w-full"></div>
-      <p className="text-sm text-center max-w-[400px]">
-        Start your conversation with PaperDebugger. You can ask for scoring paper or make revisions.
-      </p>
+export const EmptyView = () => {
+  const { currentConversation, updateCurrentConversation } = useConversationStore();
+
+  return (
+    <div className="pd-app-tab-content-body noselect" id="pd-chat-item-container-empty">
+      <div className="bg-gray-50 w-full h-full flex flex-col items-center justify-center text-default-400 dark:text-default-50">
+        <Logo className="bg-gray-100 rounded-full flex justify-center items-center" />
+        <p className="text-2xl font-semibold">Ask or Edit</p>
+        <div className="block w-full"></div>
+        <p className="text-sm text-center max-w-[400px]">
+          Start your conversation with PaperDebugger. You can ask for scoring paper or make revisions.
+        </p>
+        {/* the persona is kept by the conversation, it is chosen before the first message */}
+        <Select
+          size="sm"
+          className="w-48 mt-2"
+          label="Persona"
+          selectedKeys={[String(currentConversation.conversationType)]}
+          onSelectionChange={(keys) =>
+            keys.currentKey &&
+            updateCurrentConversation((prev) => ({ ...prev, conversationType: Number(keys.currentKey) }))
+          }
+        >
+          {Object.entries(conversationPersonas).map(([key, value]) => (
+            <SelectItem key={key}>{value}</SelectItem>
+          ))}
+        </Select>
+      </div>
     </div>
-  </div>
-);
+  );
+};
@@ -16,6 +16,8 @@ import { useActions } from "../actions/actions";
 import { ChatActions } from "./toolbar/chat-actions";
 import { ModelSelection } from "./toolbar/model-selection";
 import { useSettingStore } from "../../../stores/setting-store";
+import { PromptVariablesModal } from "./prompt-variables-modal";
+import { Prompt } from "../../../pkg/gen/apiclient/user/v1/user_pb";
 
 // Add animation keyframes
 const blinkAnimation = `@keyframes blink {
@@ -38,7 +40,8 @@ if (typeof document !== "undefined") {
 }
 
 export function PromptInput() {
-  const { prompt, heightCollapseRequired, inputRef, setPrompt } = useConversationUiStore();
+  const { prompt, promptId, heightCollapseRequired, inputRef, setPrompt, setPromptId } = useConversationUiStore();
+  const [templatePrompt, setTemplatePrompt] = useState<Prompt | undefined>();
   const { searchPrompts } = usePromptLibraryStore();
   const [showModelSelection, setShowModelSelection] = useState(false);
   const prompts = useMemo(
@@ -67,10 +70,11 @@ export function PromptInput() {
       userId: user?.id,
     });
     setPrompt("");
+    setPromptId("");
     setIsStreaming(true);
-    await sendMessageStream(prompt, selectedText ?? "");
+    await sendMessageStream(prompt, selectedText ?? "", promptId);
     setIsStreaming(false);
-  }, [sendMessageStream, prompt, selectedText, user?.id, setIsStreaming, setPrompt]);
+  }, [sendMessageStream, prompt, promptId, selectedText, user?.id, setIsStreaming, setPrompt, setPromptId]);
   const handleKeyDown = useCallback(
     async (e: React.KeyboardEvent<HTMLTextAreaElement>) => {
       // Check if IME composition is in progress to avoid submitting during Chinese input
@@ -91,7 +95,17 @@ export function PromptInput() {
 
   return (
     <div className="pd-app-tab-content-footer chat-prompt-input noselect rnd-cancel">
-      {prompts.length > 0 && <PromptSelection prompts={prompts} />}
+      {prompts.length > 0 && <PromptSelection prompts={prompts} onSelectTemplate={setTemplatePrompt} />}
+      <PromptVariablesModal
+        prompt={templatePrompt}
+        onClose={() => setTemplatePrompt(undefined)}
+        onRendered={(content) => {
+          setPrompt(content);
+          setPromptId(templatePrompt?.id ?? "");
+          setTemplatePrompt(undefined);
+          inputRef.current?.focus();
+        }}
+      />
       {actions.length > 0 && <ActionSelection actions={actions} />}
       {showModelSelection && <ModelSelection onSelectModel={handleModelSelect} />}
 
@@ -0,0 +1,103 @@
+import { useEffect, useState } from "react";
+import { Button, Input, Select, SelectItem } from "@heroui/react";
+import { Modal } from "../../../components/modal";
+import { Prompt, PromptVariableType } from "../../../pkg/gen/apiclient/user/v1/user_pb";
+import { useRenderPromptMutation } from "../../../query";
+import { useSelectionStore } from "../../../stores/selection-store";
+
+type PromptVariablesModalProps = {
+  prompt?: Prompt;
+  onClose: () => void;
+  onRendered: (content: string) => void;
+};
+
+// PromptVariablesModal asks the values of the variables of a prompt template and renders it, the
+// selected text variables are filled with the text selected in the editor.
+export function PromptVariablesModal({ prompt, onClose, onRendered }: PromptVariablesModalProps) {
+  const selectedText = useSelectionStore((s) => s.selectedText);
+  const [values, setValues] = useState<Record<string, string>>({});
+
+  useEffect(() => {
+    setValues(Object.fromEntries((prompt?.variables ?? []).map((v) => [v.name, v.defaultValue])));
+  }, [prompt]);
+
+  // the api client shows the errors, e.g. a value of the wrong type
+  const { mutate: renderPrompt, isPending } = useRenderPromptMutation({
+    onSuccess: (response) => onRendered(response.content),
+  });
+
+  const variables = prompt?.variables ?? [];
+  const missing = variables.some(
+    (v) => v.required && v.type !== PromptVariableType.SELECTED_TEXT && !values[v.name] && !v.defaultValue,
+  );
+
+  const submit = () => {
+    if (!prompt) {
+      return;
+    }
+    const filled = { ...values };
+    for (const variable of variables) {
+      if (variable.type === PromptVariableType.SELECTED_TEXT) {
+        filled[variable.name] = selectedText ?? "";
+      }
+    }
+    renderPrompt({ promptId: prompt.id, values: filled });
+  };
+
+  return (
+    <Modal
+      isOpen={!!prompt}
+      onOpenChange={(open) => !open && onClose()}
+      header={<>{prompt?.title}</>}
+      footer={
+        <>
+          <Button size="sm" variant="flat" onPress={onClose}>
+            Cancel
+          </Button>
+          <Button size="sm" color="primary" isLoading={isPending} isDisabled={missing} onPress={submit}>
+            Use Prompt
+          </Button>
+        </>
+      }
+    >
+      <div className="w-full flex flex-col gap-2">
+        {variables.map((variable) =>
+          variable.type === PromptVariableType.SELECTED_TEXT ? (
+            <div key={variable.name} className="text-xs text-default-500">
+              {`{{${variable.name}}}`}:{" "}
+              {selectedText ? `the selected text (${selectedText.length} characters)` : "no text is selected"}
+            </div>
+          ) : variable.type === PromptVariableType.CHOICE ? (
+            <Select
+              key={variable.name}
+              size="sm"
+              label={variable.name}
+              description={variable.description}
+              isRequired={variable.required}
+              selectedKeys={values[variable.name] ? [values[variable.name]] : []}
+              onSelectionChange={(keys) =>
+                setValues({ ...values, [variable.name]: keys.currentKey ? String(keys.currentKey) : "" })
+              }
+            >
+              {variable.options.map((option) => (
+                <SelectItem key={option}>{option}</SelectItem>
+              ))}
+            </Select>
+          ) : (
+            <Input
+              key={variable.name}
+              size="sm"
+              label={variable.name}
+              description={variable.description}
+              isRequired={variable.required}
+              type={variable.type === PromptVariableType.NUMBER ? "number" : "text"}
+              value={values[variable.name] ?? ""}
+              onValueChange={(value) => setValues({ ...values, [variable.name]: value })}
+              onMouseDown={(e) => e.stopPropagation()}
+            />
+          ),
+        )}
+      </div>
+    </Modal>
+  );
+}
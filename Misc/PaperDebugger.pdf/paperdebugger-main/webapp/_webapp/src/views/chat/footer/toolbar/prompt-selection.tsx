@@ -5,10 +5,11 @@ import { useConversationUiStore } from "../../../../stores/conversation/conversa
 
 type PromptSelectionProps = {
   prompts: Prompt[];
+  onSelectTemplate: (prompt: Prompt) => void; // a prompt with variables to fill
 };
 
-export function PromptSelection({ prompts }: PromptSelectionProps) {
-  const { inputRef, setPrompt } = useConversationUiStore();
+export function PromptSelection({ prompts, onSelectTemplate }: PromptSelectionProps) {
+  const { inputRef, setPrompt, setPromptId } = useConversationUiStore();
   const items: SelectionItem<Prompt>[] = useMemo(() => {
     return (
       prompts.map((prompt) => ({
@@ -21,12 +22,18 @@ export function PromptSelection({ prompts }: PromptSelectionProps) {
 
   const onSelect = useCallback(
     (item: SelectionItem<Prompt>) => {
+      if (item.value.variables.length > 0) {
+        setPrompt("");
+        onSelectTemplate(item.value);
+        return;
+      }
       if (inputRef.current) {
         setPrompt(item.value.content);
+        setPromptId(item.value.id);
         inputRef.current.focus();
       }
     },
-    [inputRef, setPrompt],
+    [inputRef, setPrompt, setPromptId, onSelectTemplate],
   );
 
   return <Selection items={items} onSelect={onSelect} />;
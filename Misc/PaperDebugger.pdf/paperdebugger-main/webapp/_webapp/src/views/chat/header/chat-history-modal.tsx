@@ -1,40 +1,64 @@
-import { Input, Listbox, ListboxItem, ListboxSection, Tooltip } from "@heroui/react";
+import { cn, Input, Listbox, ListboxItem, ListboxSection, Tooltip } from "@heroui/react";
 import { Icon } from "@iconify/react";
 import { useEffect, useRef, useState } from "react";
-import { Conversation } from "../../../pkg/gen/apiclient/chat/v1/chat_pb";
-import { getConversation, updateConversation } from "../../../query/api";
+import {
+  Conversation,
+  ConversationExportFormat,
+  SearchSnippet,
+} from "../../../pkg/gen/apiclient/chat/v1/chat_pb";
+import {
+  exportConversation,
+  getConversation,
+  importConversation,
+  shareConversation,
+  updateConversation,
+} from "../../../query/api";
 import { errorToast } from "../../../libs/toasts";
-import { useDeleteConversationMutation, useListConversationsQuery } from "../../../query";
+import {
+  useDeleteConversationMutation,
+  useListConversationsQuery,
+  useSearchConversationsQuery,
+} from "../../../query";
 import { logError } from "../../../libs/logger";
 import { Modal } from "../../../components/modal";
 import googleAnalytics from "../../../libs/google-analytics";
 import { useStreamingMessageStore } from "../../../stores/streaming-message-store";
-import { getProjectId } from "../../../libs/helpers";
+import { downloadFile, getProjectId } from "../../../libs/helpers";
 import { useConversationStore } from "../../../stores/conversation/conversation-store";
 import { useConversationUiStore } from "../../../stores/conversation/conversation-ui-store";
 import { useAuthStore } from "../../../stores/auth-store";
+import { DeletedConversations } from "./deleted-conversations";
+import { SharedConversations } from "./shared-conversations";
 
 export const ChatHistoryModal = () => {
   const { currentConversation, setCurrentConversation } = useConversationStore();
   const { inputRef: promptInputRef } = useConversationUiStore();
   const { showChatHistory, setShowChatHistory } = useConversationUiStore();
   const {
-    data: conversations,
+    data: conversationPages,
     isFetching: isFetchingConversations,
     refetch: refetchConversationList,
+    hasNextPage,
+    fetchNextPage,
+    isFetchingNextPage,
   } = useListConversationsQuery(getProjectId());
+  const conversations = conversationPages?.pages.flatMap((page) => page.conversations) ?? [];
 
   // State
   const { user } = useAuthStore();
   const [searchQuery, setSearchQuery] = useState("");
+  const [debouncedSearchQuery, setDebouncedSearchQuery] = useState("");
   const [hoveredItemId, setHoveredItemId] = useState<string | null>(null);
   const [editingTitleId, setEditingTitleId] = useState<string | null>(null);
   const [editedTitle, setEditedTitle] = useState("");
   const [deletingId, setDeletingId] = useState<string | null>(null);
+  // the conversations of the user, the deleted ones or the ones the other members shared
+  const [view, setView] = useState<"history" | "deleted" | "shared">("history");
 
   // Refs
   const inputRef = useRef<HTMLInputElement | null>(null);
   const editInputRef = useRef<HTMLInputElement | null>(null);
+  const importInputRef = useRef<HTMLInputElement | null>(null);
 
   // Mutations
   const deleteConversationMutation = useDeleteConversationMutation({
@@ -64,7 +88,7 @@ export const ChatHistoryModal = () => {
       return;
     }
 
-    const chatToUpdate = conversations?.conversations.find((chat) => chat.id === id);
+    const chatToUpdate = filteredHistory.find(({ chat }) => chat.id === id)?.chat;
     if (!chatToUpdate || chatToUpdate.title === editedTitle.trim()) {
       setEditingTitleId(null);
       return;
@@ -120,19 +144,84 @@ export const ChatHistoryModal = () => {
     }
   };
 
-  // Filter conversations
-  const filteredHistory =
-    conversations?.conversations.filter((chat) => chat.title.toLowerCase().includes(searchQuery.toLowerCase())) || [];
+  // Export and import handlers
+  const handleExportConversation = async (id: string, format: ConversationExportFormat) => {
+    try {
+      googleAnalytics.fireEvent(user?.id, "conversation_export", {
+        conversationId: id,
+        format: ConversationExportFormat[format],
+      });
+      const response = await exportConversation({ conversationId: id, format });
+      downloadFile(response.filename, response.mimeType, response.content);
+    } catch (e) {
+      errorToast("Failed to export conversation");
+      logError(e);
+    }
+  };
+
+  const handleShareConversation = async (id: string, shared: boolean) => {
+    try {
+      googleAnalytics.fireEvent(user?.id, shared ? "conversation_share" : "conversation_unshare", {
+        conversationId: id,
+      });
+      await shareConversation({ conversationId: id, shared });
+      await refetchConversationList();
+    } catch (e) {
+      errorToast(
+        shared
+          ? "Failed to share conversation, only the owner and the editors of the project can share"
+          : "Failed to stop sharing conversation",
+      );
+      logError(e);
+    }
+  };
+
+  const handleImportConversation = async (file: File) => {
+    try {
+      const response = await importConversation({ projectId: getProjectId(), content: await file.text() });
+      await refetchConversationList();
+      if (response.conversation) {
+        await handleHistoryClick(response.conversation.id);
+      }
+    } catch (e) {
+      errorToast("Failed to import conversation, is it a PaperDebugger JSON export?");
+      logError(e);
+    }
+  };
+
+  // Search the titles and messages on the server, once the user stopped typing
+  useEffect(() => {
+    const timeout = setTimeout(() => setDebouncedSearchQuery(searchQuery.trim()), 300);
+    return () => clearTimeout(timeout);
+  }, [searchQuery]);
+  const { data: searchResults, isFetching: isSearching } = useSearchConversationsQuery(
+    getProjectId(),
+    debouncedSearchQuery,
+  );
+
+  const filteredHistory: { chat: Conversation; snippet?: SearchSnippet }[] = debouncedSearchQuery
+    ? (searchResults?.results ?? []).flatMap((result) =>
+        result.conversation
+          ? [
+              {
+                chat: result.conversation,
+                // the first message snippet, the title is shown anyway
+                snippet: result.snippets.find((snippet) => snippet.messageId !== ""),
+              },
+            ]
+          : [],
+      )
+    : conversations.map((chat) => ({ chat }));
 
   // Render functions
-  const renderChatItem = (chat: Conversation) => (
+  const renderChatItem = ({ chat, snippet }: { chat: Conversation; snippet?: SearchSnippet }) => (
     <ListboxItem
       aria-label="Chat History Item"
       key={chat.id}
       onMouseEnter={() => setHoveredItemId(chat.id)}
       onMouseLeave={() => setHoveredItemId(null)}
       onPress={() => handleHistoryClick(chat.id)}
-      className="h-[44px] px-[12px] py-[10px] text-default-500 mt-1 w-full"
+      className={cn("px-[12px] py-[10px] text-default-500 mt-1 w-full", snippet ? "h-auto" : "h-[44px]")}
       classNames={{
         wrapper: "w-full",
         title: "w-full",
@@ -162,7 +251,15 @@ export const ChatHistoryModal = () => {
             onBlur={() => handleSaveTitle(chat.id)}
           />
         ) : (
-          <span className="truncate">{chat.title}</span>
+          <div className="flex flex-col min-w-0">
+            <span className="truncate">
+              {chat.shared && (
+                <Icon icon="tabler:users" width={14} className="inline mr-1 align-[-2px] text-default-400" />
+              )}
+              {chat.title}
+            </span>
+            {snippet && <HighlightedSnippet snippet={snippet} />}
+          </div>
         )}
 
         <ActionButtons
@@ -171,7 +268,10 @@ export const ChatHistoryModal = () => {
           deletingId={deletingId}
           isPending={deleteConversationMutation.isPending}
           isCurrent={chat.id === currentConversation.id}
+          isShared={chat.shared}
           onEdit={() => handleEditTitle(chat)}
+          onShare={() => handleShareConversation(chat.id, !chat.shared)}
+          onExport={(format) => handleExportConversation(chat.id, format)}
           onDelete={handleDeleteConversation}
         />
       </div>
@@ -203,14 +303,70 @@ export const ChatHistoryModal = () => {
               aria-label="search"
               className="px-1 mt-1"
               labelPlacement="outside"
-              placeholder={isFetchingConversations ? "Loading..." : "Search..."}
+              placeholder={isFetchingConversations ? "Loading..." : "Search titles and messages..."}
               onValueChange={setSearchQuery}
               startContent={<Icon className="text-default-500 [&>g]:stroke-[2px]" icon="tabler:search" width={18} />}
             />
+            <Tooltip
+              content={view === "shared" ? "Back to history" : "Shared by the project members"}
+              placement="bottom"
+              className="noselect"
+              delay={500}
+            >
+              <Icon
+                icon={view === "shared" ? "tabler:history" : "tabler:users"}
+                width={28}
+                className="mt-1 rounded-md cursor-pointer hover:bg-default-200 p-[4px] text-default-500"
+                onClick={() => setView(view === "shared" ? "history" : "shared")}
+              />
+            </Tooltip>
+            <Tooltip
+              content={view === "deleted" ? "Back to history" : "Recently deleted"}
+              placement="bottom"
+              className="noselect"
+              delay={500}
+            >
+              <Icon
+                icon={view === "deleted" ? "tabler:history" : "tabler:trash"}
+                width={28}
+                className="mt-1 rounded-md cursor-pointer hover:bg-default-200 p-[4px] text-default-500"
+                onClick={() => setView(view === "deleted" ? "history" : "deleted")}
+              />
+            </Tooltip>
+            <Tooltip content="Import a JSON export" placement="bottom" className="noselect" delay={500}>
+              <Icon
+                icon="tabler:file-import"
+                width={28}
+                className="mt-1 rounded-md cursor-pointer hover:bg-default-200 p-[4px] text-default-500"
+                onClick={() => importInputRef.current?.click()}
+              />
+            </Tooltip>
+            <input
+              ref={importInputRef}
+              type="file"
+              accept=".json,application/json"
+              className="hidden"
+              onChange={(e) => {
+                const file = e.target.files?.[0];
+                e.target.value = "";
+                if (file) handleImportConversation(file);
+              }}
+            />
           </div>
 
-          {filteredHistory.length === 0 ? (
-            <div className="text-gray-400 text-sm self-center pb-4 pt-3">No chat history found</div>
+          {view === "deleted" ? (
+            <DeletedConversations onRestored={refetchConversationList} />
+          ) : view === "shared" ? (
+            <SharedConversations
+              onForked={async (conversationId) => {
+                await refetchConversationList();
+                await handleHistoryClick(conversationId);
+              }}
+            />
+          ) : filteredHistory.length === 0 ? (
+            <div className="text-gray-400 text-sm self-center pb-4 pt-3">
+              {isSearching ? "Searching..." : "No chat history found"}
+            </div>
           ) : (
             <Listbox aria-label="Chat history" variant="flat" classNames={{ list: "max-h-[300px] overflow-y-auto" }}>
               <ListboxSection
@@ -225,6 +381,15 @@ export const ChatHistoryModal = () => {
               </ListboxSection>
             </Listbox>
           )}
+          {view === "history" && !debouncedSearchQuery && hasNextPage && (
+            <button
+              className="text-sm text-default-500 hover:text-default-700 self-center pb-3"
+              disabled={isFetchingNextPage}
+              onClick={() => fetchNextPage()}
+            >
+              {isFetchingNextPage ? "Loading..." : "Load more"}
+            </button>
+          )}
         </div>
       }
     />
@@ -232,6 +397,24 @@ export const ChatHistoryModal = () => {
 };
 
 // Helper components to improve readability
+const HighlightedSnippet = ({ snippet }: { snippet: SearchSnippet }) => {
+  // the highlights are ranges of runes
+  const runes = Array.from(snippet.text);
+  const parts: React.ReactNode[] = [];
+  let position = 0;
+  snippet.highlights.forEach((highlight, index) => {
+    parts.push(runes.slice(position, highlight.start).join(""));
+    parts.push(
+      <mark key={index} className="bg-warning-100 text-default-700">
+        {runes.slice(highlight.start, highlight.end).join("")}
+      </mark>,
+    );
+    position = highlight.end;
+  });
+  parts.push(runes.slice(position).join(""));
+  return <span className="text-tiny text-default-400 line-clamp-2 whitespace-normal">{parts}</span>;
+};
+
 const TitleEditInput = ({
   ref,
   ...props
@@ -252,7 +435,10 @@ interface ActionButtonsProps {
   deletingId: string | null;
   isPending: boolean;
   isCurrent: boolean;
+  isShared: boolean;
   onEdit: (e: React.MouseEvent) => void;
+  onShare: () => void;
+  onExport: (format: ConversationExportFormat) => void;
   onDelete: (id: string, e: React.MouseEvent) => void;
 }
 
@@ -262,7 +448,10 @@ const ActionButtons = ({
   deletingId,
   isPending,
   isCurrent,
+  isShared,
   onEdit,
+  onShare,
+  onExport,
   onDelete,
 }: ActionButtonsProps) => (
   <div
@@ -282,6 +471,44 @@ const ActionButtons = ({
         }}
       />
     </Tooltip>
+    <Tooltip
+      content={isShared ? "Stop sharing" : "Share with the project members"}
+      placement="bottom"
+      className="noselect"
+      delay={500}
+    >
+      <Icon
+        icon={isShared ? "tabler:share-off" : "tabler:share"}
+        width={24}
+        className="dark:bg-default-50 rounded-md cursor-pointer hover:bg-default-200 p-[4px]"
+        onClick={(e) => {
+          e.stopPropagation();
+          onShare();
+        }}
+      />
+    </Tooltip>
+    <Tooltip content="Export as Markdown" placement="bottom" className="noselect" delay={500}>
+      <Icon
+        icon="tabler:markdown"
+        width={24}
+        className="dark:bg-default-50 rounded-md cursor-pointer hover:bg-default-200 p-[4px]"
+        onClick={(e) => {
+          e.stopPropagation();
+          onExport(ConversationExportFormat.MARKDOWN);
+        }}
+      />
+    </Tooltip>
+    <Tooltip content="Export as JSON" placement="bottom" className="noselect" delay={500}>
+      <Icon
+        icon="tabler:file-export"
+        width={24}
+        className="dark:bg-default-50 rounded-md cursor-pointer hover:bg-default-200 p-[4px]"
+        onClick={(e) => {
+          e.stopPropagation();
+          onExport(ConversationExportFormat.JSON);
+        }}
+      />
+    </Tooltip>
     {/* Do not delete current conversation */}
     {!isCurrent && (
       <Tooltip content="Delete" placement="bottom" className="noselect" delay={500}>
@@ -0,0 +1,61 @@
+import { Listbox, ListboxItem, Tooltip } from "@heroui/react";
+import { Icon } from "@iconify/react";
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { useListDeletedConversationsQuery, useRestoreConversationMutation } from "../../../query";
+import { getProjectId } from "../../../libs/helpers";
+import { errorToast } from "../../../libs/toasts";
+
+// DeletedConversations lists the deleted conversations of the project, they can be restored until
+// they are deleted for good.
+export const DeletedConversations = ({ onRestored }: { onRestored: () => void }) => {
+  const { data, isFetching, refetch } = useListDeletedConversationsQuery(getProjectId());
+  const { mutate: restoreConversation, isPending } = useRestoreConversationMutation({
+    onSuccess: async () => {
+      await refetch();
+      onRestored();
+    },
+    onError: () => errorToast("Failed to restore conversation"),
+  });
+
+  const deletedConversations = data?.conversations ?? [];
+  if (deletedConversations.length === 0) {
+    return (
+      <div className="text-gray-400 text-sm self-center pb-4 pt-3">
+        {isFetching ? "Loading..." : "No deleted conversations"}
+      </div>
+    );
+  }
+
+  return (
+    <Listbox aria-label="Deleted conversations" variant="flat" classNames={{ list: "max-h-[300px] overflow-y-auto" }}>
+      {deletedConversations.map(({ conversation, purgeAt }) => (
+        <ListboxItem
+          aria-label="Deleted Conversation Item"
+          key={conversation?.id ?? ""}
+          className="px-[12px] py-[10px] text-default-500 mt-1 w-full h-auto"
+          textValue={conversation?.title}
+        >
+          <div className="flex justify-between items-center w-full">
+            <div className="flex flex-col min-w-0">
+              <span className="truncate">{conversation?.title}</span>
+              {purgeAt && (
+                <span className="text-tiny text-default-400">
+                  Deleted for good on {timestampDate(purgeAt).toLocaleDateString()}
+                </span>
+              )}
+            </div>
+            <Tooltip content="Restore" placement="bottom" className="noselect" delay={500}>
+              <button
+                className="p-1 hover:bg-default-100 rounded"
+                disabled={isPending}
+                onClick={() => conversation && restoreConversation({ conversationId: conversation.id })}
+              >
+                <Icon icon="tabler:restore" width="16" />
+              </button>
+            </Tooltip>
+          </div>
+        </ListboxItem>
+      ))}
+    </Listbox>
+  );
+};
@@ -33,7 +33,10 @@ export const ChatHeader = () => {
   const messageCount = useMemo(() => {
     return (
       currentConversation.messages.filter(
-        (m: Message) => m.payload?.messageType.case === "assistant" || m.payload?.messageType.case === "user",
+        (m: Message) =>
+          m.payload?.messageType.case === "assistant" ||
+          m.payload?.messageType.case === "revision" ||
+          m.payload?.messageType.case === "user",
       ).length ?? 0
     );
   }, [currentConversation]);
@@ -0,0 +1,63 @@
+import { Listbox, ListboxItem, Tooltip } from "@heroui/react";
+import { Icon } from "@iconify/react";
+import { useForkConversationMutation, useListSharedConversationsQuery } from "../../../query";
+import { getProjectId } from "../../../libs/helpers";
+import { errorToast } from "../../../libs/toasts";
+
+// SharedConversations lists the conversations the other members shared in the project, they are
+// forked to be continued.
+export const SharedConversations = ({ onForked }: { onForked: (conversationId: string) => void }) => {
+  const { data, isFetching, error } = useListSharedConversationsQuery(getProjectId());
+  const { mutate: forkConversation, isPending } = useForkConversationMutation({
+    onSuccess: (response) => {
+      if (response.conversation) {
+        onForked(response.conversation.id);
+      }
+    },
+    onError: () => errorToast("Failed to copy conversation"),
+  });
+
+  const sharedConversations = data?.conversations ?? [];
+  if (sharedConversations.length === 0) {
+    return (
+      <div className="text-gray-400 text-sm self-center pb-4 pt-3">
+        {isFetching
+          ? "Loading..."
+          : error
+            ? "You are not a member of this project"
+            : "No conversation shared in this project"}
+      </div>
+    );
+  }
+
+  return (
+    <Listbox aria-label="Shared conversations" variant="flat" classNames={{ list: "max-h-[300px] overflow-y-auto" }}>
+      {sharedConversations.map(({ conversation, ownerName, ownerEmail }) => (
+        <ListboxItem
+          aria-label="Shared Conversation Item"
+          key={conversation?.id ?? ""}
+          className="px-[12px] py-[10px] text-default-500 mt-1 w-full h-auto"
+          textValue={conversation?.title}
+        >
+          <div className="flex justify-between items-center w-full">
+            <div className="flex flex-col min-w-0">
+              <span className="truncate">{conversation?.title}</span>
+              <span className="text-tiny text-default-400">Shared by {ownerName || ownerEmail || "a former member"}</span>
+            </div>
+            <Tooltip content="Copy to my conversations" placement="bottom" className="noselect" delay={500}>
+              <button
+                className="p-1 hover:bg-default-100 rounded"
+                disabled={isPending}
+                onClick={() =>
+                  conversation && forkConversation({ conversationId: conversation.id, upToMessageId: "" })
+                }
+              >
+                <Icon icon="tabler:git-fork" width="16" />
+              </button>
+            </Tooltip>
+          </div>
+        </ListboxItem>
+      ))}
+    </Listbox>
+  );
+};
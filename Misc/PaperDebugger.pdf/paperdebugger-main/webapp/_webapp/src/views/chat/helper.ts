@@ -2,7 +2,10 @@ import {
   Conversation,
   Message,
   MessageTypeAssistant,
+  MessageTypeRevision,
+  MessageTypeTextEdits,
   MessageTypeToolCall,
+  MessageTypeToolCallApprovalRequired,
   MessageTypeToolCallPrepareArguments,
   MessageTypeUnknown,
   MessageTypeUser,
@@ -36,6 +39,12 @@ export function filterVisibleMessages(conversation?: Conversation): Message[] {
       if (m.payload?.messageType.case === "assistant") {
         return m.payload?.messageType.value.content.length > 0;
       }
+      if (m.payload?.messageType.case === "revision") {
+        return true;
+      }
+      if (m.payload?.messageType.case === "textEdits") {
+        return true;
+      }
       if (m.payload?.messageType.case === "toolCall") {
         return true;
       }
@@ -52,6 +61,14 @@ export function messageToMessageEntry(message: Message): MessageEntry {
       message.payload?.messageType.case === "assistant"
         ? (message.payload?.messageType.value as MessageTypeAssistant)
         : undefined,
+    revision:
+      message.payload?.messageType.case === "revision"
+        ? (message.payload?.messageType.value as MessageTypeRevision)
+        : undefined,
+    textEdits:
+      message.payload?.messageType.case === "textEdits"
+        ? (message.payload?.messageType.value as MessageTypeTextEdits)
+        : undefined,
     user:
       message.payload?.messageType.case === "user"
         ? (message.payload?.messageType.value as MessageTypeUser)
@@ -60,6 +77,10 @@ export function messageToMessageEntry(message: Message): MessageEntry {
       message.payload?.messageType.case === "toolCall"
         ? (message.payload?.messageType.value as MessageTypeToolCall)
         : undefined,
+    toolCallApprovalRequired:
+      message.payload?.messageType.case === "toolCallApprovalRequired"
+        ? (message.payload?.messageType.value as MessageTypeToolCallApprovalRequired)
+        : undefined,
     toolCallPrepareArguments:
       message.payload?.messageType.case === "toolCallPrepareArguments"
         ? (message.payload?.messageType.value as MessageTypeToolCallPrepareArguments)
@@ -68,5 +89,6 @@ export function messageToMessageEntry(message: Message): MessageEntry {
       message.payload?.messageType.case === "unknown"
         ? (message.payload?.messageType.value as MessageTypeUnknown)
         : undefined,
+    siblingIds: message.siblingIds,
   } as MessageEntry;
 }
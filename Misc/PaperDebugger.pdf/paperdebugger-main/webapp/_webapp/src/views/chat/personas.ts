@@ -0,0 +1,19 @@
+import { ConversationType } from "../../pkg/gen/apiclient/chat/v1/chat_pb";
+
+// the personas of a new conversation, "Project default" is the default persona of the project
+export const conversationPersonas = {
+  [String(ConversationType.UNSPECIFIED)]: "Project default",
+  [String(ConversationType.REVIEWER)]: "Reviewer",
+  [String(ConversationType.TRANSLATOR)]: "Translator",
+  [String(ConversationType.PROOFREADER)]: "Proofreader",
+  [String(ConversationType.REBUTTAL_WRITER)]: "Rebuttal writer",
+};
+
+// the default personas of a project, by their name on the server
+export const projectPersonas = {
+  default: "Default",
+  reviewer: "Reviewer",
+  translator: "Translator",
+  proofreader: "Proofreader",
+  rebuttal_writer: "Rebuttal writer",
+};
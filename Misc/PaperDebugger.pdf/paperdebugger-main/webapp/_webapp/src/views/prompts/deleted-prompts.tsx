@@ -0,0 +1,60 @@
+import { cn } from "@heroui/react";
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ChatButton } from "../chat/header/chat-button";
+import { SettingsSectionContainer, SettingsSectionTitle } from "../settings/sections/components";
+import { useListDeletedPromptsQuery, useRestorePromptMutation } from "../../query";
+import { usePromptLibraryStore } from "../../stores/prompt-library-store";
+import { errorToast } from "../../libs/toasts";
+
+// DeletedPrompts lists the deleted prompts, they can be restored until they are deleted for good.
+export function DeletedPrompts() {
+  const { loadPrompts } = usePromptLibraryStore();
+  const { data, refetch } = useListDeletedPromptsQuery();
+  const { mutate: restorePrompt, isPending } = useRestorePromptMutation({
+    onSuccess: async () => {
+      await Promise.all([refetch(), loadPrompts()]);
+    },
+    onError: () => errorToast("Failed to restore prompt"),
+  });
+
+  const deletedPrompts = data?.prompts ?? [];
+  if (deletedPrompts.length === 0) {
+    return null;
+  }
+
+  return (
+    <SettingsSectionContainer>
+      <SettingsSectionTitle>Recently Deleted</SettingsSectionTitle>
+      <div className="w-full flex flex-col bg-gray-50">
+        {deletedPrompts.map(({ prompt, purgeAt }) => (
+          <div
+            key={prompt?.id}
+            className={cn(
+              "w-full flex flex-row gap-2 items-center px-4 py-2 noselect",
+              "border-l-1 border-r-1 border-gray-200",
+              "first:border-t-1 last:border-b-1 first:rounded-t-md last:rounded-b-md border-t",
+            )}
+          >
+            <div className="flex-grow flex flex-col gap-1 min-w-0">
+              <div className="text-xs font-medium truncate text-gray-500">{prompt?.title}</div>
+              {purgeAt && (
+                <div className="text-xs text-gray-400 truncate">
+                  Deleted for good on {timestampDate(purgeAt).toLocaleDateString()}
+                </div>
+              )}
+            </div>
+            <ChatButton
+              icon="tabler:restore"
+              alt="Restore Prompt"
+              tooltip="Restore"
+              noBorder
+              disableAnimation
+              disabled={isPending}
+              onClick={() => prompt && restorePrompt({ promptId: prompt.id })}
+            />
+          </div>
+        ))}
+      </div>
+    </SettingsSectionContainer>
+  );
+}
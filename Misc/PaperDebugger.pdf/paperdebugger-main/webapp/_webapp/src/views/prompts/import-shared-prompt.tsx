@@ -0,0 +1,40 @@
+import { useState } from "react";
+import { Button, Input } from "@heroui/react";
+import { useImportSharedPromptMutation } from "../../query";
+import { successToast } from "../../libs/toasts";
+import { usePromptLibraryStore } from "../../stores/prompt-library-store";
+
+// ImportSharedPrompt copies a prompt shared by a colleague to the prompts of the user.
+export function ImportSharedPrompt() {
+  const { loadPrompts } = usePromptLibraryStore();
+  const [token, setToken] = useState("");
+
+  const { mutate: importSharedPrompt, isPending } = useImportSharedPromptMutation({
+    onSuccess: (response) => {
+      setToken("");
+      loadPrompts();
+      successToast(`"${response.prompt?.title}" was added to your prompts`, "Prompt imported");
+    },
+  });
+
+  return (
+    <div className="flex flex-row gap-2 items-center">
+      <Input
+        size="sm"
+        placeholder="Paste a shared prompt token"
+        value={token}
+        onValueChange={setToken}
+        onMouseDown={(e) => e.stopPropagation()}
+      />
+      <Button
+        size="sm"
+        color="primary"
+        isDisabled={token.trim() === ""}
+        isLoading={isPending}
+        onPress={() => importSharedPrompt({ token: token.trim() })}
+      >
+        Import
+      </Button>
+    </div>
+  );
+}
@@ -1,16 +1,25 @@
 import { PromptLibraryHeader } from "./prompt-library-header";
 import { PromptLibraryTable } from "./prompt-library-table";
 import { PromptModal } from "./prompt-modal";
+import { DeletedPrompts } from "./deleted-prompts";
 import { ProjectInstructions } from "./project-instructions";
+import { ProjectPersona } from "./project-persona";
+import { ProjectMembers } from "./project-members";
+import { Organizations } from "./organizations";
+import { SharePromptModal } from "./share-prompt-modal";
+import { ImportSharedPrompt } from "./import-shared-prompt";
 import { UserInstructions } from "./user-instructions";
 import { usePromptModal } from "./hooks/usePromptModal";
 import { SettingsSectionContainer, SettingsSectionTitle } from "../settings/sections/components";
 import { Icon } from "@iconify/react/dist/iconify.js";
 import { Tooltip } from "@heroui/react";
+import { useState } from "react";
+import { Prompt } from "../../pkg/gen/apiclient/user/v1/user_pb";
 
 export function Prompts() {
   const { mode, selectedPrompt, isOpen, onOpen, onClose, onCreateOpen, onUpdateOpen, onViewOpen, onDeleteOpen } =
     usePromptModal();
+  const [sharedPrompt, setSharedPrompt] = useState<Prompt | undefined>();
 
   return (
     <div className="pd-app-tab-content">
@@ -28,16 +37,28 @@ export function Prompts() {
               />
             </Tooltip>
           </div>
-          <PromptLibraryTable onDelete={onDeleteOpen} onUpdate={onUpdateOpen} onView={onViewOpen} />
+          <PromptLibraryTable
+            onDelete={onDeleteOpen}
+            onUpdate={onUpdateOpen}
+            onView={onViewOpen}
+            onShare={setSharedPrompt}
+          />
+          <ImportSharedPrompt />
         </SettingsSectionContainer>
 
+        <DeletedPrompts />
+
         <div className="flex flex-col mt-4">
+          <Organizations />
           <ProjectInstructions />
+          <ProjectPersona />
+          <ProjectMembers />
           <UserInstructions />
         </div>
       </div>
 
       <PromptModal mode={mode} prompt={selectedPrompt} isOpen={isOpen} onOpenChange={onOpen} onClose={onClose} />
+      <SharePromptModal prompt={sharedPrompt} onClose={() => setSharedPrompt(undefined)} />
     </div>
   );
 }
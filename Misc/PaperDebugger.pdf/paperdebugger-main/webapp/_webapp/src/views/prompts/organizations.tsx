@@ -0,0 +1,359 @@
+import { useEffect, useState } from "react";
+import { Button, cn, Input, Select, SelectItem, Tooltip } from "@heroui/react";
+import { Icon } from "@iconify/react";
+import { useQueryClient } from "@tanstack/react-query";
+import { SettingsSectionContainer, SettingsSectionTitle } from "../settings/sections/components";
+import {
+  useAcceptOrganizationInvitationMutation,
+  useAddOrganizationMemberMutation,
+  useCreateOrganizationMutation,
+  useDeleteOrganizationMutation,
+  useListOrganizationMembersQuery,
+  useListOrganizationsQuery,
+  useRemoveOrganizationMemberMutation,
+  useUpdateOrganizationMemberMutation,
+  useUpdateOrganizationMutation,
+} from "../../query";
+import { queryKeys } from "../../query/keys";
+import { errorToast } from "../../libs/toasts";
+import { useAuthStore } from "../../stores/auth-store";
+import { usePromptLibraryStore } from "../../stores/prompt-library-store";
+import { Organization, OrganizationRole } from "../../pkg/gen/apiclient/organization/v1/organization_pb";
+
+const roleNames: Record<OrganizationRole, string> = {
+  [OrganizationRole.UNSPECIFIED]: "Not a member",
+  [OrganizationRole.OWNER]: "Owner",
+  [OrganizationRole.EDITOR]: "Editor",
+  [OrganizationRole.VIEWER]: "Viewer",
+};
+
+const memberRoles = {
+  [String(OrganizationRole.EDITOR)]: roleNames[OrganizationRole.EDITOR],
+  [String(OrganizationRole.VIEWER)]: roleNames[OrganizationRole.VIEWER],
+};
+
+// Organizations lists the teams of the user and their invitations. The team instructions apply to
+// every conversation of the members, before the project and the user instructions, and the team
+// prompts are in the prompt library of the members. The invited users become members, and get the
+// team instructions, only once they accept.
+export function Organizations() {
+  const queryClient = useQueryClient();
+  const { loadPrompts } = usePromptLibraryStore();
+  const [name, setName] = useState("");
+  const [selectedId, setSelectedId] = useState("");
+
+  const { data, isFetching } = useListOrganizationsQuery();
+  const organizations = data?.organizations ?? [];
+  const invitations = data?.invitations ?? [];
+  const selected = organizations.find((o) => o.id === selectedId) ?? organizations[0];
+
+  const invalidate = () =>
+    queryClient.invalidateQueries({ queryKey: queryKeys.organizations.listOrganizations().queryKey });
+
+  const createOrganization = useCreateOrganizationMutation({
+    onSuccess: (response) => {
+      setName("");
+      setSelectedId(response.organization?.id ?? "");
+      invalidate();
+    },
+    onError: () => errorToast("Failed to create the team"),
+  });
+
+  return (
+    <SettingsSectionContainer>
+      <SettingsSectionTitle>Teams</SettingsSectionTitle>
+      <div className="text-xs text-default-500 pb-2">
+        {organizations.length === 0
+          ? isFetching
+            ? "Loading..."
+            : "Create a team to share prompts and instructions with your lab or group."
+          : "Team instructions apply to all your conversations, project and user instructions take precedence over them."}
+      </div>
+
+      {invitations.map((invitation) => (
+        <Invitation
+          key={invitation.id}
+          organization={invitation}
+          onAccepted={() => {
+            setSelectedId(invitation.id);
+            invalidate();
+            loadPrompts();
+          }}
+          onDeclined={invalidate}
+        />
+      ))}
+
+      {organizations.length > 1 && (
+        <Select
+          size="sm"
+          aria-label="Team"
+          className="pb-2"
+          selectedKeys={selected ? [selected.id] : []}
+          onSelectionChange={(keys) => keys.currentKey && setSelectedId(String(keys.currentKey))}
+        >
+          {organizations.map((o) => (
+            <SelectItem key={o.id}>{o.name}</SelectItem>
+          ))}
+        </Select>
+      )}
+
+      {selected && (
+        <OrganizationDetails
+          key={selected.id}
+          organization={selected}
+          onChanged={invalidate}
+          onLeft={() => {
+            setSelectedId("");
+            invalidate();
+            loadPrompts();
+          }}
+        />
+      )}
+
+      <div className="flex flex-row gap-2 items-center mt-2">
+        <Input
+          size="sm"
+          placeholder="New team name"
+          value={name}
+          onValueChange={setName}
+          onMouseDown={(e) => e.stopPropagation()}
+        />
+        <Button
+          size="sm"
+          color="primary"
+          isDisabled={name.trim() === ""}
+          isLoading={createOrganization.isPending}
+          onPress={() => createOrganization.mutate({ name: name.trim() })}
+        >
+          Create
+        </Button>
+      </div>
+    </SettingsSectionContainer>
+  );
+}
+
+type InvitationProps = {
+  organization: Organization;
+  onAccepted: () => void;
+  onDeclined: () => void;
+};
+
+function Invitation({ organization, onAccepted, onDeclined }: InvitationProps) {
+  const { user } = useAuthStore();
+  const organizationId = organization.id;
+
+  const acceptInvitation = useAcceptOrganizationInvitationMutation({
+    onSuccess: onAccepted,
+    onError: () => errorToast("Failed to join the team"),
+  });
+  const declineInvitation = useRemoveOrganizationMemberMutation({
+    onSuccess: onDeclined,
+    onError: () => errorToast("Failed to decline the invitation"),
+  });
+  const isPending = acceptInvitation.isPending || declineInvitation.isPending;
+
+  return (
+    <div className="flex flex-row gap-2 items-center bg-content2 rounded-medium px-3 py-1 mb-2">
+      <div className="flex flex-col flex-1 min-w-0">
+        <span className="text-sm truncate">{organization.name}</span>
+        <span className="text-xs text-default-500 truncate">
+          Invited as {roleNames[organization.myRole].toLowerCase()}
+        </span>
+      </div>
+      <Button
+        size="sm"
+        variant="bordered"
+        isDisabled={isPending}
+        onPress={() => user && declineInvitation.mutate({ organizationId, userId: user.id })}
+      >
+        Decline
+      </Button>
+      <Button
+        size="sm"
+        color="primary"
+        isDisabled={isPending}
+        isLoading={acceptInvitation.isPending}
+        onPress={() => acceptInvitation.mutate({ organizationId })}
+      >
+        Join
+      </Button>
+    </div>
+  );
+}
+
+type OrganizationDetailsProps = {
+  organization: Organization;
+  onChanged: () => void;
+  onLeft: () => void;
+};
+
+function OrganizationDetails({ organization, onChanged, onLeft }: OrganizationDetailsProps) {
+  const { user } = useAuthStore();
+  const queryClient = useQueryClient();
+  const [instructions, setInstructions] = useState(organization.instructions);
+  const [email, setEmail] = useState("");
+  const [role, setRole] = useState<OrganizationRole>(OrganizationRole.EDITOR);
+
+  useEffect(() => {
+    setInstructions(organization.instructions);
+  }, [organization.instructions]);
+
+  const organizationId = organization.id;
+  const isOwner = organization.myRole === OrganizationRole.OWNER;
+  const canEdit = isOwner || organization.myRole === OrganizationRole.EDITOR;
+  const hasChanges = instructions !== organization.instructions;
+
+  const { data } = useListOrganizationMembersQuery(organizationId);
+  const members = data?.members ?? [];
+  const invalidateMembers = () =>
+    queryClient.invalidateQueries({
+      queryKey: queryKeys.organizations.listOrganizationMembers(organizationId).queryKey,
+    });
+
+  const updateOrganization = useUpdateOrganizationMutation({
+    onSuccess: onChanged,
+    onError: () => errorToast("Failed to save the team instructions"),
+  });
+  const deleteOrganization = useDeleteOrganizationMutation({
+    onSuccess: onLeft,
+    onError: () => errorToast("Failed to delete the team"),
+  });
+  const addMember = useAddOrganizationMemberMutation({
+    onSuccess: () => {
+      setEmail("");
+      invalidateMembers();
+    },
+    onError: () => errorToast("Failed to invite the member"),
+  });
+  const updateMember = useUpdateOrganizationMemberMutation({
+    onSuccess: invalidateMembers,
+    onError: () => errorToast("Failed to change the role"),
+  });
+  const removeMember = useRemoveOrganizationMemberMutation({
+    onSuccess: (_, variables) => (variables.userId === user?.id ? onLeft() : invalidateMembers()),
+    onError: () => errorToast("Failed to remove the member"),
+  });
+
+  return (
+    <div className="flex flex-col gap-2">
+      <div className="flex flex-row gap-2 items-center">
+        <span className="text-sm font-medium flex-1 truncate">{organization.name}</span>
+        <span className="text-xs text-default-500">{roleNames[organization.myRole]}</span>
+        <Tooltip content={isOwner ? "Delete team" : "Leave team"} placement="bottom" size="sm" delay={500}>
+          <button
+            className="p-1 hover:bg-default-100 rounded"
+            disabled={deleteOrganization.isPending || removeMember.isPending}
+            onClick={() =>
+              isOwner
+                ? deleteOrganization.mutate({ organizationId })
+                : user && removeMember.mutate({ organizationId, userId: user.id })
+            }
+          >
+            <Icon icon={isOwner ? "tabler:trash" : "tabler:logout"} width="16" />
+          </button>
+        </Tooltip>
+      </div>
+
+      <textarea
+        onMouseDown={(e) => e.stopPropagation()}
+        className={cn(
+          "flex-grow resize-none noselect focus:outline-none rnd-cancel px-2 py-1 border border-gray-200 rounded-md w-full",
+        )}
+        style={{ fontSize: "12px", minHeight: "50px" }}
+        placeholder="Team Level Instructions will be applied to the conversations of all the members."
+        value={instructions}
+        readOnly={!canEdit}
+        onChange={(e) => setInstructions(e.target.value)}
+      />
+      {canEdit && (
+        <div className="flex justify-end">
+          <Button
+            size="sm"
+            color={hasChanges ? "primary" : "default"}
+            variant={hasChanges ? "solid" : "bordered"}
+            isDisabled={!hasChanges || updateOrganization.isPending}
+            isLoading={updateOrganization.isPending}
+            onPress={() => updateOrganization.mutate({ organizationId, instructions })}
+          >
+            Save
+          </Button>
+        </div>
+      )}
+
+      <div className="flex flex-col gap-1">
+        {members.map((member) => (
+          <div key={member.userId} className="flex flex-row gap-2 items-center bg-content2 rounded-medium px-3 py-1">
+            <div className="flex flex-col flex-1 min-w-0">
+              <span className="text-sm truncate">{member.name || member.email || "Deleted account"}</span>
+              <span className="text-xs text-default-500 truncate">
+                {member.pending ? `${member.email}, invited` : member.email}
+              </span>
+            </div>
+            {isOwner && member.role !== OrganizationRole.OWNER ? (
+              <Select
+                size="sm"
+                className="w-28"
+                aria-label="Role"
+                selectedKeys={[String(member.role)]}
+                isDisabled={updateMember.isPending}
+                onSelectionChange={(keys) =>
+                  keys.currentKey &&
+                  updateMember.mutate({ organizationId, userId: member.userId, role: Number(keys.currentKey) })
+                }
+              >
+                {Object.entries(memberRoles).map(([key, value]) => (
+                  <SelectItem key={key}>{value}</SelectItem>
+                ))}
+              </Select>
+            ) : (
+              <span className="text-xs text-default-500">{roleNames[member.role]}</span>
+            )}
+            {isOwner && member.role !== OrganizationRole.OWNER && (
+              <Tooltip content="Remove" placement="bottom" size="sm" delay={500}>
+                <button
+                  className="p-1 hover:bg-default-100 rounded"
+                  disabled={removeMember.isPending}
+                  onClick={() => removeMember.mutate({ organizationId, userId: member.userId })}
+                >
+                  <Icon icon="tabler:user-minus" width="16" />
+                </button>
+              </Tooltip>
+            )}
+          </div>
+        ))}
+      </div>
+
+      {isOwner && (
+        <div className="flex flex-row gap-2 items-center">
+          <Input
+            size="sm"
+            placeholder="Member email"
+            value={email}
+            onValueChange={setEmail}
+            onMouseDown={(e) => e.stopPropagation()}
+          />
+          <Select
+            size="sm"
+            className="w-28"
+            aria-label="Role"
+            selectedKeys={[String(role)]}
+            onSelectionChange={(keys) => keys.currentKey && setRole(Number(keys.currentKey))}
+          >
+            {Object.entries(memberRoles).map(([key, value]) => (
+              <SelectItem key={key}>{value}</SelectItem>
+            ))}
+          </Select>
+          <Button
+            size="sm"
+            color="primary"
+            isDisabled={email.trim() === ""}
+            isLoading={addMember.isPending}
+            onPress={() => addMember.mutate({ organizationId, email: email.trim(), role })}
+          >
+            Invite
+          </Button>
+        </div>
+      )}
+    </div>
+  );
+}
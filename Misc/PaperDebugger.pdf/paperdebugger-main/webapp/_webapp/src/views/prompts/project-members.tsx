@@ -0,0 +1,148 @@
+import { useState } from "react";
+import { Button, Input, Select, SelectItem, Tooltip } from "@heroui/react";
+import { Icon } from "@iconify/react";
+import { useQueryClient } from "@tanstack/react-query";
+import { SettingsSectionContainer, SettingsSectionTitle } from "../settings/sections/components";
+import {
+  useAddProjectMemberMutation,
+  useListProjectMembersQuery,
+  useRemoveProjectMemberMutation,
+  useUpdateProjectMemberMutation,
+} from "../../query";
+import { queryKeys } from "../../query/keys";
+import { getProjectId } from "../../libs/helpers";
+import { errorToast } from "../../libs/toasts";
+import { useAuthStore } from "../../stores/auth-store";
+import { ProjectRole } from "../../pkg/gen/apiclient/project/v1/project_pb";
+
+const roleNames: Record<ProjectRole, string> = {
+  [ProjectRole.UNSPECIFIED]: "Not a member",
+  [ProjectRole.OWNER]: "Owner",
+  [ProjectRole.EDITOR]: "Editor",
+  [ProjectRole.VIEWER]: "Viewer",
+};
+
+const memberRoles = {
+  [String(ProjectRole.EDITOR)]: roleNames[ProjectRole.EDITOR],
+  [String(ProjectRole.VIEWER)]: roleNames[ProjectRole.VIEWER],
+};
+
+// ProjectMembers lists the coauthors who see the conversations shared in the project, the owner
+// adds them and changes their roles.
+export function ProjectMembers() {
+  const projectId = getProjectId();
+  const { user } = useAuthStore();
+  const queryClient = useQueryClient();
+  const [email, setEmail] = useState("");
+  const [role, setRole] = useState<ProjectRole>(ProjectRole.EDITOR);
+
+  const { data, isFetching } = useListProjectMembersQuery(projectId);
+  const invalidate = () =>
+    queryClient.invalidateQueries({ queryKey: queryKeys.projects.listProjectMembers(projectId).queryKey });
+
+  const addMember = useAddProjectMemberMutation({
+    onSuccess: () => {
+      setEmail("");
+      invalidate();
+    },
+    onError: () => errorToast("Failed to add the member"),
+  });
+  const updateMember = useUpdateProjectMemberMutation({
+    onSuccess: invalidate,
+    onError: () => errorToast("Failed to change the role"),
+  });
+  const removeMember = useRemoveProjectMemberMutation({
+    onSuccess: invalidate,
+    onError: () => errorToast("Failed to remove the member"),
+  });
+
+  const myRole = data?.myRole ?? ProjectRole.UNSPECIFIED;
+  const isOwner = myRole === ProjectRole.OWNER;
+  const members = data?.members ?? [];
+
+  return (
+    <SettingsSectionContainer>
+      <SettingsSectionTitle>Project Members</SettingsSectionTitle>
+      <div className="text-xs text-default-500 pb-2">
+        {myRole === ProjectRole.UNSPECIFIED
+          ? isFetching
+            ? "Loading..."
+            : "You are not a member of this project, ask its owner to add you to see the shared conversations."
+          : `You are ${roleNames[myRole].toLowerCase()} of this project. Members read the conversations shared in it.`}
+      </div>
+
+      <div className="flex flex-col gap-1">
+        {members.map((member) => (
+          <div key={member.userId} className="flex flex-row gap-2 items-center bg-content2 rounded-medium px-3 py-1">
+            <div className="flex flex-col flex-1 min-w-0">
+              <span className="text-sm truncate">{member.name || member.email || "Deleted account"}</span>
+              <span className="text-xs text-default-500 truncate">{member.email}</span>
+            </div>
+            {isOwner && member.role !== ProjectRole.OWNER ? (
+              <Select
+                size="sm"
+                className="w-28"
+                aria-label="Role"
+                selectedKeys={[String(member.role)]}
+                isDisabled={updateMember.isPending}
+                onSelectionChange={(keys) =>
+                  keys.currentKey &&
+                  updateMember.mutate({ projectId, userId: member.userId, role: Number(keys.currentKey) })
+                }
+              >
+                {Object.entries(memberRoles).map(([key, value]) => (
+                  <SelectItem key={key}>{value}</SelectItem>
+                ))}
+              </Select>
+            ) : (
+              <span className="text-xs text-default-500">{roleNames[member.role]}</span>
+            )}
+            {member.role !== ProjectRole.OWNER && (isOwner || member.userId === user?.id) && (
+              <Tooltip content={member.userId === user?.id ? "Leave" : "Remove"} placement="bottom" size="sm" delay={500}>
+                <button
+                  className="p-1 hover:bg-default-100 rounded"
+                  disabled={removeMember.isPending}
+                  onClick={() => removeMember.mutate({ projectId, userId: member.userId })}
+                >
+                  <Icon icon="tabler:user-minus" width="16" />
+                </button>
+              </Tooltip>
+            )}
+          </div>
+        ))}
+      </div>
+
+      {isOwner && (
+        <div className="flex flex-row gap-2 items-center mt-2">
+          <Input
+            size="sm"
+            placeholder="Coauthor email"
+            value={email}
+            onValueChange={setEmail}
+            onMouseDown={(e) => e.stopPropagation()}
+          />
+          <Select
+            size="sm"
+            className="w-28"
+            aria-label="Role"
+            selectedKeys={[String(role)]}
+            onSelectionChange={(keys) => keys.currentKey && setRole(Number(keys.currentKey))}
+          >
+            {Object.entries(memberRoles).map(([key, value]) => (
+              <SelectItem key={key}>{value}</SelectItem>
+            ))}
+          </Select>
+          <Button
+            size="sm"
+            color="primary"
+            isDisabled={email.trim() === ""}
+            isLoading={addMember.isPending}
+            onPress={() => addMember.mutate({ projectId, email: email.trim(), role })}
+          >
+            Add
+          </Button>
+        </div>
+      )}
+    </SettingsSectionContainer>
+  );
+}
@@ -0,0 +1,35 @@
+import { useQueryClient } from "@tanstack/react-query";
+import { SettingItemSelect } from "../settings/setting-item-select";
+import { useGetProjectQuery, useUpdateProjectPersonaMutation } from "../../query/index";
+import { queryKeys } from "../../query/keys";
+import { getProjectId } from "../../libs/helpers";
+import { projectPersonas } from "../chat/personas";
+
+// ProjectPersona chooses the persona of the new conversations of the project which keep the
+// "Project default" persona.
+export function ProjectPersona() {
+  const projectId = getProjectId();
+  const queryClient = useQueryClient();
+
+  const { data } = useGetProjectQuery(projectId, { enabled: !!projectId });
+  const { mutate: updateProjectPersona, isPending } = useUpdateProjectPersonaMutation({
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: queryKeys.projects.getProject(projectId).queryKey });
+    },
+  });
+
+  if (!data?.project) {
+    return null;
+  }
+
+  return (
+    <SettingItemSelect
+      label="Default Persona"
+      description="The system prompt of the new conversations in this project"
+      className="w-48"
+      selected={data.project.defaultPersona || "default"}
+      options={projectPersonas}
+      onSelectChange={(defaultPersona) => !isPending && updateProjectPersona({ projectId, defaultPersona })}
+    />
+  );
+}
@@ -1,19 +1,33 @@
 import { cn, Spinner } from "@heroui/react";
-import { useCallback, useState } from "react";
-import { Prompt, PromptSchema } from "../../pkg/gen/apiclient/user/v1/user_pb";
+import { useCallback, useMemo, useState } from "react";
+import { Prompt, PromptSchema, PromptStats } from "../../pkg/gen/apiclient/user/v1/user_pb";
 import { ChatButton } from "../chat/header/chat-button";
 import { fromJson, toJson } from "@bufbuild/protobuf";
 import { usePromptLibraryStore } from "../../stores/prompt-library-store";
+import { useGetPromptStatsQuery, useListOrganizationsQuery, useUpdatePromptPreferencesMutation } from "../../query";
+import { errorToast } from "../../libs/toasts";
+import { OrganizationRole } from "../../pkg/gen/apiclient/organization/v1/organization_pb";
 
 type PromptLibraryTableProps = {
   onDelete: (prompt: Prompt) => void;
   onUpdate: (prompt: Prompt) => void;
   onView: (prompt: Prompt) => void;
+  onShare: (prompt: Prompt) => void;
 };
 
-export function PromptLibraryTable({ onDelete, onUpdate, onView }: PromptLibraryTableProps) {
-  const { isLoading, prompts } = usePromptLibraryStore();
+// usageText tells how often the user used the prompt, and all the users for the shared ones.
+function usageText(stats: PromptStats) {
+  const text = `used ${stats.uses} time${stats.uses === 1 ? "" : "s"}`;
+  if (stats.totalUses === stats.uses) {
+    return text;
+  }
+  return `${text}, ${stats.totalUses} by ${stats.users} user${stats.users === 1 ? "" : "s"}`;
+}
+
+export function PromptLibraryTable({ onDelete, onUpdate, onView, onShare }: PromptLibraryTableProps) {
+  const { isLoading, prompts, loadPrompts } = usePromptLibraryStore();
   const [filter, setFilter] = useState("");
+  const [showHidden, setShowHidden] = useState(false);
   const searchPrompts = useCallback(
     (query: string) =>
       prompts.filter(
@@ -23,7 +37,42 @@ export function PromptLibraryTable({ onDelete, onUpdate, onView }: PromptLibrary
       ),
     [prompts],
   );
-  const filteredPrompts = searchPrompts(filter);
+  const filteredPrompts = searchPrompts(filter).filter((prompt) => showHidden || !prompt.hidden);
+  const hiddenCount = prompts.filter((prompt) => prompt.hidden).length;
+
+  // the built-in prompts are hidden or pinned by key
+  const { mutate: updatePromptPreferences, isPending: isUpdatingPreferences } = useUpdatePromptPreferencesMutation({
+    onSuccess: () => loadPrompts(),
+    onError: () => errorToast("Failed to update the prompt preferences"),
+  });
+  const toggleBuiltin = useCallback(
+    (prompt: Prompt, preference: "hidden" | "pinned") => {
+      const keys = prompts
+        .filter((p) => p.key && p[preference] && p.key !== prompt.key)
+        .map((p) => p.key)
+        .concat(prompt[preference] ? [] : [prompt.key]);
+      updatePromptPreferences(
+        preference === "hidden" ? { hiddenPrompts: { keys } } : { pinnedPrompts: { keys } },
+      );
+    },
+    [prompts, updatePromptPreferences],
+  );
+
+  // the editors of an organization change the prompts of its library
+  const { data: organizationsData } = useListOrganizationsQuery();
+  const canEdit = useCallback(
+    (prompt: Prompt) => {
+      if (prompt.isUserPrompt) {
+        return true;
+      }
+      const organization = organizationsData?.organizations.find((o) => o.id === prompt.organizationId);
+      return organization?.myRole === OrganizationRole.OWNER || organization?.myRole === OrganizationRole.EDITOR;
+    },
+    [organizationsData],
+  );
+
+  const { data: statsData } = useGetPromptStatsQuery();
+  const stats = useMemo(() => new Map((statsData?.stats ?? []).map((s) => [s.promptId, s])), [statsData]);
 
   const duplicatePrompt = useCallback((prompt: Prompt) => {
     const promptJson = toJson(PromptSchema, prompt);
@@ -55,10 +104,51 @@ export function PromptLibraryTable({ onDelete, onUpdate, onView }: PromptLibrary
             )}
           >
             <div className="flex-grow flex flex-col gap-1 min-w-0">
-              <div className="text-xs font-medium truncate">{prompt.title}</div>
+              <div className="flex flex-row gap-1 items-center min-w-0">
+                <div className={cn("text-xs font-medium truncate", prompt.hidden && "text-gray-400")}>
+                  {prompt.title}
+                </div>
+                {prompt.organizationName && (
+                  <span className="text-[10px] px-1 rounded bg-default-200 text-default-600 whitespace-nowrap">
+                    {prompt.organizationName}
+                  </span>
+                )}
+                {prompt.category && (
+                  <span className="text-[10px] px-1 rounded bg-default-100 text-default-500 whitespace-nowrap">
+                    {prompt.category}
+                  </span>
+                )}
+                {stats.has(prompt.id) && (
+                  <span className="text-[10px] text-default-400 whitespace-nowrap">
+                    {usageText(stats.get(prompt.id)!)}
+                  </span>
+                )}
+              </div>
               <div className="text-xs text-gray-500 truncate">{prompt.content}</div>
             </div>
             <div className="flex flex-row gap-1">
+              {prompt.key && (
+                <>
+                  <ChatButton
+                    icon={prompt.pinned ? "tabler:pinned-off" : "tabler:pin"}
+                    alt={prompt.pinned ? "Unpin Prompt" : "Pin Prompt"}
+                    tooltip={prompt.pinned ? "Unpin" : "Pin to the top"}
+                    noBorder
+                    disableAnimation
+                    disabled={isUpdatingPreferences}
+                    onClick={() => toggleBuiltin(prompt, "pinned")}
+                  />
+                  <ChatButton
+                    icon={prompt.hidden ? "tabler:restore" : "tabler:eye-off"}
+                    alt={prompt.hidden ? "Show Prompt" : "Hide Prompt"}
+                    tooltip={prompt.hidden ? "Show again" : "Hide"}
+                    noBorder
+                    disableAnimation
+                    disabled={isUpdatingPreferences}
+                    onClick={() => toggleBuiltin(prompt, "hidden")}
+                  />
+                </>
+              )}
               <ChatButton
                 icon="tabler:eye"
                 alt="View Prompt"
@@ -66,10 +156,20 @@ export function PromptLibraryTable({ onDelete, onUpdate, onView }: PromptLibrary
                 noBorder
                 disableAnimation
                 onClick={() =>
-                  prompt.isUserPrompt ? onUpdate(duplicatePrompt(prompt)) : onView(duplicatePrompt(prompt))
+                  canEdit(prompt) ? onUpdate(duplicatePrompt(prompt)) : onView(duplicatePrompt(prompt))
                 }
               />
-              {prompt.isUserPrompt && (
+              {!prompt.key && canEdit(prompt) && (
+                <ChatButton
+                  icon="tabler:share"
+                  alt="Share Prompt"
+                  tooltip="Share"
+                  noBorder
+                  disableAnimation
+                  onClick={() => onShare(prompt)}
+                />
+              )}
+              {canEdit(prompt) && (
                 <ChatButton
                   icon="tabler:trash"
                   variant="danger"
@@ -84,6 +184,11 @@ export function PromptLibraryTable({ onDelete, onUpdate, onView }: PromptLibrary
           </div>
         ))}
       </div>
+      {hiddenCount > 0 && (
+        <button className="text-xs text-gray-500 hover:underline self-center" onClick={() => setShowHidden(!showHidden)}>
+          {showHidden ? "Hide the hidden prompts" : `Show ${hiddenCount} hidden built-in prompt${hiddenCount > 1 ? "s" : ""}`}
+        </button>
+      )}
     </div>
   );
 }
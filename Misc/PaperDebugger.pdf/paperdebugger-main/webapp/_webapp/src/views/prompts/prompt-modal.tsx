@@ -1,9 +1,18 @@
-import { Button, Input, Textarea } from "@heroui/react";
-import { useCreatePromptMutation, useDeletePromptMutation, useUpdatePromptMutation } from "../../query";
+import { Button, Input, Select, SelectItem, Textarea } from "@heroui/react";
+import {
+  useCreatePromptMutation,
+  useDeletePromptMutation,
+  useListOrganizationsQuery,
+  useUpdatePromptMutation,
+} from "../../query";
 import { useCallback, useEffect, useMemo, useState } from "react";
-import { Prompt } from "../../pkg/gen/apiclient/user/v1/user_pb";
+import { Prompt, PromptVariableType } from "../../pkg/gen/apiclient/user/v1/user_pb";
 import { Modal } from "../../components/modal";
 import { usePromptLibraryStore } from "../../stores/prompt-library-store";
+import { useQueryClient } from "@tanstack/react-query";
+import { queryKeys } from "../../query/keys";
+import { OrganizationRole } from "../../pkg/gen/apiclient/organization/v1/organization_pb";
+import { declaredVariables, PromptVariableDraft, PromptVariablesEditor } from "./prompt-variables-editor";
 
 type PromptModalProps = {
   mode: "create" | "update" | "view" | "delete";
@@ -15,14 +24,27 @@ type PromptModalProps = {
 
 export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: PromptModalProps) => {
   const { loadPrompts } = usePromptLibraryStore();
+  const queryClient = useQueryClient();
   const [title, setTitle] = useState("");
   const [content, setContent] = useState("");
+  const [organizationId, setOrganizationId] = useState("");
+  const [variables, setVariables] = useState<Record<string, PromptVariableDraft>>({});
   const [isSubmitting, setIsSubmitting] = useState(false);
 
   const { mutateAsync: createPrompt } = useCreatePromptMutation();
   const { mutateAsync: updatePrompt } = useUpdatePromptMutation();
   const { mutateAsync: deletePrompt } = useDeletePromptMutation();
 
+  // new prompts go to the user's library or to the library of an organization they edit
+  const { data: organizationsData } = useListOrganizationsQuery();
+  const editableOrganizations = useMemo(
+    () =>
+      (organizationsData?.organizations ?? []).filter(
+        (o) => o.myRole === OrganizationRole.OWNER || o.myRole === OrganizationRole.EDITOR,
+      ),
+    [organizationsData],
+  );
+
   const modalTitle = useMemo(() => {
     if (mode == "create") {
       return "Create Prompt";
@@ -39,20 +61,39 @@ export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: Pro
   useEffect(() => {
     setTitle(prompt?.title || "");
     setContent(prompt?.content || "");
+    setVariables(Object.fromEntries((prompt?.variables ?? []).map((v) => [v.name, v])));
   }, [prompt]);
 
+  // the placeholders of a new prompt are variables, the ones added to an existing prompt are not
+  // until typed, the double braces of LaTeX in the existing prompts stay as they are
+  const newVariableType = mode == "create" ? PromptVariableType.TEXT : PromptVariableType.UNSPECIFIED;
+
   const handleCreate = useCallback(async () => {
     setIsSubmitting(true);
     await createPrompt({
       title,
       content,
+      organizationId: organizationId || undefined,
+      variables: declaredVariables(content, variables, newVariableType),
     });
     await loadPrompts();
     setIsSubmitting(false);
     onClose?.();
     setTitle("");
     setContent("");
-  }, [createPrompt, onClose, title, content, loadPrompts, setIsSubmitting]);
+    setOrganizationId("");
+    setVariables({});
+  }, [
+    createPrompt,
+    onClose,
+    title,
+    content,
+    organizationId,
+    variables,
+    newVariableType,
+    loadPrompts,
+    setIsSubmitting,
+  ]);
 
   const handleUpdate = useCallback(async () => {
     setIsSubmitting(true);
@@ -60,11 +101,12 @@ export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: Pro
       promptId: prompt?.id || "",
       title,
       content,
+      variables: declaredVariables(content, variables, newVariableType),
     });
     await loadPrompts();
     setIsSubmitting(false);
     onClose?.();
-  }, [updatePrompt, onClose, prompt?.id, title, content, loadPrompts, setIsSubmitting]);
+  }, [updatePrompt, onClose, prompt?.id, title, content, variables, newVariableType, loadPrompts, setIsSubmitting]);
 
   const handleDelete = useCallback(async () => {
     setIsSubmitting(true);
@@ -72,9 +114,10 @@ export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: Pro
       promptId: prompt?.id || "",
     });
     await loadPrompts();
+    queryClient.invalidateQueries({ queryKey: queryKeys.prompts.listDeletedPrompts().queryKey });
     setIsSubmitting(false);
     onClose?.();
-  }, [deletePrompt, onClose, prompt?.id, loadPrompts, setIsSubmitting]);
+  }, [deletePrompt, onClose, prompt?.id, loadPrompts, queryClient, setIsSubmitting]);
 
   return (
     <Modal
@@ -125,6 +168,24 @@ export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: Pro
       }
     >
       <div className="w-full flex flex-col gap-2">
+        {mode == "create" && editableOrganizations.length > 0 && (
+          <Select
+            label="Library"
+            variant="bordered"
+            color="primary"
+            radius="sm"
+            size="sm"
+            selectedKeys={[organizationId || "@self"]}
+            onSelectionChange={(keys) =>
+              setOrganizationId(keys.currentKey && keys.currentKey !== "@self" ? String(keys.currentKey) : "")
+            }
+          >
+            {[
+              <SelectItem key="@self">My prompts</SelectItem>,
+              ...editableOrganizations.map((o) => <SelectItem key={o.id}>{o.name}</SelectItem>),
+            ]}
+          </Select>
+        )}
         <Input
           label="Title"
           variant="bordered"
@@ -154,6 +215,13 @@ export const PromptModal = ({ mode, prompt, isOpen, onOpenChange, onClose }: Pro
           }}
           isReadOnly={mode == "view" || mode == "delete"}
         />
+        <PromptVariablesEditor
+          content={content}
+          drafts={variables}
+          newType={newVariableType}
+          onChange={setVariables}
+          isReadOnly={mode == "view" || mode == "delete"}
+        />
       </div>
     </Modal>
   );
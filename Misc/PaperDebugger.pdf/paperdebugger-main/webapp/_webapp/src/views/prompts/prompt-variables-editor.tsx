@@ -0,0 +1,136 @@
+import { useMemo } from "react";
+import { Checkbox, Input, Select, SelectItem } from "@heroui/react";
+import { PromptVariable, PromptVariableType } from "../../pkg/gen/apiclient/user/v1/user_pb";
+import { PlainMessage } from "../../query/types";
+
+export type PromptVariableDraft = PlainMessage<PromptVariable>;
+
+const typeNames = {
+  [String(PromptVariableType.UNSPECIFIED)]: "Not a variable",
+  [String(PromptVariableType.TEXT)]: "Text",
+  [String(PromptVariableType.NUMBER)]: "Number",
+  [String(PromptVariableType.CHOICE)]: "Choice",
+  [String(PromptVariableType.SELECTED_TEXT)]: "Selected text",
+};
+
+// same as the server, the placeholders are {{name}} or {{ name }}
+const placeholderPattern = /\{\{\s*([a-z][a-z0-9_]*)\s*\}\}/g;
+
+export function placeholderNames(content: string) {
+  return [...new Set([...content.matchAll(placeholderPattern)].map((match) => match[1]))];
+}
+
+// declaredVariables returns the variables of the placeholders of the content, the placeholders
+// without a draft get newType. The ones which are not variables are left out.
+export function declaredVariables(
+  content: string,
+  drafts: Record<string, PromptVariableDraft>,
+  newType: PromptVariableType,
+): PromptVariableDraft[] {
+  return placeholderNames(content)
+    .map((name) => drafts[name] ?? newDraft(name, newType))
+    .filter((variable) => variable.type !== PromptVariableType.UNSPECIFIED);
+}
+
+function newDraft(name: string, type: PromptVariableType): PromptVariableDraft {
+  return {
+    name,
+    type,
+    description: "",
+    defaultValue: "",
+    options: [],
+    required: type !== PromptVariableType.UNSPECIFIED,
+  };
+}
+
+type PromptVariablesEditorProps = {
+  content: string;
+  drafts: Record<string, PromptVariableDraft>;
+  newType: PromptVariableType;
+  onChange: (drafts: Record<string, PromptVariableDraft>) => void;
+  isReadOnly?: boolean;
+};
+
+// PromptVariablesEditor types the {{name}} placeholders of a prompt, a placeholder set to "Not a
+// variable" is kept as it is, e.g. the double braces of LaTeX.
+export function PromptVariablesEditor({ content, drafts, newType, onChange, isReadOnly }: PromptVariablesEditorProps) {
+  const names = useMemo(() => placeholderNames(content), [content]);
+  if (names.length === 0) {
+    return null;
+  }
+
+  const update = (name: string, change: Partial<PromptVariableDraft>) =>
+    onChange({ ...drafts, [name]: { ...(drafts[name] ?? newDraft(name, newType)), ...change } });
+
+  return (
+    <div className="flex flex-col gap-2">
+      <div className="text-xs text-default-500">Variables, filled when the prompt is used</div>
+      {names.map((name) => {
+        const variable = drafts[name] ?? newDraft(name, newType);
+        const isVariable = variable.type !== PromptVariableType.UNSPECIFIED;
+        return (
+          <div key={name} className="flex flex-col gap-1 border border-gray-200 rounded-md p-2">
+            <div className="flex flex-row gap-2 items-center">
+              <span className="text-xs font-mono flex-1 truncate">{`{{${name}}}`}</span>
+              <Select
+                size="sm"
+                className="w-36"
+                aria-label="Type"
+                isDisabled={isReadOnly}
+                selectedKeys={[String(variable.type)]}
+                onSelectionChange={(keys) =>
+                  keys.currentKey && update(name, { type: Number(keys.currentKey), options: [] })
+                }
+              >
+                {Object.entries(typeNames).map(([key, value]) => (
+                  <SelectItem key={key}>{value}</SelectItem>
+                ))}
+              </Select>
+              {isVariable && (
+                <Checkbox
+                  size="sm"
+                  isDisabled={isReadOnly}
+                  isSelected={variable.required}
+                  onValueChange={(required) => update(name, { required })}
+                >
+                  <span className="text-xs">Required</span>
+                </Checkbox>
+              )}
+            </div>
+            {isVariable && variable.type !== PromptVariableType.SELECTED_TEXT && (
+              <div className="flex flex-row gap-2">
+                {variable.type === PromptVariableType.CHOICE && (
+                  <Input
+                    size="sm"
+                    placeholder="Options, comma separated"
+                    isReadOnly={isReadOnly}
+                    value={variable.options.join(",")}
+                    onValueChange={(value) => update(name, { options: value.split(",").map((o) => o.trim()) })}
+                    onBlur={() => update(name, { options: variable.options.filter((o) => o !== "") })}
+                    onMouseDown={(e) => e.stopPropagation()}
+                  />
+                )}
+                <Input
+                  size="sm"
+                  placeholder="Default"
+                  isReadOnly={isReadOnly}
+                  value={variable.defaultValue}
+                  onValueChange={(defaultValue) => update(name, { defaultValue })}
+                  onMouseDown={(e) => e.stopPropagation()}
+                />
+                <Input
+                  size="sm"
+                  placeholder="Description"
+                  isReadOnly={isReadOnly}
+                  value={variable.description}
+                  onValueChange={(description) => update(name, { description })}
+                  onMouseDown={(e) => e.stopPropagation()}
+                />
+              </div>
+            )}
+          </div>
+        );
+      })}
+    </div>
+  );
+}
@@ -0,0 +1,93 @@
+import { useEffect, useState } from "react";
+import { Button, Input } from "@heroui/react";
+import { Modal } from "../../components/modal";
+import { Prompt, SharePromptResponse } from "../../pkg/gen/apiclient/user/v1/user_pb";
+import { useRevokePromptShareMutation, useSharePromptMutation } from "../../query";
+import { successToast } from "../../libs/toasts";
+import { ChatButton } from "../chat/header/chat-button";
+
+type SharePromptModalProps = {
+  prompt?: Prompt;
+  onClose: () => void;
+};
+
+// SharePromptModal shows the share token of a prompt, colleagues paste it in their prompt library
+// to import a copy of the prompt.
+export function SharePromptModal({ prompt, onClose }: SharePromptModalProps) {
+  const [share, setShare] = useState<SharePromptResponse | undefined>();
+
+  // the token is created on the first share, the next ones return the same token
+  const { mutate: sharePrompt, isPending } = useSharePromptMutation({ onSuccess: setShare });
+  const { mutate: revokePromptShare, isPending: isRevoking } = useRevokePromptShareMutation({
+    onSuccess: () => {
+      successToast("The token no longer imports the prompt", "Sharing stopped");
+      onClose();
+    },
+  });
+
+  useEffect(() => {
+    setShare(undefined);
+    if (prompt) {
+      sharePrompt({ promptId: prompt.id });
+    }
+  }, [prompt, sharePrompt]);
+
+  return (
+    <Modal
+      isOpen={!!prompt}
+      onOpenChange={(open) => !open && onClose()}
+      header={<>Share {prompt?.title}</>}
+      footer={
+        <>
+          <Button size="sm" variant="flat" onPress={onClose}>
+            Close
+          </Button>
+          <Button
+            size="sm"
+            color="danger"
+            isLoading={isRevoking}
+            isDisabled={!share || isRevoking}
+            onPress={() => prompt && revokePromptShare({ promptId: prompt.id })}
+          >
+            Stop Sharing
+          </Button>
+        </>
+      }
+    >
+      <div className="w-full flex flex-col gap-2">
+        <div className="text-xs text-default-500">
+          Anyone with this token can import a copy of the prompt into their library. Later changes to the prompt are
+          not applied to the copies.
+        </div>
+        <div className="flex flex-row gap-2 items-center">
+          <Input
+            size="sm"
+            aria-label="Share token"
+            isReadOnly
+            value={share?.token ?? (isPending ? "Loading..." : "")}
+            onMouseDown={(e) => e.stopPropagation()}
+          />
+          <ChatButton
+            icon="tabler:copy"
+            alt="Copy Token"
+            tooltip="Copy"
+            noBorder
+            disableAnimation
+            disabled={!share}
+            onClick={() => {
+              if (share) {
+                navigator.clipboard.writeText(share.token);
+                successToast("Send it to your colleagues", "Token copied");
+              }
+            }}
+          />
+        </div>
+        {share && share.imports > 0 && (
+          <div className="text-xs text-default-500">
+            Imported {share.imports} time{share.imports > 1 ? "s" : ""}
+          </div>
+        )}
+      </div>
+    </Modal>
+  );
+}
@@ -1,16 +1,50 @@
-import { Button, cn } from "@heroui/react";
+import { Button, cn, Input } from "@heroui/react";
 import { SettingsSectionContainer, SettingsSectionTitle } from "./components";
 import CellWrapper from "../../../components/cell-wrapper";
 import { useSettingStore } from "../../../stores/setting-store";
 import { useAuthStore } from "../../../stores/auth-store";
 import { useEffect, useState } from "react";
 import { getCookies } from "../../../intermediate";
+import { deleteAccount, exportMyData } from "../../../query/api";
+import { downloadFile } from "../../../libs/helpers";
+import { errorToast } from "../../../libs/toasts";
+import { logError } from "../../../libs/logger";
 
 export const AccountSettings = () => {
   const { updateSettings } = useSettingStore();
   const { logout, user } = useAuthStore();
   const [overleafSession, setOverleafSession] = useState("");
   const [gclb, setGclb] = useState("");
+  const [isExporting, setIsExporting] = useState(false);
+  const [confirmingDeletion, setConfirmingDeletion] = useState(false);
+  const [confirmEmail, setConfirmEmail] = useState("");
+  const [isDeleting, setIsDeleting] = useState(false);
+
+  const handleExport = async () => {
+    setIsExporting(true);
+    try {
+      const { filename, blob } = await exportMyData();
+      downloadFile(filename, blob.type, blob);
+    } catch (e) {
+      errorToast("Failed to export your data");
+      logError(e);
+    } finally {
+      setIsExporting(false);
+    }
+  };
+
+  const handleDeleteAccount = async () => {
+    setIsDeleting(true);
+    try {
+      await deleteAccount({ confirmEmail });
+      await logout();
+    } catch (e) {
+      errorToast("Failed to delete your account");
+      logError(e);
+    } finally {
+      setIsDeleting(false);
+    }
+  };
 
   useEffect(() => {
     getCookies(window.location.hostname).then((cookies) => {
@@ -73,6 +107,48 @@ export const AccountSettings = () => {
           Log out
         </Button>
       </CellWrapper>
+      <CellWrapper>
+        <div className="flex flex-col">
+          <div className="text-sm">Export my data</div>
+          <div className="text-xs text-default-500">Download your prompts, projects and conversations as JSON</div>
+        </div>
+        <Button size="sm" color="primary" radius="full" variant="flat" isLoading={isExporting} onPress={handleExport}>
+          Export
+        </Button>
+      </CellWrapper>
+      <CellWrapper>
+        <div className="flex flex-col flex-1">
+          <div className="text-sm">Delete account</div>
+          <div className="text-xs text-default-500">
+            Your account and all its data are deleted after a grace period, logging in again cancels it
+          </div>
+          {confirmingDeletion && (
+            <Input
+              size="sm"
+              className="mt-2"
+              placeholder={`Type ${user?.email ?? "your email"} to confirm`}
+              value={confirmEmail}
+              onValueChange={setConfirmEmail}
+            />
+          )}
+        </div>
+        {confirmingDeletion ? (
+          <Button
+            size="sm"
+            color="danger"
+            radius="full"
+            isLoading={isDeleting}
+            isDisabled={confirmEmail.trim().toLowerCase() !== user?.email.toLowerCase()}
+            onPress={handleDeleteAccount}
+          >
+            Delete
+          </Button>
+        ) : (
+          <Button size="sm" color="danger" radius="full" variant="flat" onPress={() => setConfirmingDeletion(true)}>
+            Delete
+          </Button>
+        )}
+      </CellWrapper>
     </SettingsSectionContainer>
   );
 };
@@ -3,7 +3,7 @@ import { useSettingStore } from "../../../stores/setting-store";
 import { SettingItem } from "../setting-items";
 
 export const BetaFeatureSettings = () => {
-  const { updateSettings, isUpdating, settings } = useSettingStore();
+  const { updateSettings, isUpdating, settings, structuredRevisions, setStructuredRevisions } = useSettingStore();
 
   return (
     <SettingsSectionContainer>
@@ -15,6 +15,12 @@ export const BetaFeatureSettings = () => {
         selected={settings?.enableCompletion ?? false}
         onSelectChange={(selected) => updateSettings({ enableCompletion: selected })}
       />
+      <SettingItem
+        label="Structured revisions"
+        description="Answer messages about selected text with a revision to compare and insert"
+        selected={structuredRevisions}
+        onSelectChange={setStructuredRevisions}
+      />
       <SettingItem
         hidden
         label="Enable full document RAG"